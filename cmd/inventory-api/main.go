@@ -25,6 +25,9 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	// Size the runtime to the container before anything starts
+	tuneRuntime(cfg.Runtime)
+
 	// Create server
 	srv, err := server.NewServer(cfg)
 	if err != nil {
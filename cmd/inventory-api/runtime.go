@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"go.uber.org/automaxprocs/maxprocs"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// cgroup files holding the container memory limit
+const (
+	cgroupV2MemoryMax   = "/sys/fs/cgroup/memory.max"
+	cgroupV1MemoryLimit = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
+)
+
+// tuneRuntime sizes GOMAXPROCS to the container CPU quota, so the service isn't throttled
+// by running more threads than its quota, and GOMEMLIMIT to a share of the container
+// memory limit, so the GC works harder before the container is OOM-killed. GOMAXPROCS
+// and GOMEMLIMIT environment variables take precedence over both.
+func tuneRuntime(cfg appconfig.RuntimeConfig) {
+	switch {
+	case os.Getenv("GOMAXPROCS") != "":
+		// Applied by the runtime already
+	case cfg.MaxProcs > 0:
+		runtime.GOMAXPROCS(cfg.MaxProcs)
+	default:
+		logf := func(format string, args ...interface{}) { fmt.Printf(format+"\n", args...) }
+		if _, err := maxprocs.Set(maxprocs.Logger(logf)); err != nil {
+			fmt.Printf("Warning: failed to set GOMAXPROCS from the CPU quota: %v\n", err)
+		}
+	}
+
+	if os.Getenv("GOMEMLIMIT") != "" {
+		return
+	}
+	limit := cfg.MemoryLimit
+	if limit <= 0 {
+		containerLimit, err := cgroupMemoryLimit()
+		if err != nil {
+			fmt.Printf("Warning: failed to read the container memory limit: %v\n", err)
+			return
+		}
+		if containerLimit == 0 {
+			return
+		}
+		limit = int64(float64(containerLimit) * cfg.MemoryLimitRatio)
+	}
+	debug.SetMemoryLimit(limit)
+	fmt.Printf("GOMEMLIMIT set to %d bytes\n", limit)
+}
+
+// cgroupMemoryLimit returns the memory limit of the container in bytes, or 0 if it has none
+func cgroupMemoryLimit() (int64, error) {
+	if data, err := os.ReadFile(cgroupV2MemoryMax); err == nil {
+		value := strings.TrimSpace(string(data))
+		if value == "max" {
+			return 0, nil
+		}
+		return strconv.ParseInt(value, 10, 64)
+	}
+
+	data, err := os.ReadFile(cgroupV1MemoryLimit)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	limit, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	// Unlimited v1 cgroups report a page-aligned maximum value
+	if limit >= 1<<62 {
+		return 0, nil
+	}
+	return limit, nil
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/traffictacos/inventory-api/internal/recording"
+	_ "github.com/traffictacos/inventory-api/proto"    // registers the recorded request types
+	_ "github.com/traffictacos/inventory-api/proto/v2" // and the v2 service
+)
+
+// inventory-replay re-drives recorded RPCs against a deployment, keeping their original
+// spacing (scaled by -speed), and reports how the outcomes compare with the recording.
+func main() {
+	bucket := flag.String("bucket", "", "S3 bucket holding the recordings")
+	prefix := flag.String("prefix", "recordings/", "S3 prefix of the recordings to replay, e.g. recordings/2026/10/16/")
+	file := flag.String("file", "", "local recording object to replay instead of S3")
+	target := flag.String("target", "localhost:8080", "gRPC address of the deployment to replay against")
+	speed := flag.Float64("speed", 1, "replay speed; 2 replays twice as fast as recorded")
+	maxInFlight := flag.Int("max-in-flight", 1000, "maximum concurrent replayed requests")
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout of each replayed request")
+	flag.Parse()
+
+	if *speed <= 0 {
+		log.Fatalf("-speed must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	records, err := loadRecords(ctx, *bucket, *prefix, *file)
+	if err != nil {
+		log.Fatalf("Failed to load recordings: %v", err)
+	}
+	if len(records) == 0 {
+		log.Fatalf("No recorded requests found")
+	}
+
+	conn, err := grpc.NewClient(*target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to %s: %v", *target, err)
+	}
+	defer conn.Close()
+
+	fmt.Printf("Replaying %d requests recorded over %v against %s at %gx\n",
+		len(records), records[len(records)-1].Time.Sub(records[0].Time), *target, *speed)
+
+	report := replay(ctx, conn, records, *speed, *maxInFlight, *timeout)
+	report.print(os.Stdout)
+}
+
+// loadRecords reads the records of a local recording object, or of every object under an S3 prefix
+func loadRecords(ctx context.Context, bucket, prefix, file string) ([]recording.Record, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return recording.ReadRecords(f)
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("-bucket or -file is required")
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return recording.LoadRecords(ctx, s3.NewFromConfig(awsCfg), bucket, prefix)
+}
+
+// replayReport aggregates the outcomes of replayed requests
+type replayReport struct {
+	mu       sync.Mutex
+	sent     int
+	skipped  int
+	late     int
+	codes    map[string]int
+	diverged map[string]int
+	latency  map[string][]time.Duration
+}
+
+// replay sends every record at its recorded offset from the first one, divided by speed
+func replay(ctx context.Context, conn *grpc.ClientConn, records []recording.Record, speed float64, maxInFlight int, timeout time.Duration) *replayReport {
+	report := &replayReport{
+		codes:    make(map[string]int),
+		diverged: make(map[string]int),
+		latency:  make(map[string][]time.Duration),
+	}
+	slots := make(chan struct{}, max(maxInFlight, 1))
+	var wg sync.WaitGroup
+
+	start := time.Now()
+	for _, record := range records {
+		req, resp, err := newMessages(record)
+		if err != nil {
+			fmt.Printf("Warning: skipping %s: %v\n", record.Method, err)
+			report.skip()
+			continue
+		}
+
+		at := start.Add(time.Duration(float64(record.Time.Sub(records[0].Time)) / speed))
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return report
+		case <-time.After(time.Until(at)):
+		}
+
+		select {
+		case slots <- struct{}{}:
+		default:
+			// Every slot is busy: the target is slower than the recording, so this request goes out late
+			report.lateSend()
+			slots <- struct{}{}
+		}
+
+		wg.Add(1)
+		go func(record recording.Record) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			sent := time.Now()
+			err := conn.Invoke(callCtx, record.Method, req, resp)
+			report.observe(record, status.Code(err).String(), time.Since(sent))
+		}(record)
+	}
+
+	wg.Wait()
+	return report
+}
+
+// newMessages decodes a recorded request and allocates the response of its method
+func newMessages(record recording.Record) (protobuf.Message, protobuf.Message, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(record.Method, "/"), "/")
+	if !ok {
+		return nil, nil, fmt.Errorf("malformed method name")
+	}
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown service: %w", err)
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a service", service)
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(method))
+	if methodDescriptor == nil || methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		return nil, nil, fmt.Errorf("unknown unary method")
+	}
+
+	reqType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	respType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := reqType.New().Interface()
+	if err := protojson.Unmarshal(record.Request, req); err != nil {
+		return nil, nil, fmt.Errorf("malformed request: %w", err)
+	}
+	return req, respType.New().Interface(), nil
+}
+
+// observe records the outcome of a replayed request
+func (r *replayReport) observe(record recording.Record, code string, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sent++
+	r.codes[code]++
+	if code != record.Code {
+		r.diverged[record.Code+" -> "+code]++
+	}
+	r.latency[record.Method] = append(r.latency[record.Method], latency)
+}
+
+// skip counts a record that couldn't be replayed
+func (r *replayReport) skip() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.skipped++
+}
+
+// lateSend counts a request held back because every slot was busy
+func (r *replayReport) lateSend() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.late++
+}
+
+// print writes the report
+func (r *replayReport) print(w *os.File) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fmt.Fprintf(w, "Sent %d requests (%d skipped, %d sent late)\n", r.sent, r.skipped, r.late)
+	for _, code := range sortedKeys(r.codes) {
+		fmt.Fprintf(w, "  %-20s %d\n", code, r.codes[code])
+	}
+	if len(r.diverged) > 0 {
+		fmt.Fprintln(w, "Outcomes different from the recording:")
+		for _, change := range sortedKeys(r.diverged) {
+			fmt.Fprintf(w, "  %-40s %d\n", change, r.diverged[change])
+		}
+	}
+	fmt.Fprintln(w, "Latency (p50 / p99):")
+	for _, method := range sortedKeys(r.latency) {
+		latencies := r.latency[method]
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		fmt.Fprintf(w, "  %-55s %v / %v\n", method, latencies[len(latencies)/2], latencies[len(latencies)*99/100])
+	}
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
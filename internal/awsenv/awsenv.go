@@ -0,0 +1,33 @@
+// Package awsenv loads the AWS SDK configuration shared by every AWS integration, so a
+// single switch can point all of them at LocalStack for local development
+package awsenv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// Load loads the default AWS configuration with optFns. With LOCALSTACK_ENABLED every
+// client built from it calls the LocalStack endpoint with LocalStack's static test
+// credentials instead.
+func Load(ctx context.Context, cfg *appconfig.Config, optFns ...func(*awsconfig.LoadOptions) error) (aws.Config, error) {
+	if cfg.LocalStack.Enabled {
+		optFns = append(optFns,
+			awsconfig.WithRegion(cfg.AWS.Region),
+			awsconfig.WithBaseEndpoint(cfg.LocalStack.Endpoint),
+			awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("test", "test", "")),
+		)
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return awsCfg, nil
+}
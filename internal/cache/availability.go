@@ -0,0 +1,183 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// addIfExistsScript adjusts a counter only if it is cached, so a missing key is never
+// recreated from a delta alone
+var addIfExistsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+return false
+`)
+
+// AvailabilityCounter caches event availability in Redis.
+// DynamoDB stays the source of truth: the counter is updated after every successful
+// write, invalidated when such an update fails, and periodically reconciled.
+//
+// Keys:
+//
+//	<prefix>remaining:<event_id>  remaining quantity of a quantity event
+//	<prefix>seats:<event_id>      hash of seat_id -> status for a seat event
+//	<prefix>events                set of event IDs with cached state
+type AvailabilityCounter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewAvailabilityCounter creates a Redis availability counter
+func NewAvailabilityCounter(cfg *appconfig.Config) *AvailabilityCounter {
+	return &AvailabilityCounter{
+		client: newRedisClient(cfg),
+		prefix: cfg.Redis.KeyPrefix,
+	}
+}
+
+// newRedisClient creates a client of the configured Redis instance
+func newRedisClient(cfg *appconfig.Config) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:         cfg.Redis.Addr,
+		Password:     cfg.Redis.Password,
+		DB:           cfg.Redis.DB,
+		DialTimeout:  cfg.Redis.Timeout,
+		ReadTimeout:  cfg.Redis.Timeout,
+		WriteTimeout: cfg.Redis.Timeout,
+	})
+}
+
+// Close closes the Redis client
+func (c *AvailabilityCounter) Close() error {
+	return c.client.Close()
+}
+
+// Ping checks that Redis responds
+func (c *AvailabilityCounter) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// Remaining returns the cached remaining quantity of an event; ok is false on a cache miss
+func (c *AvailabilityCounter) Remaining(ctx context.Context, eventID string) (remaining int32, ok bool, err error) {
+	value, err := c.client.Get(ctx, c.remainingKey(eventID)).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get cached remaining: %w", err)
+	}
+	return int32(value), true, nil
+}
+
+// SetRemaining caches the remaining quantity of an event
+func (c *AvailabilityCounter) SetRemaining(ctx context.Context, eventID string, remaining int32) error {
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, c.remainingKey(eventID), remaining, 0)
+	pipe.SAdd(ctx, c.eventsKey(), eventID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to cache remaining: %w", err)
+	}
+	return nil
+}
+
+// AddRemaining adjusts the cached remaining quantity of an event by delta, if it is cached
+func (c *AvailabilityCounter) AddRemaining(ctx context.Context, eventID string, delta int32) error {
+	err := addIfExistsScript.Run(ctx, c.client, []string{c.remainingKey(eventID)}, delta).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to adjust cached remaining: %w", err)
+	}
+	return nil
+}
+
+// SeatStatuses returns the cached statuses of the given seats; ok is false if any seat is not cached
+func (c *AvailabilityCounter) SeatStatuses(ctx context.Context, eventID string, seatIDs []string) (statuses map[string]string, ok bool, err error) {
+	values, err := c.client.HMGet(ctx, c.seatsKey(eventID), seatIDs...).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get cached seat statuses: %w", err)
+	}
+
+	statuses = make(map[string]string, len(seatIDs))
+	for i, value := range values {
+		status, isString := value.(string)
+		if !isString {
+			return nil, false, nil
+		}
+		statuses[seatIDs[i]] = status
+	}
+	return statuses, true, nil
+}
+
+// SetSeatStatuses caches the statuses of the given seats
+func (c *AvailabilityCounter) SetSeatStatuses(ctx context.Context, eventID string, statuses map[string]string) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, c.seatsKey(eventID), statuses)
+	pipe.SAdd(ctx, c.eventsKey(), eventID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to cache seat statuses: %w", err)
+	}
+	return nil
+}
+
+// ReplaceSeatStatuses atomically replaces all cached seat statuses of an event
+func (c *AvailabilityCounter) ReplaceSeatStatuses(ctx context.Context, eventID string, statuses map[string]string) error {
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, c.seatsKey(eventID))
+	if len(statuses) > 0 {
+		pipe.HSet(ctx, c.seatsKey(eventID), statuses)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to replace cached seat statuses: %w", err)
+	}
+	return nil
+}
+
+// HasSeats reports whether seat statuses are cached for an event
+func (c *AvailabilityCounter) HasSeats(ctx context.Context, eventID string) (bool, error) {
+	n, err := c.client.Exists(ctx, c.seatsKey(eventID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check cached seats: %w", err)
+	}
+	return n > 0, nil
+}
+
+// Invalidate drops all cached state of an event so reads fall back to DynamoDB
+func (c *AvailabilityCounter) Invalidate(ctx context.Context, eventID string) error {
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, c.remainingKey(eventID), c.seatsKey(eventID))
+	pipe.SRem(ctx, c.eventsKey(), eventID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to invalidate cached availability: %w", err)
+	}
+	return nil
+}
+
+// Events returns the IDs of events with cached state
+func (c *AvailabilityCounter) Events(ctx context.Context) ([]string, error) {
+	events, err := c.client.SMembers(ctx, c.eventsKey()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cached events: %w", err)
+	}
+	return events, nil
+}
+
+func (c *AvailabilityCounter) remainingKey(eventID string) string {
+	return c.prefix + "remaining:" + eventID
+}
+
+func (c *AvailabilityCounter) seatsKey(eventID string) string {
+	return c.prefix + "seats:" + eventID
+}
+
+func (c *AvailabilityCounter) eventsKey() string {
+	return c.prefix + "events"
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// addWindowScript adjusts a window counter, expiring it with its window when it is created
+var addWindowScript = redis.NewScript(`
+local value = redis.call("INCRBY", KEYS[1], ARGV[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return value
+`)
+
+// QuotaCounter counts quota usage in fixed windows in Redis.
+//
+// Keys:
+//
+//	<prefix>quota:<kind>:<subject>:<window_start>  usage of a subject in a window (unix seconds)
+type QuotaCounter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewQuotaCounter creates a Redis quota counter
+func NewQuotaCounter(cfg *appconfig.Config) *QuotaCounter {
+	return &QuotaCounter{
+		client: newRedisClient(cfg),
+		prefix: cfg.Redis.KeyPrefix,
+	}
+}
+
+// Close closes the Redis client
+func (c *QuotaCounter) Close() error {
+	return c.client.Close()
+}
+
+// Ping checks that Redis responds
+func (c *QuotaCounter) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// Add adjusts a subject's usage of kind in the window starting at windowStart by delta
+// and returns the usage after the adjustment. Counters expire ttl after creation.
+func (c *QuotaCounter) Add(ctx context.Context, kind, subject string, windowStart time.Time, ttl time.Duration, delta int64) (int64, error) {
+	key := fmt.Sprintf("%squota:%s:%s:%d", c.prefix, kind, subject, windowStart.Unix())
+	value, err := addWindowScript.Run(ctx, c.client, []string{key}, delta, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count quota usage: %w", err)
+	}
+	return value, nil
+}
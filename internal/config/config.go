@@ -3,16 +3,45 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config holds all configuration for the application
 type Config struct {
 	Server        ServerConfig
+	Admin         AdminConfig
+	Gateway       GatewayConfig
+	GrpcWeb       GrpcWebConfig
 	AWS           AWSConfig
+	LocalStack    LocalStackConfig
 	DynamoDB      DynamoDBConfig
+	Migration     MigrationConfig
 	Idempotency   IdempotencyConfig
-	Observability ObservabilityConfig
+	Inventory     InventoryConfig
+	CommitPool    CommitPoolConfig
+	Preauth       PreauthConfig
+	Warmup        WarmupConfig
+	SeatReplica   SeatReplicaConfig
+	ChangeFeed    ChangeFeedConfig
+	Holds         HoldsConfig
+	Scanner       ScannerConfig
+	Redis         RedisConfig
+	Notifications NotificationsConfig
+	// ReservationEvents drives holds from reservation-api lifecycle events
+	ReservationEvents ReservationEventsConfig
+	Anomaly           AnomalyConfig
+	CostBudget        CostBudgetConfig
+	DeadlineBudget    DeadlineBudgetConfig
+	Quota             QuotaConfig
+	Velocity          VelocityConfig
+	OrderLimits       OrderLimitsConfig
+	Health            HealthConfig
+	Profiling         ProfilingConfig
+	Runtime           RuntimeConfig
+	Brownout          BrownoutConfig
+	Recording         RecordingConfig
+	Observability     ObservabilityConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -21,26 +50,468 @@ type ServerConfig struct {
 	Timeout         time.Duration `json:"timeout"`
 	MaxConcurrency  int           `json:"max_concurrency"`
 	KeepAlivePeriod time.Duration `json:"keep_alive_period"`
+	// Interceptors lists middleware names from outermost to innermost
+	Interceptors []string `json:"interceptors"`
+	// ThrottleRetryBaseDelay is the retry delay suggested to clients of requests
+	// DynamoDB throttled, multiplied by the number throttled in the current second
+	ThrottleRetryBaseDelay time.Duration `json:"throttle_retry_base_delay"`
+	// ThrottleRetryMaxDelay caps the suggested retry delay
+	ThrottleRetryMaxDelay time.Duration `json:"throttle_retry_max_delay"`
+	// AuthTokens are the bearer tokens the auth middleware accepts on public RPCs; empty
+	// accepts every caller, e.g. behind a gateway that authenticates them
+	AuthTokens []string `json:"-"`
+	// RateLimit bounds the public RPCs this instance serves per second, with bursts of up
+	// to RateLimitBurst; 0 disables the rate_limit middleware
+	RateLimit      float64 `json:"rate_limit"`
+	RateLimitBurst int     `json:"rate_limit_burst"`
+}
+
+// AdminConfig holds configuration for the admin gRPC listener
+type AdminConfig struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	// AuthToken is the bearer token required on every admin RPC
+	AuthToken    string        `json:"-"`
+	Timeout      time.Duration `json:"timeout"`
+	Interceptors []string      `json:"interceptors"`
+	// ErasureTokenKey keys the tokens replacing erased reservation IDs, keeping records
+	// of one reservation linkable; erased IDs get random tokens when empty
+	ErasureTokenKey string `json:"-"`
+	// UIEnabled serves the inspection web UI over HTTP on the admin port
+	UIEnabled bool `json:"ui_enabled"`
+}
+
+// GatewayConfig holds configuration for the HTTP/JSON gateway of the Inventory service
+type GatewayConfig struct {
+	Enabled bool `json:"enabled"`
+	Port    int  `json:"port"`
+	// Timeout bounds each proxied request, including its gRPC call
+	Timeout time.Duration `json:"timeout"`
+	// SeatMapMaxAge is the Cache-Control max-age of rendered seat maps
+	SeatMapMaxAge time.Duration `json:"seat_map_max_age"`
+}
+
+// GrpcWebConfig holds configuration for serving the Inventory service over gRPC-Web
+type GrpcWebConfig struct {
+	Enabled bool `json:"enabled"`
+	Port    int  `json:"port"`
+	// AllowedOrigins may call from browsers; "*" allows any origin
+	AllowedOrigins []string `json:"allowed_origins"`
 }
 
 // AWSConfig holds AWS-related configuration
 type AWSConfig struct {
 	Region  string `json:"region"`
 	Profile string `json:"profile,omitempty"`
+	// WebIdentityRoleARN and WebIdentityTokenFile assume a role with a projected service
+	// account token (IRSA) instead of resolving credentials through the default chain
+	WebIdentityRoleARN   string `json:"web_identity_role_arn,omitempty"`
+	WebIdentityTokenFile string `json:"web_identity_token_file,omitempty"`
+	RoleSessionName      string `json:"role_session_name"`
+	// AssumeRoleARN is a second role assumed with the base credentials for the DynamoDB
+	// tables and their streams, e.g. a tenant's or another account's tables
+	AssumeRoleARN        string        `json:"assume_role_arn,omitempty"`
+	AssumeRoleExternalID string        `json:"-"`
+	AssumeRoleDuration   time.Duration `json:"assume_role_duration"`
+}
+
+// LocalStackConfig switches every AWS integration to LocalStack for local development
+type LocalStackConfig struct {
+	Enabled  bool   `json:"enabled"`
+	Endpoint string `json:"endpoint"`
+	// CreateTables creates missing DynamoDB tables, with their streams, TTLs and
+	// reservation index, at startup
+	CreateTables bool `json:"create_tables"`
 }
 
 // DynamoDBConfig holds DynamoDB configuration
 type DynamoDBConfig struct {
-	TableInventory string        `json:"table_inventory"`
-	TableSeats     string        `json:"table_seats"`
-	MaxRetries     int           `json:"max_retries"`
-	Timeout        time.Duration `json:"timeout"`
+	TableInventory string `json:"table_inventory"`
+	TableSeats     string `json:"table_seats"`
+	TableHolds     string `json:"table_holds"`
+	// TableVenueTemplates stores versioned seat layouts events are instantiated from
+	TableVenueTemplates string `json:"table_venue_templates"`
+	// TableLedger records bulk seat changes such as closing an event, one entry per chunk
+	TableLedger string `json:"table_ledger"`
+	// TableIdempotency holds idempotency records, commit statuses, operations, scan
+	// checkpoints and stream shard leases; its TTL attribute is expires_at
+	TableIdempotency string `json:"table_idempotency"`
+	// SeatsReservationIndex is the seats table GSI keyed by reservation_id
+	SeatsReservationIndex string        `json:"seats_reservation_index"`
+	MaxRetries            int           `json:"max_retries"`
+	Timeout               time.Duration `json:"timeout"`
+	// FieldEncryptionDataKey is a base64 KMS-wrapped data key; when set, reservation and
+	// order identifiers are encrypted before they are stored
+	FieldEncryptionDataKey string `json:"-"`
+	// StubBackend answers every DynamoDB call in-process after a synthetic latency of
+	// StubLatency plus up to StubJitter, for load tests that must not incur DynamoDB cost
+	StubBackend bool          `json:"stub_backend"`
+	StubLatency time.Duration `json:"stub_latency"`
+	StubJitter  time.Duration `json:"stub_jitter"`
+	// HTTP tunes the connection pool of the AWS SDK's HTTP client
+	HTTP AWSHTTPConfig `json:"http"`
+}
+
+// AWSHTTPConfig holds the connection pool settings of the AWS SDK's HTTP client. The
+// SDK defaults keep only 10 idle connections per host, so at high RPS connections are
+// closed and redialed constantly.
+type AWSHTTPConfig struct {
+	MaxIdleConns        int `json:"max_idle_conns"`
+	MaxIdleConnsPerHost int `json:"max_idle_conns_per_host"`
+	// MaxConnsPerHost caps dialing, in-use and idle connections per host; 0 is unlimited
+	MaxConnsPerHost     int           `json:"max_conns_per_host"`
+	IdleConnTimeout     time.Duration `json:"idle_conn_timeout"`
+	DialTimeout         time.Duration `json:"dial_timeout"`
+	TLSHandshakeTimeout time.Duration `json:"tls_handshake_timeout"`
+	KeepAlive           time.Duration `json:"keep_alive"`
+	// SpreadAddresses rotates new connections across the addresses the endpoint resolves
+	// to, so the pool isn't pinned to the first one (and its availability zone)
+	SpreadAddresses bool `json:"spread_addresses"`
+	// PrewarmConns is the number of connections opened to DynamoDB at startup
+	PrewarmConns int `json:"prewarm_conns"`
+}
+
+// MigrationConfig holds configuration for migrating the inventory, seats and holds
+// tables to a secondary set of tables
+type MigrationConfig struct {
+	// DualWrite mirrors every write on the authoritative tables to the other set
+	DualWrite bool `json:"dual_write"`
+	// Cutover makes the secondary tables authoritative; with DualWrite the original
+	// tables keep receiving mirrored writes so the cutover can be rolled back
+	Cutover        bool   `json:"cutover"`
+	TableInventory string `json:"table_inventory"`
+	TableSeats     string `json:"table_seats"`
+	TableHolds     string `json:"table_holds"`
+	// VerifySampleRate is the fraction of reads compared against the mirror for divergence metrics
+	VerifySampleRate float64 `json:"verify_sample_rate"`
+	// SeatsTable is a new seats table events are moved to one at a time (blue/green);
+	// it can't be combined with DualWrite or Cutover
+	SeatsTable string `json:"seats_table"`
+	// SeatsStateTTL is how long an instance caches an event's seats migration state
+	SeatsStateTTL time.Duration `json:"seats_state_ttl"`
+	// CanaryTableInventory and CanaryTableSeats hold a candidate storage layout; a sample
+	// of inventory and seat reads is answered by it too and the answers compared
+	CanaryTableInventory string  `json:"canary_table_inventory"`
+	CanaryTableSeats     string  `json:"canary_table_seats"`
+	CanarySampleRate     float64 `json:"canary_sample_rate"`
+	// CanaryCleanWindow and CanaryMinComparisons are how long and how many comparisons
+	// must pass without divergence before the candidate is reported ready for cutover
+	CanaryCleanWindow    time.Duration `json:"canary_clean_window"`
+	CanaryMinComparisons int           `json:"canary_min_comparisons"`
 }
 
 // IdempotencyConfig holds idempotency configuration
 type IdempotencyConfig struct {
 	TTLDuration time.Duration `json:"ttl_duration"`
 	CacheSize   int           `json:"cache_size"`
+	// DedupeWindow collapses identical commit/release requests of a caller arriving
+	// within this window into one execution; 0 disables
+	DedupeWindow time.Duration `json:"dedupe_window"`
+	// CleanupInterval runs a sweep deleting expired records, for tables without
+	// DynamoDB TTL; 0 disables
+	CleanupInterval time.Duration `json:"cleanup_interval"`
+	// CleanupRate caps the records deleted per second by a sweep
+	CleanupRate int `json:"cleanup_rate"`
+}
+
+// ScannerConfig holds configuration for the full-table scans of background jobs
+// (stuck hold sweeps, idempotency cleanup)
+type ScannerConfig struct {
+	// Segments is the number of scan segments read in parallel
+	Segments int   `json:"segments"`
+	PageSize int32 `json:"page_size"`
+	// ReadUnitsPerSecond caps the read capacity all background scans consume; 0 is unlimited
+	ReadUnitsPerSecond float64 `json:"read_units_per_second"`
+}
+
+// InventoryConfig holds inventory business rule configuration
+type InventoryConfig struct {
+	// QuantityVersionCheck makes quantity commits also require a matching version
+	// (optimistic locking). When disabled, commits are guarded only by
+	// remaining >= qty so concurrent commits don't conflict while stock lasts.
+	QuantityVersionCheck bool `json:"quantity_version_check"`
+	// VersionRetries is how many times a quantity commit that lost a version race to a
+	// concurrent writer is retried; VersionRetryDelay is the jittered backoff before the
+	// first retry, doubled for each further one
+	VersionRetries    int           `json:"version_retries"`
+	VersionRetryDelay time.Duration `json:"version_retry_delay"`
+	// CounterReadRepair corrects the remaining counter of template-based seat events when
+	// it disagrees with the number of AVAILABLE seats seen by reads and reconciliation
+	CounterReadRepair bool `json:"counter_read_repair"`
+	// DegradedMode answers availability checks from the seat replica or Redis while
+	// health probes find DynamoDB down, and fails writes fast meanwhile
+	DegradedMode bool `json:"degraded_mode"`
+	// VisibilityCacheTTL is how long an instance caches an event's visibility rules
+	VisibilityCacheTTL time.Duration `json:"visibility_cache_ttl"`
+	// RemainingExactThreshold is the largest remaining quantity quantity checks report
+	// exactly; larger quantities are rounded down to RemainingStep. 0 always reports exactly.
+	RemainingExactThreshold int32 `json:"remaining_exact_threshold"`
+	RemainingStep           int32 `json:"remaining_step"`
+	// BatchCheckConcurrency is how many events of a BatchCheckAvailability call are checked at once
+	BatchCheckConcurrency int `json:"batch_check_concurrency"`
+}
+
+// WarmupConfig holds configuration for preloading hot events before serving
+type WarmupConfig struct {
+	// Events are the event IDs (event_id or event_id#performance_id) to preload at startup
+	Events []string `json:"events"`
+	// Concurrency is the number of events loaded at once, which is also the number of
+	// DynamoDB connections opened ahead of traffic
+	Concurrency int `json:"concurrency"`
+	// Timeout bounds the warm-up; serving starts when it expires
+	Timeout time.Duration `json:"timeout"`
+}
+
+// SeatReplicaConfig holds configuration for the in-memory seat state replica that
+// serves availability checks of flash-sale events
+type SeatReplicaConfig struct {
+	// Events are the event IDs (event_id or event_id#performance_id) to replicate; empty disables the replica
+	Events []string `json:"events"`
+	// PollInterval is how often the seats table stream is read
+	PollInterval time.Duration `json:"poll_interval"`
+	// MaxLag is how long the replica keeps serving after the stream was last read successfully
+	MaxLag time.Duration `json:"max_lag"`
+}
+
+// ChangeFeedConfig holds configuration for the change feed streamed to sibling services
+type ChangeFeedConfig struct {
+	Enabled bool `json:"enabled"`
+	// PollInterval is how often the inventory and seats table streams are read
+	PollInterval time.Duration `json:"poll_interval"`
+	// BufferSize is the number of recent changes kept for slow and resuming subscribers
+	BufferSize int `json:"buffer_size"`
+}
+
+// CommitPoolConfig bounds concurrent commit transactions
+type CommitPoolConfig struct {
+	// Workers is the number of concurrent commits; 0 disables the pool
+	Workers   int `json:"workers"`
+	QueueSize int `json:"queue_size"`
+	// QueueWait is how long a commit waits for a queue slot before it is rejected
+	QueueWait time.Duration `json:"queue_wait"`
+	// MinTimeLeft rejects commits whose deadline is closer than this up front
+	MinTimeLeft time.Duration `json:"min_time_left"`
+	// AsyncTimeout bounds an asynchronous commit, including its time in the queue
+	AsyncTimeout time.Duration `json:"async_timeout"`
+}
+
+// DeadlineBudgetConfig divides what is left of a commit's deadline, less a safety margin,
+// among validation, the DynamoDB write and publishing its outcome by share
+type DeadlineBudgetConfig struct {
+	Enabled         bool          `json:"enabled"`
+	SafetyMargin    time.Duration `json:"safety_margin"`
+	ValidationShare float64       `json:"validation_share"`
+	DynamoDBShare   float64       `json:"dynamodb_share"`
+	PublishShare    float64       `json:"publish_share"`
+}
+
+// PreauthConfig holds configuration of commit pre-authorization tokens
+type PreauthConfig struct {
+	// Key signs pre-authorization tokens; empty disables PreauthorizeCommit
+	Key string        `json:"-"`
+	TTL time.Duration `json:"ttl"`
+	// Required rejects commits that don't present a valid token
+	Required bool `json:"required"`
+}
+
+// HoldsConfig holds seat hold lifecycle configuration
+type HoldsConfig struct {
+	// TTL, MaxExtensions and MaxSeats are defaults for events without their own hold policy
+	TTL time.Duration `json:"ttl"`
+	// MaxExtensions is how many times a reservation may re-hold seats it already holds
+	MaxExtensions int `json:"max_extensions"`
+	// MaxSeats is the largest number of seats in one hold
+	MaxSeats int `json:"max_seats"`
+	// MaxTTL caps per-event hold TTLs; stuck hold detection waits MaxTTL plus grace
+	MaxTTL time.Duration `json:"max_ttl"`
+	// StuckGrace is added to TTL before a hold that was never released is considered stuck
+	StuckGrace        time.Duration `json:"stuck_grace"`
+	StuckScanEnabled  bool          `json:"stuck_scan_enabled"`
+	StuckScanInterval time.Duration `json:"stuck_scan_interval"`
+	// StuckAutoRelease releases detected stuck holds instead of only reporting them
+	StuckAutoRelease bool `json:"stuck_auto_release"`
+	// ExpiryStreamEnabled consumes the holds table stream and returns TTL-expired holds to sale
+	ExpiryStreamEnabled      bool          `json:"expiry_stream_enabled"`
+	ExpiryStreamPollInterval time.Duration `json:"expiry_stream_poll_interval"`
+	// CommitClockSkew is how long past its expiry a hold is still accepted at commit
+	CommitClockSkew time.Duration `json:"commit_clock_skew"`
+}
+
+// RedisConfig holds configuration of the Redis availability counter
+type RedisConfig struct {
+	// Enabled serves availability checks from Redis, falling back to DynamoDB on a miss
+	Enabled  bool   `json:"enabled"`
+	Addr     string `json:"addr"`
+	Password string `json:"-"`
+	DB       int    `json:"db"`
+	// KeyPrefix namespaces keys when the Redis instance is shared
+	KeyPrefix string        `json:"key_prefix"`
+	Timeout   time.Duration `json:"timeout"`
+	// ReconcileInterval is how often cached counters are re-read from DynamoDB
+	ReconcileInterval time.Duration `json:"reconcile_interval"`
+}
+
+// AnomalyConfig holds configuration of sales velocity anomaly detection
+type AnomalyConfig struct {
+	Enabled bool `json:"enabled"`
+	// Window is the period over which sales are aggregated and evaluated
+	Window time.Duration `json:"window"`
+	// MinVolume is the number of tickets below which a window is never flagged
+	MinVolume int `json:"min_volume"`
+	// SpikeFactor flags an event selling this many times its trailing average
+	SpikeFactor float64 `json:"spike_factor"`
+	// CallerShare flags a single caller buying at least this share of an event's window sales
+	CallerShare float64 `json:"caller_share"`
+	// HoldConversion flags a caller holding seats that converts fewer of them into sales
+	HoldConversion float64 `json:"hold_conversion"`
+	// ThrottleEnabled rejects writes from flagged callers for ThrottleDuration
+	ThrottleEnabled  bool          `json:"throttle_enabled"`
+	ThrottleDuration time.Duration `json:"throttle_duration"`
+}
+
+// CostBudgetConfig holds per-request DynamoDB capacity budgets
+type CostBudgetConfig struct {
+	// Enabled rejects requests once they would exceed a budget; consumption is measured regardless
+	Enabled bool `json:"enabled"`
+	// ReadUnits and WriteUnits are the capacity units one RPC may consume; 0 is unlimited
+	ReadUnits  float64 `json:"read_units"`
+	WriteUnits float64 `json:"write_units"`
+}
+
+// QuotaConfig holds partner quotas, counted per tenant (or per caller without a tenant)
+// in fixed windows in Redis and shared by all instances
+type QuotaConfig struct {
+	Enabled bool          `json:"enabled"`
+	Window  time.Duration `json:"window"`
+	// Requests and Seats are the default requests and committed seats per window; 0 is unlimited
+	Requests int `json:"requests"`
+	Seats    int `json:"seats"`
+	// Overrides set the limits of individual subjects as "<subject>=<requests>/<seats>",
+	// where the subject is "tenant:<tenant>" or "caller:<caller_id>"
+	Overrides []string `json:"overrides"`
+	// IdentityKey verifies the signed quota identities the gateway attaches to requests;
+	// requests without a valid one are counted per peer address
+	IdentityKey string `json:"-"`
+}
+
+// VelocityConfig holds anti-scalping limits on the orders of one buyer fingerprint per
+// event, counted in Redis and shared by all instances
+type VelocityConfig struct {
+	Enabled bool `json:"enabled"`
+	// Rules are "<window>=<max orders>" limits, e.g. "10m=2" allows two orders per buyer
+	// and event in any fixed ten-minute window; every rule applies
+	Rules []string `json:"rules"`
+}
+
+// OrderLimitsConfig caps the tickets (seats plus quantities) of one order
+type OrderLimitsConfig struct {
+	// MaxTickets is the most tickets of one event per order; 0 is unlimited
+	MaxTickets int `json:"max_tickets"`
+	// MaxTicketsPerReservation is the most tickets of a reservation's commit across all
+	// its events, e.g. of a bundle; 0 is unlimited
+	MaxTicketsPerReservation int `json:"max_tickets_per_reservation"`
+	// Overrides set the limits of single events as "<event_id>=<per order>/<per reservation>"
+	Overrides []string `json:"overrides"`
+}
+
+// HealthConfig holds configuration of the dependency probes behind the gRPC health service
+type HealthConfig struct {
+	// ProbeInterval is how often dependencies are probed; 0 disables probing
+	ProbeInterval time.Duration `json:"probe_interval"`
+	ProbeTimeout  time.Duration `json:"probe_timeout"`
+	// FailureThreshold is the number of consecutive failed probes that mark a dependency down
+	FailureThreshold int `json:"failure_threshold"`
+}
+
+// ProfilingConfig holds configuration of the pprof endpoint for continuous profilers
+type ProfilingConfig struct {
+	// Enabled serves pprof on Host:Port and labels profile samples with the RPC method.
+	// Host defaults to loopback since profiles expose command lines and memory contents.
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	// MutexProfileFraction and BlockProfileRate enable contention profiles; 0 disables them
+	MutexProfileFraction int `json:"mutex_profile_fraction"`
+	BlockProfileRate     int `json:"block_profile_rate"`
+}
+
+// RuntimeConfig overrides the container-aware Go runtime sizing
+type RuntimeConfig struct {
+	// MaxProcs overrides GOMAXPROCS; 0 derives it from the container CPU quota
+	MaxProcs int `json:"max_procs"`
+	// MemoryLimit overrides GOMEMLIMIT in bytes; 0 derives it from the container memory limit
+	MemoryLimit int64 `json:"memory_limit"`
+	// MemoryLimitRatio is the share of the container memory limit used as GOMEMLIMIT
+	MemoryLimitRatio float64 `json:"memory_limit_ratio"`
+}
+
+// BrownoutConfig holds configuration of the brownout controller, which sheds non-critical
+// load in levels while the public RPCs are overloaded
+type BrownoutConfig struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the evaluation period of latency and error signals
+	Interval time.Duration `json:"interval"`
+	// LatencyTarget marks slower public RPCs as slow
+	LatencyTarget time.Duration `json:"latency_target"`
+	// SlowRatio and ErrorRatio are the shares of slow and failed RPCs in an interval
+	// above which the interval counts as overloaded
+	SlowRatio  float64 `json:"slow_ratio"`
+	ErrorRatio float64 `json:"error_ratio"`
+	// MinRequests is the number of RPCs below which an interval never counts as overloaded
+	MinRequests int `json:"min_requests"`
+	// TripIntervals overloaded intervals in a row raise the level; RecoverIntervals
+	// healthy intervals in a row lower it
+	TripIntervals    int `json:"trip_intervals"`
+	RecoverIntervals int `json:"recover_intervals"`
+	// NonCritical lists the full method names shed from level 1
+	NonCritical []string `json:"non_critical"`
+	// CheckConcurrency caps concurrent CheckAvailability calls from level 2, halved at level 3
+	CheckConcurrency int `json:"check_concurrency"`
+}
+
+// RecordingConfig holds configuration for recording sampled public RPCs to S3, to be
+// replayed against a staging deployment
+type RecordingConfig struct {
+	// Bucket receives the recordings; empty disables recording
+	Bucket string `json:"bucket"`
+	Prefix string `json:"prefix"`
+	// SampleRate is the fraction of public RPCs recorded
+	SampleRate float64 `json:"sample_rate"`
+	// FlushInterval and MaxBatch bound how long and how many records are buffered per object
+	FlushInterval time.Duration `json:"flush_interval"`
+	MaxBatch      int           `json:"max_batch"`
+	// AnonymizeFields are request fields replaced by keyed tokens before recording
+	AnonymizeFields []string `json:"anonymize_fields"`
+	// AnonymizeKey keys the tokens; a random key is used when empty, so tokens of
+	// different instances and restarts don't match
+	AnonymizeKey string `json:"-"`
+}
+
+// NotificationsConfig holds outbound notification configuration
+type NotificationsConfig struct {
+	// RestockTopicARN receives "tickets available again" notifications; empty disables them
+	RestockTopicARN string `json:"restock_topic_arn"`
+	// HoldEventsTopicARN receives hold_expired events; empty disables them
+	HoldEventsTopicARN string        `json:"hold_events_topic_arn"`
+	PublishTimeout     time.Duration `json:"publish_timeout"`
+	// SchemaRegistryURL is a Confluent-compatible schema registry the message schemas are
+	// registered in at startup; empty disables registration and schema version attributes
+	SchemaRegistryURL      string        `json:"schema_registry_url"`
+	SchemaRegistryUsername string        `json:"schema_registry_username"`
+	SchemaRegistryPassword string        `json:"-"`
+	SchemaRegistryTimeout  time.Duration `json:"schema_registry_timeout"`
+}
+
+// ReservationEventsConfig holds configuration for consuming reservation-api lifecycle
+// events, which EventBridge delivers to an SQS queue
+type ReservationEventsConfig struct {
+	// QueueURL is the SQS queue of the EventBridge rule's target; empty disables consumption
+	QueueURL string `json:"queue_url"`
+	// WaitTime is the SQS long-poll duration (at most 20s)
+	WaitTime time.Duration `json:"wait_time"`
+	// HandleTimeout bounds handling one event
+	HandleTimeout time.Duration `json:"handle_timeout"`
 }
 
 // ObservabilityConfig holds observability configuration
@@ -50,37 +521,297 @@ type ObservabilityConfig struct {
 	OTLPEndpoint   string `json:"otlp_endpoint"`
 	LogLevel       string `json:"log_level"`
 	MetricsPort    int    `json:"metrics_port"`
+	// BaggageKeys are the OTel baggage members copied onto spans, logs and notifications
+	BaggageKeys []string `json:"baggage_keys"`
+	// SlowRequestThreshold is the duration above which a unary RPC is logged with its
+	// DynamoDB calls; 0 disables slow request logs
+	SlowRequestThreshold time.Duration `json:"slow_request_threshold"`
+	// SlowRequestMethodThresholds override the threshold by method name, e.g. CommitReservation
+	SlowRequestMethodThresholds map[string]time.Duration `json:"slow_request_method_thresholds"`
+	// EMFEnabled also writes the core business metrics to stdout in CloudWatch embedded
+	// metric format, summed over EMFFlushInterval, under EMFNamespace
+	EMFEnabled       bool          `json:"emf_enabled"`
+	EMFNamespace     string        `json:"emf_namespace"`
+	EMFFlushInterval time.Duration `json:"emf_flush_interval"`
 }
 
 // Load loads configuration from environment variables with defaults
 func Load() (*Config, error) {
 	return &Config{
 		Server: ServerConfig{
-			Port:            getEnvAsInt("GRPC_PORT", 8080),
-			Timeout:         getEnvAsDuration("GRPC_TIMEOUT", 250*time.Millisecond),
-			MaxConcurrency:  getEnvAsInt("GRPC_MAX_CONCURRENCY", 1000),
-			KeepAlivePeriod: getEnvAsDuration("GRPC_KEEP_ALIVE_PERIOD", 30*time.Second),
+			Port:                   getEnvAsInt("GRPC_PORT", 8080),
+			Timeout:                getEnvAsDuration("GRPC_TIMEOUT", 250*time.Millisecond),
+			MaxConcurrency:         getEnvAsInt("GRPC_MAX_CONCURRENCY", 1000),
+			KeepAlivePeriod:        getEnvAsDuration("GRPC_KEEP_ALIVE_PERIOD", 30*time.Second),
+			Interceptors:           getEnvAsSlice("GRPC_INTERCEPTORS", []string{"recovery", "baggage", "tracing", "metrics", "load", "profiling", "logging", "slow_log", "recording", "auth", "rate_limit", "validation", "retry_info", "quota", "brownout", "timeout", "cost_budget"}),
+			ThrottleRetryBaseDelay: getEnvAsDuration("THROTTLE_RETRY_BASE_DELAY", 100*time.Millisecond),
+			ThrottleRetryMaxDelay:  getEnvAsDuration("THROTTLE_RETRY_MAX_DELAY", 5*time.Second),
+			AuthTokens:             getEnvAsSlice("GRPC_AUTH_TOKENS", nil),
+			RateLimit:              getEnvAsFloat("GRPC_RATE_LIMIT", 0),
+			RateLimitBurst:         getEnvAsInt("GRPC_RATE_LIMIT_BURST", 0),
+		},
+		Admin: AdminConfig{
+			Enabled:         getEnvAsBool("ADMIN_GRPC_ENABLED", false),
+			Host:            getEnv("ADMIN_GRPC_HOST", "127.0.0.1"),
+			Port:            getEnvAsInt("ADMIN_GRPC_PORT", 8081),
+			AuthToken:       getEnv("ADMIN_AUTH_TOKEN", ""),
+			Timeout:         getEnvAsDuration("ADMIN_GRPC_TIMEOUT", 30*time.Second),
+			Interceptors:    getEnvAsSlice("ADMIN_GRPC_INTERCEPTORS", []string{"recovery", "baggage", "tracing", "metrics", "logging", "slow_log", "brownout", "admin_timeout"}),
+			ErasureTokenKey: getEnv("ADMIN_ERASURE_TOKEN_KEY", ""),
+			UIEnabled:       getEnvAsBool("ADMIN_UI_ENABLED", true),
+		},
+		Gateway: GatewayConfig{
+			Enabled: getEnvAsBool("GATEWAY_ENABLED", false),
+			Port:    getEnvAsInt("GATEWAY_PORT", 8082),
+			Timeout: getEnvAsDuration("GATEWAY_TIMEOUT", 10*time.Second),
+
+			SeatMapMaxAge: getEnvAsDuration("GATEWAY_SEAT_MAP_MAX_AGE", 2*time.Second),
+		},
+		GrpcWeb: GrpcWebConfig{
+			Enabled:        getEnvAsBool("GRPC_WEB_ENABLED", false),
+			Port:           getEnvAsInt("GRPC_WEB_PORT", 8083),
+			AllowedOrigins: getEnvAsSlice("GRPC_WEB_ALLOWED_ORIGINS", nil),
 		},
 		AWS: AWSConfig{
 			Region:  getEnv("AWS_REGION", "ap-northeast-2"),
 			Profile: getEnv("AWS_PROFILE", ""),
+			// The names EKS injects for IRSA
+			WebIdentityRoleARN:   getEnv("AWS_ROLE_ARN", ""),
+			WebIdentityTokenFile: getEnv("AWS_WEB_IDENTITY_TOKEN_FILE", ""),
+			RoleSessionName:      getEnv("AWS_ROLE_SESSION_NAME", "inventory-api"),
+			AssumeRoleARN:        getEnv("AWS_ASSUME_ROLE_ARN", ""),
+			AssumeRoleExternalID: getEnv("AWS_ASSUME_ROLE_EXTERNAL_ID", ""),
+			AssumeRoleDuration:   getEnvAsDuration("AWS_ASSUME_ROLE_DURATION", time.Hour),
+		},
+		LocalStack: LocalStackConfig{
+			Enabled:      getEnvAsBool("LOCALSTACK_ENABLED", false),
+			Endpoint:     getEnv("LOCALSTACK_ENDPOINT", "http://localhost:4566"),
+			CreateTables: getEnvAsBool("LOCALSTACK_CREATE_TABLES", true),
 		},
 		DynamoDB: DynamoDBConfig{
-			TableInventory: getEnv("DDB_TABLE_INVENTORY", "inventory"),
-			TableSeats:     getEnv("DDB_TABLE_SEATS", "inventory_seats"),
-			MaxRetries:     getEnvAsInt("DDB_MAX_RETRIES", 3),
-			Timeout:        getEnvAsDuration("DDB_TIMEOUT", 200*time.Millisecond),
+			TableInventory:         getEnv("DDB_TABLE_INVENTORY", "inventory"),
+			TableSeats:             getEnv("DDB_TABLE_SEATS", "inventory_seats"),
+			TableHolds:             getEnv("DDB_TABLE_HOLDS", "inventory_holds"),
+			TableVenueTemplates:    getEnv("DDB_TABLE_VENUE_TEMPLATES", "inventory_venue_templates"),
+			TableLedger:            getEnv("DDB_TABLE_LEDGER", "inventory_ledger"),
+			TableIdempotency:       getEnv("DDB_TABLE_IDEMPOTENCY", "idempotency"),
+			SeatsReservationIndex:  getEnv("DDB_SEATS_RESERVATION_INDEX", "reservation_id-index"),
+			MaxRetries:             getEnvAsInt("DDB_MAX_RETRIES", 3),
+			Timeout:                getEnvAsDuration("DDB_TIMEOUT", 200*time.Millisecond),
+			FieldEncryptionDataKey: getEnv("DDB_FIELD_ENCRYPTION_DATA_KEY", ""),
+			StubBackend:            getEnvAsBool("DDB_STUB_BACKEND", false),
+			StubLatency:            getEnvAsDuration("DDB_STUB_LATENCY", 3*time.Millisecond),
+			StubJitter:             getEnvAsDuration("DDB_STUB_JITTER", 2*time.Millisecond),
+			HTTP: AWSHTTPConfig{
+				MaxIdleConns:        getEnvAsInt("DDB_HTTP_MAX_IDLE_CONNS", 512),
+				MaxIdleConnsPerHost: getEnvAsInt("DDB_HTTP_MAX_IDLE_CONNS_PER_HOST", 256),
+				MaxConnsPerHost:     getEnvAsInt("DDB_HTTP_MAX_CONNS_PER_HOST", 0),
+				IdleConnTimeout:     getEnvAsDuration("DDB_HTTP_IDLE_CONN_TIMEOUT", 90*time.Second),
+				DialTimeout:         getEnvAsDuration("DDB_HTTP_DIAL_TIMEOUT", 2*time.Second),
+				TLSHandshakeTimeout: getEnvAsDuration("DDB_HTTP_TLS_HANDSHAKE_TIMEOUT", 2*time.Second),
+				KeepAlive:           getEnvAsDuration("DDB_HTTP_KEEP_ALIVE", 30*time.Second),
+				SpreadAddresses:     getEnvAsBool("DDB_HTTP_SPREAD_ADDRESSES", true),
+				PrewarmConns:        getEnvAsInt("DDB_HTTP_PREWARM_CONNS", 32),
+			},
+		},
+		Migration: MigrationConfig{
+			DualWrite:        getEnvAsBool("MIGRATION_DUAL_WRITE", false),
+			Cutover:          getEnvAsBool("MIGRATION_CUTOVER", false),
+			TableInventory:   getEnv("MIGRATION_TABLE_INVENTORY", ""),
+			TableSeats:       getEnv("MIGRATION_TABLE_SEATS", ""),
+			TableHolds:       getEnv("MIGRATION_TABLE_HOLDS", ""),
+			VerifySampleRate: getEnvAsFloat("MIGRATION_VERIFY_SAMPLE_RATE", 0.01),
+			SeatsTable:       getEnv("MIGRATION_SEATS_TABLE", ""),
+			SeatsStateTTL:    getEnvAsDuration("MIGRATION_SEATS_STATE_TTL", 10*time.Second),
+
+			CanaryTableInventory: getEnv("MIGRATION_CANARY_TABLE_INVENTORY", ""),
+			CanaryTableSeats:     getEnv("MIGRATION_CANARY_TABLE_SEATS", ""),
+			CanarySampleRate:     getEnvAsFloat("MIGRATION_CANARY_SAMPLE_RATE", 0.01),
+			CanaryCleanWindow:    getEnvAsDuration("MIGRATION_CANARY_CLEAN_WINDOW", time.Hour),
+			CanaryMinComparisons: getEnvAsInt("MIGRATION_CANARY_MIN_COMPARISONS", 1000),
 		},
 		Idempotency: IdempotencyConfig{
-			TTLDuration: getEnvAsDuration("IDEMPOTENCY_TTL_SECONDS", 300*time.Second),
-			CacheSize:   getEnvAsInt("IDEMPOTENCY_CACHE_SIZE", 10000),
+			TTLDuration:     getEnvAsDuration("IDEMPOTENCY_TTL_SECONDS", 300*time.Second),
+			CacheSize:       getEnvAsInt("IDEMPOTENCY_CACHE_SIZE", 10000),
+			DedupeWindow:    getEnvAsDuration("IDEMPOTENCY_DEDUPE_WINDOW", 250*time.Millisecond),
+			CleanupInterval: getEnvAsDuration("IDEMPOTENCY_CLEANUP_INTERVAL", 0),
+			CleanupRate:     getEnvAsInt("IDEMPOTENCY_CLEANUP_RATE", 100),
+		},
+		Inventory: InventoryConfig{
+			QuantityVersionCheck:    getEnvAsBool("QUANTITY_COMMIT_VERSION_CHECK", false),
+			VersionRetries:          getEnvAsInt("QUANTITY_COMMIT_VERSION_RETRIES", 3),
+			VersionRetryDelay:       getEnvAsDuration("QUANTITY_COMMIT_VERSION_RETRY_DELAY", 5*time.Millisecond),
+			CounterReadRepair:       getEnvAsBool("COUNTER_READ_REPAIR_ENABLED", false),
+			DegradedMode:            getEnvAsBool("DEGRADED_MODE_ENABLED", false),
+			VisibilityCacheTTL:      getEnvAsDuration("VISIBILITY_RULES_CACHE_TTL", 10*time.Second),
+			RemainingExactThreshold: int32(getEnvAsInt("REMAINING_EXACT_THRESHOLD", 0)),
+			RemainingStep:           int32(getEnvAsInt("REMAINING_STEP", 10)),
+			BatchCheckConcurrency:   getEnvAsInt("BATCH_CHECK_CONCURRENCY", 16),
+		},
+		CommitPool: CommitPoolConfig{
+			Workers:      getEnvAsInt("COMMIT_WORKERS", 0),
+			QueueSize:    getEnvAsInt("COMMIT_QUEUE_SIZE", 256),
+			QueueWait:    getEnvAsDuration("COMMIT_QUEUE_WAIT", 50*time.Millisecond),
+			MinTimeLeft:  getEnvAsDuration("COMMIT_MIN_TIME_LEFT", 20*time.Millisecond),
+			AsyncTimeout: getEnvAsDuration("COMMIT_ASYNC_TIMEOUT", 10*time.Second),
+		},
+		DeadlineBudget: DeadlineBudgetConfig{
+			Enabled:         getEnvAsBool("DEADLINE_BUDGET_ENABLED", false),
+			SafetyMargin:    getEnvAsDuration("DEADLINE_BUDGET_SAFETY_MARGIN", 10*time.Millisecond),
+			ValidationShare: getEnvAsFloat("DEADLINE_BUDGET_VALIDATION_SHARE", 0.2),
+			DynamoDBShare:   getEnvAsFloat("DEADLINE_BUDGET_DYNAMODB_SHARE", 0.6),
+			PublishShare:    getEnvAsFloat("DEADLINE_BUDGET_PUBLISH_SHARE", 0.2),
+		},
+		Preauth: PreauthConfig{
+			Key:      getEnv("COMMIT_PREAUTH_KEY", ""),
+			TTL:      getEnvAsDuration("COMMIT_PREAUTH_TTL", 2*time.Minute),
+			Required: getEnvAsBool("COMMIT_PREAUTH_REQUIRED", false),
+		},
+		Warmup: WarmupConfig{
+			Events:      getEnvAsSlice("WARMUP_EVENTS", nil),
+			Concurrency: getEnvAsInt("WARMUP_CONCURRENCY", 8),
+			Timeout:     getEnvAsDuration("WARMUP_TIMEOUT", 10*time.Second),
+		},
+		SeatReplica: SeatReplicaConfig{
+			Events:       getEnvAsSlice("SEAT_REPLICA_EVENTS", nil),
+			PollInterval: getEnvAsDuration("SEAT_REPLICA_POLL_INTERVAL", 250*time.Millisecond),
+			MaxLag:       getEnvAsDuration("SEAT_REPLICA_MAX_LAG", 5*time.Second),
+		},
+		ChangeFeed: ChangeFeedConfig{
+			Enabled:      getEnvAsBool("CHANGE_FEED_ENABLED", false),
+			PollInterval: getEnvAsDuration("CHANGE_FEED_POLL_INTERVAL", 250*time.Millisecond),
+			BufferSize:   getEnvAsInt("CHANGE_FEED_BUFFER_SIZE", 10000),
+		},
+		Scanner: ScannerConfig{
+			Segments:           getEnvAsInt("SCANNER_SEGMENTS", 4),
+			PageSize:           int32(getEnvAsInt("SCANNER_PAGE_SIZE", 200)),
+			ReadUnitsPerSecond: getEnvAsFloat("SCANNER_READ_UNITS_PER_SECOND", 50),
+		},
+		Holds: HoldsConfig{
+			TTL:                      getEnvAsDuration("HOLD_TTL", 5*time.Minute),
+			MaxExtensions:            getEnvAsInt("HOLD_MAX_EXTENSIONS", 2),
+			MaxSeats:                 getEnvAsInt("HOLD_MAX_SEATS", 50),
+			MaxTTL:                   getEnvAsDuration("HOLD_MAX_TTL", 15*time.Minute),
+			StuckGrace:               getEnvAsDuration("STUCK_HOLD_GRACE", 2*time.Minute),
+			StuckScanEnabled:         getEnvAsBool("STUCK_HOLD_SCAN_ENABLED", false),
+			StuckScanInterval:        getEnvAsDuration("STUCK_HOLD_SCAN_INTERVAL", time.Minute),
+			StuckAutoRelease:         getEnvAsBool("STUCK_HOLD_AUTO_RELEASE", false),
+			ExpiryStreamEnabled:      getEnvAsBool("HOLD_EXPIRY_STREAM_ENABLED", false),
+			ExpiryStreamPollInterval: getEnvAsDuration("HOLD_EXPIRY_STREAM_POLL_INTERVAL", time.Second),
+			CommitClockSkew:          getEnvAsDuration("HOLD_COMMIT_CLOCK_SKEW", 2*time.Second),
+		},
+		Redis: RedisConfig{
+			Enabled:           getEnvAsBool("REDIS_AVAILABILITY_ENABLED", false),
+			Addr:              getEnv("REDIS_ADDR", "localhost:6379"),
+			Password:          getEnv("REDIS_PASSWORD", ""),
+			DB:                getEnvAsInt("REDIS_DB", 0),
+			KeyPrefix:         getEnv("REDIS_KEY_PREFIX", "inventory:"),
+			Timeout:           getEnvAsDuration("REDIS_TIMEOUT", 50*time.Millisecond),
+			ReconcileInterval: getEnvAsDuration("REDIS_RECONCILE_INTERVAL", 30*time.Second),
+		},
+		Notifications: NotificationsConfig{
+			RestockTopicARN:        getEnv("RESTOCK_SNS_TOPIC_ARN", ""),
+			HoldEventsTopicARN:     getEnv("HOLD_EVENTS_SNS_TOPIC_ARN", ""),
+			PublishTimeout:         getEnvAsDuration("RESTOCK_PUBLISH_TIMEOUT", 2*time.Second),
+			SchemaRegistryURL:      getEnv("SCHEMA_REGISTRY_URL", ""),
+			SchemaRegistryUsername: getEnv("SCHEMA_REGISTRY_USERNAME", ""),
+			SchemaRegistryPassword: getEnv("SCHEMA_REGISTRY_PASSWORD", ""),
+			SchemaRegistryTimeout:  getEnvAsDuration("SCHEMA_REGISTRY_TIMEOUT", 5*time.Second),
+		},
+		ReservationEvents: ReservationEventsConfig{
+			QueueURL:      getEnv("RESERVATION_EVENTS_QUEUE_URL", ""),
+			WaitTime:      getEnvAsDuration("RESERVATION_EVENTS_WAIT_TIME", 20*time.Second),
+			HandleTimeout: getEnvAsDuration("RESERVATION_EVENTS_HANDLE_TIMEOUT", 5*time.Second),
+		},
+		Anomaly: AnomalyConfig{
+			Enabled:          getEnvAsBool("ANOMALY_DETECTION_ENABLED", false),
+			Window:           getEnvAsDuration("ANOMALY_WINDOW", time.Minute),
+			MinVolume:        getEnvAsInt("ANOMALY_MIN_VOLUME", 20),
+			SpikeFactor:      getEnvAsFloat("ANOMALY_SPIKE_FACTOR", 5),
+			CallerShare:      getEnvAsFloat("ANOMALY_CALLER_SHARE", 0.3),
+			HoldConversion:   getEnvAsFloat("ANOMALY_HOLD_CONVERSION", 0.1),
+			ThrottleEnabled:  getEnvAsBool("ANOMALY_THROTTLE_ENABLED", false),
+			ThrottleDuration: getEnvAsDuration("ANOMALY_THROTTLE_DURATION", 10*time.Minute),
+		},
+		CostBudget: CostBudgetConfig{
+			Enabled:    getEnvAsBool("COST_BUDGET_ENABLED", false),
+			ReadUnits:  getEnvAsFloat("COST_BUDGET_READ_UNITS", 500),
+			WriteUnits: getEnvAsFloat("COST_BUDGET_WRITE_UNITS", 500),
+		},
+		Quota: QuotaConfig{
+			Enabled:   getEnvAsBool("QUOTA_ENABLED", false),
+			Window:    getEnvAsDuration("QUOTA_WINDOW", time.Minute),
+			Requests:  getEnvAsInt("QUOTA_REQUESTS", 0),
+			Seats:     getEnvAsInt("QUOTA_SEATS", 0),
+			Overrides: getEnvAsSlice("QUOTA_OVERRIDES", nil),
+
+			IdentityKey: getEnv("QUOTA_IDENTITY_KEY", ""),
+		},
+		Velocity: VelocityConfig{
+			Enabled: getEnvAsBool("VELOCITY_LIMITS_ENABLED", false),
+			Rules:   getEnvAsSlice("VELOCITY_RULES", []string{"10m=2", "24h=6"}),
+		},
+		OrderLimits: OrderLimitsConfig{
+			MaxTickets:               getEnvAsInt("ORDER_MAX_TICKETS", 10),
+			MaxTicketsPerReservation: getEnvAsInt("ORDER_MAX_TICKETS_PER_RESERVATION", 20),
+			Overrides:                getEnvAsSlice("ORDER_LIMIT_OVERRIDES", nil),
+		},
+		Health: HealthConfig{
+			ProbeInterval:    getEnvAsDuration("HEALTH_PROBE_INTERVAL", 5*time.Second),
+			ProbeTimeout:     getEnvAsDuration("HEALTH_PROBE_TIMEOUT", time.Second),
+			FailureThreshold: getEnvAsInt("HEALTH_FAILURE_THRESHOLD", 3),
+		},
+		Profiling: ProfilingConfig{
+			Enabled:              getEnvAsBool("PROFILING_ENABLED", false),
+			Host:                 getEnv("PROFILING_HOST", "127.0.0.1"),
+			Port:                 getEnvAsInt("PROFILING_PORT", 6060),
+			MutexProfileFraction: getEnvAsInt("PROFILING_MUTEX_FRACTION", 0),
+			BlockProfileRate:     getEnvAsInt("PROFILING_BLOCK_RATE", 0),
+		},
+		Runtime: RuntimeConfig{
+			MaxProcs:         getEnvAsInt("RUNTIME_MAX_PROCS", 0),
+			MemoryLimit:      int64(getEnvAsInt("RUNTIME_MEMORY_LIMIT", 0)),
+			MemoryLimitRatio: getEnvAsFloat("RUNTIME_MEMORY_LIMIT_RATIO", 0.9),
+		},
+		Brownout: BrownoutConfig{
+			Enabled:          getEnvAsBool("BROWNOUT_ENABLED", false),
+			Interval:         getEnvAsDuration("BROWNOUT_INTERVAL", time.Second),
+			LatencyTarget:    getEnvAsDuration("BROWNOUT_LATENCY_TARGET", 100*time.Millisecond),
+			SlowRatio:        getEnvAsFloat("BROWNOUT_SLOW_RATIO", 0.1),
+			ErrorRatio:       getEnvAsFloat("BROWNOUT_ERROR_RATIO", 0.05),
+			MinRequests:      getEnvAsInt("BROWNOUT_MIN_REQUESTS", 50),
+			TripIntervals:    getEnvAsInt("BROWNOUT_TRIP_INTERVALS", 3),
+			RecoverIntervals: getEnvAsInt("BROWNOUT_RECOVER_INTERVALS", 10),
+			NonCritical: getEnvAsSlice("BROWNOUT_NON_CRITICAL", []string{
+				"/inventory.v1.InventoryAdmin/StreamEventStats",
+				"/inventory.v1.InventoryAdmin/PlanCapacity",
+				"/inventory.v1.InventoryAdmin/ListStuckHolds",
+				"/inventory.v1.InventoryAdmin/GetSeats",
+				"/grpc.health.v1.Health/Watch",
+			}),
+			CheckConcurrency: getEnvAsInt("BROWNOUT_CHECK_CONCURRENCY", 200),
+		},
+		Recording: RecordingConfig{
+			Bucket:          getEnv("RECORDING_S3_BUCKET", ""),
+			Prefix:          getEnv("RECORDING_S3_PREFIX", "recordings/"),
+			SampleRate:      getEnvAsFloat("RECORDING_SAMPLE_RATE", 0.01),
+			FlushInterval:   getEnvAsDuration("RECORDING_FLUSH_INTERVAL", time.Minute),
+			MaxBatch:        getEnvAsInt("RECORDING_MAX_BATCH", 10000),
+			AnonymizeFields: getEnvAsSlice("RECORDING_ANONYMIZE_FIELDS", []string{"reservation_id", "payment_intent_id", "order_id"}),
+			AnonymizeKey:    getEnv("RECORDING_ANONYMIZE_KEY", ""),
 		},
 		Observability: ObservabilityConfig{
-			ServiceName:    getEnv("SERVICE_NAME", "inventory-api"),
-			ServiceVersion: getEnv("SERVICE_VERSION", "1.0.0"),
-			OTLPEndpoint:   getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4317"),
-			LogLevel:       getEnv("LOG_LEVEL", "info"),
-			MetricsPort:    getEnvAsInt("METRICS_PORT", 9090),
+			ServiceName:                 getEnv("SERVICE_NAME", "inventory-api"),
+			ServiceVersion:              getEnv("SERVICE_VERSION", "1.0.0"),
+			OTLPEndpoint:                getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4317"),
+			LogLevel:                    getEnv("LOG_LEVEL", "info"),
+			MetricsPort:                 getEnvAsInt("METRICS_PORT", 9090),
+			BaggageKeys:                 getEnvAsSlice("OTEL_BAGGAGE_KEYS", []string{"tenant", "campaign", "client_app"}),
+			SlowRequestThreshold:        getEnvAsDuration("SLOW_REQUEST_THRESHOLD", 100*time.Millisecond),
+			SlowRequestMethodThresholds: getEnvAsDurationMap("SLOW_REQUEST_METHOD_THRESHOLDS", nil),
+			EMFEnabled:                  getEnvAsBool("METRICS_EMF_ENABLED", false),
+			EMFNamespace:                getEnv("METRICS_EMF_NAMESPACE", "TrafficTacos/Inventory"),
+			EMFFlushInterval:            getEnvAsDuration("METRICS_EMF_FLUSH_INTERVAL", time.Minute),
 		},
 	}, nil
 }
@@ -103,6 +834,26 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsFloat gets an environment variable as float64 or returns a default value
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsBool gets an environment variable as bool or returns a default value
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 // getEnvAsDuration gets an environment variable as duration or returns a default value
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
@@ -112,3 +863,36 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+// getEnvAsSlice gets a comma-separated environment variable as a slice or returns a default value
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	if value := os.Getenv(key); value != "" {
+		var values []string
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+		return values
+	}
+	return defaultValue
+}
+
+// getEnvAsDurationMap gets a comma-separated list of name=duration pairs as a map or
+// returns a default value. Malformed pairs are ignored.
+func getEnvAsDurationMap(key string, defaultValue map[string]time.Duration) map[string]time.Duration {
+	if value := os.Getenv(key); value != "" {
+		values := make(map[string]time.Duration)
+		for _, part := range strings.Split(value, ",") {
+			name, durationValue, ok := strings.Cut(strings.TrimSpace(part), "=")
+			if !ok {
+				continue
+			}
+			if duration, err := time.ParseDuration(strings.TrimSpace(durationValue)); err == nil {
+				values[strings.TrimSpace(name)] = duration
+			}
+		}
+		return values
+	}
+	return defaultValue
+}
@@ -0,0 +1,192 @@
+// Package errors defines the domain errors of the inventory service. The repository
+// returns them wrapped with context, the service adds its own, and the gRPC layer maps
+// them to status codes with errors.Is and errors.As instead of matching messages.
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Domain error kinds
+var (
+	// ErrInsufficientInventory reports that an event has fewer tickets left than requested
+	ErrInsufficientInventory = errors.New("insufficient inventory")
+	// ErrSeatConflict reports that seats aren't in the status a write requires, e.g. taken
+	// by another reservation
+	ErrSeatConflict = errors.New("seat not available")
+	// ErrNotFound reports that an inventory item, seat or other record doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrIdempotencyConflict reports that an idempotency key was reused for a different request
+	ErrIdempotencyConflict = errors.New("idempotency conflict")
+	// ErrHoldExpired reports that a reservation's hold expired before it was committed
+	ErrHoldExpired = errors.New("hold expired")
+	// ErrEventNotOnSale reports that an event doesn't accept sales, e.g. because it is frozen
+	ErrEventNotOnSale = errors.New("event not on sale")
+	// ErrLimitExceeded reports that a request exceeds a seat, extension or quota limit
+	ErrLimitExceeded = errors.New("limit exceeded")
+	// ErrVelocityLimitExceeded reports that a buyer placed more orders of an event than the
+	// velocity rules allow
+	ErrVelocityLimitExceeded = errors.New("velocity limit exceeded")
+	// ErrOrderLimitExceeded reports that an order has more tickets than an event allows
+	ErrOrderLimitExceeded = errors.New("order limit exceeded")
+	// ErrVersionConflict reports that a write lost an optimistic version check to
+	// concurrent writers
+	ErrVersionConflict = errors.New("version conflict")
+	// ErrUnavailable reports that the instance can't serve a request now: writes are
+	// disabled by maintenance or degraded mode, or the instance is shutting down
+	ErrUnavailable = errors.New("unavailable")
+	// ErrDeadlineTooClose reports that a request's deadline leaves too little time to
+	// finish it
+	ErrDeadlineTooClose = errors.New("deadline too close")
+	// ErrOverloaded reports that the instance has no capacity for a request now, e.g.
+	// because the commit queue is full
+	ErrOverloaded = errors.New("overloaded")
+)
+
+// kindError is an error of a domain kind with its own message and an optional cause
+type kindError struct {
+	kind  error
+	msg   string
+	cause error
+}
+
+// Error returns the message
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+// Is reports whether target is the kind of the error
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// Unwrap returns the cause, if any
+func (e *kindError) Unwrap() error {
+	return e.cause
+}
+
+// New returns an error of kind with a formatted message. A %w verb in format records a
+// cause, which errors.Is and errors.As also see.
+func New(kind error, format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	return &kindError{kind: kind, msg: err.Error(), cause: errors.Unwrap(err)}
+}
+
+// NotFound returns an ErrNotFound error with a formatted message
+func NotFound(format string, args ...any) error {
+	return New(ErrNotFound, format, args...)
+}
+
+// InsufficientInventory returns an ErrInsufficientInventory error for an event
+func InsufficientInventory(eventID string) error {
+	return New(ErrInsufficientInventory, "insufficient inventory for event %s", eventID)
+}
+
+// SeatConflictError reports the seats of an event a write found unavailable. SeatIDs is
+// empty when the store didn't say which seats conflicted.
+type SeatConflictError struct {
+	EventID string
+	SeatIDs []string
+	// Status is the status the seats were found in, e.g. SOLD, when it is known
+	Status string
+	// Err is the store error the conflict was found in, if any
+	Err error
+}
+
+// Error describes the conflict
+func (e *SeatConflictError) Error() string {
+	switch len(e.SeatIDs) {
+	case 0:
+		return fmt.Sprintf("one or more seats are not available for event %s", e.EventID)
+	case 1:
+		return fmt.Sprintf("seat %s is not available for event %s", e.SeatIDs[0], e.EventID)
+	default:
+		return fmt.Sprintf("seats %s are not available for event %s", strings.Join(e.SeatIDs, ", "), e.EventID)
+	}
+}
+
+// Is reports whether target is ErrSeatConflict
+func (e *SeatConflictError) Is(target error) bool {
+	return target == ErrSeatConflict
+}
+
+// Unwrap returns the store error
+func (e *SeatConflictError) Unwrap() error {
+	return e.Err
+}
+
+// SeatConflict returns a SeatConflictError for seats of an event
+func SeatConflict(eventID string, seatIDs ...string) error {
+	return &SeatConflictError{EventID: eventID, SeatIDs: seatIDs}
+}
+
+// Cancellation reasons of a TransactionError, from the DynamoDB cancellation reason codes
+const (
+	TxReasonThrottled           = "throttled"
+	TxReasonItemTooLarge        = "item_too_large"
+	TxReasonTransactionConflict = "transaction_conflict"
+	TxReasonOther               = "other"
+)
+
+// TransactionError reports a seat transaction DynamoDB canceled without any condition
+// failing, e.g. because it was throttled or a seat item grew over the item size limit.
+// It doesn't unwrap to the canceled transaction, so callers treating canceled
+// transactions as seat conflicts don't mistake it for one.
+type TransactionError struct {
+	EventID string
+	// Reason is the reason of the transaction: item_too_large if any item was too large,
+	// else throttled, transaction_conflict or other
+	Reason string
+	// SeatReasons maps the seats whose writes were canceled to their reasons
+	SeatReasons map[string]string
+	// Err is the canceled transaction
+	Err error
+}
+
+// Error describes the cancellation, the seats it hit and the canceled transaction, whose
+// message lists the DynamoDB reason codes
+func (e *TransactionError) Error() string {
+	seatIDs := make([]string, 0, len(e.SeatReasons))
+	for seatID := range e.SeatReasons {
+		seatIDs = append(seatIDs, seatID)
+	}
+	sort.Strings(seatIDs)
+	if len(seatIDs) == 0 {
+		return fmt.Sprintf("seat transaction for event %s canceled (%s): %v", e.EventID, e.Reason, e.Err)
+	}
+	return fmt.Sprintf("seat transaction for event %s canceled (%s) at seats %s: %v", e.EventID, e.Reason, strings.Join(seatIDs, ", "), e.Err)
+}
+
+// retryError is an error the caller may retry after a delay
+type retryError struct {
+	err   error
+	delay time.Duration
+}
+
+// Error returns the message of the error
+func (e *retryError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error
+func (e *retryError) Unwrap() error {
+	return e.err
+}
+
+// RetryAfter returns err annotated with the delay after which retrying may succeed
+func RetryAfter(err error, delay time.Duration) error {
+	return &retryError{err: err, delay: delay}
+}
+
+// RetryDelay returns the retry delay err was annotated with by RetryAfter, if any
+func RetryDelay(err error) (time.Duration, bool) {
+	var retry *retryError
+	if !errors.As(err, &retry) {
+		return 0, false
+	}
+	return retry.delay, true
+}
@@ -0,0 +1,188 @@
+// Package gateway serves the Inventory service over HTTP/JSON for tools that can't
+// speak gRPC. Requests are proxied to the public gRPC listener, so they pass through
+// the same interceptors (metrics, quotas, brownout, deadlines) as gRPC calls.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxRequestBytes bounds the JSON body of a request
+const maxRequestBytes = 1 << 20
+
+// forwardedHeaders are passed to the gRPC service as metadata under their own names, in
+// addition to the "Grpc-Metadata-" headers and permanent HTTP headers grpc-gateway forwards
+var forwardedHeaders = map[string]bool{
+	"x-caller-id": true,
+	"traceparent": true,
+	"tracestate":  true,
+	"baggage":     true,
+}
+
+// Gateway is the HTTP/JSON facade of the Inventory service
+type Gateway struct {
+	conn    *grpc.ClientConn
+	client  proto.InventoryClient
+	mux     *runtime.ServeMux
+	server  *http.Server
+	timeout time.Duration
+	// seatMapMaxAge is how long shared caches may serve a rendered seat map
+	seatMapMaxAge time.Duration
+}
+
+// New creates a gateway proxying to the public gRPC listener of this instance
+func New(cfg *appconfig.Config) (*Gateway, error) {
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", cfg.Server.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gateway client: %w", err)
+	}
+
+	g := &Gateway{
+		conn:    conn,
+		client:  proto.NewInventoryClient(conn),
+		mux:     runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeader)),
+		timeout: cfg.Gateway.Timeout,
+
+		seatMapMaxAge: cfg.Gateway.SeatMapMaxAge,
+	}
+	routes, err := inventoryRoutes()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	for _, rt := range routes {
+		if err := g.mux.HandlePath(rt.method, rt.pattern, g.handler(rt)); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to register %s %s: %w", rt.method, rt.pattern, err)
+		}
+	}
+	if err := g.mux.HandlePath(http.MethodGet, seatMapPath, g.serveSeatMap); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to register %s: %w", seatMapPath, err)
+	}
+	document, err := openAPIDocument(routes)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to build OpenAPI document: %w", err)
+	}
+	err = g.mux.HandlePath(http.MethodGet, openAPIPath, func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to register %s: %w", openAPIPath, err)
+	}
+	g.server = &http.Server{
+		Handler:           g.mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return g, nil
+}
+
+// Serve serves the gateway on listener until Shutdown
+func (g *Gateway) Serve(listener net.Listener) error {
+	if err := g.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops accepting requests, waits for in-flight ones and closes the gRPC client
+func (g *Gateway) Shutdown(ctx context.Context) error {
+	err := g.server.Shutdown(ctx)
+	g.conn.Close()
+	return err
+}
+
+// handler proxies the requests of a route to its RPC. Requests of routes with a body are
+// read from the JSON body and others from the query string; path parameters take
+// precedence over both.
+func (g *Gateway) handler(rt route) runtime.HandlerFunc {
+	fullMethod := "/" + proto.Inventory_ServiceDesc.ServiceName + "/" + string(rt.rpc.Name())
+
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		inbound, outbound := runtime.MarshalerForRequest(g.mux, r)
+
+		ctx, cancel := context.WithTimeout(r.Context(), g.timeout)
+		defer cancel()
+		ctx, err := runtime.AnnotateContext(ctx, g.mux, r, fullMethod, runtime.WithHTTPPathPattern(rt.pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+
+		decode := func(req gproto.Message) error {
+			if !rt.body {
+				filter := make([][]string, 0, len(pathParams))
+				for name := range pathParams {
+					filter = append(filter, strings.Split(name, "."))
+				}
+				if err := runtime.PopulateQueryParameters(req, r.URL.Query(), utilities.NewDoubleArray(filter)); err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+				}
+			} else {
+				body := http.MaxBytesReader(w, r.Body, maxRequestBytes)
+				if err := inbound.NewDecoder(body).Decode(req); err != nil && !errors.Is(err, io.EOF) {
+					return status.Errorf(codes.InvalidArgument, "invalid body: %v", err)
+				}
+			}
+			for name, value := range pathParams {
+				if err := runtime.PopulateFieldFromPath(req, name, value); err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid %s: %v", name, err)
+				}
+			}
+			return nil
+		}
+
+		req, err := newMessage(rt.rpc.Input())
+		if err == nil {
+			err = decode(req)
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		resp, err := newMessage(rt.rpc.Output())
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+
+		var md runtime.ServerMetadata
+		err = g.conn.Invoke(ctx, fullMethod, req, resp, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, g.mux, outbound, w, r, resp)
+	}
+}
+
+// incomingHeader forwards the tracing, baggage and caller headers under their own names
+func incomingHeader(key string) (string, bool) {
+	if name := strings.ToLower(key); forwardedHeaders[name] {
+		return name, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,218 @@
+package gateway
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// openAPIPath serves the OpenAPI document of the gateway
+const openAPIPath = "/openapi.json"
+
+// pathParamPattern matches the parameters of a route pattern
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// openAPIDocument describes the routes as an OpenAPI 3 document. Like the routes, it is
+// derived from inventory.proto: schemas come from the descriptors of the RPCs' messages, with the field names and encodings
+// grpc-gateway's JSON marshaler uses (lowerCamelCase, 64-bit integers as strings).
+func openAPIDocument(routes []route) ([]byte, error) {
+	service := proto.File_proto_inventory_proto.Services().ByName("Inventory")
+	schemas := map[string]any{
+		"Status": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":    map[string]any{"type": "integer", "format": "int32", "description": "gRPC status code"},
+				"message": map[string]any{"type": "string"},
+				"details": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+			},
+		},
+	}
+	paths := map[string]map[string]any{}
+
+	for _, rt := range routes {
+		method := rt.rpc
+
+		var parameters []any
+		inPath := map[string]bool{}
+		for _, match := range pathParamPattern.FindAllStringSubmatch(rt.pattern, -1) {
+			inPath[match[1]] = true
+			parameters = append(parameters, map[string]any{
+				"name":     match[1],
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			})
+		}
+
+		operation := map[string]any{
+			"operationId": operationID(rt, routes),
+			"tags":        []string{string(service.Name())},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "OK",
+					"content":     jsonContent(messageRef(method.Output(), schemas)),
+				},
+				"default": map[string]any{
+					"description": "Error, with the HTTP status mapped from the gRPC status code",
+					"content":     jsonContent(map[string]any{"$ref": "#/components/schemas/Status"}),
+				},
+			},
+		}
+		if !rt.body {
+			fields := method.Input().Fields()
+			for i := 0; i < fields.Len(); i++ {
+				field := fields.Get(i)
+				if inPath[string(field.Name())] || field.Kind() == protoreflect.MessageKind || field.IsMap() {
+					continue
+				}
+				parameters = append(parameters, map[string]any{
+					"name":   field.JSONName(),
+					"in":     "query",
+					"schema": fieldSchema(field, schemas),
+				})
+			}
+		} else {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(messageRef(method.Input(), schemas)),
+			}
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+
+		if paths[rt.pattern] == nil {
+			paths[rt.pattern] = map[string]any{}
+		}
+		paths[rt.pattern][strings.ToLower(rt.method)] = operation
+	}
+
+	paths[seatMapPath] = map[string]any{"get": seatMapOperation()}
+
+	return json.Marshal(map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Inventory API",
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	})
+}
+
+// seatMapOperation describes the seat map rendering route, which isn't an RPC
+func seatMapOperation() map[string]any {
+	return map[string]any{
+		"operationId": "GetSeatMap",
+		"tags":        []string{"SeatMap"},
+		"description": "Seat availability as a grid of status codes per row (" + strings.Join(seatMapStatuses(), ", ") +
+			", .=no seat), or as SVG. Hidden seat segments are left out.",
+		"parameters": []any{
+			map[string]any{"name": "event_id", "in": "path", "required": true, "schema": map[string]any{"type": "string"}},
+			map[string]any{"name": "performance_id", "in": "query", "schema": map[string]any{"type": "string"}},
+			map[string]any{"name": "format", "in": "query", "schema": map[string]any{"type": "string", "enum": []string{"json", "svg"}}},
+		},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "OK; public and cacheable, with an ETag",
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": map[string]any{"type": "object"}},
+					"image/svg+xml":    map[string]any{"schema": map[string]any{"type": "string"}},
+				},
+			},
+			"304": map[string]any{"description": "Not modified since the ETag in If-None-Match"},
+			"default": map[string]any{
+				"description": "Error, with the HTTP status mapped from the gRPC status code",
+				"content":     jsonContent(map[string]any{"$ref": "#/components/schemas/Status"}),
+			},
+		},
+	}
+}
+
+// operationID names a route's operation after its RPC; an RPC served by several routes
+// is told apart by HTTP method
+func operationID(rt route, routes []route) string {
+	name := string(rt.rpc.Name())
+	for _, other := range routes {
+		if other.rpc == rt.rpc && other.method != rt.method {
+			return name + "_" + strings.ToLower(rt.method)
+		}
+	}
+	return name
+}
+
+// jsonContent is a JSON media type object with the given schema
+func jsonContent(schema any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// messageRef returns the schema of a message: a well-known type inline, or a reference
+// to its component schema, which is added along with the messages it refers to
+func messageRef(message protoreflect.MessageDescriptor, schemas map[string]any) map[string]any {
+	switch message.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "example": "1.5s"}
+	}
+
+	name := string(message.Name())
+	if _, ok := schemas[name]; !ok {
+		// Reserve the name first so recursive messages terminate
+		schemas[name] = nil
+		properties := map[string]any{}
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			properties[fields.Get(i).JSONName()] = fieldSchema(fields.Get(i), schemas)
+		}
+		schemas[name] = map[string]any{"type": "object", "properties": properties}
+	}
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// fieldSchema returns the schema of a field as encoded by protojson
+func fieldSchema(field protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	if field.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": valueSchema(field.MapValue(), schemas)}
+	}
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": valueSchema(field, schemas)}
+	}
+	return valueSchema(field, schemas)
+}
+
+// valueSchema returns the schema of one value of a field
+func valueSchema(field protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(field.Message(), schemas)
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// route maps an HTTP method and path pattern to an Inventory RPC
+type route struct {
+	method  string
+	pattern string
+	rpc     protoreflect.MethodDescriptor
+	// body is whether the request is read from the JSON body rather than the query string
+	body bool
+}
+
+// inventoryRoutes returns the REST mappings of the Inventory RPCs, from their
+// google.api.http options in inventory.proto. RPCs without one, such as the
+// SubscribeChanges stream, aren't exposed.
+func inventoryRoutes() ([]route, error) {
+	var routes []route
+	methods := proto.File_proto_inventory_proto.Services().ByName("Inventory").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		rule, ok := gproto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			return nil, fmt.Errorf("streaming RPC %s can't have an HTTP mapping", method.Name())
+		}
+		for _, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+			rt, err := bindingRoute(method, binding)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, rt)
+		}
+	}
+	return routes, nil
+}
+
+// bindingRoute returns the route of one HTTP binding of an RPC
+func bindingRoute(method protoreflect.MethodDescriptor, binding *annotations.HttpRule) (route, error) {
+	rt := route{rpc: method, body: binding.Body != ""}
+	switch pattern := binding.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		rt.method, rt.pattern = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		rt.method, rt.pattern = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		rt.method, rt.pattern = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		rt.method, rt.pattern = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		rt.method, rt.pattern = http.MethodDelete, pattern.Delete
+	default:
+		return route{}, fmt.Errorf("RPC %s has an unsupported HTTP binding", method.Name())
+	}
+	if binding.Body != "" && binding.Body != "*" {
+		return route{}, fmt.Errorf("RPC %s binds its body to field %q; only \"*\" is supported", method.Name(), binding.Body)
+	}
+	return rt, nil
+}
+
+// newMessage returns an empty message of a type of the proto package
+func newMessage(message protoreflect.MessageDescriptor) (gproto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(message.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find message %s: %w", message.FullName(), err)
+	}
+	return messageType.New().Interface(), nil
+}
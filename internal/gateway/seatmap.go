@@ -0,0 +1,263 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// seatMapPath serves the rendered seat map of an event
+const seatMapPath = "/v1/events/{event_id}/seatmap"
+
+// Seat map limits: seats are read a ListSeats page at a time, up to maxSeatMapSeats
+const (
+	seatMapPageSize = 1000
+	maxSeatMapSeats = 50000
+)
+
+// SVG layout of a seat map, in pixels
+const (
+	svgSeatPitch     = 10
+	svgSeatRadius    = 4
+	svgLabelWidth    = 40
+	svgSectionHeader = 20
+	svgSectionGap    = 10
+)
+
+// seatMapCodes are the one-character codes of seat statuses in JSON grid rows; gaps in a
+// row are "."
+var seatMapCodes = map[proto.SeatStatus]byte{
+	proto.SeatStatus_SEAT_STATUS_AVAILABLE:         'A',
+	proto.SeatStatus_SEAT_STATUS_HOLD:              'H',
+	proto.SeatStatus_SEAT_STATUS_SOLD:              'S',
+	proto.SeatStatus_SEAT_STATUS_BLOCKED:           'B',
+	proto.SeatStatus_SEAT_STATUS_KILLED:            'K',
+	proto.SeatStatus_SEAT_STATUS_RESERVED_INTERNAL: 'R',
+	proto.SeatStatus_SEAT_STATUS_ALLOCATED:         'L',
+	proto.SeatStatus_SEAT_STATUS_CLOSED:            'C',
+}
+
+// seatMapColors are the SVG fill colors of seat status codes
+var seatMapColors = map[byte]string{
+	'A': "#2e7d32",
+	'H': "#f9a825",
+	'S': "#9e9e9e",
+	'B': "#6d4c41",
+	'K': "#ffffff",
+	'R': "#1565c0",
+	'L': "#6a1b9a",
+	'C': "#bdbdbd",
+}
+
+// seatMapRow is a row of a rendered seat map. Seats is one status code per seat number
+// starting at 1.
+type seatMapRow struct {
+	Row   string `json:"row"`
+	Seats string `json:"seats"`
+}
+
+// seatMapSection is a section of a rendered seat map, rows in seat ID order
+type seatMapSection struct {
+	Section string       `json:"section"`
+	Rows    []seatMapRow `json:"rows"`
+}
+
+// seatMap is the compact JSON grid of an event's seat availability
+type seatMap struct {
+	EventID        string            `json:"event_id"`
+	PerformanceID  string            `json:"performance_id,omitempty"`
+	SeatMapVersion int32             `json:"seat_map_version"`
+	Legend         map[string]string `json:"legend"`
+	Sections       []seatMapSection  `json:"sections"`
+}
+
+// serveSeatMap renders the seat availability of an event as a JSON grid, or as SVG with
+// ?format=svg, for front ends to draw without listing every seat themselves. Responses
+// are public and carry an ETag of their content, so a CDN can cache them for the
+// configured max age and revalidate cheaply. Hidden seat segments are left out, as no
+// access code is accepted on a shared cache.
+func (g *Gateway) serveSeatMap(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	_, outbound := runtime.MarshalerForRequest(g.mux, r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), g.timeout)
+	defer cancel()
+	fullMethod := "/" + proto.Inventory_ServiceDesc.ServiceName + "/ListSeats"
+	ctx, err := runtime.AnnotateContext(ctx, g.mux, r, fullMethod, runtime.WithHTTPPathPattern(seatMapPath))
+	if err != nil {
+		runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "svg" {
+		runtime.HTTPError(ctx, g.mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "format must be json or svg, got %q", format))
+		return
+	}
+
+	sm, err := g.loadSeatMap(ctx, pathParams["event_id"], r.URL.Query().Get("performance_id"))
+	if err != nil {
+		runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+		return
+	}
+
+	var body []byte
+	contentType := "application/json"
+	if format == "svg" {
+		body = renderSeatMapSVG(sm)
+		contentType = "image/svg+xml"
+	} else if body, err = json.Marshal(sm); err != nil {
+		runtime.HTTPError(ctx, g.mux, outbound, w, r, status.Errorf(codes.Internal, "failed to encode seat map: %v", err))
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(g.seatMapMaxAge.Seconds())))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Vary", "Accept-Encoding")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// loadSeatMap pages through the seats of an event and groups them into a grid. Seat IDs
+// are "<section>-<row>-<number>" as CreateEvent lays them out; seats with other IDs are
+// placed one after another on a row named after the rest of their ID.
+func (g *Gateway) loadSeatMap(ctx context.Context, eventID, performanceID string) (*seatMap, error) {
+	sm := &seatMap{EventID: eventID, PerformanceID: performanceID, Legend: make(map[string]string)}
+	for seatStatus, code := range seatMapCodes {
+		sm.Legend[string(code)] = strings.TrimPrefix(seatStatus.String(), "SEAT_STATUS_")
+	}
+
+	type rowKey struct{ section, row string }
+	rows := make(map[rowKey][]byte)
+	var order []rowKey
+	seen := 0
+	pageToken := ""
+	for {
+		page, err := g.client.ListSeats(ctx, &proto.ListSeatsReq{
+			EventId:       eventID,
+			PerformanceId: performanceID,
+			PageToken:     pageToken,
+			PageSize:      seatMapPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sm.SeatMapVersion = page.SeatMapVersion
+
+		for _, seat := range page.Seats {
+			seen++
+			if seen > maxSeatMapSeats {
+				return nil, status.Errorf(codes.FailedPrecondition, "event %s has more than %d seats to render", eventID, maxSeatMapSeats)
+			}
+
+			section, row, number := splitSeatID(seat.SeatId)
+			key := rowKey{section, row}
+			cells, ok := rows[key]
+			if !ok {
+				order = append(order, key)
+			}
+			if number <= 0 {
+				number = len(cells) + 1
+			}
+			for len(cells) < number {
+				cells = append(cells, '.')
+			}
+			code, ok := seatMapCodes[seat.Status]
+			if !ok {
+				code = '.'
+			}
+			cells[number-1] = code
+			rows[key] = cells
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	for _, key := range order {
+		if len(sm.Sections) == 0 || sm.Sections[len(sm.Sections)-1].Section != key.section {
+			sm.Sections = append(sm.Sections, seatMapSection{Section: key.section})
+		}
+		section := &sm.Sections[len(sm.Sections)-1]
+		section.Rows = append(section.Rows, seatMapRow{Row: key.row, Seats: string(rows[key])})
+	}
+	return sm, nil
+}
+
+// splitSeatID splits a "<section>-<row>-<number>" seat ID; number is 0 for other IDs
+func splitSeatID(seatID string) (section, row string, number int) {
+	parts := strings.Split(seatID, "-")
+	if len(parts) >= 3 {
+		if n, err := strconv.Atoi(parts[len(parts)-1]); err == nil && n > 0 && n <= maxSeatMapSeats {
+			return parts[0], strings.Join(parts[1:len(parts)-1], "-"), n
+		}
+	}
+	section, row, _ = strings.Cut(seatID, "-")
+	return section, row, 0
+}
+
+// renderSeatMapSVG draws each seat as a dot colored by status, a section per block
+func renderSeatMapSVG(sm *seatMap) []byte {
+	width, height := svgLabelWidth, 0
+	for _, section := range sm.Sections {
+		height += svgSectionHeader + len(section.Rows)*svgSeatPitch + svgSectionGap
+		for _, row := range section.Rows {
+			width = max(width, svgLabelWidth+len(row.Seats)*svgSeatPitch)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="9">`, width, height, width, height)
+	y := 0
+	for _, section := range sm.Sections {
+		fmt.Fprintf(&b, `<text x="0" y="%d" font-weight="bold">%s</text>`, y+svgSectionHeader-6, html.EscapeString(section.Section))
+		y += svgSectionHeader
+		for _, row := range section.Rows {
+			cy := y + svgSeatPitch/2
+			fmt.Fprintf(&b, `<text x="0" y="%d">%s</text>`, cy+3, html.EscapeString(row.Row))
+			for i := range len(row.Seats) {
+				color, ok := seatMapColors[row.Seats[i]]
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="%d" fill="%s" stroke="#424242" stroke-width="0.5"/>`,
+					svgLabelWidth+i*svgSeatPitch+svgSeatPitch/2, cy, svgSeatRadius, color)
+			}
+			y += svgSeatPitch
+		}
+		y += svgSectionGap
+	}
+	b.WriteString(`</svg>`)
+	return b.Bytes()
+}
+
+// seatMapStatuses lists the status codes of grid rows in a stable order
+func seatMapStatuses() []string {
+	names := make([]string, 0, len(seatMapCodes))
+	for seatStatus, code := range seatMapCodes {
+		names = append(names, fmt.Sprintf("%c=%s", code, strings.TrimPrefix(seatStatus.String(), "SEAT_STATUS_")))
+	}
+	slices.Sort(names)
+	return names
+}
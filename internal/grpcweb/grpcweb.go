@@ -0,0 +1,248 @@
+// Package grpcweb serves the Inventory service to browsers over gRPC-Web. Requests are
+// translated in process and handed to the public gRPC server, so no Envoy is needed in
+// front and calls pass through the same interceptors as native gRPC calls.
+package grpcweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// gRPC-Web content types; "+proto" may follow either
+const (
+	contentTypeGRPC        = "application/grpc"
+	contentTypeGRPCWeb     = "application/grpc-web"
+	contentTypeGRPCWebText = "application/grpc-web-text"
+)
+
+// trailerFrameFlag marks the frame carrying the trailers at the end of a response body
+const trailerFrameFlag byte = 0x80
+
+// preflightMaxAge is how long browsers may cache a CORS preflight
+const preflightMaxAge = 10 * time.Minute
+
+// Server serves the Inventory RPCs of a gRPC server over gRPC-Web
+type Server struct {
+	grpc   *grpc.Server
+	server *http.Server
+	// prefix is the path prefix of the Inventory RPCs; other services aren't exposed
+	prefix string
+	// streams are the paths of the streaming RPCs
+	streams map[string]bool
+
+	origins    map[string]bool
+	anyOrigin  bool
+	stopCalls  context.CancelFunc
+	streamsCtx context.Context
+	stopStream context.CancelFunc
+}
+
+// New creates a gRPC-Web server handing calls to grpcServer
+func New(cfg *appconfig.Config, grpcServer *grpc.Server) *Server {
+	s := &Server{
+		grpc:    grpcServer,
+		prefix:  "/" + proto.Inventory_ServiceDesc.ServiceName + "/",
+		streams: make(map[string]bool),
+		origins: make(map[string]bool),
+	}
+	for _, stream := range proto.Inventory_ServiceDesc.Streams {
+		s.streams[s.prefix+stream.StreamName] = true
+	}
+	for _, origin := range cfg.GrpcWeb.AllowedOrigins {
+		if origin == "*" {
+			s.anyOrigin = true
+		}
+		s.origins[origin] = true
+	}
+
+	callsCtx, stopCalls := context.WithCancel(context.Background())
+	s.stopCalls = stopCalls
+	s.streamsCtx, s.stopStream = context.WithCancel(callsCtx)
+	s.server = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 5 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return callsCtx },
+	}
+	return s
+}
+
+// Serve serves gRPC-Web on listener until Shutdown
+func (s *Server) Serve(listener net.Listener) error {
+	if err := s.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops accepting requests, ends streams and waits for unary calls. Calls
+// still running when ctx is done are canceled and waited for: the gRPC server can't
+// drain calls served over HTTP itself, so they must be over before it stops.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.stopStream()
+	err := s.server.Shutdown(ctx)
+	if err != nil {
+		s.stopCalls()
+		s.server.Shutdown(context.Background())
+	}
+	return err
+}
+
+// ServeHTTP translates a gRPC-Web request to gRPC and the gRPC response back
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !s.anyOrigin && !s.origins[origin] {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Expose-Headers", "grpc-status, grpc-message, grpc-status-details-bin")
+	}
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+		w.Header().Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+		w.Header().Set("Access-Control-Max-Age", fmt.Sprintf("%d", int(preflightMaxAge.Seconds())))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "gRPC-Web requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, s.prefix) {
+		http.NotFound(w, r)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	webType := contentTypeGRPCWeb
+	if strings.HasPrefix(contentType, contentTypeGRPCWebText) {
+		webType = contentTypeGRPCWebText
+	} else if !strings.HasPrefix(contentType, contentTypeGRPCWeb) {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+	text := webType == contentTypeGRPCWebText
+
+	// Streams end on shutdown right away; clients resume them elsewhere
+	ctx := r.Context()
+	if s.streams[r.URL.Path] {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer context.AfterFunc(s.streamsCtx, cancel)()
+		defer cancel()
+	}
+
+	req := r.Clone(ctx)
+	req.ProtoMajor, req.ProtoMinor = 2, 0
+	req.Header.Set("Content-Type", contentTypeGRPC+strings.TrimPrefix(contentType, webType))
+	req.Header.Del("Content-Length")
+	if text {
+		req.Body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	rw := &responseWriter{w: w, header: make(http.Header), webType: webType, text: text}
+	s.grpc.ServeHTTP(rw, req)
+	rw.finish()
+}
+
+// responseWriter turns the HTTP/2 response of the gRPC server into gRPC-Web: trailers
+// become a frame at the end of the body, and in text mode the body is base64-encoded
+// one flush (which is one message) at a time.
+type responseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	webType     string
+	text        bool
+	wroteHeader bool
+	// pending is the text-mode body not yet encoded and flushed
+	pending bytes.Buffer
+}
+
+// Header returns the header the gRPC server writes to; trailers are set on it after the body
+func (rw *responseWriter) Header() http.Header {
+	return rw.header
+}
+
+// WriteHeader sends the headers, without trailer declarations and with the gRPC-Web content type
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+
+	header := rw.w.Header()
+	for name, values := range rw.header {
+		if name == "Trailer" || strings.HasPrefix(name, http.TrailerPrefix) {
+			continue
+		}
+		header[name] = values
+	}
+	if contentType := rw.header.Get("Content-Type"); strings.HasPrefix(contentType, contentTypeGRPC) {
+		header.Set("Content-Type", rw.webType+strings.TrimPrefix(contentType, contentTypeGRPC))
+	}
+	rw.w.WriteHeader(code)
+}
+
+// Write writes to the body
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+	if rw.text {
+		return rw.pending.Write(b)
+	}
+	return rw.w.Write(b)
+}
+
+// Flush sends the body written so far
+func (rw *responseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+	if rw.text && rw.pending.Len() > 0 {
+		encoded := base64.StdEncoding.EncodeToString(rw.pending.Bytes())
+		rw.pending.Reset()
+		if _, err := io.WriteString(rw.w, encoded); err != nil {
+			return
+		}
+	}
+	rw.w.(http.Flusher).Flush()
+}
+
+// finish writes the trailers the gRPC server set as the final frame of the body
+func (rw *responseWriter) finish() {
+	var trailers bytes.Buffer
+	for _, name := range rw.header.Values("Trailer") {
+		for _, value := range rw.header.Values(name) {
+			fmt.Fprintf(&trailers, "%s: %s\r\n", strings.ToLower(name), value)
+		}
+	}
+	for name, values := range rw.header {
+		if trailer, ok := strings.CutPrefix(name, http.TrailerPrefix); ok {
+			for _, value := range values {
+				fmt.Fprintf(&trailers, "%s: %s\r\n", strings.ToLower(trailer), value)
+			}
+		}
+	}
+	if trailers.Len() == 0 {
+		return
+	}
+
+	frame := make([]byte, 5, 5+trailers.Len())
+	frame[0] = trailerFrameFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(trailers.Len()))
+	rw.Write(append(frame, trailers.Bytes()...))
+	rw.Flush()
+}
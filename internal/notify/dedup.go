@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// DedupKey returns the deterministic deduplication key of an event: the same operation
+// on the same version of a reservation's state always gets the same key, so consumers
+// can drop redeliveries and retried publishes
+func DedupKey(operation, reservationID string, version int64) string {
+	return fmt.Sprintf("%s:%s:%d", operation, reservationID, version)
+}
+
+// deduplicate makes a publish idempotent under dedupKey. The key is always set as the
+// dedup_key message attribute; FIFO topics additionally drop duplicates themselves
+// within their 5-minute deduplication window, ordered per groupID.
+func deduplicate(input *sns.PublishInput, dedupKey, groupID string) {
+	if input.MessageAttributes == nil {
+		input.MessageAttributes = make(map[string]snstypes.MessageAttributeValue)
+	}
+	input.MessageAttributes["dedup_key"] = snstypes.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(dedupKey),
+	}
+
+	if !strings.HasSuffix(aws.ToString(input.TopicArn), ".fifo") {
+		return
+	}
+	// Deduplication IDs are limited to 128 characters
+	sum := sha256.Sum256([]byte(dedupKey))
+	input.MessageDeduplicationId = aws.String(hex.EncodeToString(sum[:]))
+	input.MessageGroupId = aws.String(groupID)
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	"github.com/traffictacos/inventory-api/internal/awsenv"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// HoldEventExpired is the type of events announcing that holds expired and their seats
+// returned to sale
+const HoldEventExpired = "hold_expired"
+
+// HoldEvent announces a change of a reservation's seat holds
+type HoldEvent struct {
+	Type    string `json:"type"`
+	EventID string `json:"event_id"`
+	// PerformanceID is set for performances of multi-performance events
+	PerformanceID string    `json:"performance_id,omitempty"`
+	ReservationID string    `json:"reservation_id"`
+	SeatIDs       []string  `json:"seat_ids"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	// DedupKey is the same for every delivery of the same change (see DedupKey)
+	DedupKey string `json:"dedup_key"`
+}
+
+// HoldEventPublisher publishes hold events to an SNS topic, e.g. for reservation-api to
+// expire reservations whose seats were reclaimed
+type HoldEventPublisher struct {
+	client   *sns.Client
+	topicARN string
+	timeout  time.Duration
+	schemas  *SchemaRegistry
+}
+
+// NewHoldEventPublisher creates a hold event publisher, or returns nil when no topic is configured
+func NewHoldEventPublisher(cfg *appconfig.Config, schemas *SchemaRegistry) (*HoldEventPublisher, error) {
+	if cfg.Notifications.HoldEventsTopicARN == "" {
+		return nil, nil
+	}
+
+	awsCfg, err := awsenv.Load(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HoldEventPublisher{
+		client:   sns.NewFromConfig(awsCfg),
+		topicARN: cfg.Notifications.HoldEventsTopicARN,
+		timeout:  cfg.Notifications.PublishTimeout,
+		schemas:  schemas,
+	}, nil
+}
+
+// Publish sends a hold event. The type and event ID are also set as message attributes
+// so subscribers can filter on them. Publishing is idempotent under the event's dedup key.
+func (p *HoldEventPublisher) Publish(ctx context.Context, e *HoldEvent) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal hold event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(p.topicARN),
+		Message:  aws.String(string(body)),
+		MessageAttributes: map[string]snstypes.MessageAttributeValue{
+			"type": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(e.Type),
+			},
+			"event_id": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(e.EventID),
+			},
+		},
+	}
+	deduplicate(input, e.DedupKey, e.EventID)
+	p.schemas.stamp(input, holdEventSubject)
+
+	_, err = p.client.Publish(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to publish hold event: %w", err)
+	}
+
+	return nil
+}
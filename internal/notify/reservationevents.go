@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/traffictacos/inventory-api/internal/awsenv"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// Reservation lifecycle event detail types published by reservation-api
+const (
+	ReservationCreated   = "ReservationCreated"
+	ReservationExpired   = "ReservationExpired"
+	ReservationCancelled = "ReservationCancelled"
+)
+
+// maxReceiveMessages is the SQS ReceiveMessage batch limit
+const maxReceiveMessages = 10
+
+// ReservationEvent is the detail of a reservation lifecycle event
+type ReservationEvent struct {
+	ReservationID string   `json:"reservation_id"`
+	EventID       string   `json:"event_id"`
+	PerformanceID string   `json:"performance_id,omitempty"`
+	Qty           int32    `json:"qty,omitempty"`
+	SeatIDs       []string `json:"seat_ids,omitempty"`
+	// SectionQtys are general-admission quantities of a hybrid event by section
+	SectionQtys map[string]int32 `json:"section_qtys,omitempty"`
+}
+
+// ReservationEventMessage is a reservation lifecycle event received from the queue.
+// Type is empty and Event nil when the message isn't a well-formed lifecycle event.
+type ReservationEventMessage struct {
+	Type          string
+	Event         *ReservationEvent
+	receiptHandle string
+}
+
+// eventBridgeEnvelope is the EventBridge event an SQS target receives as message body
+type eventBridgeEnvelope struct {
+	DetailType string          `json:"detail-type"`
+	Detail     json.RawMessage `json:"detail"`
+}
+
+// ReservationEventQueue receives reservation-api lifecycle events from the SQS queue an
+// EventBridge rule targets. Messages that aren't acknowledged with Delete are delivered
+// again after the queue's visibility timeout, and moved to its dead-letter queue, if
+// configured, after its maximum receive count.
+type ReservationEventQueue struct {
+	client   *sqs.Client
+	queueURL string
+	waitTime time.Duration
+}
+
+// NewReservationEventQueue creates a reservation event queue, or returns nil when no queue is configured
+func NewReservationEventQueue(cfg *appconfig.Config) (*ReservationEventQueue, error) {
+	if cfg.ReservationEvents.QueueURL == "" {
+		return nil, nil
+	}
+
+	awsCfg, err := awsenv.Load(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationEventQueue{
+		client:   sqs.NewFromConfig(awsCfg),
+		queueURL: cfg.ReservationEvents.QueueURL,
+		waitTime: min(cfg.ReservationEvents.WaitTime, 20*time.Second),
+	}, nil
+}
+
+// Receive long-polls the queue for up to 10 events
+func (q *ReservationEventQueue) Receive(ctx context.Context) ([]*ReservationEventMessage, error) {
+	result, err := q.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(q.queueURL),
+		MaxNumberOfMessages: maxReceiveMessages,
+		WaitTimeSeconds:     int32(q.waitTime.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive reservation events: %w", err)
+	}
+
+	messages := make([]*ReservationEventMessage, 0, len(result.Messages))
+	for _, message := range result.Messages {
+		received := &ReservationEventMessage{receiptHandle: aws.ToString(message.ReceiptHandle)}
+
+		var envelope eventBridgeEnvelope
+		event := &ReservationEvent{}
+		if json.Unmarshal([]byte(aws.ToString(message.Body)), &envelope) == nil &&
+			json.Unmarshal(envelope.Detail, event) == nil {
+			received.Type = envelope.DetailType
+			received.Event = event
+		}
+		messages = append(messages, received)
+	}
+
+	return messages, nil
+}
+
+// Delete acknowledges a handled event so it isn't delivered again
+func (q *ReservationEventQueue) Delete(ctx context.Context, message *ReservationEventMessage) error {
+	_, err := q.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(q.queueURL),
+		ReceiptHandle: aws.String(message.receiptHandle),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete reservation event: %w", err)
+	}
+
+	return nil
+}
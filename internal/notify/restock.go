@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	"github.com/traffictacos/inventory-api/internal/awsenv"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// RestockNotification announces that inventory returned to sale for a sold-out event
+type RestockNotification struct {
+	EventID string `json:"event_id"`
+	// PerformanceID is set for performances of multi-performance events
+	PerformanceID string `json:"performance_id,omitempty"`
+	// Quantity is the number of tickets (quantity events) or seats (seat events) returned
+	Quantity int32 `json:"quantity"`
+	// Sections lists the sections of the returned seats; empty for quantity events
+	Sections    []string  `json:"sections,omitempty"`
+	Reason      string    `json:"reason"` // RELEASED, EXPIRED, SWAPPED
+	RestockedAt time.Time `json:"restocked_at"`
+	// Tags are the baggage tags (tenant, campaign, ...) of the request that returned inventory
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// RestockPublisher publishes restock notifications to an SNS topic.
+// Fan-notification systems subscribe to the topic directly or through an SQS queue.
+type RestockPublisher struct {
+	client      *sns.Client
+	topicARN    string
+	timeout     time.Duration
+	baggageKeys []string
+	schemas     *SchemaRegistry
+}
+
+// NewRestockPublisher creates a restock publisher, or returns nil when no topic is configured
+func NewRestockPublisher(cfg *appconfig.Config, schemas *SchemaRegistry) (*RestockPublisher, error) {
+	if cfg.Notifications.RestockTopicARN == "" {
+		return nil, nil
+	}
+
+	awsCfg, err := awsenv.Load(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestockPublisher{
+		client:      sns.NewFromConfig(awsCfg),
+		topicARN:    cfg.Notifications.RestockTopicARN,
+		timeout:     cfg.Notifications.PublishTimeout,
+		baggageKeys: cfg.Observability.BaggageKeys,
+		schemas:     schemas,
+	}, nil
+}
+
+// Publish sends a restock notification tagged with the baggage of ctx. The event ID
+// and tags are also set as message attributes so subscribers can filter on them, along
+// with the schema version when a schema registry is configured.
+func (p *RestockPublisher) Publish(ctx context.Context, n *RestockNotification) error {
+	if n.Tags == nil {
+		n.Tags = observability.BaggageTags(ctx, p.baggageKeys)
+	}
+
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to marshal restock notification: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	attributes := map[string]snstypes.MessageAttributeValue{
+		"event_id": {
+			DataType:    aws.String("String"),
+			StringValue: aws.String(n.EventID),
+		},
+	}
+	for key, value := range n.Tags {
+		attributes["baggage."+key] = snstypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(value),
+		}
+	}
+
+	input := &sns.PublishInput{
+		TopicArn:          aws.String(p.topicARN),
+		Message:           aws.String(string(body)),
+		MessageAttributes: attributes,
+	}
+	p.schemas.stamp(input, restockSubject)
+
+	_, err = p.client.Publish(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to publish restock notification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// Schema registry subjects of the published messages
+const (
+	restockSubject   = "inventory-api.restock_notification"
+	holdEventSubject = "inventory-api.hold_event"
+)
+
+// Registered schemas are the JSON schemas of the message bodies. Changes must stay
+// compatible under the subject's compatibility level, or registration fails at startup.
+var eventSchemas = map[string]string{
+	restockSubject: `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "RestockNotification",
+  "type": "object",
+  "properties": {
+    "event_id": {"type": "string"},
+    "performance_id": {"type": "string"},
+    "quantity": {"type": "integer"},
+    "sections": {"type": "array", "items": {"type": "string"}},
+    "reason": {"type": "string", "enum": ["RELEASED", "EXPIRED"]},
+    "restocked_at": {"type": "string", "format": "date-time"},
+    "tags": {"type": "object", "additionalProperties": {"type": "string"}}
+  },
+  "required": ["event_id", "quantity", "reason", "restocked_at"]
+}`,
+	holdEventSubject: `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "HoldEvent",
+  "type": "object",
+  "properties": {
+    "type": {"type": "string", "enum": ["hold_expired"]},
+    "event_id": {"type": "string"},
+    "performance_id": {"type": "string"},
+    "reservation_id": {"type": "string"},
+    "seat_ids": {"type": "array", "items": {"type": "string"}},
+    "occurred_at": {"type": "string", "format": "date-time"},
+    "dedup_key": {"type": "string"}
+  },
+  "required": ["type", "event_id", "reservation_id", "seat_ids", "occurred_at", "dedup_key"]
+}`,
+}
+
+// SchemaRegistry registers the schemas of published messages in a Confluent-compatible
+// schema registry and stamps every message with the subject and version of its schema,
+// so consumers can pick the schema a message was written with
+type SchemaRegistry struct {
+	client   *http.Client
+	url      string
+	username string
+	password string
+	// versions is the registered version of each subject, filled in by Register
+	versions map[string]int
+}
+
+// NewSchemaRegistry creates a schema registry client, or returns nil when no registry is configured
+func NewSchemaRegistry(cfg *appconfig.Config) *SchemaRegistry {
+	if cfg.Notifications.SchemaRegistryURL == "" {
+		return nil
+	}
+
+	return &SchemaRegistry{
+		client:   &http.Client{Timeout: cfg.Notifications.SchemaRegistryTimeout},
+		url:      strings.TrimSuffix(cfg.Notifications.SchemaRegistryURL, "/"),
+		username: cfg.Notifications.SchemaRegistryUsername,
+		password: cfg.Notifications.SchemaRegistryPassword,
+		versions: make(map[string]int),
+	}
+}
+
+// Register registers every message schema, which is a no-op for schemas already
+// registered, and looks up their versions. It must complete before publishing starts.
+func (r *SchemaRegistry) Register(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	for subject, schema := range eventSchemas {
+		body, err := json.Marshal(map[string]string{"schemaType": "JSON", "schema": schema})
+		if err != nil {
+			return fmt.Errorf("failed to marshal schema %s: %w", subject, err)
+		}
+
+		if err := r.post(ctx, "/subjects/"+url.PathEscape(subject)+"/versions", body, nil); err != nil {
+			return fmt.Errorf("failed to register schema %s: %w", subject, err)
+		}
+
+		var registered struct {
+			Version int `json:"version"`
+		}
+		if err := r.post(ctx, "/subjects/"+url.PathEscape(subject), body, &registered); err != nil {
+			return fmt.Errorf("failed to look up schema %s: %w", subject, err)
+		}
+		r.versions[subject] = registered.Version
+		fmt.Printf("Registered schema %s version %d\n", subject, registered.Version)
+	}
+
+	return nil
+}
+
+// post sends a registry request and decodes its response into out when out is non-nil
+func (r *SchemaRegistry) post(ctx context.Context, path string, body []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	if r.username != "" {
+		req.SetBasicAuth(r.username, r.password)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("schema registry returned %d: %s", resp.StatusCode, bytes.TrimSpace(message))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// stamp sets the schema_subject and schema_version message attributes of a publish
+func (r *SchemaRegistry) stamp(input *sns.PublishInput, subject string) {
+	if r == nil {
+		return
+	}
+
+	if input.MessageAttributes == nil {
+		input.MessageAttributes = make(map[string]snstypes.MessageAttributeValue)
+	}
+	input.MessageAttributes["schema_subject"] = snstypes.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(subject),
+	}
+	input.MessageAttributes["schema_version"] = snstypes.MessageAttributeValue{
+		DataType:    aws.String("Number"),
+		StringValue: aws.String(strconv.Itoa(r.versions[subject])),
+	}
+}
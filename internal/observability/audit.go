@@ -0,0 +1,76 @@
+package observability
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditRecord is one entry of the audit log
+type AuditRecord struct {
+	Time    time.Time              `json:"time"`
+	Type    string                 `json:"type"` // always "audit", to route records apart from other logs
+	Action  string                 `json:"action"`
+	EventID string                 `json:"event_id,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// auditRecentRecords is the number of latest records kept in memory for the admin UI
+const auditRecentRecords = 100
+
+// AuditLog writes audit records as JSON lines for changes the service makes on its own
+// (rather than on behalf of a caller), so operators can trace them later
+type AuditLog struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	// recent is a ring of the latest records; next is the slot the next record takes
+	recent []AuditRecord
+	next   int
+}
+
+// NewAuditLog creates an audit log writing to w, or to stdout when w is nil
+func NewAuditLog(w io.Writer) *AuditLog {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &AuditLog{enc: json.NewEncoder(w)}
+}
+
+// Record writes an audit record, logging failures
+func (a *AuditLog) Record(action, eventID string, details map[string]interface{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	record := AuditRecord{
+		Time:    time.Now().UTC(),
+		Type:    "audit",
+		Action:  action,
+		EventID: eventID,
+		Details: details,
+	}
+	if len(a.recent) < auditRecentRecords {
+		a.recent = append(a.recent, record)
+	} else {
+		a.recent[a.next] = record
+	}
+	a.next = (a.next + 1) % auditRecentRecords
+
+	if err := a.enc.Encode(record); err != nil {
+		fmt.Printf("Warning: failed to write audit record %s for event %s: %v\n", action, eventID, err)
+	}
+}
+
+// Recent returns the latest records written by this instance, newest first
+func (a *AuditLog) Recent() []AuditRecord {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	records := make([]AuditRecord, 0, len(a.recent))
+	for i := 1; i <= len(a.recent); i++ {
+		records = append(records, a.recent[(a.next-i+len(a.recent))%len(a.recent)])
+	}
+	return records
+}
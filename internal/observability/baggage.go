@@ -0,0 +1,78 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestPropagator extracts W3C trace context and baggage from incoming requests.
+// It doesn't depend on the global propagator so baggage is available without a tracer.
+var requestPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+// Get returns the first value of a key
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set sets a key's value
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys returns all keys
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ExtractIncoming returns ctx with the remote span context and baggage of the incoming request
+func ExtractIncoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return requestPropagator.Extract(ctx, metadataCarrier(md))
+}
+
+// BaggageTags returns the values of the given baggage keys in ctx, omitting absent keys
+func BaggageTags(ctx context.Context, keys []string) map[string]string {
+	bag := baggage.FromContext(ctx)
+	var tags map[string]string
+	for _, key := range keys {
+		if value := bag.Member(key).Value(); value != "" {
+			if tags == nil {
+				tags = make(map[string]string, len(keys))
+			}
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
+// BaggageAttributes returns the given baggage keys in ctx as "baggage.<key>" span attributes
+func BaggageAttributes(ctx context.Context, keys []string) []attribute.KeyValue {
+	tags := BaggageTags(ctx, keys)
+	attrs := make([]attribute.KeyValue, 0, len(tags))
+	for _, key := range keys {
+		if value, ok := tags[key]; ok {
+			attrs = append(attrs, attribute.String("baggage."+key, value))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,152 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// emfMaxValues is the most values CloudWatch accepts for one metric of an EMF document
+const emfMaxValues = 100
+
+// emfKey identifies an aggregated metric by name and dimension values
+type emfKey struct {
+	name       string
+	dimensions [2]string
+}
+
+// emfDimensionNames are the dimension names of each business metric, beside Service
+var emfDimensionNames = map[string][]string{
+	"CommitReservations": {"InventoryType", "Status"},
+	"ReleaseHolds":       {"InventoryType", "Status"},
+	"AvailabilityChecks": {"InventoryType", "Result"},
+	"InventoryConflicts": {"ConflictType"},
+	"HoldFunnel":         {"Stage"},
+}
+
+// EMFEmitter writes the core business metrics in CloudWatch embedded metric format, one
+// JSON document per metric and dimension set per flush, to stdout where the CloudWatch
+// agent or the Lambda/ECS log driver extracts them. Counts are summed between flushes so
+// the log volume doesn't grow with traffic.
+type EMFEmitter struct {
+	out       io.Writer
+	namespace string
+	service   string
+	interval  time.Duration
+
+	mu           sync.Mutex
+	counts       map[emfKey]float64
+	holdToCommit []float64
+}
+
+// NewEMFEmitter creates an EMF emitter, or returns nil when EMF is disabled
+func NewEMFEmitter(cfg *appconfig.Config) *EMFEmitter {
+	if !cfg.Observability.EMFEnabled {
+		return nil
+	}
+	return &EMFEmitter{
+		out:       os.Stdout,
+		namespace: cfg.Observability.EMFNamespace,
+		service:   cfg.Observability.ServiceName,
+		interval:  cfg.Observability.EMFFlushInterval,
+		counts:    make(map[emfKey]float64),
+	}
+}
+
+// count adds one to a business metric; a nil emitter ignores it
+func (e *EMFEmitter) count(name string, dimensions ...string) {
+	if e == nil {
+		return
+	}
+	key := emfKey{name: name}
+	copy(key.dimensions[:], dimensions)
+
+	e.mu.Lock()
+	e.counts[key]++
+	e.mu.Unlock()
+}
+
+// observeHoldToCommit records a hold-to-commit latency; beyond the EMF value limit per
+// flush further samples are dropped
+func (e *EMFEmitter) observeHoldToCommit(latency time.Duration) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	if len(e.holdToCommit) < emfMaxValues {
+		e.holdToCommit = append(e.holdToCommit, float64(latency.Milliseconds()))
+	}
+	e.mu.Unlock()
+}
+
+// Run flushes the aggregated metrics every interval until ctx is done, then flushes once more
+func (e *EMFEmitter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.flush()
+			return
+		case <-ticker.C:
+			e.flush()
+		}
+	}
+}
+
+// flush writes and resets the aggregated metrics
+func (e *EMFEmitter) flush() {
+	e.mu.Lock()
+	counts, holdToCommit := e.counts, e.holdToCommit
+	e.counts, e.holdToCommit = make(map[emfKey]float64), nil
+	e.mu.Unlock()
+
+	timestamp := time.Now().UnixMilli()
+	for key, value := range counts {
+		dimensions := emfDimensionNames[key.name]
+		doc := e.document(timestamp, key.name, "Count", dimensions)
+		for i, name := range dimensions {
+			doc[name] = key.dimensions[i]
+		}
+		doc[key.name] = value
+		e.write(doc)
+	}
+	if len(holdToCommit) > 0 {
+		doc := e.document(timestamp, "HoldToCommit", "Milliseconds", nil)
+		doc["HoldToCommit"] = holdToCommit
+		e.write(doc)
+	}
+}
+
+// document returns an EMF document declaring one metric with the Service dimension and
+// the given dimension names; the caller sets their values and the metric's
+func (e *EMFEmitter) document(timestamp int64, name, unit string, dimensions []string) map[string]any {
+	return map[string]any{
+		"_aws": map[string]any{
+			"Timestamp": timestamp,
+			"CloudWatchMetrics": []map[string]any{{
+				"Namespace":  e.namespace,
+				"Dimensions": [][]string{append([]string{"Service"}, dimensions...)},
+				"Metrics":    []map[string]string{{"Name": name, "Unit": unit}},
+			}},
+		},
+		"Service": e.service,
+	}
+}
+
+// write writes one EMF document as a line
+func (e *EMFEmitter) write(doc map[string]any) {
+	line, err := json.Marshal(doc)
+	if err != nil {
+		fmt.Printf("Warning: failed to encode EMF metrics: %v\n", err)
+		return
+	}
+	e.out.Write(append(line, '\n'))
+}
@@ -15,23 +15,86 @@ import (
 // Metrics holds all Prometheus metrics
 type Metrics struct {
 	// gRPC metrics
-	GRPCRequestsTotal    *prometheus.CounterVec
-	GRPCRequestDuration  *prometheus.HistogramVec
-	GRPCActiveRequests   prometheus.Gauge
+	GRPCRequestsTotal   *prometheus.CounterVec
+	GRPCRequestDuration *prometheus.HistogramVec
+	GRPCActiveRequests  prometheus.Gauge
 
 	// Business logic metrics
-	CommitReservationsTotal    *prometheus.CounterVec
-	ReleaseHoldsTotal         *prometheus.CounterVec
-	CheckAvailabilityTotal    *prometheus.CounterVec
-	InventoryConflictsTotal   *prometheus.CounterVec
+	CommitReservationsTotal *prometheus.CounterVec
+	ReleaseHoldsTotal       *prometheus.CounterVec
+	CheckAvailabilityTotal  *prometheus.CounterVec
+	InventoryConflictsTotal *prometheus.CounterVec
+	CounterDriftTotal       *prometheus.CounterVec
+	// RequestFailuresTotal counts failed RPCs that carry an error code
+	RequestFailuresTotal *prometheus.CounterVec
+
+	// Commit pool metrics
+	CommitQueueWait     prometheus.Histogram
+	CommitRejectedTotal *prometheus.CounterVec
 
 	// DynamoDB metrics
-	DynamoDBLatency          *prometheus.HistogramVec
-	DynamoDBRequestsTotal    *prometheus.CounterVec
+	DynamoDBLatency       *prometheus.HistogramVec
+	DynamoDBRequestsTotal *prometheus.CounterVec
+	// RequestCapacityUnits is the DynamoDB capacity consumed per RPC
+	RequestCapacityUnits *prometheus.HistogramVec
+	// MirrorDivergenceTotal counts dual-write mirror failures and sampled mismatches
+	MirrorDivergenceTotal *prometheus.CounterVec
+	// CanaryComparisonsTotal counts sampled reads compared with a candidate implementation
+	CanaryComparisonsTotal *prometheus.CounterVec
+	// AWSCredentialRefreshesTotal counts retrievals of temporary AWS credentials by source
+	AWSCredentialRefreshesTotal *prometheus.CounterVec
+	AWSCredentialExpiry         *prometheus.GaugeVec
 
 	// Idempotency metrics
-	IdempotencyHitsTotal     *prometheus.CounterVec
-	IdempotencyMissesTotal   *prometheus.CounterVec
+	IdempotencyHitsTotal   *prometheus.CounterVec
+	IdempotencyMissesTotal *prometheus.CounterVec
+	DedupedRequestsTotal   *prometheus.CounterVec
+	IdempotencyDeleted     prometheus.Counter
+
+	// Hold lifecycle metrics
+	StuckHolds              prometheus.Gauge
+	StuckHoldsReleasedTotal prometheus.Counter
+	// HoldFunnelTotal counts reservation holds by checkout funnel stage; per-event
+	// counts are kept by the event stats instead of a label to bound cardinality
+	HoldFunnelTotal     *prometheus.CounterVec
+	HoldToCommitSeconds prometheus.Histogram
+
+	// SeatReplicaReadsTotal counts availability checks by whether the seat replica served them
+	SeatReplicaReadsTotal *prometheus.CounterVec
+
+	// ReservationEventsTotal counts consumed reservation lifecycle events
+	ReservationEventsTotal *prometheus.CounterVec
+
+	// Abuse detection metrics
+	AnomaliesTotal   *prometheus.CounterVec
+	ThrottledCallers prometheus.Gauge
+
+	// Partner quota metrics
+	QuotaRejectionsTotal *prometheus.CounterVec
+
+	// IdempotencyExpiredReuseTotal counts idempotency keys reused after their record expired
+	IdempotencyExpiredReuseTotal *prometheus.CounterVec
+	// VelocityRejectionsTotal counts commits rejected by buyer velocity rules
+	VelocityRejectionsTotal *prometheus.CounterVec
+	// TransactionCancellationsTotal counts the items of canceled seat transactions by reason
+	TransactionCancellationsTotal *prometheus.CounterVec
+	// QuantityVersionRetriesTotal counts quantity commits retried after version conflicts
+	QuantityVersionRetriesTotal *prometheus.CounterVec
+
+	// DependencyUp reports the probed state of each dependency
+	DependencyUp *prometheus.GaugeVec
+
+	// Brownout metrics
+	BrownoutLevel           prometheus.Gauge
+	BrownoutRejectionsTotal *prometheus.CounterVec
+
+	// emf also emits the business metrics in CloudWatch embedded metric format when set
+	emf *EMFEmitter
+}
+
+// UseEMF sends the core business metrics to an EMF emitter as well as Prometheus
+func (m *Metrics) UseEMF(emf *EMFEmitter) {
+	m.emf = emf
 }
 
 // NewMetrics creates a new metrics instance
@@ -93,6 +156,14 @@ func NewMetrics() *Metrics {
 			[]string{"conflict_type"}, // quantity, seat
 		),
 
+		RequestFailuresTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_request_failures_total",
+				Help: "Total number of failed requests by error code",
+			},
+			[]string{"method", "error_code"},
+		),
+
 		DynamoDBLatency: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
 				Name:    "dynamodb_operation_duration_seconds",
@@ -110,6 +181,71 @@ func NewMetrics() *Metrics {
 			[]string{"operation", "table", "status"},
 		),
 
+		RequestCapacityUnits: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "dynamodb_request_capacity_units",
+				Help:    "DynamoDB capacity units consumed per gRPC request",
+				Buckets: []float64{0.5, 1, 2, 5, 10, 25, 50, 100, 250, 500, 1000},
+			},
+			[]string{"method", "kind"}, // read, write
+		),
+
+		MirrorDivergenceTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "dynamodb_mirror_divergence_total",
+				Help: "Total number of dual-write mirror failures and divergent items found by sampled reads",
+			},
+			[]string{"table", "kind"}, // write_failed, dropped, missing, mismatch
+		),
+
+		CanaryComparisonsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "dynamodb_canary_comparisons_total",
+				Help: "Total number of sampled reads compared with the candidate storage implementation",
+			},
+			[]string{"read", "result"}, // match, mismatch, error
+		),
+
+		AWSCredentialRefreshesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "aws_credential_refreshes_total",
+				Help: "Total number of AWS credential retrievals by credential source",
+			},
+			[]string{"source", "result"}, // source: web_identity, assume_role; result: success, error
+		),
+
+		AWSCredentialExpiry: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "aws_credential_expiry_timestamp_seconds",
+				Help: "Unix time the current AWS credentials of each source expire",
+			},
+			[]string{"source"},
+		),
+
+		CounterDriftTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_counter_drift_total",
+				Help: "Total number of remaining counters found disagreeing with seat statuses",
+			},
+			[]string{"outcome"}, // repaired, conflict, error
+		),
+
+		CommitQueueWait: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "inventory_commit_queue_wait_seconds",
+				Help:    "Time commits wait in the commit pool queue",
+				Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25},
+			},
+		),
+
+		CommitRejectedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_commit_rejected_total",
+				Help: "Total number of commits rejected by the commit pool",
+			},
+			[]string{"reason"}, // queue_full, deadline
+		),
+
 		IdempotencyHitsTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "idempotency_hits_total",
@@ -125,6 +261,141 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"operation_type"},
 		),
+
+		DedupedRequestsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_deduped_requests_total",
+				Help: "Total number of requests collapsed into an identical in-flight or recent request",
+			},
+			[]string{"method"}, // commit, release
+		),
+
+		IdempotencyDeleted: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "idempotency_records_deleted_total",
+				Help: "Total number of expired idempotency records deleted by the cleanup job",
+			},
+		),
+
+		StuckHolds: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "inventory_stuck_holds",
+				Help: "Number of HOLD seats older than hold TTL plus grace found by the last scan",
+			},
+		),
+
+		StuckHoldsReleasedTotal: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "inventory_stuck_holds_released_total",
+				Help: "Total number of stuck holds released automatically",
+			},
+		),
+
+		HoldFunnelTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_hold_funnel_total",
+				Help: "Total number of reservation holds by funnel stage",
+			},
+			[]string{"stage"}, // created, expired, committed
+		),
+
+		HoldToCommitSeconds: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "inventory_hold_to_commit_seconds",
+				Help:    "Time from holding seats to committing them",
+				Buckets: prometheus.ExponentialBuckets(5, 2, 10), // 5s to ~43m
+			},
+		),
+
+		SeatReplicaReadsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_seat_replica_reads_total",
+				Help: "Total number of seat availability reads of replicated events",
+			},
+			[]string{"result"}, // hit, stale
+		),
+
+		ReservationEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_reservation_events_total",
+				Help: "Total number of reservation lifecycle events consumed",
+			},
+			[]string{"type", "outcome"}, // applied, rejected, retried, ignored
+		),
+
+		AnomaliesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_anomalies_total",
+				Help: "Total number of sales velocity anomalies detected",
+			},
+			[]string{"kind"}, // velocity_spike, caller_concentration, negative_drift
+		),
+
+		ThrottledCallers: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "inventory_throttled_callers",
+				Help: "Number of callers currently throttled after an anomaly",
+			},
+		),
+
+		QuotaRejectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_quota_rejections_total",
+				Help: "Total number of requests rejected for exceeding a partner quota",
+			},
+			[]string{"kind"}, // requests, seats
+		),
+		IdempotencyExpiredReuseTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_idempotency_expired_reuse_total",
+				Help: "Total number of idempotency lookups that found an expired record not yet deleted by TTL and reused its key",
+			},
+			[]string{"kind"}, // commit, release, ...
+		),
+		VelocityRejectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_velocity_rejections_total",
+				Help: "Total number of commits rejected for exceeding a buyer velocity rule",
+			},
+			[]string{"window"},
+		),
+		TransactionCancellationsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_transaction_cancellations_total",
+				Help: "Total number of items of canceled DynamoDB seat transactions, by cancellation reason",
+			},
+			[]string{"reason"}, // condition_failed, throttled, item_too_large, transaction_conflict, other
+		),
+		QuantityVersionRetriesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_quantity_version_retries_total",
+				Help: "Total number of quantity commit version conflicts, by whether the commit was retried or out of retries",
+			},
+			[]string{"outcome"}, // retried, exhausted
+		),
+
+		DependencyUp: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "inventory_dependency_up",
+				Help: "Whether a dependency passed its health probes (1) or is down (0)",
+			},
+			[]string{"dependency"}, // dynamodb, redis
+		),
+
+		BrownoutLevel: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "inventory_brownout_level",
+				Help: "Current brownout level (0 normal, 1 shedding, 2 check limited, 3 check tight)",
+			},
+		),
+
+		BrownoutRejectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "inventory_brownout_rejections_total",
+				Help: "Total number of requests shed by the brownout controller",
+			},
+			[]string{"method"},
+		),
 	}
 }
 
@@ -153,21 +424,55 @@ func (m *Metrics) DecrementActiveRequests() {
 // RecordCommitReservation records a reservation commit
 func (m *Metrics) RecordCommitReservation(inventoryType, status string) {
 	m.CommitReservationsTotal.WithLabelValues(inventoryType, status).Inc()
+	m.emf.count("CommitReservations", inventoryType, status)
 }
 
 // RecordReleaseHold records a hold release
 func (m *Metrics) RecordReleaseHold(inventoryType, status string) {
 	m.ReleaseHoldsTotal.WithLabelValues(inventoryType, status).Inc()
+	m.emf.count("ReleaseHolds", inventoryType, status)
 }
 
 // RecordCheckAvailability records an availability check
 func (m *Metrics) RecordCheckAvailability(inventoryType, result string) {
 	m.CheckAvailabilityTotal.WithLabelValues(inventoryType, result).Inc()
+	m.emf.count("AvailabilityChecks", inventoryType, result)
 }
 
 // RecordInventoryConflict records an inventory conflict
 func (m *Metrics) RecordInventoryConflict(conflictType string) {
 	m.InventoryConflictsTotal.WithLabelValues(conflictType).Inc()
+	m.emf.count("InventoryConflicts", conflictType)
+}
+
+// RecordRequestFailure records a failed RPC with an error code
+func (m *Metrics) RecordRequestFailure(method, errorCode string) {
+	m.RequestFailuresTotal.WithLabelValues(method, errorCode).Inc()
+}
+
+// RecordCounterDrift records a drifted remaining counter and the outcome of its repair
+func (m *Metrics) RecordCounterDrift(outcome string) {
+	m.CounterDriftTotal.WithLabelValues(outcome).Inc()
+}
+
+// RecordCommitQueueWait records how long a commit waited for a worker
+func (m *Metrics) RecordCommitQueueWait(wait time.Duration) {
+	m.CommitQueueWait.Observe(wait.Seconds())
+}
+
+// RecordCommitRejected records a commit rejected by the commit pool
+func (m *Metrics) RecordCommitRejected(reason string) {
+	m.CommitRejectedTotal.WithLabelValues(reason).Inc()
+}
+
+// RecordSeatReplicaRead records a seat availability read of a replicated event
+func (m *Metrics) RecordSeatReplicaRead(result string) {
+	m.SeatReplicaReadsTotal.WithLabelValues(result).Inc()
+}
+
+// RecordReservationEvent records a consumed reservation lifecycle event and its outcome
+func (m *Metrics) RecordReservationEvent(eventType, outcome string) {
+	m.ReservationEventsTotal.WithLabelValues(eventType, outcome).Inc()
 }
 
 // RecordDynamoDBOperation records a DynamoDB operation
@@ -176,6 +481,35 @@ func (m *Metrics) RecordDynamoDBOperation(operation, table, status string, durat
 	m.DynamoDBRequestsTotal.WithLabelValues(operation, table, status).Inc()
 }
 
+// RecordRequestCapacity records the DynamoDB capacity consumed by a gRPC request
+func (m *Metrics) RecordRequestCapacity(method string, read, write float64) {
+	m.RequestCapacityUnits.WithLabelValues(method, "read").Observe(read)
+	m.RequestCapacityUnits.WithLabelValues(method, "write").Observe(write)
+}
+
+// RecordMirrorDivergence records a dual-write mirror failure or divergent item
+func (m *Metrics) RecordMirrorDivergence(table, kind string) {
+	m.MirrorDivergenceTotal.WithLabelValues(table, kind).Inc()
+}
+
+// RecordCredentialRefresh records a retrieval of AWS credentials and, when it
+// succeeded, when the retrieved credentials expire
+func (m *Metrics) RecordCredentialRefresh(source string, err error, expires time.Time) {
+	if err != nil {
+		m.AWSCredentialRefreshesTotal.WithLabelValues(source, "error").Inc()
+		return
+	}
+	m.AWSCredentialRefreshesTotal.WithLabelValues(source, "success").Inc()
+	if !expires.IsZero() {
+		m.AWSCredentialExpiry.WithLabelValues(source).Set(float64(expires.Unix()))
+	}
+}
+
+// RecordCanaryComparison records the result of comparing a read with the candidate implementation
+func (m *Metrics) RecordCanaryComparison(read, result string) {
+	m.CanaryComparisonsTotal.WithLabelValues(read, result).Inc()
+}
+
 // RecordIdempotencyHit records an idempotency cache hit
 func (m *Metrics) RecordIdempotencyHit(operationType string) {
 	m.IdempotencyHitsTotal.WithLabelValues(operationType).Inc()
@@ -185,3 +519,90 @@ func (m *Metrics) RecordIdempotencyHit(operationType string) {
 func (m *Metrics) RecordIdempotencyMiss(operationType string) {
 	m.IdempotencyMissesTotal.WithLabelValues(operationType).Inc()
 }
+
+// RecordDedupedRequest records a request served by an identical request's execution
+func (m *Metrics) RecordDedupedRequest(method string) {
+	m.DedupedRequestsTotal.WithLabelValues(method).Inc()
+}
+
+// RecordIdempotencyRecordsDeleted records expired idempotency records deleted by the cleanup job
+func (m *Metrics) RecordIdempotencyRecordsDeleted(count int) {
+	m.IdempotencyDeleted.Add(float64(count))
+}
+
+// SetStuckHolds records the number of stuck holds found by the last scan
+func (m *Metrics) SetStuckHolds(count int) {
+	m.StuckHolds.Set(float64(count))
+}
+
+// RecordStuckHoldsReleased records automatically released stuck holds
+func (m *Metrics) RecordStuckHoldsReleased(count int) {
+	m.StuckHoldsReleasedTotal.Add(float64(count))
+}
+
+// RecordHoldFunnel records a reservation hold reaching a funnel stage
+func (m *Metrics) RecordHoldFunnel(stage string) {
+	m.HoldFunnelTotal.WithLabelValues(stage).Inc()
+	m.emf.count("HoldFunnel", stage)
+}
+
+// RecordHoldToCommit records the time from holding seats to committing them
+func (m *Metrics) RecordHoldToCommit(latency time.Duration) {
+	m.HoldToCommitSeconds.Observe(latency.Seconds())
+	m.emf.observeHoldToCommit(latency)
+}
+
+// RecordAnomaly records a detected sales velocity anomaly
+func (m *Metrics) RecordAnomaly(kind string) {
+	m.AnomaliesTotal.WithLabelValues(kind).Inc()
+}
+
+// SetThrottledCallers records the number of currently throttled callers
+func (m *Metrics) SetThrottledCallers(count int) {
+	m.ThrottledCallers.Set(float64(count))
+}
+
+// SetDependencyUp records the probed state of a dependency
+func (m *Metrics) SetDependencyUp(dependency string, up bool) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	m.DependencyUp.WithLabelValues(dependency).Set(value)
+}
+
+// SetBrownoutLevel records the current brownout level
+func (m *Metrics) SetBrownoutLevel(level int) {
+	m.BrownoutLevel.Set(float64(level))
+}
+
+// RecordBrownoutRejection records a request shed by the brownout controller
+func (m *Metrics) RecordBrownoutRejection(method string) {
+	m.BrownoutRejectionsTotal.WithLabelValues(method).Inc()
+}
+
+// RecordIdempotencyExpiredReuse records an idempotency key of a kind reused after its record expired
+func (m *Metrics) RecordIdempotencyExpiredReuse(kind string) {
+	m.IdempotencyExpiredReuseTotal.WithLabelValues(kind).Inc()
+}
+
+// RecordVelocityRejection records a commit rejected by the velocity rule of a window
+func (m *Metrics) RecordVelocityRejection(window time.Duration) {
+	m.VelocityRejectionsTotal.WithLabelValues(window.String()).Inc()
+}
+
+// RecordTransactionCancellation records an item of a canceled seat transaction and its reason
+func (m *Metrics) RecordTransactionCancellation(reason string) {
+	m.TransactionCancellationsTotal.WithLabelValues(reason).Inc()
+}
+
+// RecordQuantityVersionRetry records a version conflict of a quantity commit and whether
+// it was retried or out of retries
+func (m *Metrics) RecordQuantityVersionRetry(outcome string) {
+	m.QuantityVersionRetriesTotal.WithLabelValues(outcome).Inc()
+}
+
+// RecordQuotaRejection records a request rejected for exceeding a partner quota
+func (m *Metrics) RecordQuotaRejection(kind string) {
+	m.QuotaRejectionsTotal.WithLabelValues(kind).Inc()
+}
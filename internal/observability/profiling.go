@@ -0,0 +1,35 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// StartProfilingServer serves pprof profiles for pull-based continuous profilers such as
+// Parca or a Pyroscope scrape. Samples taken while an RPC runs carry its rpc_method and
+// the service version as labels (see WithProfileLabels), so regressions can be compared
+// per method across deploys.
+func StartProfilingServer(cfg *appconfig.Config) error {
+	runtime.SetMutexProfileFraction(cfg.Profiling.MutexProfileFraction)
+	runtime.SetBlockProfileRate(cfg.Profiling.BlockProfileRate)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return http.ListenAndServe(fmt.Sprintf("%s:%d", cfg.Profiling.Host, cfg.Profiling.Port), mux)
+}
+
+// WithProfileLabels runs fn with profiler labels naming the RPC method and service version
+func WithProfileLabels(ctx context.Context, method, version string, fn func(ctx context.Context)) {
+	runtimepprof.Do(ctx, runtimepprof.Labels("rpc_method", method, "version", version), fn)
+}
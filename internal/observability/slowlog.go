@@ -0,0 +1,65 @@
+package observability
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// SlowRequestRecord is one entry of the slow request log
+type SlowRequestRecord struct {
+	Time        time.Time `json:"time"`
+	Type        string    `json:"type"` // always "slow_request", to route records apart from other logs
+	Method      string    `json:"method"`
+	Code        string    `json:"code"`
+	DurationMs  float64   `json:"duration_ms"`
+	ThresholdMs float64   `json:"threshold_ms"`
+	ReadUnits   float64   `json:"read_units"`
+	WriteUnits  float64   `json:"write_units"`
+	// DynamoDBCalls are the request's DynamoDB calls in the order they finished
+	DynamoDBCalls []SlowDynamoDBCall `json:"dynamodb_calls"`
+	// DroppedCalls counts calls beyond the traced ones
+	DroppedCalls int               `json:"dropped_calls,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// SlowDynamoDBCall is one DynamoDB call of a slow request
+type SlowDynamoDBCall struct {
+	Operation  string  `json:"operation"`
+	Table      string  `json:"table,omitempty"`
+	DurationMs float64 `json:"duration_ms"`
+	ReadUnits  float64 `json:"read_units,omitempty"`
+	WriteUnits float64 `json:"write_units,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// SlowRequestLog writes requests that exceeded their latency threshold as JSON lines,
+// with the latency and consumed capacity of every DynamoDB call they made, so tail
+// latency can be triaged without full tracing
+type SlowRequestLog struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewSlowRequestLog creates a slow request log writing to w, or to stdout when w is nil
+func NewSlowRequestLog(w io.Writer) *SlowRequestLog {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &SlowRequestLog{enc: json.NewEncoder(w)}
+}
+
+// Record writes a slow request record, logging failures
+func (l *SlowRequestLog) Record(record SlowRequestRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record.Time = time.Now().UTC()
+	record.Type = "slow_request"
+	if err := l.enc.Encode(record); err != nil {
+		fmt.Printf("Warning: failed to write slow request record for %s: %v\n", record.Method, err)
+	}
+}
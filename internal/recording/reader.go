@@ -0,0 +1,77 @@
+package recording
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// maxRecordSize bounds one JSON line of a recording
+const maxRecordSize = 4 << 20
+
+// ReadRecords decodes the records of one gzipped recording object
+func ReadRecords(r io.Reader) ([]Record, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer zr.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(zr)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("malformed record: %w", err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	return records, nil
+}
+
+// LoadRecords reads every recording object under a prefix and returns the records in time order
+func LoadRecords(ctx context.Context, client *s3.Client, bucket, prefix string) ([]Record, error) {
+	var records []Record
+
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list recordings: %w", err)
+		}
+		for _, object := range page.Contents {
+			result, err := client.GetObject(ctx, &s3.GetObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    object.Key,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get recording %s: %w", aws.ToString(object.Key), err)
+			}
+			objectRecords, err := ReadRecords(result.Body)
+			result.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("recording %s: %w", aws.ToString(object.Key), err)
+			}
+			records = append(records, objectRecords...)
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	return records, nil
+}
@@ -0,0 +1,228 @@
+package recording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	mathrand "math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/traffictacos/inventory-api/internal/awsenv"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// uploadTimeout bounds uploading one recording object
+const uploadTimeout = 30 * time.Second
+
+// anonymizedPrefix marks tokens that replaced anonymized request fields
+const anonymizedPrefix = "anon_"
+
+// Record is one recorded RPC
+type Record struct {
+	Method string    `json:"method"`
+	Time   time.Time `json:"time"`
+	// Duration is how long the recorded instance took to answer
+	Duration time.Duration `json:"duration"`
+	Code     string        `json:"code"`
+	// Request is the anonymized request in protojson
+	Request json.RawMessage `json:"request"`
+}
+
+// Recorder records a sample of RPCs with their timing and uploads them to S3 as gzipped
+// JSON lines, one object per flush. Request fields that identify reservations or
+// payments are replaced by keyed tokens: the same value always maps to the same token,
+// so a replayed commit and its release still refer to the same reservation. Records
+// are dropped rather than delaying requests when the buffer is full.
+type Recorder struct {
+	client     *s3.Client
+	bucket     string
+	prefix     string
+	host       string
+	sampleRate float64
+	interval   time.Duration
+	maxBatch   int
+	fields     map[string]bool
+	key        []byte
+
+	mu      sync.Mutex
+	pending []Record
+	dropped int
+	flush   chan struct{}
+}
+
+// NewRecorder creates a recorder, or returns nil when no bucket is configured
+func NewRecorder(cfg *appconfig.Config) (*Recorder, error) {
+	if cfg.Recording.Bucket == "" {
+		return nil, nil
+	}
+
+	awsCfg, err := awsenv.Load(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	key := []byte(cfg.Recording.AnonymizeKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate anonymization key: %w", err)
+		}
+	}
+	fields := make(map[string]bool, len(cfg.Recording.AnonymizeFields))
+	for _, field := range cfg.Recording.AnonymizeFields {
+		fields[field] = true
+	}
+	host, _ := os.Hostname()
+
+	return &Recorder{
+		client: s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			// LocalStack serves buckets by path rather than by virtual host
+			o.UsePathStyle = cfg.LocalStack.Enabled
+		}),
+		bucket:     cfg.Recording.Bucket,
+		prefix:     cfg.Recording.Prefix,
+		host:       host,
+		sampleRate: cfg.Recording.SampleRate,
+		interval:   cfg.Recording.FlushInterval,
+		maxBatch:   max(cfg.Recording.MaxBatch, 1),
+		fields:     fields,
+		key:        key,
+		flush:      make(chan struct{}, 1),
+	}, nil
+}
+
+// Sampled reports whether the next RPC should be recorded
+func (r *Recorder) Sampled() bool {
+	return r != nil && mathrand.Float64() < r.sampleRate
+}
+
+// Record buffers an anonymized copy of a request with its timing and outcome
+func (r *Recorder) Record(method string, req proto.Message, start time.Time, duration time.Duration, code string) {
+	anonymized := proto.Clone(req)
+	r.anonymize(anonymized.ProtoReflect())
+	body, err := protojson.Marshal(anonymized)
+	if err != nil {
+		fmt.Printf("Warning: failed to record %s: %v\n", method, err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) >= r.maxBatch {
+		r.dropped++
+		return
+	}
+	r.pending = append(r.pending, Record{
+		Method:   method,
+		Time:     start.UTC(),
+		Duration: duration,
+		Code:     code,
+		Request:  body,
+	})
+	if len(r.pending) == r.maxBatch {
+		select {
+		case r.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run uploads buffered records every interval, or sooner when the buffer fills, until
+// ctx is canceled; the last records are uploaded on the way out
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.upload(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+		case <-r.flush:
+		}
+		r.upload(ctx)
+	}
+}
+
+// upload writes the buffered records to one S3 object
+func (r *Recorder) upload(ctx context.Context) {
+	r.mu.Lock()
+	records, dropped := r.pending, r.dropped
+	r.pending, r.dropped = nil, 0
+	r.mu.Unlock()
+
+	if dropped > 0 {
+		fmt.Printf("Warning: dropped %d recorded requests while the recording buffer was full\n", dropped)
+	}
+	if len(records) == 0 {
+		return
+	}
+
+	var body bytes.Buffer
+	zw := gzip.NewWriter(&body)
+	enc := json.NewEncoder(zw)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			fmt.Printf("Warning: failed to encode recorded requests: %v\n", err)
+			return
+		}
+	}
+	if err := zw.Close(); err != nil {
+		fmt.Printf("Warning: failed to compress recorded requests: %v\n", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uploadTimeout)
+	defer cancel()
+
+	key := fmt.Sprintf("%s%s/%s-%d.jsonl.gz", r.prefix, records[0].Time.Format("2006/01/02/15"), r.host, records[0].Time.UnixNano())
+	_, err := r.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(r.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body.Bytes()),
+		ContentType: aws.String("application/gzip"),
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to upload %d recorded requests to s3://%s/%s: %v\n", len(records), r.bucket, key, err)
+	}
+}
+
+// anonymize replaces the configured string fields of a message and its nested messages by tokens
+func (r *Recorder) anonymize(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() && r.fields[string(fd.Name())]:
+			m.Set(fd, protoreflect.ValueOfString(r.token(v.String())))
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				r.anonymize(list.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			r.anonymize(v.Message())
+		}
+		return true
+	})
+}
+
+// token returns the keyed token replacing a field value
+func (r *Recorder) token(value string) string {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(value))
+	return anonymizedPrefix + hex.EncodeToString(mac.Sum(nil))[:32]
+}
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// maxTracedCalls bounds the calls a trace keeps, so long-running requests such as bulk
+// admin operations don't grow it without limit
+const maxTracedCalls = 200
+
+// DynamoDBCall is one DynamoDB API call made on behalf of a request
+type DynamoDBCall struct {
+	Operation string
+	// Table is empty for batch and transactional operations, which may span tables
+	Table string
+	// Duration includes the SDK's retries of the call
+	Duration   time.Duration
+	ReadUnits  float64
+	WriteUnits float64
+	Err        error
+}
+
+// CallTrace collects the DynamoDB calls made with its context, for slow request logs
+type CallTrace struct {
+	mu      sync.Mutex
+	calls   []DynamoDBCall
+	dropped int
+}
+
+type callTraceKey struct{}
+
+// WithCallTrace returns ctx with a new call trace
+func WithCallTrace(ctx context.Context) (context.Context, *CallTrace) {
+	trace := &CallTrace{}
+	return context.WithValue(ctx, callTraceKey{}, trace), trace
+}
+
+// callTraceFrom returns the call trace of ctx, if any
+func callTraceFrom(ctx context.Context) *CallTrace {
+	trace, _ := ctx.Value(callTraceKey{}).(*CallTrace)
+	return trace
+}
+
+// Calls returns the calls traced so far in the order they finished, and how many more
+// calls were made after the trace was full
+func (t *CallTrace) Calls() ([]DynamoDBCall, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]DynamoDBCall(nil), t.calls...), t.dropped
+}
+
+// add records a finished call
+func (t *CallTrace) add(call DynamoDBCall) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.calls) >= maxTracedCalls {
+		t.dropped++
+		return
+	}
+	t.calls = append(t.calls, call)
+}
+
+// addCallTraceMiddleware times every call made with a call trace in its context and
+// records it with the capacity it consumed
+func addCallTraceMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("CallTrace", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		trace := callTraceFrom(ctx)
+		if trace == nil {
+			return next.HandleInitialize(ctx, in)
+		}
+		requestConsumedCapacity(in.Parameters)
+
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		call := DynamoDBCall{
+			Operation: middleware.GetOperationName(ctx),
+			Table:     operationTable(in.Parameters),
+			Duration:  time.Since(start),
+			Err:       err,
+		}
+		if err == nil {
+			call.ReadUnits, call.WriteUnits = consumedUnits(out.Result)
+		}
+		trace.add(call)
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// operationTable returns the table of a single-table operation
+func operationTable(params interface{}) string {
+	switch input := params.(type) {
+	case *dynamodb.GetItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.QueryInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.ScanInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.PutItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.UpdateItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.DeleteItemInput:
+		return aws.ToString(input.TableName)
+	}
+	return ""
+}
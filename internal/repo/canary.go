@@ -0,0 +1,223 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// canaryTimeout bounds one comparison, which outlives the request that sampled it
+const canaryTimeout = 2 * time.Second
+
+// maxCanaryComparisons bounds the comparisons in flight; samples beyond it are dropped
+const maxCanaryComparisons = 64
+
+// Canary comparison results
+const (
+	canaryMatch    = "match"
+	canaryMismatch = "mismatch"
+	canaryError    = "error"
+)
+
+// InventoryReader answers the reads the canary compares. A redesigned storage layout
+// implements it to be compared against the current repository before cutover.
+type InventoryReader interface {
+	GetInventory(ctx context.Context, eventID string) (*InventoryItem, error)
+	GetSeats(ctx context.Context, eventID string, seatIDs []string) ([]*SeatItem, error)
+}
+
+// CanaryReport summarizes the comparisons of the current and candidate implementations
+// since the instance started
+type CanaryReport struct {
+	Comparisons int64
+	Divergences int64
+	// CleanSince is the start of the current window without divergence, and
+	// CleanComparisons the comparisons made in it
+	CleanSince       time.Time
+	CleanComparisons int64
+	LastDivergence   string
+	// CutoverReady is set once the clean window spans the required time and comparisons
+	CutoverReady bool
+}
+
+// canary replays a sample of successful reads against a candidate implementation and
+// compares the answers, rather than the stored items, so the candidate may lay out its
+// data differently. A mismatch is read again from both sides before it counts, so
+// writes landing between the two reads aren't reported. A nil canary is a no-op.
+type canary struct {
+	primary        InventoryReader
+	candidate      InventoryReader
+	sampleRate     float64
+	cleanWindow    time.Duration
+	minComparisons int64
+	metrics        *observability.Metrics
+	inFlight       chan struct{}
+
+	mu               sync.Mutex
+	comparisons      int64
+	divergences      int64
+	cleanSince       time.Time
+	cleanComparisons int64
+	lastDivergence   string
+}
+
+// canaryKey marks the reads made by the canary itself, which are never sampled
+type canaryKey struct{}
+
+// compareInventory samples an event's inventory read for comparison
+func (c *canary) compareInventory(ctx context.Context, eventID string, item *InventoryItem) {
+	if c == nil || ctx.Value(canaryKey{}) != nil || rand.Float64() >= c.sampleRate {
+		return
+	}
+	c.compare(ctx, "inventory", func(ctx context.Context, reread bool) (string, error) {
+		primary := item
+		if reread {
+			var err error
+			if primary, err = c.primary.GetInventory(ctx, eventID); err != nil {
+				return "", err
+			}
+		}
+		candidate, err := c.candidate.GetInventory(ctx, eventID)
+		if err != nil {
+			return "", err
+		}
+		return inventoryDifference(eventID, primary, candidate), nil
+	})
+}
+
+// compareSeats samples a seats read for comparison
+func (c *canary) compareSeats(ctx context.Context, eventID string, seatIDs []string, seats []*SeatItem) {
+	if c == nil || len(seatIDs) == 0 || ctx.Value(canaryKey{}) != nil || rand.Float64() >= c.sampleRate {
+		return
+	}
+	c.compare(ctx, "seats", func(ctx context.Context, reread bool) (string, error) {
+		primary := seats
+		if reread {
+			var err error
+			if primary, err = c.primary.GetSeats(ctx, eventID, seatIDs); err != nil {
+				return "", err
+			}
+		}
+		candidate, err := c.candidate.GetSeats(ctx, eventID, seatIDs)
+		if err != nil {
+			return "", err
+		}
+		return seatsDifference(eventID, primary, candidate), nil
+	})
+}
+
+// compare runs diff in the background, then once more with fresh primary reads if the
+// answers differ, and records the outcome. diff returns "" when the answers match.
+func (c *canary) compare(ctx context.Context, read string, diff func(ctx context.Context, reread bool) (string, error)) {
+	select {
+	case c.inFlight <- struct{}{}:
+	default:
+		return
+	}
+
+	ctx = context.WithValue(WithoutCostMeter(context.WithoutCancel(ctx)), canaryKey{}, true)
+	go func() {
+		defer func() { <-c.inFlight }()
+		ctx, cancel := context.WithTimeout(ctx, canaryTimeout)
+		defer cancel()
+
+		difference, err := diff(ctx, false)
+		if err == nil && difference != "" {
+			difference, err = diff(ctx, true)
+		}
+		switch {
+		case err != nil:
+			c.record(read, canaryError, fmt.Sprintf("%s: %v", read, err))
+		case difference != "":
+			c.record(read, canaryMismatch, difference)
+		default:
+			c.record(read, canaryMatch, "")
+		}
+	}()
+}
+
+// record counts a comparison; any divergence restarts the clean window
+func (c *canary) record(read, result, divergence string) {
+	c.metrics.RecordCanaryComparison(read, result)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.comparisons++
+	if result == canaryMatch {
+		c.cleanComparisons++
+		return
+	}
+
+	fmt.Printf("Warning: canary divergence: %s\n", divergence)
+	c.divergences++
+	c.lastDivergence = divergence
+	c.cleanSince = time.Now()
+	c.cleanComparisons = 0
+}
+
+// report returns the comparison totals and whether the candidate is ready for cutover
+func (c *canary) report() *CanaryReport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &CanaryReport{
+		Comparisons:      c.comparisons,
+		Divergences:      c.divergences,
+		CleanSince:       c.cleanSince,
+		CleanComparisons: c.cleanComparisons,
+		LastDivergence:   c.lastDivergence,
+		CutoverReady:     time.Since(c.cleanSince) >= c.cleanWindow && c.cleanComparisons >= c.minComparisons,
+	}
+}
+
+// inventoryDifference describes how two answers for an event's inventory differ, or returns ""
+func inventoryDifference(eventID string, primary, candidate *InventoryItem) string {
+	switch {
+	case primary.Remaining != candidate.Remaining:
+		return fmt.Sprintf("inventory %s: remaining %d, candidate %d", eventID, primary.Remaining, candidate.Remaining)
+	case primary.Version != candidate.Version:
+		return fmt.Sprintf("inventory %s: version %d, candidate %d", eventID, primary.Version, candidate.Version)
+	case primary.TotalSeats != candidate.TotalSeats:
+		return fmt.Sprintf("inventory %s: total seats %d, candidate %d", eventID, primary.TotalSeats, candidate.TotalSeats)
+	case primary.Frozen != candidate.Frozen:
+		return fmt.Sprintf("inventory %s: frozen %t, candidate %t", eventID, primary.Frozen, candidate.Frozen)
+	default:
+		return ""
+	}
+}
+
+// seatsDifference describes how two answers for an event's seats differ, or returns ""
+func seatsDifference(eventID string, primary, candidate []*SeatItem) string {
+	candidates := make(map[string]*SeatItem, len(candidate))
+	for _, seat := range candidate {
+		candidates[seat.SeatID] = seat
+	}
+
+	for _, seat := range primary {
+		other, ok := candidates[seat.SeatID]
+		switch {
+		case !ok:
+			return fmt.Sprintf("seat %s/%s: missing from candidate", eventID, seat.SeatID)
+		case seat.Status != other.Status:
+			return fmt.Sprintf("seat %s/%s: status %s, candidate %s", eventID, seat.SeatID, seat.Status, other.Status)
+		case seat.ReservationID != other.ReservationID:
+			return fmt.Sprintf("seat %s/%s: reservation differs from candidate", eventID, seat.SeatID)
+		}
+		delete(candidates, seat.SeatID)
+	}
+	for seatID := range candidates {
+		return fmt.Sprintf("seat %s/%s: only in candidate", eventID, seatID)
+	}
+	return ""
+}
+
+// CanaryReport returns the canary comparisons of this instance, or nil when the canary is disabled
+func (r *DynamoDBRepository) CanaryReport() *CanaryReport {
+	if r.canary == nil {
+		return nil
+	}
+	return r.canary.report()
+}
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	streamstypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+)
+
+// ChangeRecord is one write read from the inventory or seats table stream
+type ChangeRecord struct {
+	// ID is unique across both streams
+	ID string
+	At time.Time
+	// Exactly one of Seat and Inventory is set. Deleted seats have an empty status.
+	Seat      *SeatItem
+	Inventory *InventoryItem
+}
+
+// ChangeStream reads the writes of every instance from the inventory and seats table
+// streams. Both streams must be enabled with NEW_IMAGE (or NEW_AND_OLD_IMAGES) view type.
+type ChangeStream struct {
+	seats     *tableStream
+	inventory *tableStream
+}
+
+// NewChangeStream creates a reader for the inventory and seats table streams
+func (r *DynamoDBRepository) NewChangeStream() *ChangeStream {
+	return &ChangeStream{
+		seats:     r.newTableStream(r.tableSeats),
+		inventory: r.newTableStream(r.tableInventory),
+	}
+}
+
+// Poll reads one batch from every open shard of both streams and returns the changes
+// ordered by the time DynamoDB recorded them
+func (s *ChangeStream) Poll(ctx context.Context) ([]*ChangeRecord, error) {
+	seatRecords, err := s.seats.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inventoryRecords, err := s.inventory.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*ChangeRecord, 0, len(seatRecords)+len(inventoryRecords))
+	for _, record := range seatRecords {
+		if change := seatChange(record); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	for _, record := range inventoryRecords {
+		if change := inventoryChange(record); change != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].At.Before(changes[j].At) })
+	return changes, nil
+}
+
+// seatChange returns the seat write of a seats table stream record, or nil for records without one
+func seatChange(record streamstypes.Record) *ChangeRecord {
+	if record.Dynamodb == nil {
+		return nil
+	}
+
+	image := record.Dynamodb.NewImage
+	if record.EventName == streamstypes.OperationTypeRemove {
+		image = record.Dynamodb.Keys
+	}
+	seat := &SeatItem{
+		EventID: streamString(image, "event_id"),
+		SeatID:  streamString(image, "seat_id"),
+		Status:  streamString(image, "status"),
+	}
+	if seat.EventID == "" || seat.SeatID == "" {
+		return nil
+	}
+	if record.EventName == streamstypes.OperationTypeRemove {
+		seat.Status = ""
+	}
+
+	return &ChangeRecord{
+		ID:   "seats:" + aws.ToString(record.Dynamodb.SequenceNumber),
+		At:   aws.ToTime(record.Dynamodb.ApproximateCreationDateTime),
+		Seat: seat,
+	}
+}
+
+// inventoryChange returns the inventory write of an inventory table stream record, or
+// nil for deletions and records without one
+func inventoryChange(record streamstypes.Record) *ChangeRecord {
+	if record.Dynamodb == nil || record.EventName == streamstypes.OperationTypeRemove {
+		return nil
+	}
+
+	image := record.Dynamodb.NewImage
+	inventory := &InventoryItem{
+		EventID:    streamString(image, "event_id"),
+		Remaining:  int32(streamInt64(image, "remaining")),
+		Version:    int32(streamInt64(image, "version")),
+		TotalSeats: int32(streamInt64(image, "total_seats")),
+	}
+	if inventory.EventID == "" {
+		return nil
+	}
+
+	return &ChangeRecord{
+		ID:        "inventory:" + aws.ToString(record.Dynamodb.SequenceNumber),
+		At:        aws.ToTime(record.Dynamodb.ApproximateCreationDateTime),
+		Inventory: inventory,
+	}
+}
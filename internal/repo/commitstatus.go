@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// commitStatusKeyPrefix namespaces commit status items in the idempotency table
+const commitStatusKeyPrefix = "order:"
+
+// CommitStatusItem tracks an asynchronous commit. It is stored in the idempotency
+// table under "order:<order_id>" and expires through the table's expires_at TTL.
+type CommitStatusItem struct {
+	Key           string `dynamodbav:"key"`
+	OrderID       string `dynamodbav:"order_id"`
+	ReservationID string `dynamodbav:"reservation_id"`
+	EventID       string `dynamodbav:"event_id"`
+	Status        string `dynamodbav:"status"` // PENDING, CONFIRMED, FAILED
+	Error         string `dynamodbav:"error,omitempty"`
+	// ErrorCode is the ErrorCode name of a failure, if it has one
+	ErrorCode string `dynamodbav:"error_code,omitempty"`
+	// ConfirmedOrderID differs from OrderID when the reservation had already been
+	// committed by another request
+	ConfirmedOrderID string    `dynamodbav:"confirmed_order_id,omitempty"`
+	UpdatedAt        time.Time `dynamodbav:"updated_at"`
+	ExpiresAt        int64     `dynamodbav:"expires_at"`
+}
+
+// PutCommitStatus stores the status of an asynchronous commit
+func (r *DynamoDBRepository) PutCommitStatus(ctx context.Context, item *CommitStatusItem) error {
+	item.Key = commitStatusKeyPrefix + item.OrderID
+
+	dynamoItem, err := marshalDynamoItem(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal commit status item: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.tableIdempotency),
+		Item:      dynamoItem,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put commit status: %w", err)
+	}
+
+	return nil
+}
+
+// GetCommitStatus retrieves the status of an asynchronous commit; nil if unknown
+func (r *DynamoDBRepository) GetCommitStatus(ctx context.Context, orderID string) (*CommitStatusItem, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.tableIdempotency),
+		Key: map[string]types.AttributeValue{
+			"key": &types.AttributeValueMemberS{Value: commitStatusKeyPrefix + orderID},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit status: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	item := &CommitStatusItem{}
+	if err := unmarshalDynamoItem(result.Item, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal commit status item: %w", err)
+	}
+
+	return item, nil
+}
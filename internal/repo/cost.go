@@ -0,0 +1,191 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// itemReadUnits is the read capacity of an eventually consistent read of an item up to 4KB
+const itemReadUnits = 0.5
+
+// CostMeter accumulates the DynamoDB capacity consumed by one request and, when a budget
+// is set, rejects DynamoDB calls once the request has used it up
+type CostMeter struct {
+	mu          sync.Mutex
+	read        float64
+	write       float64
+	readBudget  float64
+	writeBudget float64
+}
+
+type costMeterKey struct{}
+
+// WithCostMeter returns ctx with a new cost meter. Zero budgets don't limit the request.
+func WithCostMeter(ctx context.Context, readBudget, writeBudget float64) (context.Context, *CostMeter) {
+	meter := &CostMeter{
+		readBudget:  readBudget,
+		writeBudget: writeBudget,
+	}
+	return context.WithValue(ctx, costMeterKey{}, meter), meter
+}
+
+// costMeterFrom returns the cost meter of ctx, if any
+func costMeterFrom(ctx context.Context) *CostMeter {
+	meter, _ := ctx.Value(costMeterKey{}).(*CostMeter)
+	return meter
+}
+
+// WithoutCostMeter returns ctx without its cost meter, for work not charged to the request
+// such as mirroring or commits that outlive it
+func WithoutCostMeter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, costMeterKey{}, (*CostMeter)(nil))
+}
+
+// Consumed returns the read and write capacity units consumed so far
+func (m *CostMeter) Consumed() (read, write float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.read, m.write
+}
+
+// reserve fails if the request would exceed its budget by consuming the given units
+func (m *CostMeter) reserve(read, write float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.readBudget > 0 && read > 0 && m.read+read > m.readBudget {
+		return fmt.Errorf("request cost budget exceeded: %.1f read units consumed, %.1f more needed, budget %.1f", m.read, read, m.readBudget)
+	}
+	if m.writeBudget > 0 && write > 0 && m.write+write > m.writeBudget {
+		return fmt.Errorf("request cost budget exceeded: %.1f write units consumed, %.1f more needed, budget %.1f", m.write, write, m.writeBudget)
+	}
+	return nil
+}
+
+// record adds consumed capacity units
+func (m *CostMeter) record(read, write float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.read += read
+	m.write += write
+}
+
+// ReserveItemReads fails if reading n items would exceed the request's read budget.
+// Callers use it to reject large multi-item reads before issuing them.
+func ReserveItemReads(ctx context.Context, n int) error {
+	meter := costMeterFrom(ctx)
+	if meter == nil {
+		return nil
+	}
+	return meter.reserve(float64(n)*itemReadUnits, 0)
+}
+
+// addCostMeterMiddleware asks DynamoDB for consumed capacity on every call made with a
+// cost meter in its context, records it, and rejects calls once the budget is used up
+func addCostMeterMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("CostMeter", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		meter := costMeterFrom(ctx)
+		if meter == nil {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		// Every call consumes at least some capacity, so an exhausted budget rejects it
+		if err := meter.reserve(minUnits(in.Parameters)); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+		requestConsumedCapacity(in.Parameters)
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		if err == nil {
+			meter.record(consumedUnits(out.Result))
+		}
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// minUnits returns the smallest read or write capacity a call can consume
+func minUnits(params interface{}) (read, write float64) {
+	switch params.(type) {
+	case *dynamodb.GetItemInput, *dynamodb.BatchGetItemInput, *dynamodb.QueryInput, *dynamodb.ScanInput, *dynamodb.TransactGetItemsInput:
+		return itemReadUnits, 0
+	default:
+		return 0, 1
+	}
+}
+
+// requestConsumedCapacity sets ReturnConsumedCapacity on operations that support it
+func requestConsumedCapacity(params interface{}) {
+	total := types.ReturnConsumedCapacityTotal
+	switch input := params.(type) {
+	case *dynamodb.GetItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.BatchGetItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.QueryInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.ScanInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.PutItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.UpdateItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.DeleteItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.BatchWriteItemInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.TransactWriteItemsInput:
+		input.ReturnConsumedCapacity = total
+	case *dynamodb.TransactGetItemsInput:
+		input.ReturnConsumedCapacity = total
+	}
+}
+
+// consumedUnits returns the read and write capacity reported in an operation's output
+func consumedUnits(result interface{}) (read, write float64) {
+	switch output := result.(type) {
+	case *dynamodb.GetItemOutput:
+		return capacityUnits(output.ConsumedCapacity), 0
+	case *dynamodb.BatchGetItemOutput:
+		return sumCapacityUnits(output.ConsumedCapacity), 0
+	case *dynamodb.QueryOutput:
+		return capacityUnits(output.ConsumedCapacity), 0
+	case *dynamodb.ScanOutput:
+		return capacityUnits(output.ConsumedCapacity), 0
+	case *dynamodb.TransactGetItemsOutput:
+		return sumCapacityUnits(output.ConsumedCapacity), 0
+	case *dynamodb.PutItemOutput:
+		return 0, capacityUnits(output.ConsumedCapacity)
+	case *dynamodb.UpdateItemOutput:
+		return 0, capacityUnits(output.ConsumedCapacity)
+	case *dynamodb.DeleteItemOutput:
+		return 0, capacityUnits(output.ConsumedCapacity)
+	case *dynamodb.BatchWriteItemOutput:
+		return 0, sumCapacityUnits(output.ConsumedCapacity)
+	case *dynamodb.TransactWriteItemsOutput:
+		return 0, sumCapacityUnits(output.ConsumedCapacity)
+	}
+	return 0, 0
+}
+
+// capacityUnits returns the total capacity units of a consumed capacity entry
+func capacityUnits(capacity *types.ConsumedCapacity) float64 {
+	if capacity == nil {
+		return 0
+	}
+	return aws.ToFloat64(capacity.CapacityUnits)
+}
+
+// sumCapacityUnits returns the total capacity units of multi-table consumed capacity
+func sumCapacityUnits(capacities []types.ConsumedCapacity) float64 {
+	total := 0.0
+	for i := range capacities {
+		total += capacityUnits(&capacities[i])
+	}
+	return total
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// useWebIdentity replaces the credentials of awsCfg with the web identity (IRSA) role
+// when a token file is configured. The default chain would pick the same role up from
+// the environment, but without refresh metrics.
+func useWebIdentity(awsCfg *aws.Config, cfg appconfig.AWSConfig, metrics *observability.Metrics) error {
+	if cfg.WebIdentityTokenFile == "" {
+		return nil
+	}
+	if cfg.WebIdentityRoleARN == "" {
+		return fmt.Errorf("AWS_WEB_IDENTITY_TOKEN_FILE requires AWS_ROLE_ARN")
+	}
+
+	provider := stscreds.NewWebIdentityRoleProvider(sts.NewFromConfig(*awsCfg), cfg.WebIdentityRoleARN,
+		stscreds.IdentityTokenFile(cfg.WebIdentityTokenFile),
+		func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = cfg.RoleSessionName
+		})
+	awsCfg.Credentials = aws.NewCredentialsCache(&meteredCredentials{source: "web_identity", provider: provider, metrics: metrics})
+	return nil
+}
+
+// tablesConfig returns the AWS config the DynamoDB tables are accessed with: awsCfg
+// itself, or a copy assuming the secondary role with awsCfg's credentials
+func tablesConfig(awsCfg aws.Config, cfg appconfig.AWSConfig, metrics *observability.Metrics) aws.Config {
+	if cfg.AssumeRoleARN == "" {
+		return awsCfg
+	}
+
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(awsCfg), cfg.AssumeRoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = cfg.RoleSessionName
+		o.Duration = cfg.AssumeRoleDuration
+		if cfg.AssumeRoleExternalID != "" {
+			o.ExternalID = aws.String(cfg.AssumeRoleExternalID)
+		}
+	})
+	tables := awsCfg.Copy()
+	tables.Credentials = aws.NewCredentialsCache(&meteredCredentials{source: "assume_role", provider: provider, metrics: metrics})
+	return tables
+}
+
+// meteredCredentials records every retrieval of its provider. Wrapped in a credentials
+// cache, a retrieval is a refresh of expired or expiring credentials.
+type meteredCredentials struct {
+	source   string
+	provider aws.CredentialsProvider
+	metrics  *observability.Metrics
+}
+
+// Retrieve retrieves credentials from the wrapped provider
+func (c *meteredCredentials) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	creds, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		fmt.Printf("Warning: failed to retrieve %s credentials: %v\n", c.source, err)
+	}
+	if c.metrics != nil {
+		c.metrics.RecordCredentialRefresh(c.source, err, creds.Expires)
+	}
+	return creds, err
+}
@@ -2,7 +2,11 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -10,30 +14,166 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/traffictacos/inventory-api/internal/awsenv"
 	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
 )
 
 // DynamoDBRepository handles DynamoDB operations
 type DynamoDBRepository struct {
 	client         *dynamodb.Client
+	streams        *dynamodbstreams.Client
 	tableInventory string
 	tableSeats     string
+	tableHolds     string
+	tableTemplates string
+	tableLedger    string
+	// tableIdempotency holds idempotency records and other short-lived keyed records
+	tableIdempotency string
+	// idempotencyTTL is how long idempotency records live before DynamoDB TTL deletes them
+	idempotencyTTL time.Duration
+	// metrics counts expired idempotency records read before TTL deleted them and the
+	// reasons seat transactions were canceled for
+	metrics *observability.Metrics
+	// reservationIndex is the seats table GSI keyed by reservation_id
+	reservationIndex string
+	// mirror receives copies of writes during a dual-write migration; nil otherwise
+	mirror *mirror
+	// seatsMigration routes seats per event during a blue/green seats table migration; nil otherwise
+	seatsMigration *seatsMigration
+	// canary compares sampled reads with a candidate implementation; nil otherwise
+	canary *canary
+	// visibility caches the visibility rules of events
+	visibility *visibilityCache
+	// kms wraps the field encryption data key; fieldKey is the wrapped key, empty when disabled
+	kms      *kms.Client
+	fieldKey string
 }
 
-// NewDynamoDBRepository creates a new DynamoDB repository
-func NewDynamoDBRepository(cfg *appconfig.Config) (*DynamoDBRepository, error) {
-	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background())
+// NewDynamoDBRepository creates a new DynamoDB repository.
+// Credentials come from the web identity role when AWS_WEB_IDENTITY_TOKEN_FILE is set,
+// and tables are accessed through AWS_ASSUME_ROLE_ARN when set; KMS keeps the base
+// credentials.
+// With MIGRATION_CUTOVER the migration tables are authoritative, and with
+// MIGRATION_DUAL_WRITE writes are mirrored to the other set of tables. With
+// DDB_STUB_BACKEND no call reaches DynamoDB; see stubBackend. With LOCALSTACK_ENABLED
+// and LOCALSTACK_CREATE_TABLES missing tables are created on LocalStack.
+func NewDynamoDBRepository(cfg *appconfig.Config, metrics *observability.Metrics) (*DynamoDBRepository, error) {
+	awsCfg, err := awsenv.Load(context.Background(), cfg, awsconfig.WithHTTPClient(newHTTPClient(cfg.DynamoDB.HTTP)))
 	if err != nil {
-		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+		return nil, err
 	}
+	if err := useWebIdentity(&awsCfg, cfg.AWS, metrics); err != nil {
+		return nil, err
+	}
+	tablesCfg := tablesConfig(awsCfg, cfg.AWS, metrics)
 
-	client := dynamodb.NewFromConfig(awsCfg)
+	client := dynamodb.NewFromConfig(tablesCfg, func(o *dynamodb.Options) {
+		o.APIOptions = append(o.APIOptions, addCostMeterMiddleware, addCallTraceMiddleware)
+		if cfg.DynamoDB.StubBackend {
+			stub := &stubBackend{
+				latency:        cfg.DynamoDB.StubLatency,
+				jitter:         cfg.DynamoDB.StubJitter,
+				tableInventory: cfg.DynamoDB.TableInventory,
+				tableSeats:     cfg.DynamoDB.TableSeats,
+			}
+			o.APIOptions = append(o.APIOptions, stub.addMiddleware)
+		}
+	})
 
-	return &DynamoDBRepository{
-		client:         client,
-		tableInventory: cfg.DynamoDB.TableInventory,
-		tableSeats:     cfg.DynamoDB.TableSeats,
-	}, nil
+	primary := tableSet{
+		inventory: cfg.DynamoDB.TableInventory,
+		seats:     cfg.DynamoDB.TableSeats,
+		holds:     cfg.DynamoDB.TableHolds,
+	}
+	secondary := tableSet{
+		inventory: cfg.Migration.TableInventory,
+		seats:     cfg.Migration.TableSeats,
+		holds:     cfg.Migration.TableHolds,
+	}
+	if (cfg.Migration.DualWrite || cfg.Migration.Cutover) &&
+		(secondary.inventory == "" || secondary.seats == "" || secondary.holds == "") {
+		return nil, fmt.Errorf("migration requires MIGRATION_TABLE_INVENTORY, MIGRATION_TABLE_SEATS and MIGRATION_TABLE_HOLDS")
+	}
+	if cfg.Migration.Cutover {
+		primary, secondary = secondary, primary
+	}
+
+	r := &DynamoDBRepository{
+		client:           client,
+		streams:          dynamodbstreams.NewFromConfig(tablesCfg),
+		tableInventory:   primary.inventory,
+		tableSeats:       primary.seats,
+		tableHolds:       primary.holds,
+		tableTemplates:   cfg.DynamoDB.TableVenueTemplates,
+		tableLedger:      cfg.DynamoDB.TableLedger,
+		tableIdempotency: cfg.DynamoDB.TableIdempotency,
+		idempotencyTTL:   cfg.Idempotency.TTLDuration,
+		metrics:          metrics,
+		reservationIndex: cfg.DynamoDB.SeatsReservationIndex,
+		kms:              kms.NewFromConfig(awsCfg),
+		fieldKey:         cfg.DynamoDB.FieldEncryptionDataKey,
+		visibility: &visibilityCache{
+			ttl:   cfg.Inventory.VisibilityCacheTTL,
+			rules: make(map[string]cachedVisibilityRules),
+		},
+	}
+	if r.fieldKey != "" {
+		fields, err = loadFieldCipher(context.Background(), r.kms, r.fieldKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Migration.DualWrite {
+		r.mirror = newMirror(client, primary, secondary, cfg.Migration.VerifySampleRate, metrics)
+	}
+
+	if cfg.Migration.CanaryTableInventory != "" || cfg.Migration.CanaryTableSeats != "" {
+		if cfg.Migration.CanaryTableInventory == "" || cfg.Migration.CanaryTableSeats == "" {
+			return nil, fmt.Errorf("the canary requires MIGRATION_CANARY_TABLE_INVENTORY and MIGRATION_CANARY_TABLE_SEATS")
+		}
+		candidate := &DynamoDBRepository{
+			client:         client,
+			tableInventory: cfg.Migration.CanaryTableInventory,
+			tableSeats:     cfg.Migration.CanaryTableSeats,
+			metrics:        metrics,
+		}
+		r.canary = &canary{
+			primary:        r,
+			candidate:      candidate,
+			sampleRate:     cfg.Migration.CanarySampleRate,
+			cleanWindow:    cfg.Migration.CanaryCleanWindow,
+			minComparisons: int64(cfg.Migration.CanaryMinComparisons),
+			metrics:        metrics,
+			inFlight:       make(chan struct{}, maxCanaryComparisons),
+			cleanSince:     time.Now(),
+		}
+	}
+
+	if cfg.Migration.SeatsTable != "" {
+		if cfg.Migration.DualWrite || cfg.Migration.Cutover {
+			return nil, fmt.Errorf("MIGRATION_SEATS_TABLE can't be combined with MIGRATION_DUAL_WRITE or MIGRATION_CUTOVER")
+		}
+		oldSeats := tableSet{seats: cfg.DynamoDB.TableSeats}
+		newSeats := tableSet{seats: cfg.Migration.SeatsTable}
+		r.seatsMigration = &seatsMigration{
+			stateTTL: cfg.Migration.SeatsStateTTL,
+			toNew:    newMirror(client, oldSeats, newSeats, cfg.Migration.VerifySampleRate, metrics),
+			toOld:    newMirror(client, newSeats, oldSeats, cfg.Migration.VerifySampleRate, metrics),
+			states:   make(map[string]cachedMigrationState),
+		}
+	}
+
+	if cfg.LocalStack.Enabled && cfg.LocalStack.CreateTables && !cfg.DynamoDB.StubBackend {
+		if err := r.createLocalTables(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
 }
 
 // InventoryItem represents an inventory item in DynamoDB
@@ -44,15 +184,97 @@ type InventoryItem struct {
 	UpdatedAt  time.Time              `dynamodbav:"updated_at"`
 	TotalSeats int32                  `dynamodbav:"total_seats,omitempty"`
 	Sections   map[string]interface{} `dynamodbav:"sections,omitempty"`
+	// Frozen rejects writes for the event while an operator investigates or repairs it
+	Frozen       bool   `dynamodbav:"frozen,omitempty"`
+	FrozenReason string `dynamodbav:"frozen_reason,omitempty"`
+	// TemplateID and TemplateVersion reference the venue template the event's seats came from
+	TemplateID      string `dynamodbav:"template_id,omitempty"`
+	TemplateVersion int32  `dynamodbav:"template_version,omitempty"`
+	// HoldPolicy overrides the global hold settings for this event when set
+	HoldPolicy *HoldPolicy `dynamodbav:"hold_policy,omitempty"`
+	// SeatMapVersion is bumped by every admin change to the event's seats
+	SeatMapVersion int32 `dynamodbav:"seat_map_version,omitempty"`
+	// SeatManifest records the last bulk seat upload verified against its manifest
+	SeatManifest *SeatManifestRecord `dynamodbav:"seat_manifest,omitempty"`
+	// Visibility hides segments of the event's seats from public checks and holds
+	Visibility map[string]VisibilityRule `dynamodbav:"visibility,omitempty"`
+	// SectionLocks are editor leases on sections of the event's seats
+	SectionLocks map[string]SectionLock `dynamodbav:"section_locks,omitempty"`
+	// Forecast is the demand curve a forecasting system expects for the event's on-sale
+	Forecast *DemandForecast `dynamodbav:"forecast,omitempty"`
+}
+
+// HoldPolicy is an event's seat hold policy; zero fields fall back to the global defaults
+type HoldPolicy struct {
+	TTLSeconds    int32 `dynamodbav:"ttl_seconds,omitempty"`
+	MaxExtensions int32 `dynamodbav:"max_extensions,omitempty"`
+	MaxSeats      int32 `dynamodbav:"max_seats,omitempty"`
+}
+
+// DemandForecast is an event's expected demand curve. Each point's sales rate lasts from
+// its offset from OnSaleAt until the next point's; the last one lasts until sell-out.
+type DemandForecast struct {
+	OnSaleAt  time.Time     `dynamodbav:"on_sale_at"`
+	Points    []DemandPoint `dynamodbav:"points"`
+	Source    string        `dynamodbav:"source,omitempty"`
+	UpdatedAt time.Time     `dynamodbav:"updated_at"`
+}
+
+// DemandPoint is a point of a demand curve
+type DemandPoint struct {
+	OffsetSeconds  int32   `dynamodbav:"offset_seconds"`
+	SalesPerSecond float64 `dynamodbav:"sales_per_second"`
 }
 
 // SeatItem represents a seat item in DynamoDB
 type SeatItem struct {
 	EventID       string    `dynamodbav:"event_id"`
 	SeatID        string    `dynamodbav:"seat_id"`
-	Status        string    `dynamodbav:"status"` // AVAILABLE, HOLD, SOLD
+	Status        string    `dynamodbav:"status"` // one of the Seat* statuses
 	ReservationID string    `dynamodbav:"reservation_id,omitempty"`
 	UpdatedAt     time.Time `dynamodbav:"updated_at"`
+	// Metadata and Note are operator annotations (obstructed view, companion seat,
+	// production hold reason); they never affect availability
+	Metadata map[string]string `dynamodbav:"metadata,omitempty"`
+	Note     string            `dynamodbav:"note,omitempty"`
+	// Adjacency is stored when the seat map is provisioned from a layout; seats without
+	// it are adjacent by seat number
+	Adjacency *SeatAdjacency `dynamodbav:"adjacency,omitempty"`
+}
+
+// Seat statuses as stored on seat items: the names of the SeatStatus enum values
+// without their SEAT_STATUS_ prefix
+const (
+	SeatAvailable        = "AVAILABLE"
+	SeatHold             = "HOLD"
+	SeatSold             = "SOLD"
+	SeatBlocked          = "BLOCKED"
+	SeatKilled           = "KILLED"
+	SeatReservedInternal = "RESERVED_INTERNAL"
+	SeatAllocated        = "ALLOCATED"
+	SeatClosed           = "CLOSED"
+)
+
+// SeatAdjacency records the seats directly beside a seat in its row. A side without a
+// neighbor is a row end, or an aisle if the aisle flag of the side is set.
+type SeatAdjacency struct {
+	Left       string `dynamodbav:"left,omitempty"`
+	Right      string `dynamodbav:"right,omitempty"`
+	AisleLeft  bool   `dynamodbav:"aisle_left,omitempty"`
+	AisleRight bool   `dynamodbav:"aisle_right,omitempty"`
+}
+
+// HoldItem represents a seat hold record in DynamoDB.
+// ExpiresAt (epoch seconds) is the holds table TTL attribute; TTL deletions are
+// picked up from the table stream to return expired holds to sale.
+type HoldItem struct {
+	EventID       string    `dynamodbav:"event_id"`
+	SeatID        string    `dynamodbav:"seat_id"`
+	ReservationID string    `dynamodbav:"reservation_id"`
+	ExpiresAt     int64     `dynamodbav:"expires_at"`
+	CreatedAt     time.Time `dynamodbav:"created_at"`
+	// Extensions counts how many times the reservation re-held the seat
+	Extensions int32 `dynamodbav:"extensions,omitempty"`
 }
 
 // IdempotencyItem represents an idempotency item in DynamoDB
@@ -61,6 +283,14 @@ type IdempotencyItem struct {
 	Operation string    `dynamodbav:"operation"`
 	EventID   string    `dynamodbav:"event_id"`
 	CreatedAt time.Time `dynamodbav:"created_at"`
+	// ExpiresAt is the table's TTL attribute, in unix seconds; PutIdempotency sets it from
+	// the configured TTL. Records written before it was set have none.
+	ExpiresAt int64 `dynamodbav:"expires_at,omitempty"`
+	// RequestHash and Response are the hash of a commit's request and its serialized
+	// response without the order ID, so retries get the same response and different
+	// requests reusing the key are rejected
+	RequestHash string `dynamodbav:"request_hash,omitempty"`
+	Response    []byte `dynamodbav:"response,omitempty"`
 }
 
 // GetInventory retrieves inventory information for an event
@@ -77,14 +307,16 @@ func (r *DynamoDBRepository) GetInventory(ctx context.Context, eventID string) (
 	}
 
 	if result.Item == nil {
-		return nil, fmt.Errorf("inventory not found for event: %s", eventID)
+		return nil, apperrors.NotFound("inventory not found for event: %s", eventID)
 	}
+	r.mirror.verify(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)}, []map[string]types.AttributeValue{result.Item})
 
 	item := &InventoryItem{}
 	err = unmarshalDynamoItem(result.Item, item)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal inventory item: %w", err)
 	}
+	r.canary.compareInventory(ctx, eventID, item)
 
 	return item, nil
 }
@@ -104,6 +336,7 @@ func (r *DynamoDBRepository) PutInventory(ctx context.Context, item *InventoryIt
 	if err != nil {
 		return fmt.Errorf("failed to put inventory: %w", err)
 	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(item.EventID)})
 
 	return nil
 }
@@ -124,14 +357,205 @@ func (r *DynamoDBRepository) UpdateInventoryConditionally(ctx context.Context, e
 	if err != nil {
 		return fmt.Errorf("failed to update inventory conditionally: %w", err)
 	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// UpdateInventoryWithRecord behaves like UpdateInventoryConditionally and stores an
+// idempotency record in the same transaction, so the update can't happen without its
+// record. It fails with a conditional check error if the update's condition fails, and
+// with ErrIdempotencyConflict if a live record with the key exists.
+func (r *DynamoDBRepository) UpdateInventoryWithRecord(ctx context.Context, eventID string, updateExpr string, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string, record *IdempotencyItem) error {
+	put, err := r.idempotencyRecordPut(record)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName: aws.String(r.tableInventory),
+					Key: map[string]types.AttributeValue{
+						"event_id": &types.AttributeValueMemberS{Value: eventID},
+					},
+					UpdateExpression:          aws.String(updateExpr),
+					ConditionExpression:       aws.String(conditionExpr),
+					ExpressionAttributeValues: exprValues,
+					ExpressionAttributeNames:  exprNames,
+				},
+			},
+			put,
+		},
+	})
+
+	if err != nil {
+		if conditionFailedIn(err, 1, 2) {
+			return recordConflict(record, err)
+		}
+		if conditionFailedIn(err, 0, 1) {
+			return fmt.Errorf("failed to update inventory conditionally: %w", &types.ConditionalCheckFailedException{Message: aws.String(err.Error())})
+		}
+		return fmt.Errorf("failed to update inventory conditionally: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// UpdateInventoryConditionallyReturnOld behaves like UpdateInventoryConditionally
+// and returns the inventory item as it was before the update
+func (r *DynamoDBRepository) UpdateInventoryConditionallyReturnOld(ctx context.Context, eventID string, updateExpr string, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string) (*InventoryItem, error) {
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key: map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression:          aws.String(updateExpr),
+		ConditionExpression:       aws.String(conditionExpr),
+		ExpressionAttributeValues: exprValues,
+		ExpressionAttributeNames:  exprNames,
+		ReturnValues:              types.ReturnValueAllOld,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update inventory conditionally: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	item := &InventoryItem{}
+	if err := unmarshalDynamoItem(result.Attributes, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inventory item: %w", err)
+	}
+
+	return item, nil
+}
+
+// AdjustInventory applies an admin capacity adjustment guarded by optimistic locking.
+// Unlike reservation commits, adjustments must be based on the latest observed version.
+func (r *DynamoDBRepository) AdjustInventory(ctx context.Context, eventID string, delta int32, expectedVersion int32) error {
+	updateExpr := "SET remaining = remaining + :delta, version = version + 1, updated_at = :updated_at"
+	conditionExpr := "version = :expected_version AND remaining >= :min_remaining"
+
+	// Condition expressions can't do arithmetic, so a decrease is guarded by
+	// requiring at least |delta| remaining.
+	minRemaining := int32(0)
+	if delta < 0 {
+		minRemaining = -delta
+	}
+
+	exprValues := map[string]types.AttributeValue{
+		":delta":            &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", delta)},
+		":expected_version": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expectedVersion)},
+		":min_remaining":    &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", minRemaining)},
+		":updated_at":       &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+	}
+
+	return r.UpdateInventoryConditionally(ctx, eventID, updateExpr, conditionExpr, exprValues, nil)
+}
+
+// SetEventFrozen sets or clears the per-event write freeze.
+// The item is upserted so seat-only events without a quantity row can be frozen too.
+func (r *DynamoDBRepository) SetEventFrozen(ctx context.Context, eventID string, frozen bool, reason string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key: map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET frozen = :frozen, frozen_reason = :reason, updated_at = :updated_at"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":frozen":     &types.AttributeValueMemberBOOL{Value: frozen},
+			":reason":     &types.AttributeValueMemberS{Value: reason},
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to set event frozen: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// FlushMirror waits until the writes mirrored so far during a dual-write migration were
+// copied to the mirror tables, or ctx ends
+func (r *DynamoDBRepository) FlushMirror(ctx context.Context) error {
+	return r.mirror.flush(ctx)
+}
+
+// SetHoldPolicy sets or, when policy is nil, clears an event's hold policy.
+// The item is upserted like SetEventFrozen so seat-only events can have a policy.
+func (r *DynamoDBRepository) SetHoldPolicy(ctx context.Context, eventID string, policy *HoldPolicy) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key: map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET updated_at = :updated_at REMOVE hold_policy"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	}
+
+	if policy != nil {
+		policyValue, err := attributevalue.Marshal(policy)
+		if err != nil {
+			return fmt.Errorf("failed to marshal hold policy: %w", err)
+		}
+		input.UpdateExpression = aws.String("SET hold_policy = :hold_policy, updated_at = :updated_at")
+		input.ExpressionAttributeValues[":hold_policy"] = policyValue
+	}
+
+	if _, err := r.client.UpdateItem(ctx, input); err != nil {
+		return fmt.Errorf("failed to set hold policy: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// SetDemandForecast stores or, when forecast is nil, clears an event's demand forecast.
+// The item is upserted like SetHoldPolicy so forecasts can be pushed ahead of provisioning.
+func (r *DynamoDBRepository) SetDemandForecast(ctx context.Context, eventID string, forecast *DemandForecast) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key: map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET updated_at = :updated_at REMOVE forecast"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	}
+
+	if forecast != nil {
+		forecastValue, err := attributevalue.Marshal(forecast)
+		if err != nil {
+			return fmt.Errorf("failed to marshal demand forecast: %w", err)
+		}
+		input.UpdateExpression = aws.String("SET forecast = :forecast, updated_at = :updated_at")
+		input.ExpressionAttributeValues[":forecast"] = forecastValue
+	}
+
+	if _, err := r.client.UpdateItem(ctx, input); err != nil {
+		return fmt.Errorf("failed to set demand forecast: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
 
 	return nil
 }
 
 // GetSeat retrieves seat information
 func (r *DynamoDBRepository) GetSeat(ctx context.Context, eventID, seatID string) (*SeatItem, error) {
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String(r.tableSeats),
+		TableName: aws.String(table),
 		Key: map[string]types.AttributeValue{
 			"event_id": &types.AttributeValueMemberS{Value: eventID},
 			"seat_id":  &types.AttributeValueMemberS{Value: seatID},
@@ -143,7 +567,7 @@ func (r *DynamoDBRepository) GetSeat(ctx context.Context, eventID, seatID string
 	}
 
 	if result.Item == nil {
-		return nil, fmt.Errorf("seat not found: %s", seatID)
+		return nil, apperrors.NotFound("seat not found: %s", seatID)
 	}
 
 	item := &SeatItem{}
@@ -161,17 +585,15 @@ func (r *DynamoDBRepository) GetSeats(ctx context.Context, eventID string, seatI
 		return nil, nil
 	}
 
-	keys := make([]map[string]types.AttributeValue, len(seatIDs))
-	for i, seatID := range seatIDs {
-		keys[i] = map[string]types.AttributeValue{
-			"event_id": &types.AttributeValueMemberS{Value: eventID},
-			"seat_id":  &types.AttributeValueMemberS{Value: seatID},
-		}
+	table, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, err
 	}
+	keys := seatKeys(eventID, seatIDs)
 
 	result, err := r.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
 		RequestItems: map[string]types.KeysAndAttributes{
-			r.tableSeats: {
+			table: {
 				Keys: keys,
 			},
 		},
@@ -180,9 +602,10 @@ func (r *DynamoDBRepository) GetSeats(ctx context.Context, eventID string, seatI
 	if err != nil {
 		return nil, fmt.Errorf("failed to batch get seats: %w", err)
 	}
+	m.verify(ctx, tableNameSeats, keys, result.Responses[table])
 
-	seats := make([]*SeatItem, 0, len(result.Responses[r.tableSeats]))
-	for _, item := range result.Responses[r.tableSeats] {
+	seats := make([]*SeatItem, 0, len(result.Responses[table]))
+	for _, item := range result.Responses[table] {
 		seat := &SeatItem{}
 		err = unmarshalDynamoItem(item, seat)
 		if err != nil {
@@ -190,62 +613,816 @@ func (r *DynamoDBRepository) GetSeats(ctx context.Context, eventID string, seatI
 		}
 		seats = append(seats, seat)
 	}
+	r.canary.compareSeats(ctx, eventID, seatIDs, seats)
 
 	return seats, nil
 }
 
 // TransactWriteSeats performs transactional write on multiple seats
-func (r *DynamoDBRepository) TransactWriteSeats(ctx context.Context, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue) error {
+func (r *DynamoDBRepository) TransactWriteSeats(ctx context.Context, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string) error {
 	if len(items) == 0 {
 		return nil
 	}
 
-	var transactItems []types.TransactWriteItem
+	table, m, err := r.seatsTable(ctx, items[0].EventID)
+	if err != nil {
+		return err
+	}
+	transactItems, err := seatUpdates(table, items, conditionExpr, exprValues, exprNames)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+
+	if err != nil {
+		if conflict := r.seatConflict(err, items[0].EventID, seatItemAt(items)); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to transact write seats: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(items))
+
+	return nil
+}
+
+// LiveHoldCheck requires the hold records of seats to belong to a reservation and to
+// expire after a deadline, so a commit can't race the sweeper releasing an expired hold
+type LiveHoldCheck struct {
+	EventID       string
+	ReservationID string
+	SeatIDs       []string
+	ExpiresAfter  time.Time
+}
+
+// TransactWriteSeatsWithHolds writes seats as TransactWriteSeats does, in the same
+// transaction as the live hold check and, if not nil, the idempotency record of the
+// write. If the check fails the error is ErrHoldExpired; if a live record with the
+// record's key exists it is ErrIdempotencyConflict.
+func (r *DynamoDBRepository) TransactWriteSeatsWithHolds(ctx context.Context, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string, holds *LiveHoldCheck, record *IdempotencyItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, items[0].EventID)
+	if err != nil {
+		return err
+	}
+	transactItems, err := seatUpdates(table, items, conditionExpr, exprValues, exprNames)
+	if err != nil {
+		return err
+	}
+	transactItems = append(transactItems, r.holdChecks(holds)...)
+	holdChecksEnd := len(transactItems)
+	if record != nil {
+		put, err := r.idempotencyRecordPut(record)
+		if err != nil {
+			return err
+		}
+		transactItems = append(transactItems, put)
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+
+	if err != nil {
+		if conditionFailedIn(err, holdChecksEnd, len(transactItems)) {
+			return recordConflict(record, err)
+		}
+		if conditionFailedIn(err, len(items), holdChecksEnd) {
+			return apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s: %w", holds.ReservationID, err)
+		}
+		if conflict := r.seatConflict(err, items[0].EventID, seatItemAt(items)); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to transact write seats: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(items))
+
+	return nil
+}
+
+// TransactWriteSeatsAndSections atomically writes seats (as TransactWriteSeats does) and
+// applies deltas to general-admission section pools of a hybrid event. Pools live in the
+// inventory item as sections.<name>.remaining; a negative delta requires enough remaining.
+// A non-nil holds check and idempotency record are applied as in TransactWriteSeatsWithHolds.
+func (r *DynamoDBRepository) TransactWriteSeatsAndSections(ctx context.Context, eventID string, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string, sectionDeltas map[string]int32, holds *LiveHoldCheck, record *IdempotencyItem) error {
+	table, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	transactItems, err := seatUpdates(table, items, conditionExpr, exprValues, exprNames)
+	if err != nil {
+		return err
+	}
+	transactItems = append(transactItems, r.holdChecks(holds)...)
+	holdChecksEnd := len(transactItems)
+
+	if len(sectionDeltas) > 0 {
+		sections := make([]string, 0, len(sectionDeltas))
+		for section := range sectionDeltas {
+			sections = append(sections, section)
+		}
+		sort.Strings(sections)
+
+		setExprs := []string{"version = version + 1", "updated_at = :updated_at"}
+		conditions := []string{"(attribute_not_exists(frozen) OR frozen = :not_frozen)"}
+		sectionNames := map[string]string{}
+		sectionValues := map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+			":not_frozen": &types.AttributeValueMemberBOOL{Value: false},
+		}
+		for i, section := range sections {
+			name := fmt.Sprintf("#s%d", i)
+			path := fmt.Sprintf("sections.%s.remaining", name)
+			delta := sectionDeltas[section]
+
+			sectionNames[name] = section
+			sectionValues[fmt.Sprintf(":d%d", i)] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", delta)}
+			setExprs = append(setExprs, fmt.Sprintf("%s = %s + :d%d", path, path, i))
+
+			if delta < 0 {
+				// Condition expressions can't do arithmetic, so require at least |delta| remaining
+				sectionValues[fmt.Sprintf(":m%d", i)] = &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", -delta)}
+				conditions = append(conditions, fmt.Sprintf("%s >= :m%d", path, i))
+			} else {
+				conditions = append(conditions, fmt.Sprintf("attribute_exists(%s)", path))
+			}
+		}
+
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName: aws.String(r.tableInventory),
+				Key: map[string]types.AttributeValue{
+					"event_id": &types.AttributeValueMemberS{Value: eventID},
+				},
+				UpdateExpression:          aws.String("SET " + strings.Join(setExprs, ", ")),
+				ConditionExpression:       aws.String(strings.Join(conditions, " AND ")),
+				ExpressionAttributeNames:  sectionNames,
+				ExpressionAttributeValues: sectionValues,
+			},
+		})
+	}
+
+	if len(transactItems) == 0 {
+		return nil
+	}
+	sectionsEnd := len(transactItems)
+	if record != nil {
+		put, err := r.idempotencyRecordPut(record)
+		if err != nil {
+			return err
+		}
+		transactItems = append(transactItems, put)
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+
+	if err != nil {
+		if conditionFailedIn(err, sectionsEnd, len(transactItems)) {
+			return recordConflict(record, err)
+		}
+		if conditionFailedIn(err, len(items), holdChecksEnd) {
+			return apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s: %w", holds.ReservationID, err)
+		}
+		if conflict := r.seatConflict(err, eventID, seatItemAt(items)); conflict != nil {
+			return conflict
+		}
+		if conditionFailedIn(err, holdChecksEnd, sectionsEnd) {
+			return apperrors.New(apperrors.ErrInsufficientInventory, "insufficient inventory for event %s: %w", eventID, err)
+		}
+		return fmt.Errorf("failed to transact write seats and sections: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(items))
+	if len(sectionDeltas) > 0 {
+		r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+	}
+
+	return nil
+}
+
+// seatUpdates builds transactional writes into a seats table for seat items sharing one
+// condition. Only the status, reservation and update time are written, so other seat
+// attributes such as operator metadata survive; seats that don't exist are created.
+func seatUpdates(table string, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string) ([]types.TransactWriteItem, error) {
+	transactItems := make([]types.TransactWriteItem, 0, len(items))
 
 	for _, item := range items {
-		dynamoItem, err := marshalDynamoItem(item)
+		updatedAt, err := attributevalue.Marshal(item.UpdatedAt)
 		if err != nil {
-			return fmt.Errorf("failed to marshal seat item: %w", err)
+			return nil, fmt.Errorf("failed to marshal seat item: %w", err)
+		}
+
+		names := make(map[string]string, len(exprNames)+1)
+		maps.Copy(names, exprNames)
+		names["#status"] = "status"
+
+		values := make(map[string]types.AttributeValue, len(exprValues)+3)
+		maps.Copy(values, fields.sealValues(exprValues))
+		values[":seat_status"] = &types.AttributeValueMemberS{Value: item.Status}
+		values[":seat_updated_at"] = updatedAt
+
+		updateExpr := "SET #status = :seat_status, updated_at = :seat_updated_at"
+		if item.ReservationID != "" {
+			updateExpr += ", reservation_id = :seat_reservation_id"
+			values[":seat_reservation_id"] = &types.AttributeValueMemberS{Value: fields.seal(item.ReservationID)}
+		} else {
+			updateExpr += " REMOVE reservation_id"
+		}
+
+		update := &types.Update{
+			TableName:                 aws.String(table),
+			Key:                       seatKeys(item.EventID, []string{item.SeatID})[0],
+			UpdateExpression:          aws.String(updateExpr),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		}
+		if conditionExpr != "" {
+			update.ConditionExpression = aws.String(conditionExpr)
 		}
 
 		transactItems = append(transactItems, types.TransactWriteItem{
-			Put: &types.Put{
-				TableName:           aws.String(r.tableSeats),
-				Item:                dynamoItem,
-				ConditionExpression: aws.String(conditionExpr),
+			Update: update,
+		})
+	}
+
+	return transactItems, nil
+}
+
+// holdChecks builds transactional condition checks for a live hold check, if any
+func (r *DynamoDBRepository) holdChecks(holds *LiveHoldCheck) []types.TransactWriteItem {
+	if holds == nil {
+		return nil
+	}
+
+	checks := make([]types.TransactWriteItem, 0, len(holds.SeatIDs))
+	for _, seatID := range holds.SeatIDs {
+		checks = append(checks, types.TransactWriteItem{
+			ConditionCheck: &types.ConditionCheck{
+				TableName: aws.String(r.tableHolds),
+				Key: map[string]types.AttributeValue{
+					"event_id": &types.AttributeValueMemberS{Value: holds.EventID},
+					"seat_id":  &types.AttributeValueMemberS{Value: seatID},
+				},
+				ConditionExpression: aws.String("reservation_id = :reservation_id AND expires_at > :expires_after"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(holds.ReservationID)},
+					":expires_after":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", holds.ExpiresAfter.Unix())},
+				},
 			},
 		})
 	}
+	return checks
+}
 
-	// Set expression attribute values if provided
-	if len(exprValues) > 0 {
-		for i := range transactItems {
-			if transactItems[i].Put != nil {
-				transactItems[i].Put.ExpressionAttributeValues = exprValues
+// conditionFailedIn reports whether a canceled transaction failed a condition on one of
+// the items [from, to), e.g. where its hold checks are
+func conditionFailedIn(err error, from, to int) bool {
+	var txCanceled *types.TransactionCanceledException
+	if !errors.As(err, &txCanceled) {
+		return false
+	}
+	for i := from; i < to && i < len(txCanceled.CancellationReasons); i++ {
+		if aws.ToString(txCanceled.CancellationReasons[i].Code) == "ConditionalCheckFailed" {
+			return true
+		}
+	}
+	return false
+}
+
+// seatConflict explains a canceled seat transaction: it returns a SeatConflictError naming
+// the seats whose writes failed their condition or, when no item failed a condition, a
+// TransactionError with the reason each seat write was canceled for. It returns nil if
+// err isn't a canceled transaction or only items other than seat writes failed their
+// conditions. seatAt maps a transaction item to its seat, reporting false for items that
+// aren't seat writes.
+func (r *DynamoDBRepository) seatConflict(err error, eventID string, seatAt func(i int) (string, bool)) error {
+	var txCanceled *types.TransactionCanceledException
+	if !errors.As(err, &txCanceled) {
+		return nil
+	}
+
+	// The most significant reason names the transaction; an item too large never succeeds on retry
+	rank := map[string]int{apperrors.TxReasonTransactionConflict: 1, apperrors.TxReasonThrottled: 2, apperrors.TxReasonItemTooLarge: 3}
+	txErr := &apperrors.TransactionError{EventID: eventID, Reason: apperrors.TxReasonOther, SeatReasons: map[string]string{}, Err: err}
+	var conflicted []string
+	conditionFailed := false
+	for i, cancellation := range txCanceled.CancellationReasons {
+		code := aws.ToString(cancellation.Code)
+		if code == "" || code == "None" {
+			continue
+		}
+		reason := cancellationReason(code, aws.ToString(cancellation.Message))
+		r.metrics.RecordTransactionCancellation(reason)
+		if code == "ConditionalCheckFailed" {
+			conditionFailed = true
+		} else if rank[reason] > rank[txErr.Reason] {
+			txErr.Reason = reason
+		}
+
+		if seatID, ok := seatAt(i); ok {
+			if code == "ConditionalCheckFailed" {
+				conflicted = append(conflicted, seatID)
+			} else {
+				txErr.SeatReasons[seatID] = reason
 			}
 		}
 	}
 
-	_, err := r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+	switch {
+	case len(conflicted) > 0:
+		return &apperrors.SeatConflictError{EventID: eventID, SeatIDs: conflicted, Err: err}
+	case conditionFailed:
+		return nil
+	}
+	return txErr
+}
+
+// cancellationReason maps a DynamoDB cancellation reason code to a reason of a
+// TransactionError, or condition_failed
+func cancellationReason(code, message string) string {
+	switch code {
+	case "ConditionalCheckFailed":
+		return "condition_failed"
+	case "ProvisionedThroughputExceeded", "ThrottlingError", "RequestLimitExceeded":
+		return apperrors.TxReasonThrottled
+	case "TransactionConflict":
+		return apperrors.TxReasonTransactionConflict
+	case "ItemCollectionSizeLimitExceeded":
+		return apperrors.TxReasonItemTooLarge
+	case "ValidationError":
+		if strings.Contains(message, "size") {
+			return apperrors.TxReasonItemTooLarge
+		}
+	}
+	return apperrors.TxReasonOther
+}
+
+// seatItemAt maps the leading transaction items written by seatUpdates to their seats
+func seatItemAt(items []*SeatItem) func(int) (string, bool) {
+	return func(i int) (string, bool) {
+		if i >= len(items) {
+			return "", false
+		}
+		return items[i].SeatID, true
+	}
+}
+
+// HoldSeats atomically moves seats to HOLD for a reservation and writes a hold record per seat.
+// Seats must be available or already held by the same reservation (which refreshes the hold);
+// extensions is the number of refreshes so far and is stored on the hold records.
+func (r *DynamoDBRepository) HoldSeats(ctx context.Context, eventID, reservationID string, seatIDs []string, expiresAt time.Time, extensions int32) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	transactItems := make([]types.TransactWriteItem, 0, len(seatIDs)*2)
+	for _, seatID := range seatIDs {
+		holdItem, err := marshalDynamoItem(&HoldItem{
+			EventID:       eventID,
+			SeatID:        seatID,
+			ReservationID: reservationID,
+			ExpiresAt:     expiresAt.Unix(),
+			CreatedAt:     now,
+			Extensions:    extensions,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal hold item: %w", err)
+		}
+
+		transactItems = append(transactItems,
+			types.TransactWriteItem{
+				Update: &types.Update{
+					TableName: aws.String(table),
+					Key: map[string]types.AttributeValue{
+						"event_id": &types.AttributeValueMemberS{Value: eventID},
+						"seat_id":  &types.AttributeValueMemberS{Value: seatID},
+					},
+					UpdateExpression:         aws.String("SET #status = :hold, reservation_id = :reservation_id, updated_at = :updated_at"),
+					ConditionExpression:      aws.String("#status = :available OR (#status = :hold AND reservation_id = :reservation_id)"),
+					ExpressionAttributeNames: map[string]string{"#status": "status"},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":available":      &types.AttributeValueMemberS{Value: SeatAvailable},
+						":hold":           &types.AttributeValueMemberS{Value: SeatHold},
+						":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+						":updated_at":     &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)},
+					},
+				},
+			},
+			types.TransactWriteItem{
+				Put: &types.Put{
+					TableName: aws.String(r.tableHolds),
+					Item:      holdItem,
+				},
+			},
+		)
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
 		TransactItems: transactItems,
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to transact write seats: %w", err)
+		// Each seat has its update and then its hold record
+		conflict := r.seatConflict(err, eventID, func(i int) (string, bool) {
+			return seatIDs[i/2], i%2 == 0
+		})
+		if conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to hold seats: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatKeys(eventID, seatIDs))
+	r.mirror.copy(ctx, tableNameHolds, seatKeys(eventID, seatIDs))
+
+	return nil
+}
+
+// ExtendHolds moves the expiry of a reservation's live holds on the given seats, in one
+// transaction that fails unless every seat is still held by the reservation and no hold
+// has expired
+func (r *DynamoDBRepository) ExtendHolds(ctx context.Context, eventID, reservationID string, seatIDs []string, expiresAt time.Time, extensions int32) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	transactItems := make([]types.TransactWriteItem, 0, len(seatIDs)*2)
+	for _, seatID := range seatIDs {
+		key := seatKeys(eventID, []string{seatID})[0]
+		transactItems = append(transactItems,
+			types.TransactWriteItem{
+				Update: &types.Update{
+					TableName:           aws.String(r.tableHolds),
+					Key:                 key,
+					UpdateExpression:    aws.String("SET expires_at = :expires_at, extensions = :extensions"),
+					ConditionExpression: aws.String("reservation_id = :reservation_id AND expires_at > :now"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":expires_at":     &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expiresAt.Unix())},
+						":extensions":     &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", extensions)},
+						":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+						":now":            &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", now.Unix())},
+					},
+				},
+			},
+			types.TransactWriteItem{
+				ConditionCheck: &types.ConditionCheck{
+					TableName:                aws.String(table),
+					Key:                      key,
+					ConditionExpression:      aws.String("#status = :hold AND reservation_id = :reservation_id"),
+					ExpressionAttributeNames: map[string]string{"#status": "status"},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":hold":           &types.AttributeValueMemberS{Value: SeatHold},
+						":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+					},
+				},
+			},
+		)
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extend holds: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameHolds, seatKeys(eventID, seatIDs))
+
+	return nil
+}
+
+// GetHolds retrieves the hold records of the given seats. Seats without a record are absent.
+func (r *DynamoDBRepository) GetHolds(ctx context.Context, eventID string, seatIDs []string) ([]*HoldItem, error) {
+	if len(seatIDs) == 0 {
+		return nil, nil
+	}
+
+	result, err := r.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]types.KeysAndAttributes{
+			r.tableHolds: {
+				Keys: seatKeys(eventID, seatIDs),
+			},
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to batch get holds: %w", err)
+	}
+
+	holds := make([]*HoldItem, 0, len(result.Responses[r.tableHolds]))
+	for _, item := range result.Responses[r.tableHolds] {
+		hold := &HoldItem{}
+		if err := unmarshalDynamoItem(item, hold); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hold item: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, nil
+}
+
+// QuerySeatsByStatus returns one page of an event's seats with the given status.
+// When updatedBefore is non-zero only seats last updated before it are returned.
+// A page may be empty while more results remain; callers continue until the returned key is nil.
+func (r *DynamoDBRepository) QuerySeatsByStatus(ctx context.Context, eventID, status string, updatedBefore time.Time, startKey map[string]types.AttributeValue, limit int32) ([]*SeatItem, map[string]types.AttributeValue, error) {
+	filterExpr := "#status = :status"
+	exprValues := map[string]types.AttributeValue{
+		":event_id": &types.AttributeValueMemberS{Value: eventID},
+		":status":   &types.AttributeValueMemberS{Value: status},
+	}
+	if !updatedBefore.IsZero() {
+		filterExpr += " AND updated_at < :updated_before"
+		exprValues[":updated_before"] = &types.AttributeValueMemberS{Value: updatedBefore.Format(time.RFC3339)}
+	}
+
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(table),
+		KeyConditionExpression:    aws.String("event_id = :event_id"),
+		FilterExpression:          aws.String(filterExpr),
+		ExpressionAttributeNames:  map[string]string{"#status": "status"},
+		ExpressionAttributeValues: exprValues,
+		ExclusiveStartKey:         startKey,
+	}
+	if limit > 0 {
+		input.Limit = aws.Int32(limit)
+	}
+
+	result, err := r.client.Query(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query seats: %w", err)
+	}
+
+	seats := make([]*SeatItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		seat := &SeatItem{}
+		if err := unmarshalDynamoItem(item, seat); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal seat item: %w", err)
+		}
+		seats = append(seats, seat)
+	}
+
+	return seats, result.LastEvaluatedKey, nil
+}
+
+// ListEventSeats returns all seats of an event
+func (r *DynamoDBRepository) ListEventSeats(ctx context.Context, eventID string) ([]*SeatItem, error) {
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(table),
+		KeyConditionExpression: aws.String("event_id = :event_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
 	}
 
+	var seats []*SeatItem
+	paginator := dynamodb.NewQueryPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query seats: %w", err)
+		}
+		for _, item := range page.Items {
+			seat := &SeatItem{}
+			if err := unmarshalDynamoItem(item, seat); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal seat item: %w", err)
+			}
+			seats = append(seats, seat)
+		}
+	}
+
+	return seats, nil
+}
+
+// QuerySeatsPage returns up to limit of an event's seats in seat ID order, starting after
+// startSeatID (empty for the first page). Only seats whose ID starts with seatPrefix and,
+// when statuses is non-empty, whose status is one of statuses are returned. The returned
+// seat ID continues the listing; it is empty when no seats remain.
+func (r *DynamoDBRepository) QuerySeatsPage(ctx context.Context, eventID, seatPrefix string, statuses []string, startSeatID string, limit int32) ([]*SeatItem, string, error) {
+	keyCondition := "event_id = :event_id"
+	exprValues := map[string]types.AttributeValue{
+		":event_id": &types.AttributeValueMemberS{Value: eventID},
+	}
+	if seatPrefix != "" {
+		keyCondition += " AND begins_with(seat_id, :seat_prefix)"
+		exprValues[":seat_prefix"] = &types.AttributeValueMemberS{Value: seatPrefix}
+	}
+
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(table),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeValues: exprValues,
+	}
+	if len(statuses) > 0 {
+		placeholders := make([]string, len(statuses))
+		for i, status := range statuses {
+			placeholders[i] = fmt.Sprintf(":status%d", i)
+			exprValues[placeholders[i]] = &types.AttributeValueMemberS{Value: status}
+		}
+		input.FilterExpression = aws.String(fmt.Sprintf("#status IN (%s)", strings.Join(placeholders, ", ")))
+		input.ExpressionAttributeNames = map[string]string{"#status": "status"}
+	}
+	if startSeatID != "" {
+		input.ExclusiveStartKey = seatKeys(eventID, []string{startSeatID})[0]
+	}
+
+	// The limit applies before the status filter, so keep reading until the page is full
+	seats := make([]*SeatItem, 0, limit)
+	for int32(len(seats)) < limit {
+		input.Limit = aws.Int32(limit - int32(len(seats)))
+		result, err := r.client.Query(ctx, input)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to query seats: %w", err)
+		}
+		for _, item := range result.Items {
+			seat := &SeatItem{}
+			if err := unmarshalDynamoItem(item, seat); err != nil {
+				return nil, "", fmt.Errorf("failed to unmarshal seat item: %w", err)
+			}
+			seats = append(seats, seat)
+		}
+		if result.LastEvaluatedKey == nil {
+			return seats, "", nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+
+	// A full page may end exactly at the last seat, leaving one empty page to read
+	return seats, seats[len(seats)-1].SeatID, nil
+}
+
+// CountSeatsByStatus counts the seats of an event with the given status.
+// If updatedSince is non-zero, only seats last updated at or after it are counted.
+func (r *DynamoDBRepository) CountSeatsByStatus(ctx context.Context, eventID, status string, updatedSince time.Time) (int, error) {
+	filterExpr := "#status = :status"
+	exprValues := map[string]types.AttributeValue{
+		":event_id": &types.AttributeValueMemberS{Value: eventID},
+		":status":   &types.AttributeValueMemberS{Value: status},
+	}
+	if !updatedSince.IsZero() {
+		filterExpr += " AND updated_at >= :updated_since"
+		exprValues[":updated_since"] = &types.AttributeValueMemberS{Value: updatedSince.Format(time.RFC3339)}
+	}
+
+	table, _, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return 0, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(table),
+		KeyConditionExpression:    aws.String("event_id = :event_id"),
+		FilterExpression:          aws.String(filterExpr),
+		ExpressionAttributeNames:  map[string]string{"#status": "status"},
+		ExpressionAttributeValues: exprValues,
+		Select:                    types.SelectCount,
+	}
+
+	count := 0
+	paginator := dynamodb.NewQueryPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count seats: %w", err)
+		}
+		count += int(page.Count)
+	}
+
+	return count, nil
+}
+
+// SeatsByStatusScan returns a scan job passing every seat across all events with the
+// given status that was last updated before the given time to handle, a page at a time.
+// During a blue/green seats migration it scans the original table, which every migrating
+// event's seats are mirrored to.
+func (r *DynamoDBRepository) SeatsByStatusScan(name, status string, updatedBefore time.Time, handle func(ctx context.Context, seats []*SeatItem) error) ScanJob {
+	return ScanJob{
+		Name:                     name,
+		Table:                    r.tableSeats,
+		FilterExpression:         "#status = :status AND updated_at < :updated_before",
+		ExpressionAttributeNames: map[string]string{"#status": "status"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":status":         &types.AttributeValueMemberS{Value: status},
+			":updated_before": &types.AttributeValueMemberS{Value: updatedBefore.Format(time.RFC3339)},
+		},
+		Handle: func(ctx context.Context, items []map[string]types.AttributeValue) error {
+			seats := make([]*SeatItem, 0, len(items))
+			for _, item := range items {
+				seat := &SeatItem{}
+				if err := unmarshalDynamoItem(item, seat); err != nil {
+					return fmt.Errorf("failed to unmarshal seat item: %w", err)
+				}
+				seats = append(seats, seat)
+			}
+			return handle(ctx, seats)
+		},
+	}
+}
+
+// ReleaseHeldSeats atomically returns held seats to AVAILABLE and deletes their hold
+// records, so at most 50 seats fit in one call. Each seat is conditioned on still being
+// held by the reservation it was read with, so a seat sold or re-held in the meantime
+// cancels the whole transaction. All seats must belong to the same event.
+func (r *DynamoDBRepository) ReleaseHeldSeats(ctx context.Context, seats []*SeatItem) error {
+	if len(seats) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, seats[0].EventID)
+	if err != nil {
+		return err
+	}
+
+	updatedAt := time.Now().Format(time.RFC3339)
+	// Each seat has its update and then the deletion of its hold record
+	transactItems := make([]types.TransactWriteItem, 0, 2*len(seats))
+	for _, seat := range seats {
+		reservationID := &types.AttributeValueMemberS{Value: fields.seal(seat.ReservationID)}
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName: aws.String(table),
+				Key: map[string]types.AttributeValue{
+					"event_id": &types.AttributeValueMemberS{Value: seat.EventID},
+					"seat_id":  &types.AttributeValueMemberS{Value: seat.SeatID},
+				},
+				UpdateExpression:         aws.String("SET #status = :available, updated_at = :updated_at REMOVE reservation_id"),
+				ConditionExpression:      aws.String("#status = :hold AND reservation_id = :reservation_id"),
+				ExpressionAttributeNames: map[string]string{"#status": "status"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":available":      &types.AttributeValueMemberS{Value: SeatAvailable},
+					":hold":           &types.AttributeValueMemberS{Value: SeatHold},
+					":reservation_id": reservationID,
+					":updated_at":     &types.AttributeValueMemberS{Value: updatedAt},
+				},
+			},
+		}, types.TransactWriteItem{
+			// The hold record may have expired already, but never belongs to another reservation
+			Delete: &types.Delete{
+				TableName:           aws.String(r.tableHolds),
+				Key:                 seatKeys(seat.EventID, []string{seat.SeatID})[0],
+				ConditionExpression: aws.String("attribute_not_exists(reservation_id) OR reservation_id = :reservation_id"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":reservation_id": reservationID,
+				},
+			},
+		})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to release held seats: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(seats))
+	r.mirror.copy(ctx, tableNameHolds, seatItemKeys(seats))
+
 	return nil
 }
 
-// PutIdempotency stores idempotency information
+// PutIdempotency stores idempotency information, expiring it after the idempotency TTL
 func (r *DynamoDBRepository) PutIdempotency(ctx context.Context, item *IdempotencyItem) error {
+	if item.ExpiresAt == 0 && r.idempotencyTTL > 0 {
+		item.ExpiresAt = time.Now().Add(r.idempotencyTTL).Unix()
+	}
 	dynamoItem, err := marshalDynamoItem(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal idempotency item: %w", err)
 	}
 
 	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String("idempotency"), // You might want to make this configurable
+		TableName: aws.String(r.tableIdempotency),
 		Item:      dynamoItem,
 	})
 
@@ -256,12 +1433,44 @@ func (r *DynamoDBRepository) PutIdempotency(ctx context.Context, item *Idempoten
 	return nil
 }
 
-// GetIdempotency retrieves idempotency information
+// idempotencyRecordPut returns a transaction item storing an idempotency record, expiring
+// it after the idempotency TTL, unless a live record with its key exists
+func (r *DynamoDBRepository) idempotencyRecordPut(item *IdempotencyItem) (types.TransactWriteItem, error) {
+	now := time.Now()
+	if item.ExpiresAt == 0 && r.idempotencyTTL > 0 {
+		item.ExpiresAt = now.Add(r.idempotencyTTL).Unix()
+	}
+	dynamoItem, err := marshalDynamoItem(item)
+	if err != nil {
+		return types.TransactWriteItem{}, fmt.Errorf("failed to marshal idempotency item: %w", err)
+	}
+
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName: aws.String(r.tableIdempotency),
+			Item:      dynamoItem,
+			// Expired records are about to be deleted by TTL and don't count
+			ConditionExpression:      aws.String("attribute_not_exists(#key) OR expires_at <= :now"),
+			ExpressionAttributeNames: map[string]string{"#key": "key"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":now": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", now.Unix())},
+			},
+		},
+	}, nil
+}
+
+// recordConflict reports that a transaction was canceled because its idempotency record
+// already exists
+func recordConflict(record *IdempotencyItem, err error) error {
+	return apperrors.New(apperrors.ErrIdempotencyConflict, "idempotency record %s already exists: %w", record.Key, err)
+}
+
+// GetIdempotency retrieves idempotency information; expired records are not found
 func (r *DynamoDBRepository) GetIdempotency(ctx context.Context, key string) (*IdempotencyItem, error) {
 	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
-		TableName: aws.String("idempotency"),
+		TableName: aws.String(r.tableIdempotency),
 		Key: map[string]types.AttributeValue{
-			"key": &types.AttributeValueMemberS{Value: key},
+			"key": &types.AttributeValueMemberS{Value: fields.sealKey(key)},
 		},
 	})
 
@@ -279,15 +1488,89 @@ func (r *DynamoDBRepository) GetIdempotency(ctx context.Context, key string) (*I
 		return nil, fmt.Errorf("failed to unmarshal idempotency item: %w", err)
 	}
 
+	// DynamoDB deletes expired items up to days late; until then the key is free for reuse
+	if item.ExpiresAt > 0 && item.ExpiresAt <= time.Now().Unix() {
+		kind, _, _ := strings.Cut(key, ":")
+		r.metrics.RecordIdempotencyExpiredReuse(kind)
+		return nil, nil
+	}
+
 	return item, nil
 }
 
-// marshalDynamoItem marshals a Go struct to DynamoDB attribute values
+// BatchGetIdempotency retrieves the idempotency records of several keys with BatchGetItem
+// instead of a GetItem each, returning the records found by key; expired records are not found
+func (r *DynamoDBRepository) BatchGetIdempotency(ctx context.Context, keys []string) (map[string]*IdempotencyItem, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	// Records come back with their keys sealed, so map them back to the keys asked for
+	plainKeys := make(map[string]string, len(keys))
+	dynamoKeys := make([]map[string]types.AttributeValue, 0, len(keys))
+	for _, key := range keys {
+		sealed := fields.sealKey(key)
+		if _, ok := plainKeys[sealed]; ok {
+			continue
+		}
+		plainKeys[sealed] = key
+		dynamoKeys = append(dynamoKeys, map[string]types.AttributeValue{
+			"key": &types.AttributeValueMemberS{Value: sealed},
+		})
+	}
+
+	results, err := batchGetItems(ctx, r.client, r.tableIdempotency, dynamoKeys, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to batch get idempotency: %w", err)
+	}
+
+	items := make(map[string]*IdempotencyItem, len(results))
+	now := time.Now().Unix()
+	for _, result := range results {
+		item := &IdempotencyItem{}
+		if err := unmarshalDynamoItem(result, item); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal idempotency item: %w", err)
+		}
+		key := plainKeys[item.Key]
+		if item.ExpiresAt > 0 && item.ExpiresAt <= now {
+			kind, _, _ := strings.Cut(key, ":")
+			r.metrics.RecordIdempotencyExpiredReuse(kind)
+			continue
+		}
+		item.Key = key
+		items[key] = item
+	}
+
+	return items, nil
+}
+
+// marshalDynamoItem marshals a Go struct to DynamoDB attribute values, sealing
+// identifiers when field encryption is enabled
 func marshalDynamoItem(item interface{}) (map[string]types.AttributeValue, error) {
-	return attributevalue.MarshalMap(item)
+	return attributevalue.MarshalMap(fields.sealItem(item))
 }
 
-// unmarshalDynamoItem unmarshals DynamoDB attribute values to a Go struct
+// unmarshalDynamoItem unmarshals DynamoDB attribute values to a Go struct, opening
+// sealed identifiers
 func unmarshalDynamoItem(item map[string]types.AttributeValue, out interface{}) error {
-	return attributevalue.UnmarshalMap(item, out)
+	if err := attributevalue.UnmarshalMap(item, out); err != nil {
+		return err
+	}
+	return fields.openItem(out)
+}
+
+// PerformanceKey returns the partition key of an event's inventory, seats and holds.
+// Performances of a multi-performance event are stored under "<event_id>#<performance_id>"
+// so each has independent inventory; single-performance events use the bare event ID.
+func PerformanceKey(eventID, performanceID string) string {
+	if performanceID == "" {
+		return eventID
+	}
+	return eventID + "#" + performanceID
+}
+
+// SplitPerformanceKey splits a partition key into its event and performance IDs
+func SplitPerformanceKey(key string) (eventID, performanceID string) {
+	eventID, performanceID, _ = strings.Cut(key, "#")
+	return eventID, performanceID
 }
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// TokenizeSeatReservation replaces a reservation's ID on its sold seats of an event with
+// token and returns how many seats were tokenized. Seats the reservation still holds are
+// left alone and counted as held, since their reservation is still in flight.
+func (r *DynamoDBRepository) TokenizeSeatReservation(ctx context.Context, eventID, reservationID, token string) (tokenized, held int, err error) {
+	table, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+			TableName:              aws.String(table),
+			KeyConditionExpression: aws.String("event_id = :event_id"),
+			FilterExpression:       aws.String("reservation_id = :reservation_id"),
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":event_id":       &types.AttributeValueMemberS{Value: eventID},
+				":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return tokenized, held, fmt.Errorf("failed to query reservation seats: %w", err)
+		}
+
+		for _, item := range result.Items {
+			seat := &SeatItem{}
+			if err := unmarshalDynamoItem(item, seat); err != nil {
+				return tokenized, held, fmt.Errorf("failed to unmarshal seat item: %w", err)
+			}
+			if seat.Status != SeatSold {
+				held++
+				continue
+			}
+
+			_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+				TableName:                aws.String(table),
+				Key:                      seatKeys(eventID, []string{seat.SeatID})[0],
+				UpdateExpression:         aws.String("SET reservation_id = :token"),
+				ConditionExpression:      aws.String("#status = :sold AND reservation_id = :reservation_id"),
+				ExpressionAttributeNames: map[string]string{"#status": "status"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":token":          &types.AttributeValueMemberS{Value: token},
+					":sold":           &types.AttributeValueMemberS{Value: SeatSold},
+					":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+				},
+			})
+			if err != nil {
+				var conditionFailed *types.ConditionalCheckFailedException
+				if errors.As(err, &conditionFailed) {
+					// The seat changed hands meanwhile and no longer references the reservation
+					continue
+				}
+				return tokenized, held, fmt.Errorf("failed to tokenize seat %s: %w", seat.SeatID, err)
+			}
+			m.copy(ctx, tableNameSeats, seatKeys(eventID, []string{seat.SeatID}))
+			tokenized++
+		}
+
+		if result.LastEvaluatedKey == nil {
+			return tokenized, held, nil
+		}
+		startKey = result.LastEvaluatedKey
+	}
+}
+
+// TokenizeCommitStatus replaces the reservation ID recorded for an asynchronous commit
+// with token; false if the order has no status for that reservation
+func (r *DynamoDBRepository) TokenizeCommitStatus(ctx context.Context, orderID, reservationID, token string) (bool, error) {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableIdempotency),
+		Key: map[string]types.AttributeValue{
+			"key": &types.AttributeValueMemberS{Value: commitStatusKeyPrefix + orderID},
+		},
+		UpdateExpression:    aws.String("SET reservation_id = :token"),
+		ConditionExpression: aws.String("reservation_id = :reservation_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":token":          &types.AttributeValueMemberS{Value: token},
+			":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+		},
+	})
+	if err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to tokenize commit status: %w", err)
+	}
+	return true, nil
+}
+
+// DeleteIdempotencyKey deletes an idempotency table item; false if it didn't exist
+func (r *DynamoDBRepository) DeleteIdempotencyKey(ctx context.Context, key string) (bool, error) {
+	result, err := r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.tableIdempotency),
+		Key: map[string]types.AttributeValue{
+			"key": &types.AttributeValueMemberS{Value: fields.sealKey(key)},
+		},
+		ReturnValues: types.ReturnValueAllOld,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete idempotency item: %w", err)
+	}
+	return len(result.Attributes) > 0, nil
+}
@@ -0,0 +1,244 @@
+package repo
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// sealedPrefix marks attribute values sealed by field encryption
+const sealedPrefix = "enc1:"
+
+// sealedPlaceholders are expression attribute values compared with sealed attributes;
+// they are sealed the same way before the request is sent
+var sealedPlaceholders = []string{":reservation_id", ":seat_reservation_id"}
+
+// sealedKeyNamespaces are idempotency key namespaces whose key suffix is a reservation ID
+var sealedKeyNamespaces = []string{"commit:", "release:"}
+
+// fieldEncryptionContext binds data keys to this service in KMS
+var fieldEncryptionContext = map[string]string{"service": "inventory-api"}
+
+// fields seals identifiers in items written by the repository; nil when field encryption
+// is disabled. It is package-level because items are (un)marshalled by package functions.
+var fields *fieldCipher
+
+// fieldCipher encrypts reservation and order identifiers with a KMS data key so a table
+// dump doesn't link purchases to reservations. Encryption is deterministic (AES-GCM with a
+// nonce derived from the value): equal IDs seal to equal values, which keeps conditions
+// such as reservation_id = :reservation_id working on sealed attributes.
+type fieldCipher struct {
+	aead     cipher.AEAD
+	nonceKey []byte
+}
+
+// newFieldCipher derives the encryption and nonce keys from a plaintext data key
+func newFieldCipher(dataKey []byte) (*fieldCipher, error) {
+	if len(dataKey) != 32 {
+		return nil, fmt.Errorf("field encryption data key must be 256 bits, got %d", len(dataKey)*8)
+	}
+
+	block, err := aes.NewCipher(deriveKey(dataKey, "field-encryption"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldCipher{
+		aead:     aead,
+		nonceKey: deriveKey(dataKey, "field-nonce"),
+	}, nil
+}
+
+// deriveKey derives a purpose-specific key from a data key
+func deriveKey(dataKey []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, dataKey)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// loadFieldCipher unwraps a base64 KMS-encrypted data key
+func loadFieldCipher(ctx context.Context, client *kms.Client, wrappedKey string) (*fieldCipher, error) {
+	blob, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("malformed field encryption data key: %w", err)
+	}
+
+	out, err := client.Decrypt(ctx, &kms.DecryptInput{
+		CiphertextBlob:    blob,
+		EncryptionContext: fieldEncryptionContext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap field encryption data key: %w", err)
+	}
+
+	return newFieldCipher(out.Plaintext)
+}
+
+// seal encrypts a value; empty and already sealed values are returned as is
+func (c *fieldCipher) seal(value string) string {
+	if c == nil || value == "" || strings.HasPrefix(value, sealedPrefix) {
+		return value
+	}
+
+	mac := hmac.New(sha256.New, c.nonceKey)
+	mac.Write([]byte(value))
+	nonce := mac.Sum(nil)[:c.aead.NonceSize()]
+
+	return sealedPrefix + base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, []byte(value), nil))
+}
+
+// open decrypts a sealed value; values that aren't sealed (written before encryption was
+// enabled, or erasure tokens) are returned as is
+func (c *fieldCipher) open(value string) (string, error) {
+	if !strings.HasPrefix(value, sealedPrefix) {
+		return value, nil
+	}
+	if c == nil {
+		return "", errors.New("found a sealed value but field encryption is not configured")
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, sealedPrefix))
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("malformed sealed value")
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to open sealed value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// openOrKeep decrypts a sealed value, keeping it sealed if it can't be opened. Sealed values
+// still match conditions, since seal leaves them unchanged.
+func (c *fieldCipher) openOrKeep(value string) string {
+	if opened, err := c.open(value); err == nil {
+		return opened
+	}
+	return value
+}
+
+// sealValues returns expression attribute values with sealedPlaceholders sealed
+func (c *fieldCipher) sealValues(values map[string]types.AttributeValue) map[string]types.AttributeValue {
+	if c == nil {
+		return values
+	}
+
+	sealed := make(map[string]types.AttributeValue, len(values))
+	for name, value := range values {
+		sealed[name] = value
+	}
+	for _, name := range sealedPlaceholders {
+		if value, ok := values[name].(*types.AttributeValueMemberS); ok {
+			sealed[name] = &types.AttributeValueMemberS{Value: c.seal(value.Value)}
+		}
+	}
+	return sealed
+}
+
+// sealKey seals the reservation ID in an idempotency key
+func (c *fieldCipher) sealKey(key string) string {
+	for _, namespace := range sealedKeyNamespaces {
+		if strings.HasPrefix(key, namespace) {
+			return namespace + c.seal(strings.TrimPrefix(key, namespace))
+		}
+	}
+	return key
+}
+
+// sealItem returns a copy of an item with its identifiers sealed, or the item itself
+// when it has none or field encryption is disabled
+func (c *fieldCipher) sealItem(item interface{}) interface{} {
+	if c == nil {
+		return item
+	}
+
+	switch v := item.(type) {
+	case *SeatItem:
+		sealed := *v
+		sealed.ReservationID = c.seal(v.ReservationID)
+		return &sealed
+	case *HoldItem:
+		sealed := *v
+		sealed.ReservationID = c.seal(v.ReservationID)
+		return &sealed
+	case *CommitStatusItem:
+		sealed := *v
+		sealed.ReservationID = c.seal(v.ReservationID)
+		sealed.ConfirmedOrderID = c.seal(v.ConfirmedOrderID)
+		return &sealed
+	case *IdempotencyItem:
+		sealed := *v
+		sealed.Key = c.sealKey(v.Key)
+		sealed.Operation = c.seal(v.Operation)
+		return &sealed
+	}
+	return item
+}
+
+// openItem decrypts the identifiers of an unmarshalled item in place
+func (c *fieldCipher) openItem(item interface{}) error {
+	var err error
+	switch v := item.(type) {
+	case *SeatItem:
+		v.ReservationID, err = c.open(v.ReservationID)
+	case *HoldItem:
+		v.ReservationID, err = c.open(v.ReservationID)
+	case *CommitStatusItem:
+		if v.ReservationID, err = c.open(v.ReservationID); err == nil {
+			v.ConfirmedOrderID, err = c.open(v.ConfirmedOrderID)
+		}
+	case *IdempotencyItem:
+		v.Operation, err = c.open(v.Operation)
+	}
+	return err
+}
+
+// WrapFieldEncryptionKey returns the field encryption data key wrapped under a KMS key,
+// base64 encoded for DDB_FIELD_ENCRYPTION_DATA_KEY. With a data key configured it is
+// re-encrypted under kmsKeyID, which rotates the wrapping key without changing sealed
+// values; otherwise a new data key is generated.
+func (r *DynamoDBRepository) WrapFieldEncryptionKey(ctx context.Context, kmsKeyID string) (string, bool, error) {
+	if r.fieldKey == "" {
+		out, err := r.kms.GenerateDataKeyWithoutPlaintext(ctx, &kms.GenerateDataKeyWithoutPlaintextInput{
+			KeyId:             aws.String(kmsKeyID),
+			KeySpec:           kmstypes.DataKeySpecAes256,
+			EncryptionContext: fieldEncryptionContext,
+		})
+		if err != nil {
+			return "", false, fmt.Errorf("failed to generate field encryption data key: %w", err)
+		}
+		return base64.StdEncoding.EncodeToString(out.CiphertextBlob), true, nil
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(r.fieldKey)
+	if err != nil {
+		return "", false, fmt.Errorf("malformed field encryption data key: %w", err)
+	}
+	out, err := r.kms.ReEncrypt(ctx, &kms.ReEncryptInput{
+		CiphertextBlob:               blob,
+		DestinationKeyId:             aws.String(kmsKeyID),
+		SourceEncryptionContext:      fieldEncryptionContext,
+		DestinationEncryptionContext: fieldEncryptionContext,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to re-wrap field encryption data key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(out.CiphertextBlob), false, nil
+}
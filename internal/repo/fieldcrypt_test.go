@@ -0,0 +1,214 @@
+package repo
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// newTestFieldCipher returns a cipher with a data key of repeated b
+func newTestFieldCipher(t *testing.T, b byte) *fieldCipher {
+	t.Helper()
+	c, err := newFieldCipher(bytes.Repeat([]byte{b}, 32))
+	if err != nil {
+		t.Fatalf("newFieldCipher: %v", err)
+	}
+	return c
+}
+
+func TestNewFieldCipherKeySize(t *testing.T) {
+	for _, size := range []int{0, 16, 31, 33, 64} {
+		if _, err := newFieldCipher(make([]byte, size)); err == nil {
+			t.Errorf("newFieldCipher(%d bytes) = nil error, want error", size)
+		}
+	}
+}
+
+func TestFieldCipherSealOpen(t *testing.T) {
+	c := newTestFieldCipher(t, 1)
+
+	tests := []struct {
+		name   string
+		value  string
+		sealed bool
+	}{
+		{name: "reservation ID", value: "rsv-8f14e45f", sealed: true},
+		{name: "unicode", value: "예약-001", sealed: true},
+		{name: "long", value: strings.Repeat("x", 1024), sealed: true},
+		{name: "empty", value: ""},
+		{name: "already sealed", value: c.seal("rsv-1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed := c.seal(tt.value)
+			if tt.sealed == (sealed == tt.value) {
+				t.Fatalf("seal(%q) = %q, sealed = %v", tt.value, sealed, tt.sealed)
+			}
+			if tt.sealed && !strings.HasPrefix(sealed, sealedPrefix) {
+				t.Fatalf("seal(%q) = %q, want prefix %q", tt.value, sealed, sealedPrefix)
+			}
+			if again := c.seal(tt.value); again != sealed {
+				t.Fatalf("seal is not deterministic: %q then %q", sealed, again)
+			}
+
+			opened, err := c.open(sealed)
+			if err != nil {
+				t.Fatalf("open(%q): %v", sealed, err)
+			}
+			want := tt.value
+			if strings.HasPrefix(tt.value, sealedPrefix) {
+				want = "rsv-1"
+			}
+			if opened != want {
+				t.Fatalf("open(seal(%q)) = %q, want %q", tt.value, opened, want)
+			}
+		})
+	}
+}
+
+func TestFieldCipherOpenFailures(t *testing.T) {
+	c := newTestFieldCipher(t, 1)
+	sealed := c.seal("rsv-1")
+	payload := strings.TrimPrefix(sealed, sealedPrefix)
+	flipped := []byte(payload)
+	flipped[len(flipped)-1] ^= 'A' ^ 'B'
+
+	tests := []struct {
+		name   string
+		cipher *fieldCipher
+		value  string
+	}{
+		{name: "other key", cipher: newTestFieldCipher(t, 2), value: sealed},
+		{name: "encryption disabled", cipher: nil, value: sealed},
+		{name: "tampered ciphertext", cipher: c, value: sealedPrefix + string(flipped)},
+		{name: "truncated", cipher: c, value: sealedPrefix + payload[:8]},
+		{name: "not base64", cipher: c, value: sealedPrefix + "!!!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if opened, err := tt.cipher.open(tt.value); err == nil {
+				t.Fatalf("open(%q) = %q, want error", tt.value, opened)
+			}
+			if kept := tt.cipher.openOrKeep(tt.value); kept != tt.value {
+				t.Fatalf("openOrKeep(%q) = %q, want the value kept", tt.value, kept)
+			}
+		})
+	}
+}
+
+func TestFieldCipherOpenPlain(t *testing.T) {
+	for _, c := range []*fieldCipher{nil, newTestFieldCipher(t, 1)} {
+		for _, value := range []string{"", "rsv-1", "erased:3c59dc04"} {
+			if opened, err := c.open(value); err != nil || opened != value {
+				t.Errorf("open(%q) = %q, %v; want it returned as is", value, opened, err)
+			}
+		}
+	}
+}
+
+func TestFieldCipherSealKey(t *testing.T) {
+	c := newTestFieldCipher(t, 1)
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "commit:rsv-1", want: "commit:" + c.seal("rsv-1")},
+		{key: "release:rsv-1", want: "release:" + c.seal("rsv-1")},
+		{key: "hold:rsv-1", want: "hold:rsv-1"},
+		{key: "rsv-1", want: "rsv-1"},
+	}
+	for _, tt := range tests {
+		if got := c.sealKey(tt.key); got != tt.want {
+			t.Errorf("sealKey(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestFieldCipherSealValues(t *testing.T) {
+	c := newTestFieldCipher(t, 1)
+	values := map[string]types.AttributeValue{
+		":reservation_id":      &types.AttributeValueMemberS{Value: "rsv-1"},
+		":seat_reservation_id": &types.AttributeValueMemberS{Value: "rsv-2"},
+		":hold":                &types.AttributeValueMemberS{Value: SeatHold},
+	}
+
+	sealed := c.sealValues(values)
+	tests := map[string]string{
+		":reservation_id":      c.seal("rsv-1"),
+		":seat_reservation_id": c.seal("rsv-2"),
+		":hold":                SeatHold,
+	}
+	for name, want := range tests {
+		if got := sealed[name].(*types.AttributeValueMemberS).Value; got != want {
+			t.Errorf("sealValues()[%s] = %q, want %q", name, got, want)
+		}
+	}
+	if got := values[":reservation_id"].(*types.AttributeValueMemberS).Value; got != "rsv-1" {
+		t.Errorf("sealValues changed its input to %q", got)
+	}
+}
+
+func TestFieldCipherSealItemRoundTrip(t *testing.T) {
+	c := newTestFieldCipher(t, 1)
+
+	tests := []struct {
+		name   string
+		item   interface{}
+		fields func(interface{}) []string
+	}{
+		{
+			name: "seat",
+			item: &SeatItem{EventID: "evt-1", SeatID: "A-1-1", ReservationID: "rsv-1"},
+			fields: func(item interface{}) []string {
+				return []string{item.(*SeatItem).ReservationID}
+			},
+		},
+		{
+			name: "hold",
+			item: &HoldItem{EventID: "evt-1", SeatID: "A-1-1", ReservationID: "rsv-1"},
+			fields: func(item interface{}) []string {
+				return []string{item.(*HoldItem).ReservationID}
+			},
+		},
+		{
+			name: "commit status",
+			item: &CommitStatusItem{ReservationID: "rsv-1", ConfirmedOrderID: "ord-1"},
+			fields: func(item interface{}) []string {
+				v := item.(*CommitStatusItem)
+				return []string{v.ReservationID, v.ConfirmedOrderID}
+			},
+		},
+		{
+			name: "idempotency record",
+			item: &IdempotencyItem{Key: "commit:rsv-1", Operation: "ord-1"},
+			fields: func(item interface{}) []string {
+				return []string{item.(*IdempotencyItem).Operation}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain := tt.fields(tt.item)
+			sealed := c.sealItem(tt.item)
+			for i, value := range tt.fields(sealed) {
+				if !strings.HasPrefix(value, sealedPrefix) {
+					t.Fatalf("field %d of the sealed item = %q, want it sealed", i, value)
+				}
+			}
+			if got := tt.fields(tt.item); !slices.Equal(got, plain) {
+				t.Fatalf("sealItem changed the item to %q", got)
+			}
+
+			if err := c.openItem(sealed); err != nil {
+				t.Fatalf("openItem: %v", err)
+			}
+			if got := tt.fields(sealed); !slices.Equal(got, plain) {
+				t.Fatalf("openItem(sealItem()) fields = %q, want %q", got, plain)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// healthProbeEventID is the key read by health probes; no event uses it
+const healthProbeEventID = "__health__"
+
+// Ping checks that the inventory table can be read, at the cost of one eventually
+// consistent read of a missing item
+func (r *DynamoDBRepository) Ping(ctx context.Context) error {
+	_, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key:       eventKey(healthProbeEventID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read inventory table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	streamstypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+)
+
+// ttlPrincipal is the stream user identity DynamoDB sets on TTL deletions
+const ttlPrincipal = "dynamodb.amazonaws.com"
+
+// HoldExpiryStream reads TTL deletions from the holds table stream.
+// The stream must be enabled with OLD_IMAGE (or NEW_AND_OLD_IMAGES) view type. Shards
+// are leased, so each is read by one instance, and checkpointed in the idempotency table,
+// so a restarted reader resumes where processing stopped.
+type HoldExpiryStream struct {
+	stream *tableStream
+}
+
+// NewHoldExpiryStream creates a reader for the holds table stream
+func (r *DynamoDBRepository) NewHoldExpiryStream() *HoldExpiryStream {
+	return &HoldExpiryStream{
+		stream: r.newLeasedTableStream(r.tableHolds),
+	}
+}
+
+// Poll reads one batch from every leased shard and hands the holds each shard's batch
+// deleted by TTL to process. A shard's checkpoint only advances past holds that process
+// handled; when it fails, the batch is read again on a later poll. Replays are harmless
+// since releases are conditional.
+func (s *HoldExpiryStream) Poll(ctx context.Context, process func(expired []*HoldItem) error) error {
+	return s.stream.pollShards(ctx, func(records []streamstypes.Record) error {
+		var expired []*HoldItem
+		for _, record := range records {
+			if hold := ttlExpiredHold(record); hold != nil {
+				expired = append(expired, hold)
+			}
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+		return process(expired)
+	})
+}
+
+// ReleaseExpiredHold returns the seat of an expired hold to sale if the hold's reservation
+// still holds it. released is false when the seat was committed, released or held again
+// meanwhile; any other failure is returned.
+func (r *DynamoDBRepository) ReleaseExpiredHold(ctx context.Context, hold *HoldItem) (released bool, err error) {
+	err = r.ReleaseHeldSeats(ctx, []*SeatItem{{
+		EventID:       hold.EventID,
+		SeatID:        hold.SeatID,
+		ReservationID: hold.ReservationID,
+	}})
+	if conditionFailedIn(err, 0, 1) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ttlExpiredHold returns the hold removed by a TTL deletion record, or nil for any other record
+func ttlExpiredHold(record streamstypes.Record) *HoldItem {
+	if record.EventName != streamstypes.OperationTypeRemove || record.Dynamodb == nil {
+		return nil
+	}
+	if record.UserIdentity == nil || aws.ToString(record.UserIdentity.PrincipalId) != ttlPrincipal {
+		return nil
+	}
+
+	image := record.Dynamodb.OldImage
+	hold := &HoldItem{
+		EventID:       streamString(image, "event_id"),
+		SeatID:        streamString(image, "seat_id"),
+		ReservationID: fields.openOrKeep(streamString(image, "reservation_id")),
+		ExpiresAt:     streamInt64(image, "expires_at"),
+	}
+	if hold.EventID == "" || hold.SeatID == "" || hold.ReservationID == "" {
+		return nil
+	}
+	return hold
+}
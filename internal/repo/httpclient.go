@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// newHTTPClient builds the HTTP client shared by the AWS SDK clients with the
+// configured connection pool and timeouts
+func newHTTPClient(cfg appconfig.AWSHTTPConfig) *awshttp.BuildableClient {
+	dialer := &net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: cfg.KeepAlive,
+	}
+	dial := dialer.DialContext
+	if cfg.SpreadAddresses {
+		dial = (&spreadDialer{dialer: dialer}).DialContext
+	}
+
+	return awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		tr.DialContext = dial
+		tr.MaxIdleConns = cfg.MaxIdleConns
+		tr.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		tr.MaxConnsPerHost = cfg.MaxConnsPerHost
+		tr.IdleConnTimeout = cfg.IdleConnTimeout
+		tr.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+	})
+}
+
+// spreadDialer starts each dial at the next of the addresses the host resolves to.
+// A plain dialer always tries the first address, so every pooled connection lands on
+// the same endpoint node and losing its availability zone drops the whole pool.
+type spreadDialer struct {
+	dialer *net.Dialer
+	next   atomic.Uint32
+}
+
+// DialContext dials addr, trying its resolved addresses in rotated order
+func (d *spreadDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return d.dialer.DialContext(ctx, network, addr)
+	}
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(ips) < 2 {
+		return d.dialer.DialContext(ctx, network, addr)
+	}
+
+	start := int(d.next.Add(1)) % len(ips)
+	var lastErr error
+	for i := range ips {
+		ip := ips[(start+i)%len(ips)]
+		conn, err := d.dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// PrewarmConnections opens up to n connections to DynamoDB ahead of traffic by making n
+// concurrent DescribeEndpoints calls, which read no table. Connections beyond
+// DDB_HTTP_MAX_IDLE_CONNS_PER_HOST are closed again once idle. Failures are logged.
+func (r *DynamoDBRepository) PrewarmConnections(ctx context.Context, n int) {
+	if n <= 0 {
+		return
+	}
+
+	start := time.Now()
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Int32
+	)
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.client.DescribeEndpoints(ctx, &dynamodb.DescribeEndpointsInput{}); err != nil {
+				if failed.Add(1) == 1 {
+					fmt.Printf("Warning: failed to prewarm DynamoDB connection: %v\n", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	fmt.Printf("Prewarmed %d of %d DynamoDB connections in %s\n", n-int(failed.Load()), n, time.Since(start).Round(time.Millisecond))
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ExpiredIdempotencyScan returns a scan job passing the keys of expired idempotency table
+// items to handle, a page at a time. Items carrying expires_at expire at that time; plain
+// idempotency records have none and expire once created before createdBefore. Intended
+// for tables without DynamoDB TTL.
+func (r *DynamoDBRepository) ExpiredIdempotencyScan(now, createdBefore time.Time, handle func(ctx context.Context, keys []string) error) ScanJob {
+	return ScanJob{
+		Name:                 "idempotency-cleanup",
+		Table:                r.tableIdempotency,
+		ProjectionExpression: "#key",
+		FilterExpression:     "expires_at < :now OR (attribute_not_exists(expires_at) AND created_at < :created_before)",
+		ExpressionAttributeNames: map[string]string{
+			"#key": "key",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now":            &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", now.Unix())},
+			":created_before": &types.AttributeValueMemberS{Value: createdBefore.UTC().Format(time.RFC3339Nano)},
+		},
+		Handle: func(ctx context.Context, items []map[string]types.AttributeValue) error {
+			keys := make([]string, 0, len(items))
+			for _, item := range items {
+				if key, ok := item["key"].(*types.AttributeValueMemberS); ok {
+					keys = append(keys, key.Value)
+				}
+			}
+			return handle(ctx, keys)
+		},
+	}
+}
+
+// DeleteIdempotency deletes up to 25 idempotency table items by key
+func (r *DynamoDBRepository) DeleteIdempotency(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if len(keys) > maxBatchWriteItems {
+		return fmt.Errorf("cannot delete more than %d idempotency items at once", maxBatchWriteItems)
+	}
+
+	writes := make([]types.WriteRequest, len(keys))
+	for i, key := range keys {
+		writes[i] = types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{
+				Key: map[string]types.AttributeValue{
+					"key": &types.AttributeValueMemberS{Value: key},
+				},
+			},
+		}
+	}
+
+	if err := batchWriteItems(ctx, r.client, r.tableIdempotency, writes); err != nil {
+		return fmt.Errorf("failed to delete idempotency items: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// LedgerEntry records one chunk of a bulk seat change in the ledger table. Entries are
+// written in the same transaction as the seats they describe, so the ledger lists
+// exactly the seats changed. EntryIDs sort by creation time within an event.
+type LedgerEntry struct {
+	EventID string `dynamodbav:"event_id"`
+	EntryID string `dynamodbav:"entry_id"`
+	// Operation groups the entries of one run, e.g. a close_id
+	Operation  string    `dynamodbav:"operation"`
+	Kind       string    `dynamodbav:"kind"`
+	SeatIDs    []string  `dynamodbav:"seat_ids"`
+	FromStatus string    `dynamodbav:"from_status"`
+	ToStatus   string    `dynamodbav:"to_status"`
+	Reason     string    `dynamodbav:"reason,omitempty"`
+	CreatedAt  time.Time `dynamodbav:"created_at"`
+}
+
+// TransactWriteSeatsWithLedger writes seats as TransactWriteSeats does, in the same
+// transaction as a new ledger entry. At most 99 seats fit with the entry.
+func (r *DynamoDBRepository) TransactWriteSeatsWithLedger(ctx context.Context, items []*SeatItem, conditionExpr string, exprValues map[string]types.AttributeValue, exprNames map[string]string, entry *LedgerEntry) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, items[0].EventID)
+	if err != nil {
+		return err
+	}
+	transactItems, err := seatUpdates(table, items, conditionExpr, exprValues, exprNames)
+	if err != nil {
+		return err
+	}
+
+	entryItem, err := marshalDynamoItem(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+	transactItems = append(transactItems, types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:           aws.String(r.tableLedger),
+			Item:                entryItem,
+			ConditionExpression: aws.String("attribute_not_exists(entry_id)"),
+		},
+	})
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to transact write seats: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(items))
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// localTableTimeout bounds waiting for a created table to become active
+const localTableTimeout = 30 * time.Second
+
+// localTable describes a table created for local development
+type localTable struct {
+	name string
+	// hash and sort key attributes; sort is empty for hash-only tables
+	hash, sort types.AttributeDefinition
+	// reservationIndex adds the seats table's reservation_id GSI
+	reservationIndex string
+	// ttl is the TTL attribute, if any
+	ttl string
+}
+
+// createLocalTables creates the tables the service uses that don't exist yet, with
+// streams on every table and the TTLs and index production has. Meant for LocalStack;
+// production tables are provisioned outside the service.
+func (r *DynamoDBRepository) createLocalTables(ctx context.Context) error {
+	stringAttr := func(name string) types.AttributeDefinition {
+		return types.AttributeDefinition{AttributeName: aws.String(name), AttributeType: types.ScalarAttributeTypeS}
+	}
+	tables := []localTable{
+		{name: r.tableInventory, hash: stringAttr("event_id")},
+		{name: r.tableSeats, hash: stringAttr("event_id"), sort: stringAttr("seat_id"), reservationIndex: r.reservationIndex},
+		{name: r.tableHolds, hash: stringAttr("event_id"), sort: stringAttr("seat_id"), ttl: "expires_at"},
+		{name: r.tableTemplates, hash: stringAttr("template_id"), sort: types.AttributeDefinition{AttributeName: aws.String("version"), AttributeType: types.ScalarAttributeTypeN}},
+		{name: r.tableLedger, hash: stringAttr("event_id"), sort: stringAttr("entry_id")},
+		{name: r.tableIdempotency, hash: stringAttr("key"), ttl: "expires_at"},
+	}
+
+	for _, table := range tables {
+		created, err := r.createLocalTable(ctx, table)
+		if err != nil {
+			return err
+		}
+		if created {
+			fmt.Printf("Created local table %s\n", table.name)
+		}
+	}
+	return nil
+}
+
+// createLocalTable creates one table unless it exists, reporting whether it was created
+func (r *DynamoDBRepository) createLocalTable(ctx context.Context, table localTable) (bool, error) {
+	input := &dynamodb.CreateTableInput{
+		TableName:            aws.String(table.name),
+		BillingMode:          types.BillingModePayPerRequest,
+		AttributeDefinitions: []types.AttributeDefinition{table.hash},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: table.hash.AttributeName, KeyType: types.KeyTypeHash},
+		},
+		StreamSpecification: &types.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: types.StreamViewTypeNewAndOldImages,
+		},
+	}
+	if table.sort.AttributeName != nil {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, table.sort)
+		input.KeySchema = append(input.KeySchema, types.KeySchemaElement{AttributeName: table.sort.AttributeName, KeyType: types.KeyTypeRange})
+	}
+	if table.reservationIndex != "" {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, types.AttributeDefinition{
+			AttributeName: aws.String("reservation_id"),
+			AttributeType: types.ScalarAttributeTypeS,
+		})
+		input.GlobalSecondaryIndexes = []types.GlobalSecondaryIndex{{
+			IndexName:  aws.String(table.reservationIndex),
+			KeySchema:  []types.KeySchemaElement{{AttributeName: aws.String("reservation_id"), KeyType: types.KeyTypeHash}},
+			Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+		}}
+	}
+
+	_, err := r.client.CreateTable(ctx, input)
+	if err != nil {
+		var inUse *types.ResourceInUseException
+		if errors.As(err, &inUse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create table %s: %w", table.name, err)
+	}
+
+	waiter := dynamodb.NewTableExistsWaiter(r.client)
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(table.name)}, localTableTimeout); err != nil {
+		return false, fmt.Errorf("failed waiting for table %s: %w", table.name, err)
+	}
+
+	if table.ttl != "" {
+		_, err = r.client.UpdateTimeToLive(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(table.name),
+			TimeToLiveSpecification: &types.TimeToLiveSpecification{
+				AttributeName: aws.String(table.ttl),
+				Enabled:       aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to enable TTL on table %s: %w", table.name, err)
+		}
+	}
+	return true, nil
+}
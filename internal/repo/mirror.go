@@ -0,0 +1,314 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// DynamoDB batch limits
+const (
+	maxBatchGetKeys    = 100
+	maxBatchWriteItems = 25
+	// maxBatchAttempts bounds retries of unprocessed batch keys and items
+	maxBatchAttempts = 3
+)
+
+const (
+	// mirrorTimeout bounds mirroring one write, which outlives the request that made it
+	mirrorTimeout = 2 * time.Second
+	// mirrorQueues is the number of mirror queues; the copies of a key always use the same
+	// one, so they are applied in the order of the writes
+	mirrorQueues = 8
+	// mirrorQueueSize is how many copies a queue holds before further ones are dropped
+	mirrorQueueSize = 1024
+)
+
+// Divergence kinds
+const (
+	divergenceWriteFailed = "write_failed"
+	divergenceMissing     = "missing"
+	divergenceMismatch    = "mismatch"
+	divergenceDropped     = "dropped"
+)
+
+// tableSet names one copy of the inventory, seats and holds tables
+type tableSet struct {
+	inventory string
+	seats     string
+	holds     string
+}
+
+// mirror copies items written to the authoritative tables into the mirror tables.
+// Rather than replaying each conditional write, it re-reads the written items with a
+// consistent read and puts them, or deletes them if they no longer exist, so the mirror
+// converges on the authoritative state whatever schema-specific expressions wrote it.
+// Copies run off the request path on queues ordered per key, so a slow mirror table
+// doesn't add latency to writes and an older copy never lands after a newer one.
+// A nil mirror is a no-op.
+type mirror struct {
+	client     *dynamodb.Client
+	source     tableSet
+	target     tableSet
+	sampleRate float64
+	metrics    *observability.Metrics
+
+	queues []chan mirrorCopy
+	// pending counts the copies queued or being applied, for flush
+	pending atomic.Int64
+}
+
+// mirrorCopy is a queued copy of the items of a table with keys
+type mirrorCopy struct {
+	ctx   context.Context
+	table string
+	keys  []map[string]types.AttributeValue
+}
+
+// newMirror creates a mirror from source to target tables and starts its queues
+func newMirror(client *dynamodb.Client, source, target tableSet, sampleRate float64, metrics *observability.Metrics) *mirror {
+	m := &mirror{
+		client:     client,
+		source:     source,
+		target:     target,
+		sampleRate: sampleRate,
+		metrics:    metrics,
+		queues:     make([]chan mirrorCopy, mirrorQueues),
+	}
+	for i := range m.queues {
+		m.queues[i] = make(chan mirrorCopy, mirrorQueueSize)
+		go m.run(m.queues[i])
+	}
+	return m
+}
+
+// Logical table names, used as metric labels
+const (
+	tableNameInventory = "inventory"
+	tableNameSeats     = "seats"
+	tableNameHolds     = "holds"
+)
+
+// name returns the physical name of a logical table
+func (s tableSet) name(table string) string {
+	switch table {
+	case tableNameInventory:
+		return s.inventory
+	case tableNameSeats:
+		return s.seats
+	default:
+		return s.holds
+	}
+}
+
+// copy queues making the target table's items for keys match the source table, and
+// returns without waiting for it. A copy that finds its queue full is dropped. Failures
+// are logged and counted as divergence; they never fail the primary write.
+func (m *mirror) copy(ctx context.Context, table string, keys []map[string]types.AttributeValue) {
+	if m == nil || len(keys) == 0 {
+		return
+	}
+	// The primary write has succeeded, so mirror it even if the request is canceled,
+	// and don't charge the mirror's capacity to the request's cost budget
+	ctx = WithoutCostMeter(context.WithoutCancel(ctx))
+
+	byQueue := make(map[int][]map[string]types.AttributeValue)
+	for _, key := range keys {
+		hash := fnv.New32a()
+		hash.Write([]byte(table + "\x00" + itemKeyString(key)))
+		queue := int(hash.Sum32() % uint32(len(m.queues)))
+		byQueue[queue] = append(byQueue[queue], key)
+	}
+	for queue, keys := range byQueue {
+		m.pending.Add(1)
+		select {
+		case m.queues[queue] <- mirrorCopy{ctx: ctx, table: table, keys: keys}:
+		default:
+			m.pending.Add(-1)
+			fmt.Printf("Warning: mirror queue full, dropped %d %s items\n", len(keys), table)
+			for range keys {
+				m.metrics.RecordMirrorDivergence(table, divergenceDropped)
+			}
+		}
+	}
+}
+
+// run applies the copies of a queue in order
+func (m *mirror) run(queue <-chan mirrorCopy) {
+	for c := range queue {
+		m.apply(c.ctx, c.table, c.keys)
+		m.pending.Add(-1)
+	}
+}
+
+// flush waits until no copy is queued or being applied, or ctx ends
+func (m *mirror) flush(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for m.pending.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d mirror copies still pending: %w", m.pending.Load(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// apply makes the target table's items for keys match the source table
+func (m *mirror) apply(ctx context.Context, table string, keys []map[string]types.AttributeValue) {
+	ctx, cancel := context.WithTimeout(ctx, mirrorTimeout)
+	defer cancel()
+
+	items, err := batchGetItems(ctx, m.client, m.source.name(table), keys, true)
+	if err != nil {
+		m.writeFailed(table, len(keys), err)
+		return
+	}
+
+	writes := make([]types.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		if item, ok := items[itemKeyString(key)]; ok {
+			writes = append(writes, types.WriteRequest{PutRequest: &types.PutRequest{Item: item}})
+		} else {
+			writes = append(writes, types.WriteRequest{DeleteRequest: &types.DeleteRequest{Key: key}})
+		}
+	}
+
+	for start := 0; start < len(writes); start += maxBatchWriteItems {
+		chunk := writes[start:min(start+maxBatchWriteItems, len(writes))]
+		if err := batchWriteItems(ctx, m.client, m.target.name(table), chunk); err != nil {
+			m.writeFailed(table, len(chunk), err)
+		}
+	}
+}
+
+// verify compares a sample of reads from the source table against the target table
+// and counts missing and mismatching items. Items written between the two reads can
+// show up as false mismatches, so the counts are an upper bound.
+func (m *mirror) verify(ctx context.Context, table string, keys []map[string]types.AttributeValue, sourceItems []map[string]types.AttributeValue) {
+	if m == nil || len(keys) == 0 || rand.Float64() >= m.sampleRate {
+		return
+	}
+	ctx = WithoutCostMeter(ctx)
+
+	targetItems, err := batchGetItems(ctx, m.client, m.target.name(table), keys, false)
+	if err != nil {
+		fmt.Printf("Warning: failed to verify %s mirror: %v\n", table, err)
+		return
+	}
+
+	for _, item := range sourceItems {
+		mirrored, ok := targetItems[itemKeyString(item)]
+		switch {
+		case !ok:
+			m.metrics.RecordMirrorDivergence(table, divergenceMissing)
+		case !reflect.DeepEqual(item, mirrored):
+			m.metrics.RecordMirrorDivergence(table, divergenceMismatch)
+		}
+	}
+}
+
+// writeFailed logs and counts a failed mirror write
+func (m *mirror) writeFailed(table string, items int, err error) {
+	fmt.Printf("Warning: failed to mirror %d %s items: %v\n", items, table, err)
+	for i := 0; i < items; i++ {
+		m.metrics.RecordMirrorDivergence(table, divergenceWriteFailed)
+	}
+}
+
+// batchGetItems reads items by key, retrying unprocessed keys, and returns them by itemKeyString
+func batchGetItems(ctx context.Context, client *dynamodb.Client, table string, keys []map[string]types.AttributeValue, consistent bool) (map[string]map[string]types.AttributeValue, error) {
+	items := make(map[string]map[string]types.AttributeValue, len(keys))
+
+	for start := 0; start < len(keys); start += maxBatchGetKeys {
+		pending := map[string]types.KeysAndAttributes{
+			table: {
+				Keys:           keys[start:min(start+maxBatchGetKeys, len(keys))],
+				ConsistentRead: aws.Bool(consistent),
+			},
+		}
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt == maxBatchAttempts {
+				return nil, fmt.Errorf("unprocessed keys remain after %d attempts", maxBatchAttempts)
+			}
+			result, err := client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: pending})
+			if err != nil {
+				return nil, fmt.Errorf("failed to batch get items: %w", err)
+			}
+			for _, item := range result.Responses[table] {
+				items[itemKeyString(item)] = item
+			}
+			pending = result.UnprocessedKeys
+		}
+	}
+
+	return items, nil
+}
+
+// batchWriteItems applies up to 25 write requests to a table, retrying unprocessed items
+func batchWriteItems(ctx context.Context, client *dynamodb.Client, table string, writes []types.WriteRequest) error {
+	pending := map[string][]types.WriteRequest{table: writes}
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt == maxBatchAttempts {
+			return fmt.Errorf("unprocessed items remain after %d attempts", maxBatchAttempts)
+		}
+		result, err := client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{RequestItems: pending})
+		if err != nil {
+			return fmt.Errorf("failed to batch write items: %w", err)
+		}
+		pending = result.UnprocessedItems
+	}
+	return nil
+}
+
+// eventKey returns the key of an event's inventory item
+func eventKey(eventID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"event_id": &types.AttributeValueMemberS{Value: eventID},
+	}
+}
+
+// seatKeys returns the keys of an event's seat or hold items
+func seatKeys(eventID string, seatIDs []string) []map[string]types.AttributeValue {
+	keys := make([]map[string]types.AttributeValue, len(seatIDs))
+	for i, seatID := range seatIDs {
+		keys[i] = map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+			"seat_id":  &types.AttributeValueMemberS{Value: seatID},
+		}
+	}
+	return keys
+}
+
+// seatItemKeys returns the keys of seat items
+func seatItemKeys(seats []*SeatItem) []map[string]types.AttributeValue {
+	keys := make([]map[string]types.AttributeValue, len(seats))
+	for i, seat := range seats {
+		keys[i] = seatKeys(seat.EventID, []string{seat.SeatID})[0]
+	}
+	return keys
+}
+
+// itemKeyString identifies an inventory, seat, hold or idempotency item by its key attributes
+func itemKeyString(item map[string]types.AttributeValue) string {
+	key := ""
+	for _, name := range []string{"event_id", "seat_id", "key"} {
+		if value, ok := item[name].(*types.AttributeValueMemberS); ok {
+			key += value.Value + "\x00"
+		}
+	}
+	return key
+}
@@ -0,0 +1,182 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// operationKeyPrefix namespaces long-running operation items in the idempotency table
+const operationKeyPrefix = "operation:"
+
+// Long-running operation states
+const (
+	OperationRunning   = "RUNNING"
+	OperationSucceeded = "SUCCEEDED"
+	OperationFailed    = "FAILED"
+	OperationCancelled = "CANCELLED"
+)
+
+// OperationItem tracks a long-running admin operation. It is stored in the idempotency
+// table under "operation:<operation_id>" and expires through the table's expires_at TTL.
+type OperationItem struct {
+	Key             string    `dynamodbav:"key"`
+	OperationID     string    `dynamodbav:"operation_id"`
+	Kind            string    `dynamodbav:"kind"`
+	EventID         string    `dynamodbav:"event_id"`
+	State           string    `dynamodbav:"state"`
+	CancelRequested bool      `dynamodbav:"cancel_requested"`
+	Total           int32     `dynamodbav:"total"`
+	Processed       int32     `dynamodbav:"processed"`
+	Failed          int32     `dynamodbav:"failed"`
+	Error           string    `dynamodbav:"error,omitempty"`
+	CreatedAt       time.Time `dynamodbav:"created_at"`
+	UpdatedAt       time.Time `dynamodbav:"updated_at"`
+	ExpiresAt       int64     `dynamodbav:"expires_at"`
+}
+
+// operationKey returns the key of an operation item
+func operationKey(operationID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: operationKeyPrefix + operationID},
+	}
+}
+
+// CreateOperation stores a new RUNNING operation
+func (r *DynamoDBRepository) CreateOperation(ctx context.Context, item *OperationItem) error {
+	item.Key = operationKeyPrefix + item.OperationID
+	item.State = OperationRunning
+
+	dynamoItem, err := marshalDynamoItem(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal operation item: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(r.tableIdempotency),
+		Item:                     dynamoItem,
+		ConditionExpression:      aws.String("attribute_not_exists(#key)"),
+		ExpressionAttributeNames: map[string]string{"#key": "key"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create operation: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateOperationProgress records the progress of a RUNNING operation and returns
+// whether cancellation has been requested
+func (r *DynamoDBRepository) UpdateOperationProgress(ctx context.Context, operationID string, total, processed, failed int32) (bool, error) {
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.tableIdempotency),
+		Key:                 operationKey(operationID),
+		UpdateExpression:    aws.String("SET #total = :total, #processed = :processed, #failed = :failed, updated_at = :updated_at"),
+		ConditionExpression: aws.String("#state = :running"),
+		ExpressionAttributeNames: map[string]string{
+			"#state":     "state",
+			"#total":     "total",
+			"#processed": "processed",
+			"#failed":    "failed",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":total":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", total)},
+			":processed":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", processed)},
+			":failed":     &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", failed)},
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339Nano)},
+			":running":    &types.AttributeValueMemberS{Value: OperationRunning},
+		},
+		ReturnValues: types.ReturnValueAllNew,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update operation progress: %w", err)
+	}
+
+	cancelRequested, _ := result.Attributes["cancel_requested"].(*types.AttributeValueMemberBOOL)
+	return cancelRequested != nil && cancelRequested.Value, nil
+}
+
+// FinishOperation moves a RUNNING operation to its final state
+func (r *DynamoDBRepository) FinishOperation(ctx context.Context, operationID, state string, total, processed, failed int32, errMsg string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableIdempotency),
+		Key:       operationKey(operationID),
+		UpdateExpression: aws.String("SET #state = :state, #total = :total, #processed = :processed, " +
+			"#failed = :failed, #error = :error, updated_at = :updated_at"),
+		ConditionExpression: aws.String("#state = :running"),
+		ExpressionAttributeNames: map[string]string{
+			"#state":     "state",
+			"#total":     "total",
+			"#processed": "processed",
+			"#failed":    "failed",
+			"#error":     "error",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":state":      &types.AttributeValueMemberS{Value: state},
+			":total":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", total)},
+			":processed":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", processed)},
+			":failed":     &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", failed)},
+			":error":      &types.AttributeValueMemberS{Value: errMsg},
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339Nano)},
+			":running":    &types.AttributeValueMemberS{Value: OperationRunning},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish operation: %w", err)
+	}
+
+	return nil
+}
+
+// RequestOperationCancel flags a RUNNING operation for cancellation; the instance
+// running it stops at its next progress update. Finished operations are unchanged.
+func (r *DynamoDBRepository) RequestOperationCancel(ctx context.Context, operationID string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableIdempotency),
+		Key:                      operationKey(operationID),
+		UpdateExpression:         aws.String("SET cancel_requested = :true"),
+		ConditionExpression:      aws.String("#state = :running"),
+		ExpressionAttributeNames: map[string]string{"#state": "state"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":true":    &types.AttributeValueMemberBOOL{Value: true},
+			":running": &types.AttributeValueMemberS{Value: OperationRunning},
+		},
+	})
+	if err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return nil
+		}
+		return fmt.Errorf("failed to cancel operation: %w", err)
+	}
+
+	return nil
+}
+
+// GetOperation retrieves a long-running operation; nil if unknown
+func (r *DynamoDBRepository) GetOperation(ctx context.Context, operationID string) (*OperationItem, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.tableIdempotency),
+		Key:            operationKey(operationID),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	item := &OperationItem{}
+	if err := unmarshalDynamoItem(result.Item, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal operation item: %w", err)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ProvisioningReason is the freeze reason of an event whose seats are being provisioned
+const ProvisioningReason = "provisioning"
+
+// CreateProvisioningInventory stores the inventory item of a new event, frozen with
+// ProvisioningReason until its seats are written. It fails with a conditional check error
+// if the event exists, unless it is still provisioning the same number of seats, so an
+// interrupted provisioning can be rerun.
+func (r *DynamoDBRepository) CreateProvisioningInventory(ctx context.Context, item *InventoryItem) error {
+	item.Frozen = true
+	item.FrozenReason = ProvisioningReason
+
+	dynamoItem, err := marshalDynamoItem(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal inventory item: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.tableInventory),
+		Item:      dynamoItem,
+		ConditionExpression: aws.String("attribute_not_exists(event_id) OR " +
+			"(frozen_reason = :provisioning AND total_seats = :total_seats)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":provisioning": &types.AttributeValueMemberS{Value: ProvisioningReason},
+			":total_seats":  &types.AttributeValueMemberN{Value: strconv.Itoa(int(item.TotalSeats))},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create inventory: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(item.EventID)})
+
+	return nil
+}
+
+// PutSeats writes seat items of one event unconditionally in batches of 25, overwriting
+// existing seats. It is only safe for events that can't be sold, such as one being provisioned.
+func (r *DynamoDBRepository) PutSeats(ctx context.Context, seats []*SeatItem) error {
+	if len(seats) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, seats[0].EventID)
+	if err != nil {
+		return err
+	}
+
+	writes := make([]types.WriteRequest, 0, len(seats))
+	for _, seat := range seats {
+		item, err := marshalDynamoItem(seat)
+		if err != nil {
+			return fmt.Errorf("failed to marshal seat item: %w", err)
+		}
+		writes = append(writes, types.WriteRequest{PutRequest: &types.PutRequest{Item: item}})
+	}
+
+	for start := 0; start < len(writes); start += maxBatchWriteItems {
+		chunk := writes[start:min(start+maxBatchWriteItems, len(writes))]
+		if err := batchWriteItems(ctx, r.client, table, chunk); err != nil {
+			return fmt.Errorf("failed to put seats: %w", err)
+		}
+	}
+	m.copy(ctx, tableNameSeats, seatItemKeys(seats))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// QuerySeatsByReservation returns the seats of every event that carry the reservation's ID,
+// i.e. the seats it holds or bought, from the seats table's reservation_id GSI. The index
+// is sparse (released seats drop their reservation_id) and eventually consistent. During a
+// blue/green seats migration it queries the original table, which every migrating event's
+// seats are mirrored to.
+func (r *DynamoDBRepository) QuerySeatsByReservation(ctx context.Context, reservationID string) ([]*SeatItem, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.tableSeats),
+		IndexName:              aws.String(r.reservationIndex),
+		KeyConditionExpression: aws.String("reservation_id = :reservation_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":reservation_id": &types.AttributeValueMemberS{Value: fields.seal(reservationID)},
+		},
+	}
+
+	var seats []*SeatItem
+	paginator := dynamodb.NewQueryPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query seats by reservation: %w", err)
+		}
+		for _, item := range page.Items {
+			seat := &SeatItem{}
+			if err := unmarshalDynamoItem(item, seat); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal seat item: %w", err)
+			}
+			seats = append(seats, seat)
+		}
+	}
+
+	return seats, nil
+}
@@ -0,0 +1,250 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// scanCheckpointTTL lets checkpoints of abandoned runs expire from the idempotency table
+const scanCheckpointTTL = 7 * 24 * time.Hour
+
+// ScanJob is a full-table iteration run by a TableScanner. Segments are scanned in
+// parallel, so Handle must be safe for concurrent use.
+type ScanJob struct {
+	// Name identifies the job's checkpoints; jobs sharing a name share progress
+	Name  string
+	Table string
+	// FilterExpression and ProjectionExpression are optional and share the expression
+	// attribute names and values
+	FilterExpression          string
+	ProjectionExpression      string
+	ExpressionAttributeNames  map[string]string
+	ExpressionAttributeValues map[string]types.AttributeValue
+	// Handle processes one page of items. An error stops the page's segment, which
+	// resumes from that page on the next run.
+	Handle func(ctx context.Context, items []map[string]types.AttributeValue) error
+}
+
+// TableScanner runs full-table scans for background jobs (sweeps, reconciliation,
+// archival) with segmented parallel scans, a read capacity budget shared by every job
+// on the scanner, and per-segment checkpoints so an interrupted run resumes where it
+// stopped instead of starting over.
+type TableScanner struct {
+	repo     *DynamoDBRepository
+	segments int
+	pageSize int32
+	budget   *readBudget
+}
+
+// NewTableScanner creates a table scanner configured by cfg.Scanner
+func (r *DynamoDBRepository) NewTableScanner(cfg *appconfig.Config) *TableScanner {
+	return &TableScanner{
+		repo:     r,
+		segments: max(cfg.Scanner.Segments, 1),
+		pageSize: cfg.Scanner.PageSize,
+		budget:   &readBudget{rate: cfg.Scanner.ReadUnitsPerSecond},
+	}
+}
+
+// Run scans the job's table once, resuming the segments of an interrupted run from their
+// checkpoints. Checkpoints are removed when every segment completed.
+func (s *TableScanner) Run(ctx context.Context, job ScanJob) error {
+	checkpoints := make([]*scanCheckpoint, s.segments)
+	pending := 0
+	for segment := range checkpoints {
+		checkpoint, err := s.loadCheckpoint(ctx, job.Name, segment)
+		if err != nil {
+			return err
+		}
+		checkpoints[segment] = checkpoint
+		if !checkpoint.done {
+			pending++
+		}
+	}
+	// A run that completed but wasn't cleaned up starts over
+	if pending == 0 {
+		for segment := range checkpoints {
+			checkpoints[segment] = &scanCheckpoint{}
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, s.segments)
+	for segment, checkpoint := range checkpoints {
+		if checkpoint.done {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[segment] = s.scanSegment(ctx, job, segment, checkpoint.startKey)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("scan %s: %w", job.Name, err)
+	}
+
+	for segment := range checkpoints {
+		if err := s.deleteCheckpoint(ctx, job.Name, segment); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// scanSegment scans one segment from startKey (nil for its beginning), checkpointing after every page
+func (s *TableScanner) scanSegment(ctx context.Context, job ScanJob, segment int, startKey map[string]types.AttributeValue) error {
+	input := &dynamodb.ScanInput{
+		TableName:                 aws.String(job.Table),
+		Segment:                   aws.Int32(int32(segment)),
+		TotalSegments:             aws.Int32(int32(s.segments)),
+		ExpressionAttributeNames:  job.ExpressionAttributeNames,
+		ExpressionAttributeValues: job.ExpressionAttributeValues,
+		ExclusiveStartKey:         startKey,
+		ReturnConsumedCapacity:    types.ReturnConsumedCapacityTotal,
+	}
+	if job.FilterExpression != "" {
+		input.FilterExpression = aws.String(job.FilterExpression)
+	}
+	if job.ProjectionExpression != "" {
+		input.ProjectionExpression = aws.String(job.ProjectionExpression)
+	}
+	if s.pageSize > 0 {
+		input.Limit = aws.Int32(s.pageSize)
+	}
+
+	for {
+		result, err := s.repo.client.Scan(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to scan segment %d: %w", segment, err)
+		}
+		if err := job.Handle(ctx, result.Items); err != nil {
+			return fmt.Errorf("segment %d: %w", segment, err)
+		}
+
+		done := result.LastEvaluatedKey == nil
+		if err := s.saveCheckpoint(ctx, job.Name, segment, result.LastEvaluatedKey, done); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+
+		if err := s.budget.wait(ctx, capacityUnits(result.ConsumedCapacity)); err != nil {
+			return err
+		}
+	}
+}
+
+// scanCheckpoint is the progress of one segment of a job
+type scanCheckpoint struct {
+	startKey map[string]types.AttributeValue
+	done     bool
+}
+
+// checkpointKey returns the idempotency table key of a segment's checkpoint. The segment
+// count is part of the key, so changing it starts the job over.
+func (s *TableScanner) checkpointKey(job string, segment int) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: fmt.Sprintf("scan:%s:%d/%d", job, segment, s.segments)},
+	}
+}
+
+// loadCheckpoint reads a segment's checkpoint; a segment without one starts at its beginning
+func (s *TableScanner) loadCheckpoint(ctx context.Context, job string, segment int) (*scanCheckpoint, error) {
+	result, err := s.repo.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(s.repo.tableIdempotency),
+		Key:            s.checkpointKey(job, segment),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scan checkpoint: %w", err)
+	}
+
+	checkpoint := &scanCheckpoint{}
+	if startKey, ok := result.Item["start_key"].(*types.AttributeValueMemberM); ok {
+		checkpoint.startKey = startKey.Value
+	}
+	if done, ok := result.Item["done"].(*types.AttributeValueMemberBOOL); ok {
+		checkpoint.done = done.Value
+	}
+	return checkpoint, nil
+}
+
+// saveCheckpoint records the key a segment continues from, or that it completed
+func (s *TableScanner) saveCheckpoint(ctx context.Context, job string, segment int, startKey map[string]types.AttributeValue, done bool) error {
+	item := s.checkpointKey(job, segment)
+	item["done"] = &types.AttributeValueMemberBOOL{Value: done}
+	item["expires_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Add(scanCheckpointTTL).Unix(), 10)}
+	if startKey != nil {
+		item["start_key"] = &types.AttributeValueMemberM{Value: startKey}
+	}
+
+	_, err := s.repo.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.repo.tableIdempotency),
+		Item:      item,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save scan checkpoint: %w", err)
+	}
+	return nil
+}
+
+// deleteCheckpoint removes a segment's checkpoint
+func (s *TableScanner) deleteCheckpoint(ctx context.Context, job string, segment int) error {
+	_, err := s.repo.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.repo.tableIdempotency),
+		Key:       s.checkpointKey(job, segment),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete scan checkpoint: %w", err)
+	}
+	return nil
+}
+
+// readBudget paces scans to a read capacity rate shared by every segment and job. Each
+// page is charged after it is read, delaying the next page until the budget caught up.
+type readBudget struct {
+	rate float64 // read units per second; 0 is unlimited
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait charges units to the budget and sleeps until it allows the next read
+func (b *readBudget) wait(ctx context.Context, units float64) error {
+	if b.rate <= 0 || units <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	if b.next.Before(now) {
+		b.next = now
+	}
+	b.next = b.next.Add(time.Duration(units / b.rate * float64(time.Second)))
+	delay := b.next.Sub(now)
+	b.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
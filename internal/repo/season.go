@@ -0,0 +1,237 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// allocationKeyPrefix namespaces season allocation items in the idempotency table
+const allocationKeyPrefix = "allocation:"
+
+// Season allocation states
+const (
+	SeasonAllocationActive   = "ACTIVE"
+	SeasonAllocationReleased = "RELEASED"
+)
+
+// SeasonAllocationItem is a season ticket's allocation of the same seats across the
+// performances of a series. It is stored in the idempotency table under
+// "allocation:<allocation_id>" without expires_at, so it lives until it is deleted.
+type SeasonAllocationItem struct {
+	Key          string   `dynamodbav:"key"`
+	AllocationID string   `dynamodbav:"allocation_id"`
+	EventID      string   `dynamodbav:"event_id"`
+	Performances []string `dynamodbav:"performances"`
+	SeatIDs      []string `dynamodbav:"seat_ids"`
+	// Materialized maps each sold performance to its order
+	Materialized map[string]string `dynamodbav:"materialized"`
+	Released     []string          `dynamodbav:"released"`
+	State        string            `dynamodbav:"state"`
+	CreatedAt    time.Time         `dynamodbav:"created_at"`
+	UpdatedAt    time.Time         `dynamodbav:"updated_at"`
+}
+
+// allocationKey returns the key of a season allocation item
+func allocationKey(allocationID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: allocationKeyPrefix + allocationID},
+	}
+}
+
+// seatWrite is a set of seats of one performance written in a transaction, to be mirrored afterwards
+type seatWrite struct {
+	mirror  *mirror
+	eventID string
+	seatIDs []string
+}
+
+// CreateSeasonAllocation allocates the seats of every performance and stores the
+// allocation in one transaction; it fails unless every seat is AVAILABLE
+func (r *DynamoDBRepository) CreateSeasonAllocation(ctx context.Context, item *SeasonAllocationItem) error {
+	item.Key = allocationKeyPrefix + item.AllocationID
+	item.State = SeasonAllocationActive
+	if item.Materialized == nil {
+		item.Materialized = map[string]string{}
+	}
+	if item.Released == nil {
+		item.Released = []string{}
+	}
+
+	record, err := marshalDynamoItem(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal season allocation: %w", err)
+	}
+
+	transactItems, writes, err := r.allocatedSeatUpdates(ctx, item, item.Performances,
+		"SET #status = :to, reservation_id = :allocation_id, updated_at = :updated_at",
+		"#status = :from", SeatAvailable, SeatAllocated)
+	if err != nil {
+		return err
+	}
+	transactItems = append(transactItems, types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:                aws.String(r.tableIdempotency),
+			Item:                     record,
+			ConditionExpression:      aws.String("attribute_not_exists(#key)"),
+			ExpressionAttributeNames: map[string]string{"#key": "key"},
+		},
+	})
+
+	return r.transactSeasonAllocation(ctx, transactItems, writes, "allocate")
+}
+
+// MaterializeSeasonPerformance sells the allocated seats of one performance under an
+// order and records it on the allocation, in one transaction
+func (r *DynamoDBRepository) MaterializeSeasonPerformance(ctx context.Context, item *SeasonAllocationItem, performanceID, orderID string) error {
+	transactItems, writes, err := r.allocatedSeatUpdates(ctx, item, []string{performanceID},
+		"SET #status = :to, updated_at = :updated_at",
+		"#status = :from AND reservation_id = :allocation_id", SeatAllocated, SeatSold)
+	if err != nil {
+		return err
+	}
+	transactItems = append(transactItems, types.TransactWriteItem{
+		Update: &types.Update{
+			TableName:           aws.String(r.tableIdempotency),
+			Key:                 allocationKey(item.AllocationID),
+			UpdateExpression:    aws.String("SET materialized.#performance = :order_id, updated_at = :updated_at"),
+			ConditionExpression: aws.String("#state = :active AND attribute_not_exists(materialized.#performance) AND NOT contains(released, :performance)"),
+			ExpressionAttributeNames: map[string]string{
+				"#state":       "state",
+				"#performance": performanceID,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":order_id":    &types.AttributeValueMemberS{Value: orderID},
+				":performance": &types.AttributeValueMemberS{Value: performanceID},
+				":active":      &types.AttributeValueMemberS{Value: SeasonAllocationActive},
+				":updated_at":  &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339Nano)},
+			},
+		},
+	})
+
+	return r.transactSeasonAllocation(ctx, transactItems, writes, "materialize")
+}
+
+// ReleaseSeasonPerformances returns the allocated seats of the given performances to
+// sale and records them as released, in one transaction. With end, the allocation
+// becomes RELEASED.
+func (r *DynamoDBRepository) ReleaseSeasonPerformances(ctx context.Context, item *SeasonAllocationItem, performanceIDs []string, end bool) error {
+	transactItems, writes, err := r.allocatedSeatUpdates(ctx, item, performanceIDs,
+		"SET #status = :to, updated_at = :updated_at REMOVE reservation_id",
+		"#status = :from AND reservation_id = :allocation_id", SeatAllocated, SeatAvailable)
+	if err != nil {
+		return err
+	}
+
+	updateExpr := "SET released = list_append(released, :performances), updated_at = :updated_at"
+	conditionExpr := "#state = :active"
+	names := map[string]string{"#state": "state"}
+	values := map[string]types.AttributeValue{
+		":active":     &types.AttributeValueMemberS{Value: SeasonAllocationActive},
+		":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339Nano)},
+	}
+	performances := make([]types.AttributeValue, len(performanceIDs))
+	for i, performanceID := range performanceIDs {
+		performances[i] = &types.AttributeValueMemberS{Value: performanceID}
+		names[fmt.Sprintf("#p%d", i)] = performanceID
+		values[fmt.Sprintf(":p%d", i)] = performances[i]
+		conditionExpr += fmt.Sprintf(" AND attribute_not_exists(materialized.#p%d) AND NOT contains(released, :p%d)", i, i)
+	}
+	values[":performances"] = &types.AttributeValueMemberL{Value: performances}
+	if end {
+		updateExpr += ", #state = :released"
+		values[":released"] = &types.AttributeValueMemberS{Value: SeasonAllocationReleased}
+	}
+
+	transactItems = append(transactItems, types.TransactWriteItem{
+		Update: &types.Update{
+			TableName:                 aws.String(r.tableIdempotency),
+			Key:                       allocationKey(item.AllocationID),
+			UpdateExpression:          aws.String(updateExpr),
+			ConditionExpression:       aws.String(conditionExpr),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		},
+	})
+
+	return r.transactSeasonAllocation(ctx, transactItems, writes, "release")
+}
+
+// GetSeasonAllocation retrieves a season allocation; nil if unknown
+func (r *DynamoDBRepository) GetSeasonAllocation(ctx context.Context, allocationID string) (*SeasonAllocationItem, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.tableIdempotency),
+		Key:            allocationKey(allocationID),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season allocation: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	item := &SeasonAllocationItem{}
+	if err := unmarshalDynamoItem(result.Item, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal season allocation: %w", err)
+	}
+
+	return item, nil
+}
+
+// allocatedSeatUpdates builds the updates moving the allocation's seats of the given
+// performances from one status to another
+func (r *DynamoDBRepository) allocatedSeatUpdates(ctx context.Context, item *SeasonAllocationItem, performanceIDs []string, updateExpr, conditionExpr, from, to string) ([]types.TransactWriteItem, []seatWrite, error) {
+	now := time.Now().Format(time.RFC3339)
+	transactItems := make([]types.TransactWriteItem, 0, len(performanceIDs)*len(item.SeatIDs)+1)
+	writes := make([]seatWrite, 0, len(performanceIDs))
+
+	for _, performanceID := range performanceIDs {
+		eventID := PerformanceKey(item.EventID, performanceID)
+		table, m, err := r.seatsTable(ctx, eventID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, seatID := range item.SeatIDs {
+			transactItems = append(transactItems, types.TransactWriteItem{
+				Update: &types.Update{
+					TableName:                aws.String(table),
+					Key:                      seatKeys(eventID, []string{seatID})[0],
+					UpdateExpression:         aws.String(updateExpr),
+					ConditionExpression:      aws.String(conditionExpr),
+					ExpressionAttributeNames: map[string]string{"#status": "status"},
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":from":          &types.AttributeValueMemberS{Value: from},
+						":to":            &types.AttributeValueMemberS{Value: to},
+						":allocation_id": &types.AttributeValueMemberS{Value: fields.seal(item.AllocationID)},
+						":updated_at":    &types.AttributeValueMemberS{Value: now},
+					},
+				},
+			})
+		}
+		writes = append(writes, seatWrite{mirror: m, eventID: eventID, seatIDs: item.SeatIDs})
+	}
+
+	return transactItems, writes, nil
+}
+
+// transactSeasonAllocation writes a season allocation transaction and mirrors its seats
+func (r *DynamoDBRepository) transactSeasonAllocation(ctx context.Context, transactItems []types.TransactWriteItem, writes []seatWrite, action string) error {
+	_, err := r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s season allocation: %w", action, err)
+	}
+
+	for _, write := range writes {
+		write.mirror.copy(ctx, tableNameSeats, seatKeys(write.eventID, write.seatIDs))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+)
+
+// BumpSeatMapVersion moves an event's seat map version from expected to the next
+// version, creating the inventory item if needed. Events start at version 0. The
+// version is bumped before the mutation it guards, so a failed mutation still
+// invalidates cached seat maps, which is harmless.
+func (r *DynamoDBRepository) BumpSeatMapVersion(ctx context.Context, eventID string, expected int32) (int32, error) {
+	conditionExpr := "seat_map_version = :expected"
+	if expected == 0 {
+		conditionExpr = "attribute_not_exists(seat_map_version) OR " + conditionExpr
+	}
+
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.tableInventory),
+		Key:                 eventKey(eventID),
+		UpdateExpression:    aws.String("SET seat_map_version = :next, updated_at = :updated_at"),
+		ConditionExpression: aws.String(conditionExpr),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":expected":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expected)},
+			":next":       &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", expected+1)},
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return 0, apperrors.New(apperrors.ErrVersionConflict, "seat map version conflict for event %s: expected version %d is stale", eventID, expected)
+		}
+		return 0, fmt.Errorf("failed to bump seat map version: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return expected + 1, nil
+}
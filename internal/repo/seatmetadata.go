@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// SetSeatMetadata atomically replaces the metadata and note of existing seats, leaving
+// their status untouched. Empty metadata or an empty note removes the attribute.
+// The transaction is canceled if any seat doesn't exist.
+func (r *DynamoDBRepository) SetSeatMetadata(ctx context.Context, eventID string, seatIDs []string, metadata map[string]string, note string) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+
+	table, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	var set, remove []string
+	values := map[string]types.AttributeValue{}
+	if len(metadata) > 0 {
+		metadataValue, err := attributevalue.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal seat metadata: %w", err)
+		}
+		set = append(set, "metadata = :metadata")
+		values[":metadata"] = metadataValue
+	} else {
+		remove = append(remove, "metadata")
+	}
+	if note != "" {
+		set = append(set, "note = :note")
+		values[":note"] = &types.AttributeValueMemberS{Value: note}
+	} else {
+		remove = append(remove, "note")
+	}
+
+	updateExpr := ""
+	if len(set) > 0 {
+		updateExpr = "SET " + strings.Join(set, ", ")
+	}
+	if len(remove) > 0 {
+		if updateExpr != "" {
+			updateExpr += " "
+		}
+		updateExpr += "REMOVE " + strings.Join(remove, ", ")
+	}
+
+	transactItems := make([]types.TransactWriteItem, 0, len(seatIDs))
+	for _, key := range seatKeys(eventID, seatIDs) {
+		update := &types.Update{
+			TableName:           aws.String(table),
+			Key:                 key,
+			UpdateExpression:    aws.String(updateExpr),
+			ConditionExpression: aws.String("attribute_exists(seat_id)"),
+		}
+		if len(values) > 0 {
+			update.ExpressionAttributeValues = values
+		}
+		transactItems = append(transactItems, types.TransactWriteItem{Update: update})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set seat metadata: %w", err)
+	}
+	m.copy(ctx, tableNameSeats, seatKeys(eventID, seatIDs))
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Seats table migration states of an event. Events without a state use the original seats table only.
+const (
+	// SeatsMigrationDualWrite keeps the original table authoritative and mirrors writes to the new table
+	SeatsMigrationDualWrite = "DUAL_WRITE"
+	// SeatsMigrationVerified is DUAL_WRITE after a verification pass found no divergence
+	SeatsMigrationVerified = "VERIFIED"
+	// SeatsMigrationCutover makes the new table authoritative and mirrors writes to the original table
+	SeatsMigrationCutover = "CUTOVER"
+)
+
+// seatsMigration routes each event's seat reads and writes to the original or new seats
+// table according to the event's migration state, which is cached for stateTTL
+type seatsMigration struct {
+	stateTTL time.Duration
+	// toNew mirrors seats from the original to the new table, toOld the other way round
+	toNew *mirror
+	toOld *mirror
+
+	mu     sync.Mutex
+	states map[string]cachedMigrationState
+}
+
+// cachedMigrationState is an event's migration state as last read from the inventory table
+type cachedMigrationState struct {
+	state   string
+	expires time.Time
+}
+
+// SeatsMigrationReport is the result of comparing an event's seats across both seats tables
+type SeatsMigrationReport struct {
+	State       string
+	SourceSeats int
+	TargetSeats int
+	// Missing seats are absent from the mirror, Extra seats exist only in the mirror
+	Missing    int
+	Mismatched int
+	Extra      int
+	Repaired   int
+}
+
+// seatsTable returns the authoritative seats table of an event and the mirror its
+// seat writes are copied to, which is nil when writes aren't mirrored
+func (r *DynamoDBRepository) seatsTable(ctx context.Context, eventID string) (string, *mirror, error) {
+	if r.seatsMigration == nil {
+		return r.tableSeats, r.mirror, nil
+	}
+
+	state, err := r.seatsMigrationState(ctx, eventID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch state {
+	case SeatsMigrationDualWrite, SeatsMigrationVerified:
+		return r.seatsMigration.toNew.source.seats, r.seatsMigration.toNew, nil
+	case SeatsMigrationCutover:
+		return r.seatsMigration.toOld.source.seats, r.seatsMigration.toOld, nil
+	default:
+		return r.tableSeats, nil, nil
+	}
+}
+
+// seatsMigrationState returns an event's migration state, reading it at most once per stateTTL
+func (r *DynamoDBRepository) seatsMigrationState(ctx context.Context, eventID string) (string, error) {
+	m := r.seatsMigration
+	m.mu.Lock()
+	cached, ok := m.states[eventID]
+	m.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.state, nil
+	}
+
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:            aws.String(r.tableInventory),
+		Key:                  eventKey(eventID),
+		ProjectionExpression: aws.String("seats_migration"),
+		ConsistentRead:       aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get seats migration state: %w", err)
+	}
+
+	state := ""
+	if value, ok := result.Item["seats_migration"].(*types.AttributeValueMemberS); ok {
+		state = value.Value
+	}
+
+	m.mu.Lock()
+	m.states[eventID] = cachedMigrationState{state: state, expires: time.Now().Add(m.stateTTL)}
+	m.mu.Unlock()
+
+	return state, nil
+}
+
+// SeatsMigrationStateTTL returns how long instances may keep routing an event by its previous state
+func (r *DynamoDBRepository) SeatsMigrationStateTTL() time.Duration {
+	if r.seatsMigration == nil {
+		return 0
+	}
+	return r.seatsMigration.stateTTL
+}
+
+// SetSeatsMigration moves an event to a seats migration state, or back to the original
+// table only when state is empty. Changing which table is authoritative, to or from
+// CUTOVER, requires the event to be frozen; CUTOVER also requires a VERIFIED event.
+func (r *DynamoDBRepository) SetSeatsMigration(ctx context.Context, eventID, state string) error {
+	if r.seatsMigration == nil {
+		return errors.New("invalid request: seats table migration is not configured")
+	}
+
+	input := &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		ExpressionAttributeNames: map[string]string{"#migration": "seats_migration"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+			":cutover":    &types.AttributeValueMemberS{Value: SeatsMigrationCutover},
+			":frozen":     &types.AttributeValueMemberBOOL{Value: true},
+		},
+	}
+
+	switch state {
+	case "":
+		input.UpdateExpression = aws.String("SET updated_at = :updated_at REMOVE #migration")
+		input.ConditionExpression = aws.String("attribute_not_exists(#migration) OR #migration <> :cutover OR frozen = :frozen")
+	case SeatsMigrationDualWrite:
+		input.UpdateExpression = aws.String("SET #migration = :state, updated_at = :updated_at")
+		input.ConditionExpression = aws.String("attribute_not_exists(#migration) OR #migration <> :cutover OR frozen = :frozen")
+		input.ExpressionAttributeValues[":state"] = &types.AttributeValueMemberS{Value: state}
+	case SeatsMigrationCutover:
+		input.UpdateExpression = aws.String("SET #migration = :cutover, updated_at = :updated_at")
+		input.ConditionExpression = aws.String("(#migration = :verified OR #migration = :cutover) AND frozen = :frozen")
+		input.ExpressionAttributeValues[":verified"] = &types.AttributeValueMemberS{Value: SeatsMigrationVerified}
+	default:
+		return fmt.Errorf("invalid request: unknown seats migration state %q", state)
+	}
+
+	// Copies this instance queued in the old direction must land before the other table
+	// becomes authoritative
+	if err := r.seatsMigration.toNew.flush(ctx); err != nil {
+		return err
+	}
+	if err := r.seatsMigration.toOld.flush(ctx); err != nil {
+		return err
+	}
+
+	if _, err := r.client.UpdateItem(ctx, input); err != nil {
+		var conditionFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return fmt.Errorf("precondition failed for event %s: moving seats to %q requires a frozen event, and CUTOVER a VERIFIED one", eventID, state)
+		}
+		return fmt.Errorf("failed to set seats migration: %w", err)
+	}
+	r.forgetSeatsMigrationState(eventID)
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// forgetSeatsMigrationState drops an event's cached migration state
+func (r *DynamoDBRepository) forgetSeatsMigrationState(eventID string) {
+	r.seatsMigration.mu.Lock()
+	delete(r.seatsMigration.states, eventID)
+	r.seatsMigration.mu.Unlock()
+}
+
+// VerifySeatsMigration compares every seat of an event in the authoritative table with
+// the mirror. With repair, divergent seats are copied again from the authoritative table.
+// A DUAL_WRITE event whose seats all match is marked VERIFIED.
+func (r *DynamoDBRepository) VerifySeatsMigration(ctx context.Context, eventID string, repair bool) (*SeatsMigrationReport, error) {
+	if r.seatsMigration == nil {
+		return nil, errors.New("invalid request: seats table migration is not configured")
+	}
+
+	r.forgetSeatsMigrationState(eventID)
+	state, err := r.seatsMigrationState(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	_, m, err := r.seatsTable(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("precondition failed for event %s: seats are not being migrated", eventID)
+	}
+
+	source, err := r.queryEventItems(ctx, m.source.seats, eventID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := r.queryEventItems(ctx, m.target.seats, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SeatsMigrationReport{
+		State:       state,
+		SourceSeats: len(source),
+		TargetSeats: len(target),
+	}
+	var divergent []map[string]types.AttributeValue
+	for key, item := range source {
+		mirrored, ok := target[key]
+		switch {
+		case !ok:
+			report.Missing++
+		case !reflect.DeepEqual(item, mirrored):
+			report.Mismatched++
+		default:
+			continue
+		}
+		divergent = append(divergent, seatKeys(eventID, []string{seatIDOf(item)})[0])
+	}
+	for key, item := range target {
+		if _, ok := source[key]; !ok {
+			report.Extra++
+			divergent = append(divergent, seatKeys(eventID, []string{seatIDOf(item)})[0])
+		}
+	}
+
+	if repair {
+		for start := 0; start < len(divergent); start += maxBatchGetKeys {
+			m.apply(ctx, tableNameSeats, divergent[start:min(start+maxBatchGetKeys, len(divergent))])
+		}
+		report.Repaired = len(divergent)
+		return report, nil
+	}
+
+	if len(divergent) == 0 && state == SeatsMigrationDualWrite {
+		if err := r.markSeatsMigrationVerified(ctx, eventID); err != nil {
+			return nil, err
+		}
+		report.State = SeatsMigrationVerified
+	}
+
+	return report, nil
+}
+
+// markSeatsMigrationVerified moves a DUAL_WRITE event to VERIFIED
+func (r *DynamoDBRepository) markSeatsMigrationVerified(ctx context.Context, eventID string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		UpdateExpression:         aws.String("SET #migration = :verified, updated_at = :updated_at"),
+		ConditionExpression:      aws.String("#migration = :dual_write"),
+		ExpressionAttributeNames: map[string]string{"#migration": "seats_migration"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":verified":   &types.AttributeValueMemberS{Value: SeatsMigrationVerified},
+			":dual_write": &types.AttributeValueMemberS{Value: SeatsMigrationDualWrite},
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark seats migration verified: %w", err)
+	}
+	r.forgetSeatsMigrationState(eventID)
+
+	return nil
+}
+
+// queryEventItems returns all items of an event in a table by itemKeyString
+func (r *DynamoDBRepository) queryEventItems(ctx context.Context, table, eventID string) (map[string]map[string]types.AttributeValue, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(table),
+		KeyConditionExpression: aws.String("event_id = :event_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	items := make(map[string]map[string]types.AttributeValue)
+	paginator := dynamodb.NewQueryPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", table, err)
+		}
+		for _, item := range page.Items {
+			items[itemKeyString(item)] = item
+		}
+	}
+
+	return items, nil
+}
+
+// seatIDOf returns the seat ID of a raw seat item
+func seatIDOf(item map[string]types.AttributeValue) string {
+	if value, ok := item["seat_id"].(*types.AttributeValueMemberS); ok {
+		return value.Value
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	streamstypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+)
+
+// SeatChangeStream reads seat changes from the seats table stream.
+// The stream must be enabled with NEW_IMAGE (or NEW_AND_OLD_IMAGES) view type.
+type SeatChangeStream struct {
+	repo   *DynamoDBRepository
+	stream *tableStream
+}
+
+// NewSeatChangeStream creates a reader for the seats table stream
+func (r *DynamoDBRepository) NewSeatChangeStream() *SeatChangeStream {
+	return &SeatChangeStream{
+		repo:   r,
+		stream: r.newTableStream(r.tableSeats),
+	}
+}
+
+// Covers reports whether an event's seats are in the table the stream reads, which
+// isn't the case for events moved to another table by a seats table migration
+func (s *SeatChangeStream) Covers(ctx context.Context, eventID string) (bool, error) {
+	table, _, err := s.repo.seatsTable(ctx, eventID)
+	if err != nil {
+		return false, err
+	}
+	return table == s.stream.table, nil
+}
+
+// Start positions the reader at the latest record of every shard, so a snapshot read
+// afterwards misses no change made after it
+func (s *SeatChangeStream) Start(ctx context.Context) error {
+	return s.stream.refreshShards(ctx)
+}
+
+// Poll reads one batch from every open shard and returns the changed seats as written.
+// Deleted seats have an empty status.
+func (s *SeatChangeStream) Poll(ctx context.Context) ([]*SeatItem, error) {
+	records, err := s.stream.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*SeatItem, 0, len(records))
+	for _, record := range records {
+		if record.Dynamodb == nil {
+			continue
+		}
+
+		image := record.Dynamodb.NewImage
+		if record.EventName == streamstypes.OperationTypeRemove {
+			image = record.Dynamodb.Keys
+		}
+		seat := &SeatItem{
+			EventID:       streamString(image, "event_id"),
+			SeatID:        streamString(image, "seat_id"),
+			Status:        streamString(image, "status"),
+			ReservationID: fields.openOrKeep(streamString(image, "reservation_id")),
+		}
+		if seat.EventID == "" || seat.SeatID == "" {
+			continue
+		}
+		if record.EventName == streamstypes.OperationTypeRemove {
+			seat.Status = ""
+		}
+		// Writes record updated_at with or without fractional seconds
+		seat.UpdatedAt, _ = time.Parse(time.RFC3339Nano, streamString(image, "updated_at"))
+		if record.EventName == streamstypes.OperationTypeRemove && record.Dynamodb.ApproximateCreationDateTime != nil {
+			seat.UpdatedAt = *record.Dynamodb.ApproximateCreationDateTime
+		}
+
+		changes = append(changes, seat)
+	}
+
+	return changes, nil
+}
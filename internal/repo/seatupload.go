@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// seatUploadKeyPrefix namespaces seat upload cursors in the idempotency table
+const seatUploadKeyPrefix = "seat-upload:"
+
+// seatUpsertChunkSize is the number of seats upserted per transaction
+const seatUpsertChunkSize = 25
+
+// SeatUploadItem is the cursor of a paged bulk seat upsert. It is stored in the
+// idempotency table under "seat-upload:<upload_id>" and expires through its TTL.
+type SeatUploadItem struct {
+	Key      string `dynamodbav:"key"`
+	UploadID string `dynamodbav:"upload_id"`
+	EventID  string `dynamodbav:"event_id"`
+	// NextPage is the index of the next page to apply
+	NextPage  int32     `dynamodbav:"next_page"`
+	Upserted  int64     `dynamodbav:"upserted"`
+	Skipped   int64     `dynamodbav:"skipped"`
+	UpdatedAt time.Time `dynamodbav:"updated_at"`
+	ExpiresAt int64     `dynamodbav:"expires_at"`
+	// Manifest is declared by the first page. Digest is the marshaled SHA-256 state
+	// over the rows of the pages applied so far, so verification resumes across pages.
+	Manifest *SeatUploadManifest `dynamodbav:"manifest,omitempty"`
+	Digest   []byte              `dynamodbav:"digest,omitempty"`
+	// VerifiedAt is set when the last page matched the manifest
+	VerifiedAt *time.Time `dynamodbav:"verified_at,omitempty"`
+}
+
+// SeatUploadManifest describes a whole upload: its row and page counts and the
+// hex SHA-256 of all its rows in upload order
+type SeatUploadManifest struct {
+	SHA256 string `dynamodbav:"sha256"`
+	Rows   int64  `dynamodbav:"rows"`
+	Pages  int32  `dynamodbav:"pages"`
+}
+
+// SeatManifestRecord is the verification record of the last bulk seat upload that
+// matched its manifest, kept on the event's inventory item
+type SeatManifestRecord struct {
+	UploadID   string    `dynamodbav:"upload_id"`
+	SHA256     string    `dynamodbav:"sha256"`
+	Rows       int64     `dynamodbav:"rows"`
+	Pages      int32     `dynamodbav:"pages"`
+	VerifiedAt time.Time `dynamodbav:"verified_at"`
+}
+
+// seatUploadKey returns the key of a seat upload item
+func seatUploadKey(uploadID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: seatUploadKeyPrefix + uploadID},
+	}
+}
+
+// GetSeatUpload retrieves the cursor of a seat upload; nil if no page was applied yet
+func (r *DynamoDBRepository) GetSeatUpload(ctx context.Context, uploadID string) (*SeatUploadItem, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.tableIdempotency),
+		Key:            seatUploadKey(uploadID),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seat upload: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	item := &SeatUploadItem{}
+	if err := unmarshalDynamoItem(result.Item, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal seat upload item: %w", err)
+	}
+
+	return item, nil
+}
+
+// AdvanceSeatUpload acknowledges page of an upload for an event, moving its cursor to
+// the next page and storing the running digest. The manifest is stored with the first
+// page; verified marks the upload as matching it. It fails with a conditional check
+// error if the cursor has moved on or the upload belongs to another event.
+func (r *DynamoDBRepository) AdvanceSeatUpload(ctx context.Context, uploadID, eventID string, page int32, upserted, skipped int, manifest *SeatUploadManifest, digest []byte, verified bool, ttl time.Duration) (*SeatUploadItem, error) {
+	conditionExpr := "next_page = :page AND event_id = :event_id"
+	if page == 0 {
+		conditionExpr = "attribute_not_exists(next_page) OR (" + conditionExpr + ")"
+	}
+
+	manifestValue, err := attributevalue.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal seat upload manifest: %w", err)
+	}
+
+	updateExpr := "SET upload_id = :upload_id, event_id = :event_id, next_page = :next_page, " +
+		"upserted = if_not_exists(upserted, :zero) + :upserted, skipped = if_not_exists(skipped, :zero) + :skipped, " +
+		"manifest = if_not_exists(manifest, :manifest), digest = :digest, updated_at = :updated_at, expires_at = :expires_at"
+	if verified {
+		updateExpr += ", verified_at = :updated_at"
+	}
+
+	now := time.Now()
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(r.tableIdempotency),
+		Key:                 seatUploadKey(uploadID),
+		UpdateExpression:    aws.String(updateExpr),
+		ConditionExpression: aws.String(conditionExpr),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":manifest":   manifestValue,
+			":digest":     &types.AttributeValueMemberB{Value: digest},
+			":upload_id":  &types.AttributeValueMemberS{Value: uploadID},
+			":event_id":   &types.AttributeValueMemberS{Value: eventID},
+			":page":       &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", page)},
+			":next_page":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", page+1)},
+			":upserted":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", upserted)},
+			":skipped":    &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", skipped)},
+			":zero":       &types.AttributeValueMemberN{Value: "0"},
+			":updated_at": &types.AttributeValueMemberS{Value: now.Format(time.RFC3339Nano)},
+			":expires_at": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", now.Add(ttl).Unix())},
+		},
+		ReturnValues: types.ReturnValueAllNew,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to advance seat upload: %w", err)
+	}
+
+	item := &SeatUploadItem{}
+	if err := unmarshalDynamoItem(result.Attributes, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal seat upload item: %w", err)
+	}
+
+	return item, nil
+}
+
+// UpsertSeats creates seats or overwrites the status of seats that are AVAILABLE or
+// BLOCKED, in transactions of seatUpsertChunkSize. Seats that are held, sold or
+// reserved are left unchanged and returned as skipped.
+func (r *DynamoDBRepository) UpsertSeats(ctx context.Context, seats []*SeatItem) ([]string, error) {
+	conditionExpr := "attribute_not_exists(seat_id) OR " +
+		"((#status = :available OR #status = :blocked) AND attribute_not_exists(reservation_id))"
+	exprValues := map[string]types.AttributeValue{
+		":available": &types.AttributeValueMemberS{Value: SeatAvailable},
+		":blocked":   &types.AttributeValueMemberS{Value: SeatBlocked},
+	}
+	exprNames := map[string]string{
+		"#status": "status",
+	}
+
+	var skipped []string
+	for start := 0; start < len(seats); start += seatUpsertChunkSize {
+		chunk := seats[start:min(start+seatUpsertChunkSize, len(seats))]
+		err := r.TransactWriteSeats(ctx, chunk, conditionExpr, exprValues, exprNames)
+		var txCanceled *types.TransactionCanceledException
+		if err == nil {
+			continue
+		}
+		if !errors.As(err, &txCanceled) {
+			return nil, err
+		}
+
+		// Isolate the seats that can't be overwritten and apply the rest one by one
+		for _, seat := range chunk {
+			err := r.TransactWriteSeats(ctx, []*SeatItem{seat}, conditionExpr, exprValues, exprNames)
+			if errors.As(err, &txCanceled) {
+				skipped = append(skipped, seat.SeatID)
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return skipped, nil
+}
+
+// RecordSeatManifest stores the verification record of an upload on the event's inventory
+// item, replacing the record of any earlier upload
+func (r *DynamoDBRepository) RecordSeatManifest(ctx context.Context, eventID string, record *SeatManifestRecord) error {
+	recordValue, err := attributevalue.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal seat manifest record: %w", err)
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(r.tableInventory),
+		Key:              eventKey(eventID),
+		UpdateExpression: aws.String("SET seat_manifest = :seat_manifest, updated_at = :updated_at"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":seat_manifest": recordValue,
+			":updated_at":    &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record seat manifest: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
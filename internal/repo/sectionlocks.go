@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// SectionLock is a lease on a section of an event's seats held by an external editor.
+// ExpiresAt is in epoch seconds so lease conditions can compare it numerically.
+type SectionLock struct {
+	LeaseID    string    `dynamodbav:"lease_id"`
+	Holder     string    `dynamodbav:"holder,omitempty"`
+	ExpiresAt  int64     `dynamodbav:"expires_at"`
+	AcquiredAt time.Time `dynamodbav:"acquired_at"`
+}
+
+// GetSectionLocks returns an event's section locks by section, including expired ones
+// that were not released; nil when no section was ever locked
+func (r *DynamoDBRepository) GetSectionLocks(ctx context.Context, eventID string) (map[string]SectionLock, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:            aws.String(r.tableInventory),
+		Key:                  eventKey(eventID),
+		ProjectionExpression: aws.String("section_locks"),
+		ConsistentRead:       aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get section locks: %w", err)
+	}
+
+	var locks map[string]SectionLock
+	if value, ok := result.Item["section_locks"]; ok {
+		if err := attributevalue.Unmarshal(value, &locks); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal section locks: %w", err)
+		}
+	}
+	return locks, nil
+}
+
+// AcquireSectionLock stores a lock on a section unless another lock on it is still live.
+// It fails with a conditional check error if the section is locked. The item is upserted
+// like PutVisibilityRule.
+func (r *DynamoDBRepository) AcquireSectionLock(ctx context.Context, eventID, section string, lock SectionLock) error {
+	lockValue, err := attributevalue.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("failed to marshal section lock: %w", err)
+	}
+
+	// The locks map must exist before one of its entries can be set
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(r.tableInventory),
+		Key:              eventKey(eventID),
+		UpdateExpression: aws.String("SET section_locks = if_not_exists(section_locks, :empty)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":empty": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acquire section lock: %w", err)
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		UpdateExpression:         aws.String("SET section_locks.#section = :lock"),
+		ConditionExpression:      aws.String("attribute_not_exists(section_locks.#section) OR section_locks.#section.expires_at < :now"),
+		ExpressionAttributeNames: map[string]string{"#section": section},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":lock": lockValue,
+			":now":  &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Unix(), 10)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acquire section lock: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// RenewSectionLock moves the expiry of a live lock. It fails with a conditional check
+// error if the section's lock has another lease or has expired.
+func (r *DynamoDBRepository) RenewSectionLock(ctx context.Context, eventID, section, leaseID string, expiresAt int64) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(r.tableInventory),
+		Key:              eventKey(eventID),
+		UpdateExpression: aws.String("SET section_locks.#section.expires_at = :expires_at"),
+		ConditionExpression: aws.String("section_locks.#section.lease_id = :lease_id AND " +
+			"section_locks.#section.expires_at >= :now"),
+		ExpressionAttributeNames: map[string]string{"#section": section},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":lease_id":   &types.AttributeValueMemberS{Value: leaseID},
+			":expires_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt, 10)},
+			":now":        &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Unix(), 10)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to renew section lock: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// ReleaseSectionLock removes a section's lock. It fails with a conditional check error
+// if the section's lock has another lease.
+func (r *DynamoDBRepository) ReleaseSectionLock(ctx context.Context, eventID, section, leaseID string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		UpdateExpression:         aws.String("REMOVE section_locks.#section"),
+		ConditionExpression:      aws.String("section_locks.#section.lease_id = :lease_id"),
+		ExpressionAttributeNames: map[string]string{"#section": section},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":lease_id": &types.AttributeValueMemberS{Value: leaseID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release section lock: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// streamLeaseTTL is how long a shard lease lasts unless its reader renews it; a shard
+	// of a reader that died is picked up by another one after at most this long
+	streamLeaseTTL = 20 * time.Second
+	// streamCheckpointTTL lets the checkpoints of shards trimmed from the stream, which
+	// keeps records for 24 hours, expire from the idempotency table
+	streamCheckpointTTL = 48 * time.Hour
+)
+
+// shardLeases leases the shards of a table stream to one reader across instances and
+// checkpoints how far each shard was processed. Leases and checkpoints are items of the
+// idempotency table, one per shard; a lease is taken when it is free, expired or already
+// held by this reader. One reader per shard stays within the two concurrent readers a
+// DynamoDB stream shard supports.
+type shardLeases struct {
+	ddb    *dynamodb.Client
+	table  string
+	stream string
+	owner  string
+
+	// renewAt is when each lease held is renewed next
+	renewAt map[string]time.Time
+	// busyUntil is when the lease of a shard another reader holds expires
+	busyUntil map[string]time.Time
+}
+
+// shardCheckpoint is how far a shard was processed: the sequence number of its last
+// processed record, empty if none, and whether the shard was closed and read to its end
+type shardCheckpoint struct {
+	sequenceNumber string
+	finished       bool
+}
+
+// newShardLeases creates the leases of a table stream's shards for a new reader
+func (r *DynamoDBRepository) newShardLeases(stream string) *shardLeases {
+	host, _ := os.Hostname()
+	return &shardLeases{
+		ddb:       r.client,
+		table:     r.tableIdempotency,
+		stream:    stream,
+		owner:     host + "/" + uuid.New().String()[:8],
+		renewAt:   make(map[string]time.Time),
+		busyUntil: make(map[string]time.Time),
+	}
+}
+
+// key returns the idempotency table key of a shard's lease
+func (l *shardLeases) key(shardID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"key": &types.AttributeValueMemberS{Value: fmt.Sprintf("stream:%s:%s", l.stream, shardID)},
+	}
+}
+
+// acquire leases a shard and returns its checkpoint. ok is false while another reader
+// holds the shard; it isn't asked for again until that lease would expire.
+func (l *shardLeases) acquire(ctx context.Context, shardID string) (checkpoint *shardCheckpoint, ok bool, err error) {
+	now := time.Now()
+	if now.Before(l.busyUntil[shardID]) {
+		return nil, false, nil
+	}
+
+	values := l.leaseValues(now)
+	values[":now"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(now.UnixMilli(), 10)}
+	result, err := l.ddb.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                           aws.String(l.table),
+		Key:                                 l.key(shardID),
+		UpdateExpression:                    aws.String("SET lease_owner = :owner, lease_expires_at = :lease_expires_at, expires_at = :expires_at"),
+		ConditionExpression:                 aws.String("attribute_not_exists(lease_owner) OR lease_owner = :owner OR lease_expires_at < :now"),
+		ExpressionAttributeValues:           values,
+		ReturnValues:                        types.ReturnValueAllNew,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	})
+	if err != nil {
+		var leased *types.ConditionalCheckFailedException
+		if errors.As(err, &leased) {
+			l.busyUntil[shardID] = time.UnixMilli(itemInt64(leased.Item, "lease_expires_at"))
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to lease %s stream shard %s: %w", l.stream, shardID, err)
+	}
+
+	delete(l.busyUntil, shardID)
+	l.renewAt[shardID] = now.Add(streamLeaseTTL / 2)
+	checkpoint = &shardCheckpoint{}
+	if sequenceNumber, ok := result.Attributes["sequence_number"].(*types.AttributeValueMemberS); ok {
+		checkpoint.sequenceNumber = sequenceNumber.Value
+	}
+	if finished, ok := result.Attributes["finished"].(*types.AttributeValueMemberBOOL); ok {
+		checkpoint.finished = finished.Value
+	}
+	return checkpoint, true, nil
+}
+
+// checkpoint records the last processed record of a leased shard, or that the shard was
+// read to its end, and renews the lease. It fails if the lease was lost.
+func (l *shardLeases) checkpoint(ctx context.Context, shardID string, checkpoint shardCheckpoint) error {
+	now := time.Now()
+	update := "SET lease_expires_at = :lease_expires_at, expires_at = :expires_at, finished = :finished"
+	values := l.leaseValues(now)
+	values[":finished"] = &types.AttributeValueMemberBOOL{Value: checkpoint.finished}
+	if checkpoint.sequenceNumber != "" {
+		update += ", sequence_number = :sequence_number"
+		values[":sequence_number"] = &types.AttributeValueMemberS{Value: checkpoint.sequenceNumber}
+	}
+
+	_, err := l.ddb.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(l.table),
+		Key:                       l.key(shardID),
+		UpdateExpression:          aws.String(update),
+		ConditionExpression:       aws.String("lease_owner = :owner"),
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		delete(l.renewAt, shardID)
+		return fmt.Errorf("failed to checkpoint %s stream shard %s: %w", l.stream, shardID, err)
+	}
+	l.renewAt[shardID] = now.Add(streamLeaseTTL / 2)
+	return nil
+}
+
+// renew extends a shard's lease once half of it has passed. It fails if the lease was lost.
+func (l *shardLeases) renew(ctx context.Context, shardID string) error {
+	now := time.Now()
+	if now.Before(l.renewAt[shardID]) {
+		return nil
+	}
+
+	_, err := l.ddb.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(l.table),
+		Key:                       l.key(shardID),
+		UpdateExpression:          aws.String("SET lease_expires_at = :lease_expires_at, expires_at = :expires_at"),
+		ConditionExpression:       aws.String("lease_owner = :owner"),
+		ExpressionAttributeValues: l.leaseValues(now),
+	})
+	if err != nil {
+		delete(l.renewAt, shardID)
+		return fmt.Errorf("failed to renew lease of %s stream shard %s: %w", l.stream, shardID, err)
+	}
+	l.renewAt[shardID] = now.Add(streamLeaseTTL / 2)
+	return nil
+}
+
+// leaseValues returns the expression values of a lease held by this reader from now
+func (l *shardLeases) leaseValues(now time.Time) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		":owner":            &types.AttributeValueMemberS{Value: l.owner},
+		":lease_expires_at": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(streamLeaseTTL).UnixMilli(), 10)},
+		":expires_at":       &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(streamCheckpointTTL).Unix(), 10)},
+	}
+}
+
+// itemInt64 reads a number attribute of an item, or 0 when it is missing or malformed
+func itemInt64(item map[string]types.AttributeValue, key string) int64 {
+	value, ok := item[key].(*types.AttributeValueMemberN)
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(value.Value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
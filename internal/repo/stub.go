@@ -0,0 +1,126 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// stubRemaining is the remaining count of every event in the stub backend, large enough
+// that load tests never run out of seats
+const stubRemaining = 1 << 30
+
+// stubBackend answers DynamoDB calls without sending them, after a synthetic latency.
+// It lets the gateway→inventory path be load tested without DynamoDB cost: every event
+// has plenty of remaining seats, every seat is available, other items don't exist and
+// every write succeeds. Operations it doesn't know, such as DescribeTable for stream
+// readers, fail.
+type stubBackend struct {
+	latency        time.Duration
+	jitter         time.Duration
+	tableInventory string
+	tableSeats     string
+}
+
+// addMiddleware short-circuits every call before it is signed and sent
+func (s *stubBackend) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("StubBackend", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if err := s.wait(ctx); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+		result, err := s.respond(in.Parameters)
+		return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, err
+	}), middleware.After)
+}
+
+// wait sleeps for the synthetic latency, or until ctx is done
+func (s *stubBackend) wait(ctx context.Context) error {
+	delay := s.latency
+	if s.jitter > 0 {
+		delay += rand.N(s.jitter)
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// respond returns the synthetic output of a call
+func (s *stubBackend) respond(params interface{}) (interface{}, error) {
+	switch input := params.(type) {
+	case *dynamodb.GetItemInput:
+		return &dynamodb.GetItemOutput{Item: s.item(aws.ToString(input.TableName), input.Key)}, nil
+	case *dynamodb.BatchGetItemInput:
+		responses := make(map[string][]map[string]types.AttributeValue, len(input.RequestItems))
+		for table, keys := range input.RequestItems {
+			for _, key := range keys.Keys {
+				if item := s.item(table, key); item != nil {
+					responses[table] = append(responses[table], item)
+				}
+			}
+		}
+		return &dynamodb.BatchGetItemOutput{Responses: responses}, nil
+	case *dynamodb.QueryInput:
+		return &dynamodb.QueryOutput{}, nil
+	case *dynamodb.ScanInput:
+		return &dynamodb.ScanOutput{}, nil
+	case *dynamodb.PutItemInput:
+		return &dynamodb.PutItemOutput{}, nil
+	case *dynamodb.UpdateItemInput:
+		output := &dynamodb.UpdateItemOutput{}
+		if input.ReturnValues != "" && input.ReturnValues != types.ReturnValueNone {
+			output.Attributes = s.item(aws.ToString(input.TableName), input.Key)
+			if output.Attributes == nil {
+				output.Attributes = input.Key
+			}
+		}
+		return output, nil
+	case *dynamodb.DeleteItemInput:
+		return &dynamodb.DeleteItemOutput{}, nil
+	case *dynamodb.BatchWriteItemInput:
+		return &dynamodb.BatchWriteItemOutput{}, nil
+	case *dynamodb.TransactWriteItemsInput:
+		return &dynamodb.TransactWriteItemsOutput{}, nil
+	default:
+		return nil, fmt.Errorf("stub backend: %T is not supported", params)
+	}
+}
+
+// item returns the synthetic item stored under key, or nil when the table holds none
+func (s *stubBackend) item(table string, key map[string]types.AttributeValue) map[string]types.AttributeValue {
+	updatedAt := &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)}
+
+	switch table {
+	case s.tableInventory:
+		return map[string]types.AttributeValue{
+			"event_id":   key["event_id"],
+			"remaining":  &types.AttributeValueMemberN{Value: strconv.Itoa(stubRemaining)},
+			"version":    &types.AttributeValueMemberN{Value: "1"},
+			"updated_at": updatedAt,
+		}
+	case s.tableSeats:
+		return map[string]types.AttributeValue{
+			"event_id":   key["event_id"],
+			"seat_id":    key["seat_id"],
+			"status":     &types.AttributeValueMemberS{Value: SeatAvailable},
+			"updated_at": updatedAt,
+		}
+	default:
+		return nil
+	}
+}
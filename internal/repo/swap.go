@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+)
+
+// SeatSwap exchanges seats of a reservation for other seats of the same event
+type SeatSwap struct {
+	EventID       string
+	ReservationID string
+	// Status the released seats are in and the acquired seats take: HOLD or SOLD
+	Status  string
+	Release []string
+	Acquire []string
+	// Holds of the released seats, which must still be live; set for HOLD swaps only
+	Holds *LiveHoldCheck
+	// Expiry and extensions of the hold records written for the acquired seats
+	HoldExpiresAt  time.Time
+	HoldExtensions int32
+}
+
+// SwapSeats releases and acquires the seats of a swap in one transaction. Released seats
+// must still be in the swap's status for its reservation and acquired seats must be
+// available. Held swaps move the hold records along with the seats, and fail with
+// ErrHoldExpired if a released seat's hold expired. If a released seat changed the error
+// contains "precondition failed"; a taken target seat is an ErrSeatConflict.
+func (r *DynamoDBRepository) SwapSeats(ctx context.Context, swap *SeatSwap) error {
+	table, m, err := r.seatsTable(ctx, swap.EventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updatedAt := &types.AttributeValueMemberS{Value: now.Format(time.RFC3339)}
+	reservationID := &types.AttributeValueMemberS{Value: fields.seal(swap.ReservationID)}
+
+	transactItems := make([]types.TransactWriteItem, 0, 2*(len(swap.Release)+len(swap.Acquire)))
+	for _, seatID := range swap.Release {
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:                aws.String(table),
+				Key:                      seatKeys(swap.EventID, []string{seatID})[0],
+				UpdateExpression:         aws.String("SET #status = :available, updated_at = :updated_at REMOVE reservation_id"),
+				ConditionExpression:      aws.String("#status = :status AND reservation_id = :reservation_id"),
+				ExpressionAttributeNames: map[string]string{"#status": "status"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":available":      &types.AttributeValueMemberS{Value: SeatAvailable},
+					":status":         &types.AttributeValueMemberS{Value: swap.Status},
+					":reservation_id": reservationID,
+					":updated_at":     updatedAt,
+				},
+			},
+		})
+	}
+	for _, seatID := range swap.Acquire {
+		transactItems = append(transactItems, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:                aws.String(table),
+				Key:                      seatKeys(swap.EventID, []string{seatID})[0],
+				UpdateExpression:         aws.String("SET #status = :status, reservation_id = :reservation_id, updated_at = :updated_at"),
+				ConditionExpression:      aws.String("#status = :available"),
+				ExpressionAttributeNames: map[string]string{"#status": "status"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":available":      &types.AttributeValueMemberS{Value: SeatAvailable},
+					":status":         &types.AttributeValueMemberS{Value: swap.Status},
+					":reservation_id": reservationID,
+					":updated_at":     updatedAt,
+				},
+			},
+		})
+	}
+	seatsEnd := len(transactItems)
+
+	if swap.Holds != nil {
+		// Deleting the old hold records doubles as the live hold check: a transaction
+		// can't check and write the same item
+		for _, seatID := range swap.Release {
+			transactItems = append(transactItems, types.TransactWriteItem{
+				Delete: &types.Delete{
+					TableName:           aws.String(r.tableHolds),
+					Key:                 seatKeys(swap.EventID, []string{seatID})[0],
+					ConditionExpression: aws.String("reservation_id = :reservation_id AND expires_at > :expires_after"),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":reservation_id": reservationID,
+						":expires_after":  &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", swap.Holds.ExpiresAfter.Unix())},
+					},
+				},
+			})
+		}
+		for _, seatID := range swap.Acquire {
+			holdItem, err := marshalDynamoItem(&HoldItem{
+				EventID:       swap.EventID,
+				SeatID:        seatID,
+				ReservationID: swap.ReservationID,
+				ExpiresAt:     swap.HoldExpiresAt.Unix(),
+				CreatedAt:     now,
+				Extensions:    swap.HoldExtensions,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to marshal hold item: %w", err)
+			}
+			transactItems = append(transactItems, types.TransactWriteItem{
+				Put: &types.Put{
+					TableName: aws.String(r.tableHolds),
+					Item:      holdItem,
+				},
+			})
+		}
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: transactItems,
+	})
+	if err != nil {
+		if conditionFailedIn(err, 0, len(swap.Release)) {
+			return fmt.Errorf("precondition failed: seats of reservation %s changed before the swap: %w", swap.ReservationID, err)
+		}
+		if conditionFailedIn(err, seatsEnd, seatsEnd+len(swap.Release)) {
+			return apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s: %w", swap.ReservationID, err)
+		}
+		conflict := r.seatConflict(err, swap.EventID, func(i int) (string, bool) {
+			if i < len(swap.Release) || i >= seatsEnd {
+				return "", false
+			}
+			return swap.Acquire[i-len(swap.Release)], true
+		})
+		if conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("failed to swap seats: %w", err)
+	}
+
+	seatIDs := append(append([]string(nil), swap.Release...), swap.Acquire...)
+	m.copy(ctx, tableNameSeats, seatKeys(swap.EventID, seatIDs))
+	if swap.Holds != nil {
+		r.mirror.copy(ctx, tableNameHolds, seatKeys(swap.EventID, seatIDs))
+	}
+
+	return nil
+}
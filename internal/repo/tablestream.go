@@ -0,0 +1,228 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	streamstypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+)
+
+// tableStream reads the records of a table's DynamoDB stream from every open shard
+type tableStream struct {
+	ddb     *dynamodb.Client
+	client  *dynamodbstreams.Client
+	table   string
+	arn     *string
+	started bool
+
+	// iterators holds the next iterator of every open shard; finished marks closed shards
+	iterators map[string]*string
+	finished  map[string]bool
+
+	// leases, when set, shares the shards among readers and checkpoints them; without it
+	// every reader reads every shard
+	leases *shardLeases
+}
+
+// newTableStream creates a reader for a table's stream
+func (r *DynamoDBRepository) newTableStream(table string) *tableStream {
+	return &tableStream{
+		ddb:       r.client,
+		client:    r.streams,
+		table:     table,
+		iterators: make(map[string]*string),
+		finished:  make(map[string]bool),
+	}
+}
+
+// newLeasedTableStream creates a reader for a table's stream that reads only the shards
+// it leases, resuming each from its checkpoint
+func (r *DynamoDBRepository) newLeasedTableStream(table string) *tableStream {
+	stream := r.newTableStream(table)
+	stream.leases = r.newShardLeases(table)
+	return stream
+}
+
+// poll reads one batch from every open shard. Shards present at the first poll start
+// from LATEST; shards discovered later (children of split shards, or shards whose
+// iterator was dropped) are read from TRIM_HORIZON so no record is missed.
+func (s *tableStream) poll(ctx context.Context) ([]streamstypes.Record, error) {
+	var records []streamstypes.Record
+	err := s.pollShards(ctx, func(batch []streamstypes.Record) error {
+		records = append(records, batch...)
+		return nil
+	})
+	return records, err
+}
+
+// pollShards reads one batch from every open shard and hands each shard's records to
+// process. A leased shard is checkpointed after its records were processed; when process
+// fails, the shard is read again from its checkpoint on the next poll.
+func (s *tableStream) pollShards(ctx context.Context, process func(records []streamstypes.Record) error) error {
+	if err := s.refreshShards(ctx); err != nil {
+		return err
+	}
+
+	for shardID, iterator := range s.iterators {
+		result, err := s.client.GetRecords(ctx, &dynamodbstreams.GetRecordsInput{
+			ShardIterator: iterator,
+		})
+		if err != nil {
+			// Drop the iterator; the shard is re-acquired on the next refresh
+			delete(s.iterators, shardID)
+			fmt.Printf("Warning: failed to read %s stream shard %s: %v\n", s.table, shardID, err)
+			continue
+		}
+
+		if len(result.Records) > 0 {
+			if err := process(result.Records); err != nil {
+				delete(s.iterators, shardID)
+				fmt.Printf("Warning: failed to process %s stream shard %s, retrying from its checkpoint: %v\n", s.table, shardID, err)
+				continue
+			}
+		}
+
+		if s.leases != nil {
+			checkpoint := shardCheckpoint{finished: result.NextShardIterator == nil}
+			if last := result.Records; len(last) > 0 && last[len(last)-1].Dynamodb != nil {
+				checkpoint.sequenceNumber = aws.ToString(last[len(last)-1].Dynamodb.SequenceNumber)
+			}
+			if len(result.Records) > 0 || checkpoint.finished {
+				if err := s.leases.checkpoint(ctx, shardID, checkpoint); err != nil {
+					delete(s.iterators, shardID)
+					fmt.Printf("Warning: %v\n", err)
+					continue
+				}
+			}
+		}
+
+		if result.NextShardIterator == nil {
+			delete(s.iterators, shardID)
+			s.finished[shardID] = true
+			continue
+		}
+		s.iterators[shardID] = result.NextShardIterator
+	}
+
+	if s.leases != nil {
+		for shardID := range s.iterators {
+			if err := s.leases.renew(ctx, shardID); err != nil {
+				delete(s.iterators, shardID)
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// refreshShards discovers shards that don't have an iterator yet
+func (s *tableStream) refreshShards(ctx context.Context) error {
+	if s.arn == nil {
+		table, err := s.ddb.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+			TableName: aws.String(s.table),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to describe table %s: %w", s.table, err)
+		}
+		if table.Table.LatestStreamArn == nil {
+			return fmt.Errorf("table %s has no stream enabled", s.table)
+		}
+		s.arn = table.Table.LatestStreamArn
+	}
+
+	iteratorType := streamstypes.ShardIteratorTypeTrimHorizon
+	if !s.started {
+		iteratorType = streamstypes.ShardIteratorTypeLatest
+	}
+
+	var startShardID *string
+	for {
+		stream, err := s.client.DescribeStream(ctx, &dynamodbstreams.DescribeStreamInput{
+			StreamArn:             s.arn,
+			ExclusiveStartShardId: startShardID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to describe %s stream: %w", s.table, err)
+		}
+
+		for _, shard := range stream.StreamDescription.Shards {
+			shardID := aws.ToString(shard.ShardId)
+			if _, ok := s.iterators[shardID]; ok || s.finished[shardID] {
+				continue
+			}
+
+			input := &dynamodbstreams.GetShardIteratorInput{
+				StreamArn:         s.arn,
+				ShardId:           shard.ShardId,
+				ShardIteratorType: iteratorType,
+			}
+			if s.leases != nil {
+				checkpoint, ok, err := s.leases.acquire(ctx, shardID)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				if checkpoint.finished {
+					s.finished[shardID] = true
+					continue
+				}
+				// Leased shards resume after their checkpoint, or start from the oldest record
+				input.ShardIteratorType = streamstypes.ShardIteratorTypeTrimHorizon
+				if checkpoint.sequenceNumber != "" {
+					input.ShardIteratorType = streamstypes.ShardIteratorTypeAfterSequenceNumber
+					input.SequenceNumber = aws.String(checkpoint.sequenceNumber)
+				}
+			}
+
+			iterator, err := s.client.GetShardIterator(ctx, input)
+			var trimmed *streamstypes.TrimmedDataAccessException
+			if errors.As(err, &trimmed) && input.SequenceNumber != nil {
+				// The checkpointed record was trimmed; everything after it is at the horizon
+				input.ShardIteratorType = streamstypes.ShardIteratorTypeTrimHorizon
+				input.SequenceNumber = nil
+				iterator, err = s.client.GetShardIterator(ctx, input)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get shard iterator: %w", err)
+			}
+			s.iterators[shardID] = iterator.ShardIterator
+		}
+
+		startShardID = stream.StreamDescription.LastEvaluatedShardId
+		if startShardID == nil {
+			break
+		}
+	}
+
+	s.started = true
+	return nil
+}
+
+// streamString reads a string attribute from a stream image
+func streamString(image map[string]streamstypes.AttributeValue, key string) string {
+	if value, ok := image[key].(*streamstypes.AttributeValueMemberS); ok {
+		return value.Value
+	}
+	return ""
+}
+
+// streamInt64 reads a number attribute from a stream image, or 0 when it is missing or malformed
+func streamInt64(image map[string]streamstypes.AttributeValue, key string) int64 {
+	value, ok := image[key].(*streamstypes.AttributeValueMemberN)
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(value.Value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,155 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+)
+
+// VenueTemplateItem represents one version of a venue's seat layout in DynamoDB.
+// Versions are immutable; changing a layout writes the next version so events keep
+// referencing the layout they were created from.
+type VenueTemplateItem struct {
+	TemplateID string    `dynamodbav:"template_id"`
+	Version    int32     `dynamodbav:"version"`
+	Name       string    `dynamodbav:"name,omitempty"`
+	SeatIDs    []string  `dynamodbav:"seat_ids"`
+	CreatedAt  time.Time `dynamodbav:"created_at"`
+}
+
+// PutVenueTemplate stores a new template version. It fails with a conditional check
+// error if the version already exists.
+func (r *DynamoDBRepository) PutVenueTemplate(ctx context.Context, item *VenueTemplateItem) error {
+	dynamoItem, err := marshalDynamoItem(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal venue template: %w", err)
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.tableTemplates),
+		Item:                dynamoItem,
+		ConditionExpression: aws.String("attribute_not_exists(template_id)"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put venue template: %w", err)
+	}
+
+	return nil
+}
+
+// GetVenueTemplate retrieves a template version, or the latest version if version is 0
+func (r *DynamoDBRepository) GetVenueTemplate(ctx context.Context, templateID string, version int32) (*VenueTemplateItem, error) {
+	var item map[string]types.AttributeValue
+
+	if version > 0 {
+		result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+			TableName: aws.String(r.tableTemplates),
+			Key: map[string]types.AttributeValue{
+				"template_id": &types.AttributeValueMemberS{Value: templateID},
+				"version":     &types.AttributeValueMemberN{Value: strconv.Itoa(int(version))},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get venue template: %w", err)
+		}
+		item = result.Item
+	} else {
+		result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+			TableName:              aws.String(r.tableTemplates),
+			KeyConditionExpression: aws.String("template_id = :template_id"),
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":template_id": &types.AttributeValueMemberS{Value: templateID},
+			},
+			ScanIndexForward: aws.Bool(false),
+			Limit:            aws.Int32(1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query venue template: %w", err)
+		}
+		if len(result.Items) > 0 {
+			item = result.Items[0]
+		}
+	}
+
+	if item == nil {
+		return nil, apperrors.NotFound("venue template not found: %s (version %d)", templateID, version)
+	}
+
+	template := &VenueTemplateItem{}
+	if err := unmarshalDynamoItem(item, template); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal venue template: %w", err)
+	}
+
+	return template, nil
+}
+
+// CreateEventSeats creates AVAILABLE seats for an event in transactional chunks.
+// Seats that already exist are left untouched unless they are still AVAILABLE and
+// unreserved, so a partially failed instantiation can be retried.
+func (r *DynamoDBRepository) CreateEventSeats(ctx context.Context, eventID string, seatIDs []string, chunkSize int) error {
+	conditionExpr := "attribute_not_exists(seat_id) OR (#status = :available AND attribute_not_exists(reservation_id))"
+	exprValues := map[string]types.AttributeValue{
+		":available": &types.AttributeValueMemberS{Value: SeatAvailable},
+	}
+	exprNames := map[string]string{
+		"#status": "status",
+	}
+
+	now := time.Now()
+	for start := 0; start < len(seatIDs); start += chunkSize {
+		end := min(start+chunkSize, len(seatIDs))
+
+		seats := make([]*SeatItem, 0, end-start)
+		for _, seatID := range seatIDs[start:end] {
+			seats = append(seats, &SeatItem{
+				EventID:   eventID,
+				SeatID:    seatID,
+				Status:    SeatAvailable,
+				UpdatedAt: now,
+			})
+		}
+
+		if err := r.TransactWriteSeats(ctx, seats, conditionExpr, exprValues, exprNames); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetEventTemplate records the venue template an event was instantiated from and its seat count.
+// It creates the inventory item if needed and fails with a conditional check error if the
+// event already references a different template or version.
+func (r *DynamoDBRepository) SetEventTemplate(ctx context.Context, eventID, templateID string, version, totalSeats int32) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(r.tableInventory),
+		Key: map[string]types.AttributeValue{
+			"event_id": &types.AttributeValueMemberS{Value: eventID},
+		},
+		UpdateExpression: aws.String("SET template_id = :template_id, template_version = :template_version, " +
+			"total_seats = :total_seats, remaining = if_not_exists(remaining, :zero), " +
+			"version = if_not_exists(version, :zero) + :one, updated_at = :updated_at"),
+		ConditionExpression: aws.String("attribute_not_exists(template_id) OR " +
+			"(template_id = :template_id AND template_version = :template_version)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":template_id":      &types.AttributeValueMemberS{Value: templateID},
+			":template_version": &types.AttributeValueMemberN{Value: strconv.Itoa(int(version))},
+			":total_seats":      &types.AttributeValueMemberN{Value: strconv.Itoa(int(totalSeats))},
+			":zero":             &types.AttributeValueMemberN{Value: "0"},
+			":one":              &types.AttributeValueMemberN{Value: "1"},
+			":updated_at":       &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set event template: %w", err)
+	}
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
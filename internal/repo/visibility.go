@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+)
+
+// VisibilityRule hides the seats of an event whose IDs start with a segment prefix,
+// e.g. premium seats released after general sale opens. The seats are hidden until
+// RevealAt (forever when zero) from callers that don't present the access code.
+type VisibilityRule struct {
+	RevealAt time.Time `dynamodbav:"reveal_at,omitempty"`
+	// AccessCodeHash is the hex SHA-256 of the presale code that reveals the segment early
+	AccessCodeHash string    `dynamodbav:"access_code_hash,omitempty"`
+	CreatedAt      time.Time `dynamodbav:"created_at"`
+}
+
+// visibilityCache caches the visibility rules of events, so public checks don't read
+// the inventory item for them on every request
+type visibilityCache struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	rules map[string]cachedVisibilityRules
+}
+
+// cachedVisibilityRules are an event's visibility rules as last read from the inventory table
+type cachedVisibilityRules struct {
+	rules   map[string]VisibilityRule
+	expires time.Time
+}
+
+// GetVisibilityRules returns an event's visibility rules by segment, reading them at
+// most once per cache TTL; nil when the event hides nothing
+func (r *DynamoDBRepository) GetVisibilityRules(ctx context.Context, eventID string) (map[string]VisibilityRule, error) {
+	c := r.visibility
+	c.mu.Lock()
+	cached, ok := c.rules[eventID]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.rules, nil
+	}
+
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:            aws.String(r.tableInventory),
+		Key:                  eventKey(eventID),
+		ProjectionExpression: aws.String("visibility"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get visibility rules: %w", err)
+	}
+
+	var rules map[string]VisibilityRule
+	if value, ok := result.Item["visibility"]; ok {
+		if err := attributevalue.Unmarshal(value, &rules); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal visibility rules: %w", err)
+		}
+	}
+
+	c.mu.Lock()
+	c.rules[eventID] = cachedVisibilityRules{rules: rules, expires: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+
+	return rules, nil
+}
+
+// PutVisibilityRule sets the visibility rule of one segment of an event, replacing any
+// previous rule of the segment. The item is upserted like SetHoldPolicy.
+func (r *DynamoDBRepository) PutVisibilityRule(ctx context.Context, eventID, segment string, rule VisibilityRule) error {
+	ruleValue, err := attributevalue.Marshal(rule)
+	if err != nil {
+		return fmt.Errorf("failed to marshal visibility rule: %w", err)
+	}
+
+	// The rules map must exist before one of its entries can be set
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(r.tableInventory),
+		Key:              eventKey(eventID),
+		UpdateExpression: aws.String("SET visibility = if_not_exists(visibility, :empty)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":empty": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set visibility rule: %w", err)
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		UpdateExpression:         aws.String("SET visibility.#segment = :rule, updated_at = :updated_at"),
+		ExpressionAttributeNames: map[string]string{"#segment": segment},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":rule":       ruleValue,
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set visibility rule: %w", err)
+	}
+	r.forgetVisibilityRules(eventID)
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// RemoveVisibilityRule deletes the visibility rule of one segment of an event, which
+// reveals the segment. It returns ErrNotFound when the segment has no rule.
+func (r *DynamoDBRepository) RemoveVisibilityRule(ctx context.Context, eventID, segment string) error {
+	_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(r.tableInventory),
+		Key:                      eventKey(eventID),
+		UpdateExpression:         aws.String("SET updated_at = :updated_at REMOVE visibility.#segment"),
+		ConditionExpression:      aws.String("attribute_exists(visibility.#segment)"),
+		ExpressionAttributeNames: map[string]string{"#segment": segment},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return apperrors.NotFound("visibility rule for segment %q of event %s not found", segment, eventID)
+		}
+		return fmt.Errorf("failed to remove visibility rule: %w", err)
+	}
+	r.forgetVisibilityRules(eventID)
+	r.mirror.copy(ctx, tableNameInventory, []map[string]types.AttributeValue{eventKey(eventID)})
+
+	return nil
+}
+
+// forgetVisibilityRules drops this instance's cached rules of an event after changing
+// them; other instances pick up the change within the cache TTL
+func (r *DynamoDBRepository) forgetVisibilityRules(eventID string) {
+	r.visibility.mu.Lock()
+	delete(r.visibility.rules, eventID)
+	r.visibility.mu.Unlock()
+}
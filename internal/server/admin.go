@@ -0,0 +1,403 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Admin-only middleware names, usable in ADMIN_GRPC_INTERCEPTORS. The admin listener
+// always authenticates outside its configured chain, so admin_auth there is ignored.
+const (
+	MiddlewareAdminAuth    = "admin_auth"
+	MiddlewareAdminTimeout = "admin_timeout"
+)
+
+// adminAuthUnaryInterceptor requires a matching bearer token on every admin RPC
+func adminAuthUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorizeAdmin(ctx, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// adminAuthStreamInterceptor requires a matching bearer token on every admin stream
+func adminAuthStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorizeAdmin(ss.Context(), token); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorizeAdmin validates the "authorization: Bearer <token>" metadata
+func authorizeAdmin(ctx context.Context, token string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	if !bearerTokenMatches(values[0], token) {
+		return status.Error(codes.PermissionDenied, "invalid credentials")
+	}
+
+	return nil
+}
+
+// bearerTokenMatches reports whether an authorization value carries a bearer token
+func bearerTokenMatches(authorization, token string) bool {
+	provided := strings.TrimPrefix(authorization, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
+
+// adminServer implements the InventoryAdmin gRPC service
+type adminServer struct {
+	proto.UnimplementedInventoryAdminServer
+	service *service.AdminService
+}
+
+// AdjustCapacity implements the AdjustCapacity gRPC method
+func (s *adminServer) AdjustCapacity(ctx context.Context, req *proto.AdjustCapacityReq) (*proto.AdjustCapacityRes, error) {
+	resp, err := s.service.AdjustCapacity(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// BlockSeats implements the BlockSeats gRPC method
+func (s *adminServer) BlockSeats(ctx context.Context, req *proto.BlockSeatsReq) (*proto.BlockSeatsRes, error) {
+	resp, err := s.service.BlockSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// UnblockSeats implements the UnblockSeats gRPC method
+func (s *adminServer) UnblockSeats(ctx context.Context, req *proto.UnblockSeatsReq) (*proto.UnblockSeatsRes, error) {
+	resp, err := s.service.UnblockSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SetSeatStatus implements the SetSeatStatus gRPC method
+func (s *adminServer) SetSeatStatus(ctx context.Context, req *proto.SetSeatStatusReq) (*proto.SetSeatStatusRes, error) {
+	resp, err := s.service.SetSeatStatus(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SetSeatMetadata implements the SetSeatMetadata gRPC method
+func (s *adminServer) SetSeatMetadata(ctx context.Context, req *proto.SetSeatMetadataReq) (*proto.SetSeatMetadataRes, error) {
+	resp, err := s.service.SetSeatMetadata(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetSeats implements the GetSeats gRPC method
+func (s *adminServer) GetSeats(ctx context.Context, req *proto.GetSeatsReq) (*proto.GetSeatsRes, error) {
+	resp, err := s.service.GetSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// EraseSubject implements the EraseSubject gRPC method
+func (s *adminServer) EraseSubject(ctx context.Context, req *proto.EraseSubjectReq) (*proto.EraseSubjectRes, error) {
+	resp, err := s.service.EraseSubject(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// WrapFieldEncryptionKey implements the WrapFieldEncryptionKey gRPC method
+func (s *adminServer) WrapFieldEncryptionKey(ctx context.Context, req *proto.WrapFieldEncryptionKeyReq) (*proto.WrapFieldEncryptionKeyRes, error) {
+	resp, err := s.service.WrapFieldEncryptionKey(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SetMaintenanceMode implements the SetMaintenanceMode gRPC method
+func (s *adminServer) SetMaintenanceMode(ctx context.Context, req *proto.SetMaintenanceModeReq) (*proto.SetMaintenanceModeRes, error) {
+	resp, err := s.service.SetMaintenanceMode(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// FreezeEvent implements the FreezeEvent gRPC method
+func (s *adminServer) FreezeEvent(ctx context.Context, req *proto.FreezeEventReq) (*proto.FreezeEventRes, error) {
+	resp, err := s.service.FreezeEvent(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// UnfreezeEvent implements the UnfreezeEvent gRPC method
+func (s *adminServer) UnfreezeEvent(ctx context.Context, req *proto.UnfreezeEventReq) (*proto.UnfreezeEventRes, error) {
+	resp, err := s.service.UnfreezeEvent(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// ReleaseEventHolds implements the ReleaseEventHolds gRPC method
+func (s *adminServer) ReleaseEventHolds(req *proto.ReleaseEventHoldsReq, stream proto.InventoryAdmin_ReleaseEventHoldsServer) error {
+	err := s.service.ReleaseEventHolds(stream.Context(), req, func(progress *proto.ReleaseEventHoldsProgress, failure error) error {
+		// Failed seats get the code their chunk's error would have had as an RPC error
+		if failure != nil {
+			code, _ := classifyError(failure)
+			for _, result := range progress.FailedSeats {
+				result.Code = int32(code)
+			}
+		}
+		return stream.Send(progress)
+	})
+	if err != nil {
+		return mapErrorToGRPC(err)
+	}
+	return nil
+}
+
+// ListStuckHolds implements the ListStuckHolds gRPC method
+func (s *adminServer) ListStuckHolds(ctx context.Context, req *proto.ListStuckHoldsReq) (*proto.ListStuckHoldsRes, error) {
+	resp, err := s.service.ListStuckHolds(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// PlanCapacity implements the PlanCapacity gRPC method
+func (s *adminServer) PlanCapacity(ctx context.Context, req *proto.PlanCapacityReq) (*proto.PlanCapacityRes, error) {
+	resp, err := s.service.PlanCapacity(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// StreamEventStats implements the StreamEventStats gRPC method
+func (s *adminServer) StreamEventStats(req *proto.StreamEventStatsReq, stream proto.InventoryAdmin_StreamEventStatsServer) error {
+	if err := s.service.StreamEventStats(stream.Context(), req, stream.Send); err != nil {
+		return mapErrorToGRPC(err)
+	}
+	return nil
+}
+
+// GetEventStats implements the GetEventStats gRPC method
+func (s *adminServer) GetEventStats(ctx context.Context, req *proto.GetEventStatsReq) (*proto.EventStats, error) {
+	resp, err := s.service.GetEventStats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// PutVenueTemplate implements the PutVenueTemplate gRPC method
+func (s *adminServer) PutVenueTemplate(ctx context.Context, req *proto.PutVenueTemplateReq) (*proto.PutVenueTemplateRes, error) {
+	resp, err := s.service.PutVenueTemplate(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetVenueTemplate implements the GetVenueTemplate gRPC method
+func (s *adminServer) GetVenueTemplate(ctx context.Context, req *proto.GetVenueTemplateReq) (*proto.GetVenueTemplateRes, error) {
+	resp, err := s.service.GetVenueTemplate(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// InstantiateVenueTemplate implements the InstantiateVenueTemplate gRPC method
+func (s *adminServer) InstantiateVenueTemplate(ctx context.Context, req *proto.InstantiateVenueTemplateReq) (*proto.InstantiateVenueTemplateRes, error) {
+	resp, err := s.service.InstantiateVenueTemplate(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// PutDemandForecast implements the PutDemandForecast gRPC method
+func (s *adminServer) PutDemandForecast(ctx context.Context, req *proto.PutDemandForecastReq) (*proto.PutDemandForecastRes, error) {
+	resp, err := s.service.PutDemandForecast(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SetHoldPolicy implements the SetHoldPolicy gRPC method
+func (s *adminServer) SetHoldPolicy(ctx context.Context, req *proto.SetHoldPolicyReq) (*proto.SetHoldPolicyRes, error) {
+	resp, err := s.service.SetHoldPolicy(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SetSeatsMigration implements the SetSeatsMigration gRPC method
+func (s *adminServer) SetSeatsMigration(ctx context.Context, req *proto.SetSeatsMigrationReq) (*proto.SetSeatsMigrationRes, error) {
+	resp, err := s.service.SetSeatsMigration(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// VerifySeatsMigration implements the VerifySeatsMigration gRPC method
+func (s *adminServer) VerifySeatsMigration(ctx context.Context, req *proto.VerifySeatsMigrationReq) (*proto.VerifySeatsMigrationRes, error) {
+	resp, err := s.service.VerifySeatsMigration(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetCanaryReport implements the GetCanaryReport gRPC method
+func (s *adminServer) GetCanaryReport(ctx context.Context, req *proto.GetCanaryReportReq) (*proto.GetCanaryReportRes, error) {
+	resp, err := s.service.GetCanaryReport(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// StartOperation implements the StartOperation gRPC method
+func (s *adminServer) StartOperation(ctx context.Context, req *proto.StartOperationReq) (*proto.Operation, error) {
+	resp, err := s.service.StartOperation(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetOperation implements the GetOperation gRPC method
+func (s *adminServer) GetOperation(ctx context.Context, req *proto.GetOperationReq) (*proto.Operation, error) {
+	resp, err := s.service.GetOperation(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// CancelOperation implements the CancelOperation gRPC method
+func (s *adminServer) CancelOperation(ctx context.Context, req *proto.CancelOperationReq) (*proto.Operation, error) {
+	resp, err := s.service.CancelOperation(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// UpsertSeats implements the UpsertSeats gRPC method
+func (s *adminServer) UpsertSeats(ctx context.Context, req *proto.UpsertSeatsReq) (*proto.UpsertSeatsRes, error) {
+	resp, err := s.service.UpsertSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetSeatUpload implements the GetSeatUpload gRPC method
+func (s *adminServer) GetSeatUpload(ctx context.Context, req *proto.GetSeatUploadReq) (*proto.GetSeatUploadRes, error) {
+	resp, err := s.service.GetSeatUpload(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// CloseEvent implements the CloseEvent gRPC method
+func (s *adminServer) CloseEvent(req *proto.CloseEventReq, stream proto.InventoryAdmin_CloseEventServer) error {
+	if err := s.service.CloseEvent(stream.Context(), req, stream.Send); err != nil {
+		return mapErrorToGRPC(err)
+	}
+	return nil
+}
+
+// AcquireSectionLock implements the AcquireSectionLock gRPC method
+func (s *adminServer) AcquireSectionLock(ctx context.Context, req *proto.AcquireSectionLockReq) (*proto.SectionLease, error) {
+	resp, err := s.service.AcquireSectionLock(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// RenewSectionLock implements the RenewSectionLock gRPC method
+func (s *adminServer) RenewSectionLock(ctx context.Context, req *proto.RenewSectionLockReq) (*proto.SectionLease, error) {
+	resp, err := s.service.RenewSectionLock(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// ReleaseSectionLock implements the ReleaseSectionLock gRPC method
+func (s *adminServer) ReleaseSectionLock(ctx context.Context, req *proto.ReleaseSectionLockReq) (*proto.ReleaseSectionLockRes, error) {
+	resp, err := s.service.ReleaseSectionLock(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// CreateEvent implements the CreateEvent gRPC method
+func (s *adminServer) CreateEvent(req *proto.CreateEventReq, stream proto.InventoryAdmin_CreateEventServer) error {
+	if err := s.service.CreateEvent(stream.Context(), req, stream.Send); err != nil {
+		return mapErrorToGRPC(err)
+	}
+	return nil
+}
+
+// SetVisibilityRule implements the SetVisibilityRule gRPC method
+func (s *adminServer) SetVisibilityRule(ctx context.Context, req *proto.SetVisibilityRuleReq) (*proto.SetVisibilityRuleRes, error) {
+	resp, err := s.service.SetVisibilityRule(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// RevealSegment implements the RevealSegment gRPC method
+func (s *adminServer) RevealSegment(ctx context.Context, req *proto.RevealSegmentReq) (*proto.RevealSegmentRes, error) {
+	resp, err := s.service.RevealSegment(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
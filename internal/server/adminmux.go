@@ -0,0 +1,134 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// http2Preface starts every HTTP/2 connection, which is how gRPC clients open theirs
+var http2Preface = []byte("PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+
+// protocolSniffTimeout bounds waiting for the first bytes of a connection
+const protocolSniffTimeout = 10 * time.Second
+
+// protocolSplitter shares a listener between the admin gRPC server and the admin web UI:
+// connections opening with the HTTP/2 preface go to gRPC, others (browsers speak HTTP/1.1
+// without TLS) to the UI. The listener is closed once both sides are.
+type protocolSplitter struct {
+	root       net.Listener
+	grpc, http *sniffedListener
+	open       atomic.Int32
+}
+
+// splitByProtocol starts dispatching the connections of listener and returns the
+// listeners of gRPC and of HTTP/1 connections
+func splitByProtocol(listener net.Listener) (grpcListener, httpListener net.Listener) {
+	s := &protocolSplitter{root: listener}
+	s.grpc = &sniffedListener{splitter: s, conns: make(chan net.Conn), closed: make(chan struct{})}
+	s.http = &sniffedListener{splitter: s, conns: make(chan net.Conn), closed: make(chan struct{})}
+	s.open.Store(2)
+
+	go s.run()
+	return s.grpc, s.http
+}
+
+// run accepts connections until the listener is closed
+func (s *protocolSplitter) run() {
+	for {
+		conn, err := s.root.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				fmt.Printf("Warning: admin listener stopped accepting: %v\n", err)
+			}
+			s.grpc.Close()
+			s.http.Close()
+			return
+		}
+		go s.dispatch(conn)
+	}
+}
+
+// dispatch reads as much of the connection as tells HTTP/2 apart and hands it, with
+// those bytes replayed, to the matching listener
+func (s *protocolSplitter) dispatch(conn net.Conn) {
+	conn.SetReadDeadline(time.Now().Add(protocolSniffTimeout))
+	prefix := make([]byte, 0, len(http2Preface))
+	for len(prefix) < len(http2Preface) && bytes.HasPrefix(http2Preface, prefix) {
+		n, err := conn.Read(prefix[len(prefix):cap(prefix)])
+		prefix = prefix[:len(prefix)+n]
+		if err != nil {
+			conn.Close()
+			return
+		}
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	target := s.http
+	if bytes.Equal(prefix, http2Preface) {
+		target = s.grpc
+	}
+	select {
+	case target.conns <- &prefixedConn{Conn: conn, prefix: prefix}:
+	case <-target.closed:
+		conn.Close()
+	}
+}
+
+// sniffedListener is one side of a protocolSplitter
+type sniffedListener struct {
+	splitter  *protocolSplitter
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Accept waits for the next connection of this side
+func (l *sniffedListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops this side, and the shared listener when the other side is closed too
+func (l *sniffedListener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		if l.splitter.open.Add(-1) == 0 {
+			err = l.splitter.root.Close()
+		}
+	})
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// Addr returns the address of the shared listener
+func (l *sniffedListener) Addr() net.Addr {
+	return l.splitter.root.Addr()
+}
+
+// prefixedConn replays the bytes read while sniffing before reading from the connection
+type prefixedConn struct {
+	net.Conn
+	prefix []byte
+}
+
+// Read reads the remaining sniffed bytes first
+func (c *prefixedConn) Read(b []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(b, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
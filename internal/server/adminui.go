@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// adminUIFiles are the static pages of the admin web UI
+//
+//go:embed adminui
+var adminUIFiles embed.FS
+
+// adminUISeatPageSize is the page size the seat map is read with
+const adminUISeatPageSize = 1000
+
+// adminHealthServices are the health service names shown by the admin UI
+var adminHealthServices = []string{"", proto.Inventory_ServiceDesc.ServiceName, "dynamodb", "redis"}
+
+// adminWorker is a background worker and whether it runs on this instance
+type adminWorker struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// adminUI serves a read-only web UI on the admin port, so on-call can inspect event
+// stats, seat maps, recent audit records, load and workers from a browser. The pages are
+// public; their JSON API requires the admin bearer token like admin RPCs.
+type adminUI struct {
+	token     string
+	timeout   time.Duration
+	admin     *service.AdminService
+	inventory *service.InventoryService
+	audit     *observability.AuditLog
+	load      *loadTracker
+	health    *health.Server
+	workers   []adminWorker
+}
+
+// newAdminHTTPServer creates the HTTP server of the admin web UI
+func newAdminHTTPServer(ui *adminUI) (*http.Server, error) {
+	pages, err := fs.Sub(adminUIFiles, "adminui")
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /", http.FileServerFS(pages))
+	mux.HandleFunc("GET /api/status", ui.authorized(ui.status))
+	mux.HandleFunc("GET /api/audit", ui.authorized(ui.recentAudit))
+	mux.HandleFunc("GET /api/events/{event_id}/stats", ui.authorized(ui.eventStats))
+	mux.HandleFunc("GET /api/events/{event_id}/seats", ui.authorized(ui.seats))
+
+	return &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}, nil
+}
+
+// serveAdmin serves the admin gRPC server and, when enabled, the web UI on listener
+func (s *Server) serveAdmin(listener net.Listener) {
+	if s.adminHTTP == nil {
+		if err := s.adminServer.Serve(listener); err != nil {
+			fmt.Printf("Admin server stopped: %v\n", err)
+		}
+		return
+	}
+
+	grpcListener, httpListener := splitByProtocol(listener)
+	go func() {
+		if err := s.adminHTTP.Serve(httpListener); !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("Admin UI stopped: %v\n", err)
+		}
+	}()
+	if err := s.adminServer.Serve(grpcListener); err != nil {
+		fmt.Printf("Admin server stopped: %v\n", err)
+	}
+}
+
+// backgroundWorkers lists the background workers and whether each is enabled
+func (s *Server) backgroundWorkers() []adminWorker {
+	return []adminWorker{
+		{"stuck_holds", s.config.Holds.StuckScanEnabled},
+		{"hold_expiry", s.config.Holds.ExpiryStreamEnabled},
+		{"reconciler", s.reconciler != nil},
+		{"anomalies", s.anomalies != nil},
+		{"commit_pool", s.commits != nil},
+		{"operations", s.operations != nil},
+		{"reservation_events", s.reservations != nil},
+		{"seat_replica", s.replica != nil},
+		{"change_feed", s.changes != nil},
+		{"idempotency_cleanup", s.idempotency != nil},
+		{"health_probes", s.healthProbes != nil},
+		{"brownout", s.brownout != nil},
+		{"recorder", s.recorder != nil},
+	}
+}
+
+// authorized requires the admin bearer token and bounds the request by the admin timeout
+func (ui *adminUI) authorized(handler func(ctx context.Context, r *http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !bearerTokenMatches(r.Header.Get("Authorization"), ui.token) {
+			http.Error(w, "invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ui.timeout)
+		defer cancel()
+
+		resp, err := handler(ctx, r)
+		if err != nil {
+			st := status.Convert(mapErrorToGRPC(err))
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		var body []byte
+		if message, ok := resp.(gproto.Message); ok {
+			body, err = protojson.Marshal(message)
+		} else {
+			body, err = json.Marshal(resp)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
+
+// status returns the load, dependency health and background workers of the instance
+func (ui *adminUI) status(ctx context.Context, _ *http.Request) (any, error) {
+	load, err := protojson.Marshal(ui.load.Status())
+	if err != nil {
+		return nil, err
+	}
+
+	health := make(map[string]string, len(adminHealthServices))
+	for _, name := range adminHealthServices {
+		resp, err := ui.health.Check(ctx, &healthpb.HealthCheckRequest{Service: name})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		health[name] = resp.Status.String()
+	}
+
+	return map[string]any{
+		"load":    json.RawMessage(load),
+		"health":  health,
+		"workers": ui.workers,
+	}, nil
+}
+
+// recentAudit returns the latest audit records of this instance
+func (ui *adminUI) recentAudit(context.Context, *http.Request) (any, error) {
+	return ui.audit.Recent(), nil
+}
+
+// eventStats returns the stats of an event or performance
+func (ui *adminUI) eventStats(ctx context.Context, r *http.Request) (any, error) {
+	return ui.admin.GetEventStats(ctx, &proto.GetEventStatsReq{
+		EventId:       r.PathValue("event_id"),
+		PerformanceId: r.URL.Query().Get("performance_id"),
+	})
+}
+
+// seats returns a page of the seat map of an event or performance
+func (ui *adminUI) seats(ctx context.Context, r *http.Request) (any, error) {
+	return ui.inventory.ListSeats(ctx, &proto.ListSeatsReq{
+		EventId:       r.PathValue("event_id"),
+		PerformanceId: r.URL.Query().Get("performance_id"),
+		PageToken:     r.URL.Query().Get("page_token"),
+		PageSize:      adminUISeatPageSize,
+	})
+}
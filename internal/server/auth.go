@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authUnaryInterceptor requires one of the tokens as bearer token on public RPCs. Health
+// checks and reflection stay open so probes don't need credentials; without tokens every
+// caller is accepted.
+func authUnaryInterceptor(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if len(tokens) > 0 && isPublicMethod(info.FullMethod) {
+			if err := authorizeCaller(ctx, tokens); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamInterceptor requires one of the tokens as bearer token on public streams
+func authStreamInterceptor(tokens []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if len(tokens) > 0 && isPublicMethod(info.FullMethod) {
+			if err := authorizeCaller(ss.Context(), tokens); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorizeCaller validates the "authorization: Bearer <token>" metadata against tokens.
+// Every token is compared, so the time taken doesn't tell which one nearly matched.
+func authorizeCaller(ctx context.Context, tokens []string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	matched := false
+	for _, token := range tokens {
+		matched = bearerTokenMatches(values[0], token) || matched
+	}
+	if !matched {
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Brownout levels; each level includes the measures of the levels below it
+const (
+	brownoutNormal = iota
+	// brownoutShedding rejects non-critical RPCs (stats, lists, watches)
+	brownoutShedding
+	// brownoutCheckLimited caps concurrent availability checks
+	brownoutCheckLimited
+	// brownoutCheckTight halves the availability check cap
+	brownoutCheckTight
+)
+
+// brownoutController sheds load progressively while the public RPCs are overloaded, so
+// commits and releases keep their capacity. Each interval, the share of slow and failed
+// public RPCs decides whether the interval was overloaded; TripIntervals overloaded
+// intervals in a row raise the level, RecoverIntervals healthy ones lower it.
+// Commits, releases and holds are never shed. A nil controller is a no-op.
+type brownoutController struct {
+	config      appconfig.BrownoutConfig
+	metrics     *observability.Metrics
+	nonCritical map[string]bool
+
+	level          atomic.Int32
+	checksInFlight atomic.Int64
+
+	mu         sync.Mutex
+	requests   int
+	slow       int
+	failed     int
+	overloaded int
+	healthy    int
+}
+
+// newBrownoutController creates a brownout controller, or returns nil when brownout is disabled
+func newBrownoutController(cfg *appconfig.Config, metrics *observability.Metrics) *brownoutController {
+	if !cfg.Brownout.Enabled {
+		return nil
+	}
+
+	nonCritical := make(map[string]bool, len(cfg.Brownout.NonCritical))
+	for _, method := range cfg.Brownout.NonCritical {
+		nonCritical[method] = true
+	}
+	return &brownoutController{
+		config:      cfg.Brownout,
+		metrics:     metrics,
+		nonCritical: nonCritical,
+	}
+}
+
+// Run evaluates the signals every interval until ctx is canceled
+func (b *brownoutController) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.evaluate()
+		}
+	}
+}
+
+// evaluate closes the current interval and moves the level one step if warranted
+func (b *brownoutController) evaluate() {
+	b.mu.Lock()
+	requests, slow, failed := b.requests, b.slow, b.failed
+	b.requests, b.slow, b.failed = 0, 0, 0
+
+	overloaded := requests >= b.config.MinRequests &&
+		(float64(slow) > b.config.SlowRatio*float64(requests) || float64(failed) > b.config.ErrorRatio*float64(requests))
+	if overloaded {
+		b.overloaded++
+		b.healthy = 0
+	} else {
+		b.healthy++
+		b.overloaded = 0
+	}
+
+	level := b.level.Load()
+	switch {
+	case b.overloaded >= b.config.TripIntervals && level < brownoutCheckTight:
+		level++
+		b.overloaded = 0
+	case b.healthy >= b.config.RecoverIntervals && level > brownoutNormal:
+		level--
+		b.healthy = 0
+	default:
+		b.mu.Unlock()
+		return
+	}
+	b.level.Store(level)
+	b.mu.Unlock()
+
+	fmt.Printf("Brownout level changed to %d (%d requests, %d slow, %d failed in the last interval)\n", level, requests, slow, failed)
+	b.metrics.SetBrownoutLevel(int(level))
+}
+
+// Level returns the current brownout level, 0 for a nil controller
+func (b *brownoutController) Level() int {
+	if b == nil {
+		return brownoutNormal
+	}
+	return int(b.level.Load())
+}
+
+// observe records the outcome of a public RPC
+func (b *brownoutController) observe(duration time.Duration, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.requests++
+	if duration > b.config.LatencyTarget {
+		b.slow++
+	}
+	if isOverloadError(err) {
+		b.failed++
+	}
+}
+
+// admit rejects a non-critical RPC from the shedding level on
+func (b *brownoutController) admit(method string) error {
+	if b.nonCritical[method] && b.level.Load() >= brownoutShedding {
+		b.metrics.RecordBrownoutRejection(method)
+		return status.Errorf(codes.Unavailable, "brownout: %s is disabled while the service is overloaded", method)
+	}
+	return nil
+}
+
+// acquireCheck admits an availability check within the current cap; release must be
+// called when it is done
+func (b *brownoutController) acquireCheck(method string) (release func(), err error) {
+	limit := int64(b.config.CheckConcurrency)
+	switch b.level.Load() {
+	case brownoutCheckLimited:
+	case brownoutCheckTight:
+		limit = max(limit/2, 1)
+	default:
+		return func() {}, nil
+	}
+
+	if b.checksInFlight.Add(1) > limit {
+		b.checksInFlight.Add(-1)
+		b.metrics.RecordBrownoutRejection(method)
+		return nil, status.Error(codes.Unavailable, "brownout: too many availability checks in flight while the service is overloaded")
+	}
+	return func() { b.checksInFlight.Add(-1) }, nil
+}
+
+// isOverloadError reports whether an RPC failed in a way that signals overload, as
+// opposed to per-caller limits or the request itself
+func isOverloadError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err != nil
+	}
+
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal:
+		return true
+	case codes.ResourceExhausted:
+		return isThrottleError(st.Message())
+	default:
+		return false
+	}
+}
+
+// brownoutUnaryInterceptor sheds unary RPCs by the brownout level and measures public RPCs
+func brownoutUnaryInterceptor(b *brownoutController) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if b == nil {
+			return handler(ctx, req)
+		}
+		if err := b.admit(info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		if info.FullMethod == proto.Inventory_CheckAvailability_FullMethodName {
+			release, err := b.acquireCheck(info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if isPublicMethod(info.FullMethod) {
+			b.observe(time.Since(start), err)
+		}
+		return resp, err
+	}
+}
+
+// brownoutStreamInterceptor sheds non-critical streams by the brownout level
+func brownoutStreamInterceptor(b *brownoutController) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if b == nil {
+			return handler(srv, ss)
+		}
+		if err := b.admit(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// errorDomain is the ErrorInfo domain of errors returned by the inventory service
+const errorDomain = "inventory.traffictacos.com"
+
+// ErrorInfo reasons. Clients branch on these rather than on messages, so they are part of
+// the API: add new ones freely but never rename them.
+const (
+	reasonInsufficientInventory = "INSUFFICIENT_INVENTORY"
+	reasonSeatAlreadySold       = "SEAT_ALREADY_SOLD"
+	reasonSeatAlreadyHeld       = "SEAT_ALREADY_HELD"
+	reasonSeatUnavailable       = "SEAT_UNAVAILABLE"
+	reasonConflict              = "CONFLICT"
+	reasonNotFound              = "NOT_FOUND"
+	reasonHoldExpired           = "HOLD_EXPIRED"
+	reasonIdempotencyConflict   = "IDEMPOTENCY_CONFLICT"
+	reasonInvalidRequest        = "INVALID_REQUEST"
+	reasonWritesDisabled        = "WRITES_DISABLED"
+	reasonPreconditionFailed    = "PRECONDITION_FAILED"
+	reasonDeadlineTooClose      = "DEADLINE_TOO_CLOSE"
+	reasonRateLimited           = "RATE_LIMITED"
+	reasonQuotaExceeded         = "QUOTA_EXCEEDED"
+	reasonVelocityLimitExceeded = "VELOCITY_LIMIT_EXCEEDED"
+	reasonOrderLimitExceeded    = "ORDER_LIMIT_EXCEEDED"
+	reasonThrottled             = "THROTTLED"
+	reasonItemTooLarge          = "ITEM_TOO_LARGE"
+	reasonInternal              = "INTERNAL"
+)
+
+// seatConflictReason returns the reason of a seat conflict by the stored status name the
+// seats were in
+func seatConflictReason(seatStatus string) string {
+	switch proto.SeatStatus(proto.SeatStatus_value["SEAT_STATUS_"+seatStatus]) {
+	case proto.SeatStatus_SEAT_STATUS_SOLD:
+		return reasonSeatAlreadySold
+	case proto.SeatStatus_SEAT_STATUS_HOLD:
+		return reasonSeatAlreadyHeld
+	default:
+		return reasonSeatUnavailable
+	}
+}
+
+// withErrorDetails returns st with an ErrorInfo detail of reason, an ErrorDetail with the
+// error code of err when it has one and, when err says when a retry may succeed, a
+// RetryInfo detail. ErrorInfo metadata names the event and the offending seats of seat
+// conflicts, comma-separated in seat_ids, and of canceled seat transactions, with the
+// reason of each seat in seat_reasons as "<seat_id>=<reason>" pairs.
+func withErrorDetails(st *status.Status, err error, reason string) *status.Status {
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+	var seatConflict *apperrors.SeatConflictError
+	var txFailed *apperrors.TransactionError
+	if errors.As(err, &seatConflict) {
+		info.Metadata = map[string]string{"event_id": seatConflict.EventID}
+		if len(seatConflict.SeatIDs) > 0 {
+			info.Metadata["seat_ids"] = strings.Join(seatConflict.SeatIDs, ",")
+		}
+	} else if errors.As(err, &txFailed) {
+		info.Metadata = map[string]string{"event_id": txFailed.EventID}
+		if len(txFailed.SeatReasons) > 0 {
+			seatIDs := slices.Sorted(maps.Keys(txFailed.SeatReasons))
+			seatReasons := make([]string, len(seatIDs))
+			for i, seatID := range seatIDs {
+				seatReasons[i] = seatID + "=" + txFailed.SeatReasons[seatID]
+			}
+			info.Metadata["seat_ids"] = strings.Join(seatIDs, ",")
+			info.Metadata["seat_reasons"] = strings.Join(seatReasons, ",")
+		}
+	}
+
+	details := []protoadapt.MessageV1{info}
+	if code := service.ErrorCode(err); code != proto.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		details = append(details, &proto.ErrorDetail{ErrorCode: code})
+	}
+	if delay, ok := apperrors.RetryDelay(err); ok && delay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	}
+
+	detailed, detailErr := st.WithDetails(details...)
+	if detailErr != nil {
+		return st
+	}
+	return detailed
+}
+
+// errorCodeOf returns the error code in the ErrorDetail of a status error, if any
+func errorCodeOf(err error) proto.ErrorCode {
+	for _, detail := range status.Convert(err).Details() {
+		if errorDetail, ok := detail.(*proto.ErrorDetail); ok {
+			return errorDetail.ErrorCode
+		}
+	}
+	return proto.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/traffictacos/inventory-api/proto"
+	inventoryv2 "github.com/traffictacos/inventory-api/proto/v2"
+)
+
+// isPublicMethod reports whether a full method name is a public Inventory RPC of either
+// API version
+func isPublicMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+proto.Inventory_ServiceDesc.ServiceName+"/") ||
+		strings.HasPrefix(fullMethod, "/"+inventoryv2.Inventory_ServiceDesc.ServiceName+"/")
+}
+
+// inventoryV2Server implements inventory.v2.Inventory on the v1 implementation, replacing
+// the string statuses of its responses with enums. Both versions are served until the
+// consumers have migrated.
+type inventoryV2Server struct {
+	inventoryv2.UnimplementedInventoryServer
+	v1 *inventoryServer
+}
+
+// CommitReservation implements the CommitReservation gRPC method
+func (s *inventoryV2Server) CommitReservation(ctx context.Context, req *proto.CommitReq) (*inventoryv2.CommitRes, error) {
+	resp, err := s.v1.CommitReservation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return commitResV2(resp), nil
+}
+
+// CommitReservationAsync implements the CommitReservationAsync gRPC method
+func (s *inventoryV2Server) CommitReservationAsync(ctx context.Context, req *proto.CommitReq) (*inventoryv2.CommitRes, error) {
+	resp, err := s.v1.CommitReservationAsync(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return commitResV2(resp), nil
+}
+
+// GetCommitStatus implements the GetCommitStatus gRPC method
+func (s *inventoryV2Server) GetCommitStatus(ctx context.Context, req *proto.GetCommitStatusReq) (*inventoryv2.GetCommitStatusRes, error) {
+	resp, err := s.v1.GetCommitStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv2.GetCommitStatusRes{
+		OrderId:   resp.OrderId,
+		Status:    commitStatusV2(resp.Status),
+		Error:     resp.Error,
+		UpdatedAt: resp.UpdatedAt,
+		ErrorCode: resp.ErrorCode,
+	}, nil
+}
+
+// ReleaseHold implements the ReleaseHold gRPC method
+func (s *inventoryV2Server) ReleaseHold(ctx context.Context, req *proto.ReleaseReq) (*inventoryv2.ReleaseRes, error) {
+	resp, err := s.v1.ReleaseHold(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv2.ReleaseRes{
+		Status: inventoryv2.ReleaseStatus(inventoryv2.ReleaseStatus_value["RELEASE_STATUS_"+resp.Status]),
+	}, nil
+}
+
+// HoldSeats implements the HoldSeats gRPC method
+func (s *inventoryV2Server) HoldSeats(ctx context.Context, req *proto.HoldReq) (*inventoryv2.HoldRes, error) {
+	resp, err := s.v1.HoldSeats(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return holdResV2(resp), nil
+}
+
+// ExtendHold implements the ExtendHold gRPC method
+func (s *inventoryV2Server) ExtendHold(ctx context.Context, req *proto.ExtendHoldReq) (*inventoryv2.HoldRes, error) {
+	resp, err := s.v1.ExtendHold(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return holdResV2(resp), nil
+}
+
+// SwapSeats implements the SwapSeats gRPC method
+func (s *inventoryV2Server) SwapSeats(ctx context.Context, req *proto.SwapSeatsReq) (*inventoryv2.SwapSeatsRes, error) {
+	resp, err := s.v1.SwapSeats(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv2.SwapSeatsRes{
+		Status:    seatStatusV2(resp.Status),
+		Lines:     resp.Lines,
+		ExpiresAt: resp.ExpiresAt,
+	}, nil
+}
+
+// MaterializeSeason implements the MaterializeSeason gRPC method
+func (s *inventoryV2Server) MaterializeSeason(ctx context.Context, req *proto.MaterializeSeasonReq) (*inventoryv2.MaterializeSeasonRes, error) {
+	resp, err := s.v1.MaterializeSeason(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv2.MaterializeSeasonRes{OrderId: resp.OrderId, Status: commitStatusV2(resp.Status)}, nil
+}
+
+// GetReservationStatus implements the GetReservationStatus gRPC method
+func (s *inventoryV2Server) GetReservationStatus(ctx context.Context, req *proto.GetReservationStatusReq) (*inventoryv2.GetReservationStatusRes, error) {
+	resp, err := s.v1.GetReservationStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &inventoryv2.GetReservationStatusRes{
+		ReservationId: resp.ReservationId,
+		Status:        inventoryv2.ReservationStatus(inventoryv2.ReservationStatus_value["RESERVATION_STATUS_"+resp.Status]),
+		OrderId:       resp.OrderId,
+		HeldSeats:     resp.HeldSeats,
+		SoldSeats:     resp.SoldSeats,
+	}, nil
+}
+
+// commitResV2 converts a v1 commit response
+func commitResV2(resp *proto.CommitRes) *inventoryv2.CommitRes {
+	return &inventoryv2.CommitRes{
+		OrderId:      resp.OrderId,
+		Status:       commitStatusV2(resp.Status),
+		CommittedQty: resp.CommittedQty,
+		Lines:        resp.Lines,
+	}
+}
+
+// holdResV2 converts a v1 hold response
+func holdResV2(resp *proto.HoldRes) *inventoryv2.HoldRes {
+	return &inventoryv2.HoldRes{
+		Status:              seatStatusV2(resp.Status),
+		ExpiresAt:           resp.ExpiresAt,
+		ExtensionsRemaining: resp.ExtensionsRemaining,
+	}
+}
+
+// commitStatusV2 returns the enum of a v1 commit status; unknown statuses are unspecified
+func commitStatusV2(status string) inventoryv2.CommitStatus {
+	return inventoryv2.CommitStatus(inventoryv2.CommitStatus_value["COMMIT_STATUS_"+status])
+}
+
+// seatStatusV2 returns the enum of a stored seat status name
+func seatStatusV2(status string) proto.SeatStatus {
+	return proto.SeatStatus(proto.SeatStatus_value["SEAT_STATUS_"+status])
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// loadSaturationTrailer carries the instance's saturation on public unary responses
+const loadSaturationTrailer = "x-load-saturation"
+
+// Saturation from which the load state is reported elevated or overloaded
+const (
+	loadElevatedSaturation   = 0.7
+	loadOverloadedSaturation = 0.9
+)
+
+// loadTracker measures the saturation of the instance from in-memory signals: public
+// RPCs in flight against the concurrency limit, the brownout level, the commit queue and
+// the rate of DynamoDB throttling, so gateways can apply backpressure before RPCs fail
+type loadTracker struct {
+	maxInFlight int
+	brownout    *brownoutController
+	commits     *service.CommitPool
+	service     *service.InventoryService
+
+	inFlight atomic.Int64
+
+	mu          sync.Mutex
+	windowStart time.Time
+	requests    int
+	throttled   int
+	// Counts of the last complete one-second window
+	lastRequests  int
+	lastThrottled int
+}
+
+// newLoadTracker creates a load tracker
+func newLoadTracker(cfg *appconfig.Config, brownout *brownoutController, commits *service.CommitPool, svc *service.InventoryService) *loadTracker {
+	return &loadTracker{
+		maxInFlight: cfg.Server.MaxConcurrency,
+		brownout:    brownout,
+		commits:     commits,
+		service:     svc,
+		windowStart: time.Now(),
+	}
+}
+
+// observe counts a finished public RPC in the current one-second window
+func (l *loadTracker) observe(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.roll(time.Now())
+	l.requests++
+	if err != nil && isThrottleError(status.Convert(err).Message()) {
+		l.throttled++
+	}
+}
+
+// roll starts a new window once the current one is a second old
+func (l *loadTracker) roll(now time.Time) {
+	elapsed := now.Sub(l.windowStart)
+	if elapsed < time.Second {
+		return
+	}
+	if elapsed < 2*time.Second {
+		l.lastRequests, l.lastThrottled = l.requests, l.throttled
+	} else {
+		// Nothing was observed in the last complete second
+		l.lastRequests, l.lastThrottled = 0, 0
+	}
+	l.windowStart = now
+	l.requests, l.throttled = 0, 0
+}
+
+// Status returns the current load of the instance
+func (l *loadTracker) Status() *proto.LoadStatus {
+	l.mu.Lock()
+	l.roll(time.Now())
+	requests, throttled := l.lastRequests, l.lastThrottled
+	l.mu.Unlock()
+
+	res := &proto.LoadStatus{
+		InFlight:           int32(l.inFlight.Load()),
+		MaxInFlight:        int32(l.maxInFlight),
+		BrownoutLevel:      int32(l.brownout.Level()),
+		CommitQueueFill:    l.commits.QueueFill(),
+		ThrottledPerSecond: float64(throttled),
+		Degraded:           l.service.InDegradedMode(),
+		Maintenance:        l.service.InMaintenance(),
+	}
+	if requests > 0 {
+		res.ThrottleRatio = float64(throttled) / float64(requests)
+	}
+
+	saturation := max(res.CommitQueueFill, res.ThrottleRatio, float64(res.BrownoutLevel)/brownoutCheckTight)
+	if l.maxInFlight > 0 {
+		saturation = max(saturation, float64(res.InFlight)/float64(l.maxInFlight))
+	}
+	if res.Degraded || res.Maintenance {
+		saturation = 1
+	}
+	res.Saturation = min(saturation, 1)
+
+	switch {
+	case res.Saturation >= loadOverloadedSaturation || res.BrownoutLevel > brownoutNormal:
+		res.State = proto.LoadState_LOAD_STATE_OVERLOADED
+	case res.Saturation >= loadElevatedSaturation:
+		res.State = proto.LoadState_LOAD_STATE_ELEVATED
+	default:
+		res.State = proto.LoadState_LOAD_STATE_NORMAL
+	}
+	return res
+}
+
+// loadUnaryInterceptor tracks public unary RPCs for the load status and attaches the
+// saturation to their trailers
+func loadUnaryInterceptor(l *loadTracker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l == nil || !isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		l.inFlight.Add(1)
+		resp, err := handler(ctx, req)
+		l.inFlight.Add(-1)
+		l.observe(err)
+
+		saturation := l.Status().Saturation
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(loadSaturationTrailer, strconv.FormatFloat(saturation, 'f', 2, 64)))
+		return resp, err
+	}
+}
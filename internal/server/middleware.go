@@ -0,0 +1,465 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/recording"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Built-in middleware names, usable in GRPC_INTERCEPTORS
+const (
+	MiddlewareRecovery = "recovery"
+	MiddlewareTimeout  = "timeout"
+	MiddlewareBaggage  = "baggage"
+	MiddlewareTracing  = "tracing"
+	MiddlewareMetrics  = "metrics"
+	MiddlewareLogging  = "logging"
+	// MiddlewareCostBudget meters DynamoDB capacity per RPC and enforces budgets when enabled
+	MiddlewareCostBudget = "cost_budget"
+	// MiddlewareRetryInfo tells clients of throttled requests how long to back off
+	MiddlewareRetryInfo = "retry_info"
+	// MiddlewareQuota enforces partner quotas when enabled
+	MiddlewareQuota = "quota"
+	// MiddlewareProfiling labels profile samples with the RPC method when profiling is enabled
+	MiddlewareProfiling = "profiling"
+	// MiddlewareBrownout sheds non-critical load under sustained overload when enabled
+	MiddlewareBrownout = "brownout"
+	// MiddlewareRecording records sampled public RPCs for replay when enabled
+	MiddlewareRecording = "recording"
+	// MiddlewareSlowLog logs unary RPCs slower than their threshold with their DynamoDB calls
+	MiddlewareSlowLog = "slow_log"
+	// MiddlewareLoad tracks public RPCs for GetLoadStatus and adds saturation trailers
+	MiddlewareLoad = "load"
+	// MiddlewareValidation rejects requests breaking the field rules declared in the proto
+	MiddlewareValidation = "validation"
+	// MiddlewareAuth requires one of GRPC_AUTH_TOKENS as bearer token on public RPCs
+	MiddlewareAuth = "auth"
+	// MiddlewareRateLimit bounds the public RPCs per second this instance serves
+	MiddlewareRateLimit = "rate_limit"
+)
+
+// Middleware is a named cross-cutting concern applied to every RPC.
+// Either interceptor may be nil if the middleware only applies to one RPC kind.
+type Middleware struct {
+	Name   string
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+// MiddlewareRegistry holds the available middlewares and composes them in a configured order
+type MiddlewareRegistry struct {
+	middlewares map[string]Middleware
+}
+
+// NewMiddlewareRegistry creates an empty middleware registry
+func NewMiddlewareRegistry() *MiddlewareRegistry {
+	return &MiddlewareRegistry{
+		middlewares: make(map[string]Middleware),
+	}
+}
+
+// Register adds a middleware to the registry, replacing any middleware with the same name
+func (r *MiddlewareRegistry) Register(m Middleware) {
+	r.middlewares[m.Name] = m
+}
+
+// ServerOptions builds chained interceptor options for the given order.
+// The first name in order is the outermost interceptor.
+func (r *MiddlewareRegistry) ServerOptions(order []string) ([]grpc.ServerOption, error) {
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+
+	for _, name := range order {
+		m, ok := r.middlewares[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown middleware: %s", name)
+		}
+		if m.Unary != nil {
+			unary = append(unary, m.Unary)
+		}
+		if m.Stream != nil {
+			stream = append(stream, m.Stream)
+		}
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}, nil
+}
+
+// newDefaultMiddlewareRegistry registers the built-in middlewares
+func newDefaultMiddlewareRegistry(cfg *appconfig.Config, metrics *observability.Metrics, quotas *service.QuotaEnforcer, brownout *brownoutController, recorder *recording.Recorder, load *loadTracker) *MiddlewareRegistry {
+	registry := NewMiddlewareRegistry()
+
+	registry.Register(Middleware{
+		Name:   MiddlewareRecovery,
+		Unary:  recoveryUnaryInterceptor,
+		Stream: recoveryStreamInterceptor,
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareTimeout,
+		Unary: timeoutUnaryInterceptor(cfg.Server.Timeout),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareBaggage,
+		Unary:  baggageUnaryInterceptor,
+		Stream: baggageStreamInterceptor,
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareTracing,
+		Unary:  tracingUnaryInterceptor(cfg.Observability.BaggageKeys),
+		Stream: tracingStreamInterceptor(cfg.Observability.BaggageKeys),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareMetrics,
+		Unary:  metricsUnaryInterceptor(metrics),
+		Stream: metricsStreamInterceptor(metrics),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareLoad,
+		Unary: loadUnaryInterceptor(load),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareProfiling,
+		Unary:  profilingUnaryInterceptor(cfg),
+		Stream: profilingStreamInterceptor(cfg),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareLogging,
+		Unary:  loggingUnaryInterceptor(cfg.Observability.BaggageKeys),
+		Stream: loggingStreamInterceptor(cfg.Observability.BaggageKeys),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareSlowLog,
+		Unary: slowLogUnaryInterceptor(cfg.Observability, observability.NewSlowRequestLog(nil)),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareCostBudget,
+		Unary: costBudgetUnaryInterceptor(cfg.CostBudget, metrics),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareRetryInfo,
+		Unary: retryInfoUnaryInterceptor(cfg.Server),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareQuota,
+		Unary: quotaUnaryInterceptor(quotas),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareBrownout,
+		Unary:  brownoutUnaryInterceptor(brownout),
+		Stream: brownoutStreamInterceptor(brownout),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareRecording,
+		Unary: recordingUnaryInterceptor(recorder),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareValidation,
+		Unary: validationUnaryInterceptor,
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareAuth,
+		Unary:  authUnaryInterceptor(cfg.Server.AuthTokens),
+		Stream: authStreamInterceptor(cfg.Server.AuthTokens),
+	})
+	limiter := newRateLimiter(cfg.Server.RateLimit, cfg.Server.RateLimitBurst)
+	registry.Register(Middleware{
+		Name:   MiddlewareRateLimit,
+		Unary:  rateLimitUnaryInterceptor(limiter),
+		Stream: rateLimitStreamInterceptor(limiter),
+	})
+	registry.Register(Middleware{
+		Name:   MiddlewareAdminAuth,
+		Unary:  adminAuthUnaryInterceptor(cfg.Admin.AuthToken),
+		Stream: adminAuthStreamInterceptor(cfg.Admin.AuthToken),
+	})
+	registry.Register(Middleware{
+		Name:  MiddlewareAdminTimeout,
+		Unary: timeoutUnaryInterceptor(cfg.Admin.Timeout),
+	})
+
+	return registry
+}
+
+// recoveryUnaryInterceptor converts handler panics into Internal errors
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Panic in %s: %v\n%s\n", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal server error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor converts stream handler panics into Internal errors
+func recoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("Panic in %s: %v\n%s\n", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal server error")
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// timeoutUnaryInterceptor caps the request deadline at the configured server timeout
+func timeoutUnaryInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Set timeout if not already set
+		if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > timeout {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// baggageUnaryInterceptor extracts the caller's trace context and baggage from request metadata,
+// so spans continue the caller's trace and requests can be tagged by baggage
+func baggageUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(observability.ExtractIncoming(ctx), req)
+}
+
+// baggageStreamInterceptor extracts the caller's trace context and baggage from stream metadata
+func baggageStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: observability.ExtractIncoming(ss.Context())})
+}
+
+// tracingUnaryInterceptor starts a server span for each unary RPC, tagged with the given baggage keys
+func tracingUnaryInterceptor(baggageKeys []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := observability.StartSpan(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("rpc.method", info.FullMethod)),
+			trace.WithAttributes(observability.BaggageAttributes(ctx, baggageKeys)...),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			observability.RecordError(ctx, err, fmt.Sprintf("rpc %s failed", info.FullMethod))
+		}
+		return resp, err
+	}
+}
+
+// tracingStreamInterceptor starts a server span for each streaming RPC, tagged with the given baggage keys
+func tracingStreamInterceptor(baggageKeys []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := observability.StartSpan(ss.Context(), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("rpc.method", info.FullMethod)),
+			trace.WithAttributes(observability.BaggageAttributes(ss.Context(), baggageKeys)...),
+		)
+		defer span.End()
+
+		err := handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+		if err != nil {
+			observability.RecordError(ctx, err, fmt.Sprintf("rpc %s failed", info.FullMethod))
+		}
+		return err
+	}
+}
+
+// metricsUnaryInterceptor records request counts, durations and in-flight requests
+func metricsUnaryInterceptor(metrics *observability.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		metrics.IncrementActiveRequests()
+		defer metrics.DecrementActiveRequests()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		metrics.RecordGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+		if code := errorCodeOf(err); code != proto.ErrorCode_ERROR_CODE_UNSPECIFIED {
+			metrics.RecordRequestFailure(info.FullMethod, code.String())
+		}
+		return resp, err
+	}
+}
+
+// metricsStreamInterceptor records stream counts, durations and in-flight streams
+func metricsStreamInterceptor(metrics *observability.Metrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		metrics.IncrementActiveRequests()
+		defer metrics.DecrementActiveRequests()
+
+		start := time.Now()
+		err := handler(srv, ss)
+		metrics.RecordGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
+
+// profilingUnaryInterceptor runs each unary RPC with profiler labels naming its method
+func profilingUnaryInterceptor(cfg *appconfig.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if !cfg.Profiling.Enabled {
+			return handler(ctx, req)
+		}
+		observability.WithProfileLabels(ctx, info.FullMethod, cfg.Observability.ServiceVersion, func(ctx context.Context) {
+			resp, err = handler(ctx, req)
+		})
+		return resp, err
+	}
+}
+
+// profilingStreamInterceptor runs each streaming RPC with profiler labels naming its method
+func profilingStreamInterceptor(cfg *appconfig.Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		if !cfg.Profiling.Enabled {
+			return handler(srv, ss)
+		}
+		observability.WithProfileLabels(ss.Context(), info.FullMethod, cfg.Observability.ServiceVersion, func(ctx context.Context) {
+			err = handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+		})
+		return err
+	}
+}
+
+// loggingUnaryInterceptor logs method, duration, error and baggage tags of each unary RPC
+func loggingUnaryInterceptor(baggageKeys []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		// Log request duration
+		duration := time.Since(start)
+		fmt.Printf("Method: %s, Duration: %v, Error: %v%s\n", info.FullMethod, duration, err, formatTags(ctx, baggageKeys))
+
+		return resp, err
+	}
+}
+
+// loggingStreamInterceptor logs method, duration, error and baggage tags of each streaming RPC
+func loggingStreamInterceptor(baggageKeys []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		duration := time.Since(start)
+		fmt.Printf("Stream: %s, Duration: %v, Error: %v%s\n", info.FullMethod, duration, err, formatTags(ss.Context(), baggageKeys))
+
+		return err
+	}
+}
+
+// slowLogUnaryInterceptor traces the DynamoDB calls of each unary RPC and logs the RPCs
+// that take longer than their method's threshold, along with every call
+func slowLogUnaryInterceptor(cfg appconfig.ObservabilityConfig, slowLog *observability.SlowRequestLog) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		threshold := cfg.SlowRequestThreshold
+		if methodThreshold, ok := cfg.SlowRequestMethodThresholds[path.Base(info.FullMethod)]; ok {
+			threshold = methodThreshold
+		}
+		if threshold <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, calls := repo.WithCallTrace(ctx)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(start)
+		if duration <= threshold {
+			return resp, err
+		}
+
+		traced, dropped := calls.Calls()
+		record := observability.SlowRequestRecord{
+			Method:        info.FullMethod,
+			Code:          status.Code(err).String(),
+			DurationMs:    milliseconds(duration),
+			ThresholdMs:   milliseconds(threshold),
+			DynamoDBCalls: make([]observability.SlowDynamoDBCall, len(traced)),
+			DroppedCalls:  dropped,
+			Tags:          observability.BaggageTags(ctx, cfg.BaggageKeys),
+		}
+		for i, call := range traced {
+			record.ReadUnits += call.ReadUnits
+			record.WriteUnits += call.WriteUnits
+			record.DynamoDBCalls[i] = observability.SlowDynamoDBCall{
+				Operation:  call.Operation,
+				Table:      call.Table,
+				DurationMs: milliseconds(call.Duration),
+				ReadUnits:  call.ReadUnits,
+				WriteUnits: call.WriteUnits,
+			}
+			if call.Err != nil {
+				record.DynamoDBCalls[i].Error = call.Err.Error()
+			}
+		}
+		slowLog.Record(record)
+
+		return resp, err
+	}
+}
+
+// milliseconds returns d in fractional milliseconds, for log fields
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// formatTags formats baggage tags of a request as ", key: value" log fields in key order
+func formatTags(ctx context.Context, baggageKeys []string) string {
+	tags := observability.BaggageTags(ctx, baggageKeys)
+	var b strings.Builder
+	for _, key := range baggageKeys {
+		if value, ok := tags[key]; ok {
+			fmt.Fprintf(&b, ", %s: %s", key, value)
+		}
+	}
+	return b.String()
+}
+
+// costBudgetUnaryInterceptor meters the DynamoDB capacity each unary RPC consumes.
+// With budgets enabled, DynamoDB calls that would exceed them fail and the RPC is rejected.
+func costBudgetUnaryInterceptor(cfg appconfig.CostBudgetConfig, metrics *observability.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var readBudget, writeBudget float64
+		if cfg.Enabled {
+			readBudget, writeBudget = cfg.ReadUnits, cfg.WriteUnits
+		}
+		ctx, meter := repo.WithCostMeter(ctx, readBudget, writeBudget)
+
+		resp, err := handler(ctx, req)
+
+		read, write := meter.Consumed()
+		metrics.RecordRequestCapacity(info.FullMethod, read, write)
+		if err != nil && strings.Contains(err.Error(), "request cost budget exceeded") {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return resp, err
+	}
+}
+
+// wrappedServerStream overrides the context of a server stream
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the wrapped context
+func (w *wrappedServerStream) Context() context.Context {
+	return w.ctx
+}
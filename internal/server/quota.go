@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// quotaUnaryInterceptor counts every public RPC against the caller's request quota and
+// commits against its committed seat quota, rejecting requests over quota with
+// RESOURCE_EXHAUSTED until the window ends. Health checks and admin RPCs are never counted.
+func quotaUnaryInterceptor(quotas *service.QuotaEnforcer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if quotas == nil || strings.HasPrefix(info.FullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+
+		release, err := quotas.Acquire(ctx, committedSeats(req))
+		if err != nil {
+			st := withErrorDetails(status.New(codes.ResourceExhausted, err.Error()), err, reasonQuotaExceeded)
+			return nil, withRetryInfo(ctx, st, quotas.RetryAfter())
+		}
+
+		resp, err := handler(ctx, req)
+		release(err == nil)
+		return resp, err
+	}
+}
+
+// committedSeats returns the seats and quantities a request commits, counted against the seat quota
+func committedSeats(req interface{}) int {
+	commit, ok := req.(*proto.CommitReq)
+	if !ok {
+		return 0
+	}
+
+	seats := len(commit.SeatIds) + int(commit.Qty)
+	for _, sectionQty := range commit.SectionQtys {
+		seats += int(sectionQty.Qty)
+	}
+	for _, line := range commit.LineItems {
+		seats += len(line.SeatIds) + int(line.Qty)
+		for _, sectionQty := range line.SectionQtys {
+			seats += int(sectionQty.Qty)
+		}
+	}
+	return seats
+}
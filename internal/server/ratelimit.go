@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rateLimiter is a token bucket bounding the public RPCs of this instance. Unlike quotas,
+// which bound each caller across instances, it protects the instance from all callers.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates a limiter admitting rate RPCs per second with bursts of up to
+// burst, at least one second's worth; nil if rate isn't positive
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	size := max(float64(burst), math.Ceil(rate))
+	return &rateLimiter{rate: rate, burst: size, tokens: size, last: time.Now()}
+}
+
+// allow takes a token, or returns how long until one is available
+func (l *rateLimiter) allow() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens < 1 {
+		return time.Duration((1 - l.tokens) / l.rate * float64(time.Second)), false
+	}
+	l.tokens--
+	return 0, true
+}
+
+// admit rejects a public RPC with RESOURCE_EXHAUSTED while the limiter is out of tokens.
+// Health checks, reflection and admin RPCs are never limited.
+func (l *rateLimiter) admit(ctx context.Context, fullMethod string) error {
+	if l == nil || !isPublicMethod(fullMethod) {
+		return nil
+	}
+	wait, ok := l.allow()
+	if ok {
+		return nil
+	}
+	err := fmt.Errorf("rate limited: instance serves at most %g requests per second", l.rate)
+	return withRetryInfo(ctx, withErrorDetails(status.New(codes.ResourceExhausted, err.Error()), err, reasonRateLimited), wait)
+}
+
+// rateLimitUnaryInterceptor bounds the unary public RPCs per second
+func rateLimitUnaryInterceptor(limiter *rateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limiter.admit(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor bounds the public streams opened per second
+func rateLimitStreamInterceptor(limiter *rateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limiter.admit(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
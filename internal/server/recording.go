@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/traffictacos/inventory-api/internal/recording"
+)
+
+// recordingUnaryInterceptor records a sample of public RPCs, rejected ones included, so
+// production traffic can be replayed against staging. Admin and health RPCs are never recorded.
+func recordingUnaryInterceptor(recorder *recording.Recorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		message, ok := req.(protobuf.Message)
+		if !ok || !isPublicMethod(info.FullMethod) || !recorder.Sampled() {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		recorder.Record(info.FullMethod, message, start, time.Since(start), status.Code(err).String())
+		return resp, err
+	}
+}
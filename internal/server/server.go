@@ -2,21 +2,34 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"slices"
 	"strings"
-	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
+	"github.com/traffictacos/inventory-api/internal/cache"
 	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/gateway"
+	"github.com/traffictacos/inventory-api/internal/grpcweb"
+	"github.com/traffictacos/inventory-api/internal/notify"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/recording"
 	"github.com/traffictacos/inventory-api/internal/repo"
 	"github.com/traffictacos/inventory-api/internal/service"
+	"github.com/traffictacos/inventory-api/internal/streams"
 	"github.com/traffictacos/inventory-api/proto"
+	inventoryv2 "github.com/traffictacos/inventory-api/proto/v2"
 )
 
 // Server represents the gRPC server
@@ -25,45 +38,355 @@ type Server struct {
 	server   *grpc.Server
 	listener net.Listener
 	service  *service.InventoryService
+	metrics  *observability.Metrics
+	// repository's DynamoDB connections are prewarmed on Start
+	repository *repo.DynamoDBRepository
+
+	// adminServer serves InventoryAdmin on a separate listener; nil when disabled
+	adminServer   *grpc.Server
+	adminListener net.Listener
+	// adminHTTP serves the web UI next to adminServer; nil when disabled
+	adminHTTP *http.Server
+
+	// gateway serves Inventory over HTTP/JSON; nil when disabled
+	gateway *gateway.Gateway
+	// grpcWeb serves Inventory to browsers over gRPC-Web; nil when disabled
+	grpcWeb *grpcweb.Server
+
+	// Background workers run until Stop cancels them
+	stuckHolds       *service.StuckHoldMonitor
+	holdExpiry       *streams.HoldExpiryProcessor
+	reconciler       *service.AvailabilityReconciler
+	anomalies        *service.AnomalyDetector
+	commits          *service.CommitPool
+	operations       *service.OperationRunner
+	reservations     *service.ReservationEventConsumer
+	replica          *service.SeatReplica
+	changes          *streams.ChangeFeed
+	idempotency      *service.IdempotencyCleaner
+	counter          *cache.AvailabilityCounter
+	quotas           *service.QuotaEnforcer
+	velocity         *service.VelocityLimiter
+	brownout         *brownoutController
+	recorder         *recording.Recorder
+	emf              *observability.EMFEmitter
+	health           *health.Server
+	healthProbes     *service.HealthMonitor
+	cancelBackground context.CancelFunc
 }
 
 // NewServer creates a new gRPC server
 func NewServer(cfg *appconfig.Config) (*Server, error) {
+	metrics := observability.NewMetrics()
+	emf := observability.NewEMFEmitter(cfg)
+	metrics.UseEMF(emf)
+
 	// Create repository
-	repository, err := repo.NewDynamoDBRepository(cfg)
+	repository, err := repo.NewDynamoDBRepository(cfg, metrics)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create repository: %w", err)
 	}
 
+	// Published messages carry their schema version when a schema registry is configured;
+	// an incompatible schema change fails startup rather than reaching consumers
+	schemas := notify.NewSchemaRegistry(cfg)
+	if err := schemas.Register(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to register message schemas: %w", err)
+	}
+
+	// Restock notifications are disabled when no topic is configured
+	restockPublisher, err := notify.NewRestockPublisher(cfg, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create restock publisher: %w", err)
+	}
+	restock := service.NewRestockNotifier(repository, restockPublisher)
+
+	// Availability checks are served from Redis when enabled
+	var counter *cache.AvailabilityCounter
+	if cfg.Redis.Enabled {
+		counter = cache.NewAvailabilityCounter(cfg)
+	}
+
+	// Abuse signals are only collected when anomaly detection is enabled
+	anomalies := service.NewAnomalyDetector(cfg, metrics)
+
+	// Commits run on a bounded worker pool when enabled
+	commits := service.NewCommitPool(cfg, metrics)
+
+	// Seat checks of flash-sale events are served from memory when configured
+	replica := service.NewSeatReplica(repository, metrics, cfg)
+
+	// Identical commits and releases arriving within a short window share one execution
+	deduper := service.NewRequestDeduper(cfg, metrics)
+
+	// Orders per buyer and event are limited when velocity limits are enabled
+	velocity := service.NewVelocityLimiter(cfg, metrics)
+
 	// Create service
-	svc := service.NewInventoryService(repository, cfg)
+	svc := service.NewInventoryService(repository, cfg, metrics, restock, counter, anomalies, commits, replica, deduper, velocity)
+
+	// Partner quotas are only enforced when enabled
+	quotas := service.NewQuotaEnforcer(cfg, metrics)
+
+	// Non-critical load is shed under sustained overload when brownout is enabled
+	brownout := newBrownoutController(cfg, metrics)
+
+	// Sampled public RPCs are recorded for replay when a bucket is configured
+	recorder, err := recording.NewRecorder(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request recorder: %w", err)
+	}
+
+	// Saturation signals are reported to gateways for backpressure
+	load := newLoadTracker(cfg, brownout, commits, svc)
+
+	// Compose interceptors in the configured order
+	middlewares := newDefaultMiddlewareRegistry(cfg, metrics, quotas, brownout, recorder, load)
+	interceptorOpts, err := middlewares.ServerOptions(cfg.Server.Interceptors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build interceptor chain: %w", err)
+	}
 
 	// Create gRPC server with interceptors
-	server := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor),
+	opts := append(interceptorOpts,
 		grpc.MaxConcurrentStreams(uint32(cfg.Server.MaxConcurrency)),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Time:    cfg.Server.KeepAlivePeriod,
 			Timeout: cfg.Server.Timeout,
 		}),
 	)
+	server := grpc.NewServer(opts...)
 
 	// Register services
-	inventoryServer := &inventoryServer{service: svc}
+	// Sibling services subscribe to inventory changes when the change feed is enabled
+	changes := streams.NewChangeFeed(repository, cfg)
+	inventoryServer := &inventoryServer{service: svc, changes: changes, load: load}
 	proto.RegisterInventoryServer(server, inventoryServer)
+	inventoryv2.RegisterInventoryServer(server, &inventoryV2Server{v1: inventoryServer})
+
+	// Health checks and watches reflect dependency probes when probing is enabled
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 
 	// Enable reflection for debugging
 	reflection.Register(server)
 
-	return &Server{
-		config:  cfg,
-		server:  server,
-		service: svc,
-	}, nil
+	// Reservation lifecycle events drive holds when a queue is configured
+	reservationEvents, err := notify.NewReservationEventQueue(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reservation event queue: %w", err)
+	}
+
+	// Background jobs share one scanner and its read capacity budget
+	scanner := repository.NewTableScanner(cfg)
+	stuckHolds := service.NewStuckHoldMonitor(repository, scanner, metrics, restock, svc, cfg)
+
+	// Reclaimed holds are announced when a hold events topic is configured
+	holdEvents, err := notify.NewHoldEventPublisher(cfg, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hold event publisher: %w", err)
+	}
+
+	// Drifted remaining counters of seat events are corrected when read-repair is enabled
+	audit := observability.NewAuditLog(nil)
+	repairer := service.NewCounterRepairer(repository, metrics, audit, cfg)
+
+	srv := &Server{
+		config:       cfg,
+		server:       server,
+		service:      svc,
+		metrics:      metrics,
+		repository:   repository,
+		stuckHolds:   stuckHolds,
+		holdExpiry:   streams.NewHoldExpiryProcessor(repository, restock, counter, holdEvents, svc.Stats(), cfg),
+		counter:      counter,
+		quotas:       quotas,
+		velocity:     velocity,
+		brownout:     brownout,
+		recorder:     recorder,
+		emf:          emf,
+		health:       healthServer,
+		healthProbes: service.NewHealthMonitor(healthServer, repository, svc, counter, quotas, metrics, cfg),
+		anomalies:    anomalies,
+		commits:      commits,
+		reservations: service.NewReservationEventConsumer(svc, reservationEvents, metrics, cfg),
+		replica:      replica,
+		changes:      changes,
+		idempotency:  service.NewIdempotencyCleaner(repository, scanner, metrics, cfg),
+	}
+	if counter != nil {
+		srv.reconciler = service.NewAvailabilityReconciler(repository, counter, repairer, cfg)
+	}
+
+	// Admin RPCs are never registered on the public server
+	if cfg.Admin.Enabled {
+		srv.operations = service.NewOperationRunner(repository)
+		adminSvc := service.NewAdminService(repository, svc, stuckHolds, repairer, srv.operations, audit, cfg)
+		srv.adminServer, err = newAdminServer(cfg, middlewares, adminSvc)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Admin.UIEnabled {
+			srv.adminHTTP, err = newAdminHTTPServer(&adminUI{
+				token:     cfg.Admin.AuthToken,
+				timeout:   cfg.Admin.Timeout,
+				admin:     adminSvc,
+				inventory: svc,
+				audit:     audit,
+				load:      load,
+				health:    healthServer,
+				workers:   srv.backgroundWorkers(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create admin UI: %w", err)
+			}
+		}
+	}
+
+	// Tools that can't speak gRPC reach Inventory through the HTTP/JSON gateway
+	if cfg.Gateway.Enabled {
+		srv.gateway, err = gateway.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Browser frontends call Inventory directly over gRPC-Web
+	if cfg.GrpcWeb.Enabled {
+		srv.grpcWeb = grpcweb.New(cfg, server)
+	}
+
+	return srv, nil
+}
+
+// newAdminServer creates the gRPC server for the admin listener
+func newAdminServer(cfg *appconfig.Config, middlewares *MiddlewareRegistry, adminSvc *service.AdminService) (*grpc.Server, error) {
+	if cfg.Admin.AuthToken == "" {
+		return nil, fmt.Errorf("admin listener requires ADMIN_AUTH_TOKEN")
+	}
+
+	// Authentication always runs first, outside the configurable chain, so no interceptor
+	// order can expose admin RPCs; admin_auth in ADMIN_GRPC_INTERCEPTORS is ignored
+	order := slices.DeleteFunc(slices.Clone(cfg.Admin.Interceptors), func(name string) bool {
+		return name == MiddlewareAdminAuth
+	})
+	interceptorOpts, err := middlewares.ServerOptions(order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build admin interceptor chain: %w", err)
+	}
+
+	server := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(adminAuthUnaryInterceptor(cfg.Admin.AuthToken)),
+		grpc.ChainStreamInterceptor(adminAuthStreamInterceptor(cfg.Admin.AuthToken)),
+	}, interceptorOpts...)...)
+	proto.RegisterInventoryAdminServer(server, &adminServer{service: adminSvc})
+	reflection.Register(server)
+
+	return server, nil
 }
 
 // Start starts the gRPC server
 func (s *Server) Start() error {
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	s.cancelBackground = cancel
+
+	if s.config.Holds.StuckScanEnabled {
+		go s.stuckHolds.Run(backgroundCtx)
+	}
+	if s.config.Holds.ExpiryStreamEnabled {
+		go s.holdExpiry.Run(backgroundCtx)
+	}
+	if s.reconciler != nil {
+		go s.reconciler.Run(backgroundCtx)
+	}
+	if s.anomalies != nil {
+		go s.anomalies.Run(backgroundCtx)
+	}
+	if s.commits != nil {
+		go s.commits.Run(backgroundCtx)
+	}
+	if s.operations != nil {
+		go s.operations.Run(backgroundCtx)
+	}
+	if s.reservations != nil {
+		go s.reservations.Run(backgroundCtx)
+	}
+	if s.replica != nil {
+		go s.replica.Run(backgroundCtx)
+	}
+	if s.changes != nil {
+		go s.changes.Run(backgroundCtx)
+	}
+	if s.idempotency != nil {
+		go s.idempotency.Run(backgroundCtx)
+	}
+	if s.healthProbes != nil {
+		go s.healthProbes.Run(backgroundCtx)
+	}
+	if s.brownout != nil {
+		go s.brownout.Run(backgroundCtx)
+	}
+	if s.recorder != nil {
+		go s.recorder.Run(backgroundCtx)
+	}
+	if s.emf != nil {
+		go s.emf.Run(backgroundCtx)
+	}
+	if s.config.Profiling.Enabled {
+		go func() {
+			if err := observability.StartProfilingServer(s.config); err != nil {
+				fmt.Printf("Warning: profiling server stopped: %v\n", err)
+			}
+		}()
+	}
+
+	if s.adminServer != nil {
+		adminAddr := fmt.Sprintf("%s:%d", s.config.Admin.Host, s.config.Admin.Port)
+		adminListener, err := net.Listen("tcp", adminAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on admin address %s: %w", adminAddr, err)
+		}
+
+		s.adminListener = adminListener
+		go s.serveAdmin(adminListener)
+	}
+
+	if s.gateway != nil {
+		gatewayListener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.Gateway.Port))
+		if err != nil {
+			return fmt.Errorf("failed to listen on gateway port %d: %w", s.config.Gateway.Port, err)
+		}
+
+		go func() {
+			if err := s.gateway.Serve(gatewayListener); err != nil {
+				fmt.Printf("Gateway stopped: %v\n", err)
+			}
+		}()
+	}
+
+	// DynamoDB connections are opened and hot events preloaded before the public listener
+	// accepts traffic
+	warmupCtx, cancelWarmup := context.WithTimeout(backgroundCtx, s.config.Warmup.Timeout)
+	if !s.config.DynamoDB.StubBackend {
+		s.repository.PrewarmConnections(warmupCtx, s.config.DynamoDB.HTTP.PrewarmConns)
+	}
+	s.service.WarmUp(warmupCtx, s.config.Warmup.Events, s.config.Warmup.Concurrency)
+	cancelWarmup()
+
+	if s.grpcWeb != nil {
+		grpcWebListener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.GrpcWeb.Port))
+		if err != nil {
+			return fmt.Errorf("failed to listen on gRPC-Web port %d: %w", s.config.GrpcWeb.Port, err)
+		}
+
+		go func() {
+			if err := s.grpcWeb.Serve(grpcWebListener); err != nil {
+				fmt.Printf("gRPC-Web server stopped: %v\n", err)
+			}
+		}()
+	}
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.Server.Port))
 	if err != nil {
 		return fmt.Errorf("failed to listen on port %d: %w", s.config.Server.Port, err)
@@ -75,47 +398,75 @@ func (s *Server) Start() error {
 
 // Stop stops the gRPC server gracefully
 func (s *Server) Stop(ctx context.Context) error {
+	// Watchers see NOT_SERVING before the listener drains
+	s.health.Shutdown()
+
+	if s.counter != nil {
+		defer s.counter.Close()
+	}
+	if s.quotas != nil {
+		defer s.quotas.Close()
+	}
+	if s.velocity != nil {
+		defer s.velocity.Close()
+	}
+	// Background workers such as the commit pool serve the requests still draining, so
+	// they stop only once the servers are done, before the clients they use are closed
+	if s.cancelBackground != nil {
+		defer s.cancelBackground()
+	}
+	// The gateway drains first, while the gRPC server still answers its calls
+	if s.gateway != nil {
+		if err := s.gateway.Shutdown(ctx); err != nil {
+			fmt.Printf("Warning: failed to shut down gateway: %v\n", err)
+		}
+	}
+
+	// gRPC-Web calls must be over before GracefulStop, which can't drain them
+	if s.grpcWeb != nil {
+		if err := s.grpcWeb.Shutdown(ctx); err != nil {
+			fmt.Printf("Warning: failed to shut down gRPC-Web server: %v\n", err)
+		}
+	}
+	if s.adminHTTP != nil {
+		if err := s.adminHTTP.Shutdown(ctx); err != nil {
+			fmt.Printf("Warning: failed to shut down admin UI: %v\n", err)
+		}
+	}
+
+	servers := []*grpc.Server{s.server}
+	if s.adminServer != nil {
+		servers = append(servers, s.adminServer)
+	}
+
 	done := make(chan struct{})
 
 	go func() {
-		s.server.GracefulStop()
+		for _, server := range servers {
+			server.GracefulStop()
+		}
 		close(done)
 	}()
 
 	select {
 	case <-done:
-		return nil
 	case <-ctx.Done():
-		s.server.Stop()
+		for _, server := range servers {
+			server.Stop()
+		}
 		return ctx.Err()
 	}
-}
-
-// unaryInterceptor provides common unary interceptor functionality
-func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Set timeout if not already set
-	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > 250*time.Millisecond {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, 250*time.Millisecond)
-		defer cancel()
-	}
-
-	// Add tracing/observability here if needed
-	start := time.Now()
-
-	resp, err := handler(ctx, req)
-
-	// Log request duration
-	duration := time.Since(start)
-	fmt.Printf("Method: %s, Duration: %v, Error: %v\n", info.FullMethod, duration, err)
 
-	return resp, err
+	// The writes of drained requests reach the mirror tables before the process exits
+	return s.repository.FlushMirror(ctx)
 }
 
 // inventoryServer implements the Inventory gRPC service
 type inventoryServer struct {
 	proto.UnimplementedInventoryServer
 	service *service.InventoryService
+	changes *streams.ChangeFeed
+	load    *loadTracker
 }
 
 // CheckAvailability implements the CheckAvailability gRPC method
@@ -145,25 +496,229 @@ func (s *inventoryServer) ReleaseHold(ctx context.Context, req *proto.ReleaseReq
 	return resp, nil
 }
 
-// mapErrorToGRPC maps service errors to appropriate gRPC status codes
+// HoldSeats implements the HoldSeats gRPC method
+func (s *inventoryServer) HoldSeats(ctx context.Context, req *proto.HoldReq) (*proto.HoldRes, error) {
+	resp, err := s.service.HoldSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// ExtendHold implements the ExtendHold gRPC method
+func (s *inventoryServer) ExtendHold(ctx context.Context, req *proto.ExtendHoldReq) (*proto.HoldRes, error) {
+	resp, err := s.service.ExtendHold(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetReservationStatus implements the GetReservationStatus gRPC method
+func (s *inventoryServer) GetReservationStatus(ctx context.Context, req *proto.GetReservationStatusReq) (*proto.GetReservationStatusRes, error) {
+	resp, err := s.service.GetReservationStatus(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// ListSeats implements the ListSeats gRPC method
+func (s *inventoryServer) ListSeats(ctx context.Context, req *proto.ListSeatsReq) (*proto.ListSeatsRes, error) {
+	resp, err := s.service.ListSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetAdjacentAvailable implements the GetAdjacentAvailable gRPC method
+func (s *inventoryServer) GetAdjacentAvailable(ctx context.Context, req *proto.GetAdjacentAvailableReq) (*proto.GetAdjacentAvailableRes, error) {
+	resp, err := s.service.GetAdjacentAvailable(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// SubscribeChanges implements the SubscribeChanges gRPC method
+func (s *inventoryServer) SubscribeChanges(req *proto.SubscribeChangesReq, stream proto.Inventory_SubscribeChangesServer) error {
+	if err := s.changes.Subscribe(stream.Context(), req, stream.Send); err != nil {
+		return mapErrorToGRPC(err)
+	}
+	return nil
+}
+
+// GetEventInventory implements the GetEventInventory gRPC method
+func (s *inventoryServer) GetEventInventory(ctx context.Context, req *proto.GetEventInventoryReq) (*proto.EventInventory, error) {
+	resp, err := s.service.GetEventInventory(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// CommitReservationAsync implements the CommitReservationAsync gRPC method
+func (s *inventoryServer) CommitReservationAsync(ctx context.Context, req *proto.CommitReq) (*proto.CommitRes, error) {
+	resp, err := s.service.CommitReservationAsync(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetCommitStatus implements the GetCommitStatus gRPC method
+func (s *inventoryServer) GetCommitStatus(ctx context.Context, req *proto.GetCommitStatusReq) (*proto.GetCommitStatusRes, error) {
+	resp, err := s.service.GetCommitStatus(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// AllocateSeason implements the AllocateSeason gRPC method
+func (s *inventoryServer) AllocateSeason(ctx context.Context, req *proto.AllocateSeasonReq) (*proto.SeasonAllocation, error) {
+	resp, err := s.service.AllocateSeason(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// MaterializeSeason implements the MaterializeSeason gRPC method
+func (s *inventoryServer) MaterializeSeason(ctx context.Context, req *proto.MaterializeSeasonReq) (*proto.MaterializeSeasonRes, error) {
+	resp, err := s.service.MaterializeSeason(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// ReleaseSeason implements the ReleaseSeason gRPC method
+func (s *inventoryServer) ReleaseSeason(ctx context.Context, req *proto.ReleaseSeasonReq) (*proto.SeasonAllocation, error) {
+	resp, err := s.service.ReleaseSeason(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// BatchCheckAvailability implements the BatchCheckAvailability gRPC method
+func (s *inventoryServer) BatchCheckAvailability(ctx context.Context, req *proto.BatchCheckAvailabilityReq) (*proto.BatchCheckAvailabilityRes, error) {
+	resp, errs, err := s.service.BatchCheckAvailability(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	// Failed events get the code their error would have had as a CheckAvailability error
+	for i, result := range resp.Results {
+		if errs[i] != nil {
+			code, _ := classifyError(errs[i])
+			result.Result.Code = int32(code)
+		}
+	}
+	return resp, nil
+}
+
+// PreauthorizeCommit implements the PreauthorizeCommit gRPC method
+func (s *inventoryServer) PreauthorizeCommit(ctx context.Context, req *proto.CommitReq) (*proto.PreauthorizeCommitRes, error) {
+	resp, err := s.service.PreauthorizeCommit(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// GetLoadStatus implements the GetLoadStatus gRPC method
+func (s *inventoryServer) GetLoadStatus(ctx context.Context, req *proto.GetLoadStatusReq) (*proto.LoadStatus, error) {
+	return s.load.Status(), nil
+}
+
+// SwapSeats implements the SwapSeats gRPC method
+func (s *inventoryServer) SwapSeats(ctx context.Context, req *proto.SwapSeatsReq) (*proto.SwapSeatsRes, error) {
+	resp, err := s.service.SwapSeats(ctx, req)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+	return resp, nil
+}
+
+// mapErrorToGRPC maps service errors to appropriate gRPC status codes. Domain errors are
+// mapped by kind; other errors still fall back to their messages. The status carries an
+// ErrorInfo detail with the reason (see errorDetails) so clients don't parse messages.
 func mapErrorToGRPC(err error) error {
 	if err == nil {
 		return nil
 	}
+	code, reason := classifyError(err)
+	return withErrorDetails(status.New(code, err.Error()), err, reason).Err()
+}
 
-	switch err.Error() {
-	case "insufficient inventory", "seat not available", "one or more seats are not available":
-		return status.Error(codes.Aborted, err.Error())
-	case "inventory not found", "seat not found":
-		return status.Error(codes.NotFound, err.Error())
-	default:
-		// Check for specific error patterns
-		if strings.Contains(err.Error(), "insufficient") || strings.Contains(err.Error(), "not available") {
-			return status.Error(codes.Aborted, err.Error())
+// classifyError returns the gRPC code and ErrorInfo reason of a service error
+func classifyError(err error) (codes.Code, string) {
+	var seatConflict *apperrors.SeatConflictError
+	var txFailed *apperrors.TransactionError
+	switch {
+	case errors.Is(err, apperrors.ErrInsufficientInventory):
+		return codes.Aborted, reasonInsufficientInventory
+	case errors.As(err, &seatConflict):
+		return codes.Aborted, seatConflictReason(seatConflict.Status)
+	case errors.Is(err, apperrors.ErrSeatConflict):
+		return codes.Aborted, reasonSeatUnavailable
+	case errors.Is(err, apperrors.ErrNotFound):
+		return codes.NotFound, reasonNotFound
+	case errors.Is(err, apperrors.ErrHoldExpired):
+		return codes.FailedPrecondition, reasonHoldExpired
+	case errors.Is(err, apperrors.ErrIdempotencyConflict):
+		return codes.FailedPrecondition, reasonIdempotencyConflict
+	case errors.Is(err, apperrors.ErrVelocityLimitExceeded):
+		return codes.ResourceExhausted, reasonVelocityLimitExceeded
+	case errors.Is(err, apperrors.ErrOrderLimitExceeded):
+		return codes.InvalidArgument, reasonOrderLimitExceeded
+	case errors.Is(err, apperrors.ErrEventNotOnSale), errors.Is(err, apperrors.ErrLimitExceeded):
+		return codes.FailedPrecondition, reasonPreconditionFailed
+	case errors.Is(err, apperrors.ErrVersionConflict):
+		return codes.Aborted, reasonConflict
+	case errors.Is(err, apperrors.ErrUnavailable):
+		return codes.Unavailable, reasonWritesDisabled
+	case errors.Is(err, apperrors.ErrDeadlineTooClose):
+		return codes.DeadlineExceeded, reasonDeadlineTooClose
+	case errors.Is(err, apperrors.ErrOverloaded):
+		return codes.ResourceExhausted, reasonRateLimited
+	case errors.As(err, &txFailed):
+		switch txFailed.Reason {
+		case apperrors.TxReasonThrottled:
+			return codes.ResourceExhausted, reasonThrottled
+		case apperrors.TxReasonTransactionConflict:
+			return codes.Aborted, reasonConflict
+		case apperrors.TxReasonItemTooLarge:
+			return codes.FailedPrecondition, reasonItemTooLarge
 		}
-		if strings.Contains(err.Error(), "not found") {
-			return status.Error(codes.NotFound, err.Error())
-		}
-		return status.Error(codes.Internal, err.Error())
+		return codes.Internal, reasonInternal
+	}
+
+	msg := err.Error()
+	if strings.HasPrefix(msg, "invalid request") {
+		return codes.InvalidArgument, reasonInvalidRequest
+	}
+	if strings.Contains(msg, "hold expired") {
+		return codes.FailedPrecondition, reasonHoldExpired
+	}
+	if strings.Contains(msg, "precondition failed") {
+		return codes.FailedPrecondition, reasonPreconditionFailed
+	}
+
+	if strings.Contains(msg, "rate limited") || strings.Contains(msg, "cost budget exceeded") {
+		return codes.ResourceExhausted, reasonRateLimited
+	}
+	if isThrottleError(msg) {
+		return codes.ResourceExhausted, reasonThrottled
+	}
+
+	if strings.Contains(msg, "insufficient") || strings.Contains(msg, "not available") || strings.Contains(msg, "conflict") {
+		return codes.Aborted, reasonConflict
+	}
+	if strings.Contains(msg, "not found") {
+		return codes.NotFound, reasonNotFound
 	}
+	return codes.Internal, reasonInternal
 }
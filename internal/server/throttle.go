@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+)
+
+// retryAfterHeader carries the retry delay in whole seconds for HTTP gateways,
+// which translate it into a Retry-After response header
+const retryAfterHeader = "retry-after"
+
+// throttleErrorCodes are the DynamoDB error codes returned when a table or the account is
+// throttled, and the cancellation reason code of throttled transaction items
+var throttleErrorCodes = []string{"ProvisionedThroughputExceeded", "ThrottlingException", "RequestLimitExceeded", "ThrottlingError"}
+
+// isThrottleError reports whether an error message stems from DynamoDB throttling
+func isThrottleError(msg string) bool {
+	for _, code := range throttleErrorCodes {
+		if strings.Contains(msg, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// throttleBackoff computes retry delays that grow with the number of requests
+// throttled in the current second, so clients spread out further the harder
+// the table is being hit
+type throttleBackoff struct {
+	base time.Duration
+	max  time.Duration
+
+	mu          sync.Mutex
+	windowStart time.Time
+	throttled   int
+}
+
+// delay records a throttled request and returns the delay its client should wait
+func (b *throttleBackoff) delay() time.Duration {
+	b.mu.Lock()
+	now := time.Now()
+	if now.Sub(b.windowStart) >= time.Second {
+		b.windowStart = now
+		b.throttled = 0
+	}
+	b.throttled++
+	throttled := b.throttled
+	b.mu.Unlock()
+
+	d := min(b.base*time.Duration(throttled), b.max)
+	// Up to 20% jitter keeps clients throttled together from retrying together
+	return d + time.Duration(rand.Int64N(int64(d)/5+1))
+}
+
+// retryInfoUnaryInterceptor attaches a RetryInfo detail and a retry-after header to
+// RESOURCE_EXHAUSTED errors caused by DynamoDB throttling
+func retryInfoUnaryInterceptor(cfg appconfig.ServerConfig) grpc.UnaryServerInterceptor {
+	backoff := &throttleBackoff{base: cfg.ThrottleRetryBaseDelay, max: cfg.ThrottleRetryMaxDelay}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.ResourceExhausted || !isThrottleError(st.Message()) {
+			return resp, err
+		}
+
+		return resp, withRetryInfo(ctx, st, backoff.delay())
+	}
+}
+
+// withRetryInfo sets the retry-after header and returns the error of st with a RetryInfo detail
+func withRetryInfo(ctx context.Context, st *status.Status, delay time.Duration) error {
+	retryAfter := int(math.Ceil(delay.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(retryAfter)))
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
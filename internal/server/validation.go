@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// fieldViolation is a request field that breaks its declared rules
+type fieldViolation struct {
+	field       string
+	description string
+}
+
+// Error describes the violation
+func (v *fieldViolation) Error() string {
+	return fmt.Sprintf("invalid request: %s %s", v.field, v.description)
+}
+
+// compiledRules are the declared rules of a field with its pattern compiled
+type compiledRules struct {
+	*proto.FieldRules
+	pattern *regexp.Regexp
+}
+
+// fieldRulesCache holds the compiled rules of each field seen, nil for fields without rules
+var fieldRulesCache sync.Map
+
+// validationUnaryInterceptor rejects requests breaking the field rules declared in the
+// proto (see proto/validate.proto) with INVALID_ARGUMENT and a BadRequest detail, before
+// they reach the service and DynamoDB
+func validationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(gproto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	if violation := validateMessage(msg.ProtoReflect(), ""); violation != nil {
+		st := withErrorDetails(status.New(codes.InvalidArgument, violation.Error()), violation, reasonInvalidRequest)
+		detailed, err := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: violation.field, Description: violation.description}},
+		})
+		if err == nil {
+			st = detailed
+		}
+		return nil, st.Err()
+	}
+	return handler(ctx, req)
+}
+
+// validateMessage checks the fields of m and of the messages it contains against their
+// rules, returning the first violation. Nested fields are named by path, e.g. seat_ids[2].seat_id.
+func validateMessage(m protoreflect.Message, prefix string) *fieldViolation {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		if rules := rulesOf(fd); rules != nil {
+			if description := rules.check(m, fd); description != "" {
+				return &fieldViolation{field: name, description: description}
+			}
+		}
+
+		switch {
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				if violation := validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", name, j)); violation != nil {
+					return violation
+				}
+			}
+		case m.Has(fd):
+			if violation := validateMessage(m.Get(fd).Message(), name+"."); violation != nil {
+				return violation
+			}
+		}
+	}
+	return nil
+}
+
+// rulesOf returns the compiled rules of a field, or nil if it declares none. Patterns are
+// fixed in the proto, so one that doesn't compile is a bug and panics.
+func rulesOf(fd protoreflect.FieldDescriptor) *compiledRules {
+	if cached, ok := fieldRulesCache.Load(fd); ok {
+		return cached.(*compiledRules)
+	}
+
+	var rules *compiledRules
+	if options := fd.Options(); gproto.HasExtension(options, proto.E_Rules) {
+		rules = &compiledRules{FieldRules: gproto.GetExtension(options, proto.E_Rules).(*proto.FieldRules)}
+		if rules.Pattern != "" {
+			rules.pattern = regexp.MustCompile(rules.Pattern)
+		}
+	}
+	fieldRulesCache.Store(fd, rules)
+	return rules
+}
+
+// check returns how a field of m breaks the rules, or "" if it doesn't
+func (r *compiledRules) check(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if r.Required && !m.Has(fd) {
+		return "is required"
+	}
+
+	value := m.Get(fd)
+	if fd.IsList() {
+		list := value.List()
+		if r.MinItems > 0 && list.Len() < int(r.MinItems) {
+			return fmt.Sprintf("must have at least %d items", r.MinItems)
+		}
+		if r.MaxItems > 0 && list.Len() > int(r.MaxItems) {
+			return fmt.Sprintf("must have at most %d items", r.MaxItems)
+		}
+		if fd.Kind() == protoreflect.StringKind {
+			for j := 0; j < list.Len(); j++ {
+				if description := r.checkString(list.Get(j).String()); description != "" {
+					return fmt.Sprintf("[%d] %s", j, description)
+				}
+			}
+		}
+		return ""
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return r.checkString(value.String())
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		if r.Gte != nil && value.Int() < *r.Gte {
+			return fmt.Sprintf("must be at least %d", *r.Gte)
+		}
+		if r.Lte != nil && value.Int() > *r.Lte {
+			return fmt.Sprintf("must be at most %d", *r.Lte)
+		}
+	}
+	return ""
+}
+
+// checkString returns how a string breaks the length and pattern rules, or "" if it doesn't
+func (r *compiledRules) checkString(s string) string {
+	if r.MinLen > 0 && len(s) < int(r.MinLen) {
+		return fmt.Sprintf("must be at least %d bytes", r.MinLen)
+	}
+	if r.MaxLen > 0 && len(s) > int(r.MaxLen) {
+		return fmt.Sprintf("must be at most %d bytes", r.MaxLen)
+	}
+	if r.pattern != nil && s != "" && !r.pattern.MatchString(s) {
+		return fmt.Sprintf("must match %s", r.Pattern)
+	}
+	return ""
+}
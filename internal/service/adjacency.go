@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+const (
+	// maxAdjacentSeats bounds the seats GetAdjacentAvailable finds together
+	maxAdjacentSeats = 20
+	// rowSeatsPageSize is how many seats of a row are read per query
+	rowSeatsPageSize = 1000
+)
+
+// GetAdjacentAvailable finds n available seats side by side in the row of a seat, nearest
+// to it. Seats hidden by visibility rules count as unavailable.
+func (s *InventoryService) GetAdjacentAvailable(ctx context.Context, req *proto.GetAdjacentAvailableReq) (*proto.GetAdjacentAvailableRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" || req.SeatId == "" {
+		return nil, errors.New("invalid request: event_id and seat_id are required")
+	}
+	if req.N < 1 || req.N > maxAdjacentSeats {
+		return nil, fmt.Errorf("invalid request: n must be between 1 and %d", maxAdjacentSeats)
+	}
+	cut := strings.LastIndex(req.SeatId, "-")
+	if cut <= 0 {
+		return nil, errors.New(`invalid request: seat_id must be "<section>-<row>-<number>"`)
+	}
+	rowPrefix := req.SeatId[:cut+1]
+
+	var seats []*repo.SeatItem
+	for start := ""; ; {
+		page, next, err := s.repo.QuerySeatsPage(ctx, req.EventId, rowPrefix, nil, start, rowSeatsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		seats = append(seats, page...)
+		if next == "" {
+			break
+		}
+		start = next
+	}
+	if !slices.ContainsFunc(seats, func(seat *repo.SeatItem) bool { return seat.SeatID == req.SeatId }) {
+		return nil, apperrors.NotFound("seat not found: %s", req.SeatId)
+	}
+
+	seatIDs := make([]string, len(seats))
+	for i, seat := range seats {
+		seatIDs[i] = seat.SeatID
+	}
+	hidden, err := s.hiddenSeatIDs(ctx, req.EventId, seatIDs, req.AccessCode)
+	if err != nil {
+		return nil, err
+	}
+
+	seatMapVersion, err := s.seatMapVersion(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	available := func(seat *repo.SeatItem) bool {
+		return seat.Status == seatAvailable && !slices.Contains(hidden, seat.SeatID)
+	}
+	return &proto.GetAdjacentAvailableRes{
+		SeatIds:        bestAvailableBlock(rowBlocks(seats), req.SeatId, int(req.N), available),
+		SeatMapVersion: seatMapVersion,
+	}, nil
+}
+
+// bestAvailableBlock is the best-available allocator: it returns the n adjacent available
+// seats nearest to a seat, by seat number, preferring the leftmost of equally near
+// choices, or nil if no block of n seats is available
+func bestAvailableBlock(blocks [][]*repo.SeatItem, nearSeatID string, n int, available func(*repo.SeatItem) bool) []string {
+	target := seatNumber(nearSeatID)
+
+	var best []*repo.SeatItem
+	bestDistance := 0
+	for _, block := range blocks {
+		run := 0
+		for i, seat := range block {
+			if !available(seat) {
+				run = 0
+				continue
+			}
+			run++
+			if run < n {
+				continue
+			}
+			window := block[i-n+1 : i+1]
+			distance := -1
+			for _, candidate := range window {
+				d := seatNumber(candidate.SeatID) - target
+				if d < 0 {
+					d = -d
+				}
+				if distance < 0 || d < distance {
+					distance = d
+				}
+			}
+			if best == nil || distance < bestDistance {
+				best, bestDistance = window, distance
+			}
+		}
+	}
+
+	var seatIDs []string
+	for _, seat := range best {
+		seatIDs = append(seatIDs, seat.SeatID)
+	}
+	return seatIDs
+}
+
+// rowBlocks splits the seats of a row into blocks of adjacent seats, each in row order.
+// Seats without stored adjacency are adjacent to the seats numbered one less and one more.
+func rowBlocks(seats []*repo.SeatItem) [][]*repo.SeatItem {
+	byID := make(map[string]*repo.SeatItem, len(seats))
+	for _, seat := range seats {
+		byID[seat.SeatID] = seat
+	}
+	neighbors := func(seat *repo.SeatItem) (left, right string) {
+		if seat.Adjacency != nil {
+			return seat.Adjacency.Left, seat.Adjacency.Right
+		}
+		number := seatNumber(seat.SeatID)
+		if number == 0 {
+			return "", ""
+		}
+		prefix := seat.SeatID[:strings.LastIndex(seat.SeatID, "-")+1]
+		return prefix + strconv.Itoa(number-1), prefix + strconv.Itoa(number+1)
+	}
+
+	var blocks [][]*repo.SeatItem
+	visited := make(map[string]bool, len(seats))
+	for _, seat := range seats {
+		if left, _ := neighbors(seat); byID[left] != nil {
+			continue
+		}
+		var block []*repo.SeatItem
+		for next := seat; next != nil && !visited[next.SeatID]; {
+			visited[next.SeatID] = true
+			block = append(block, next)
+			_, right := neighbors(next)
+			next = byID[right]
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// seatNumber returns the number of a "<section>-<row>-<number>" seat ID, or 0 for other IDs
+func seatNumber(seatID string) int {
+	number, err := strconv.Atoi(seatID[strings.LastIndex(seatID, "-")+1:])
+	if err != nil || number < 0 {
+		return 0
+	}
+	return number
+}
+
+// layoutAdjacency returns the adjacency of each seat of a seat layout, keyed by seat ID.
+// Seats of a row are adjacent in number order except across the layout's aisles.
+func layoutAdjacency(sections []*proto.SeatLayoutSection) map[string]*repo.SeatAdjacency {
+	adjacency := make(map[string]*repo.SeatAdjacency)
+	for _, section := range sections {
+		for _, row := range section.Rows {
+			prefix := fmt.Sprintf("%s-%s-", section.Section, row)
+			for number := 1; number <= int(section.SeatsPerRow); number++ {
+				seat := &repo.SeatAdjacency{
+					AisleLeft:  slices.Contains(section.AislesAfter, int32(number-1)),
+					AisleRight: slices.Contains(section.AislesAfter, int32(number)),
+				}
+				if number > 1 && !seat.AisleLeft {
+					seat.Left = prefix + strconv.Itoa(number-1)
+				}
+				if number < int(section.SeatsPerRow) && !seat.AisleRight {
+					seat.Right = prefix + strconv.Itoa(number+1)
+				}
+				adjacency[prefix+strconv.Itoa(number)] = seat
+			}
+		}
+	}
+	return adjacency
+}
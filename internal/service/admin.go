@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxSeatsPerTransaction is the DynamoDB TransactWriteItems item limit
+const maxSeatsPerTransaction = 100
+
+// defaultReleaseChunkSize keeps bulk release transactions small to limit contention with live traffic
+const defaultReleaseChunkSize = 25
+
+// AdminService handles operational inventory operations exposed on the admin listener
+type AdminService struct {
+	repo       *repo.DynamoDBRepository
+	inventory  *InventoryService
+	stuckHolds *StuckHoldMonitor
+	repairer   *CounterRepairer
+	operations *OperationRunner
+	audit      *observability.AuditLog
+
+	// erasureKey keys the tokens replacing erased reservation IDs; random tokens when empty
+	erasureKey []byte
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(repo *repo.DynamoDBRepository, inventory *InventoryService, stuckHolds *StuckHoldMonitor, repairer *CounterRepairer, operations *OperationRunner, audit *observability.AuditLog, cfg *appconfig.Config) *AdminService {
+	return &AdminService{
+		repo:       repo,
+		inventory:  inventory,
+		stuckHolds: stuckHolds,
+		repairer:   repairer,
+		operations: operations,
+		audit:      audit,
+		erasureKey: []byte(cfg.Admin.ErasureTokenKey),
+	}
+}
+
+// AdjustCapacity changes the remaining quantity of an event using optimistic locking
+func (s *AdminService) AdjustCapacity(ctx context.Context, req *proto.AdjustCapacityReq) (*proto.AdjustCapacityRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	err := s.repo.AdjustInventory(ctx, req.EventId, req.Delta, req.ExpectedVersion)
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil, apperrors.New(apperrors.ErrVersionConflict, "inventory version conflict or insufficient remaining for event %s", req.EventId)
+		}
+		return nil, fmt.Errorf("failed to adjust capacity: %w", err)
+	}
+	s.inventory.cacheRemainingDelta(ctx, req.EventId, req.Delta)
+
+	inventory, err := s.repo.GetInventory(ctx, req.EventId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	return &proto.AdjustCapacityRes{
+		Remaining: inventory.Remaining,
+		Version:   inventory.Version,
+	}, nil
+}
+
+// BlockSeats moves available seats to BLOCKED so they can't be sold
+func (s *AdminService) BlockSeats(ctx context.Context, req *proto.BlockSeatsReq) (*proto.BlockSeatsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	version, err := s.transitionSeats(ctx, req.EventId, req.SeatIds, []string{seatAvailable}, seatBlocked, req.ExpectedSeatMapVersion, req.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Blocked %d seats for event %s: %s\n", len(req.SeatIds), req.EventId, req.Reason)
+
+	return &proto.BlockSeatsRes{
+		Status:         seatBlocked,
+		SeatMapVersion: version,
+	}, nil
+}
+
+// UnblockSeats moves blocked seats back to AVAILABLE
+func (s *AdminService) UnblockSeats(ctx context.Context, req *proto.UnblockSeatsReq) (*proto.UnblockSeatsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	version, err := s.transitionSeats(ctx, req.EventId, req.SeatIds, []string{seatBlocked}, seatAvailable, req.ExpectedSeatMapVersion, req.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UnblockSeatsRes{
+		Status:         seatAvailable,
+		SeatMapVersion: version,
+	}, nil
+}
+
+// SetSeatStatus moves seats to an operator-managed status from any status allowed to
+// change to it. Held and sold seats are never changed.
+func (s *AdminService) SetSeatStatus(ctx context.Context, req *proto.SetSeatStatusReq) (*proto.SetSeatStatusRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	to := seatStatusName(req.Status)
+	if !slices.Contains(operatorSeatStatuses, to) {
+		return nil, fmt.Errorf("invalid request: seats cannot be set to %s", req.Status)
+	}
+
+	version, err := s.transitionSeats(ctx, req.EventId, req.SeatIds, seatTransitionSources(to), to, req.ExpectedSeatMapVersion, req.LeaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Set %d seats for event %s to %s: %s\n", len(req.SeatIds), req.EventId, to, req.Reason)
+
+	return &proto.SetSeatStatusRes{
+		Status:         req.Status,
+		SeatMapVersion: version,
+	}, nil
+}
+
+// SetMaintenanceMode toggles maintenance mode of this instance only
+func (s *AdminService) SetMaintenanceMode(ctx context.Context, req *proto.SetMaintenanceModeReq) (*proto.SetMaintenanceModeRes, error) {
+	s.inventory.SetInstanceMaintenance(req.Enabled)
+
+	fmt.Printf("Maintenance mode of this instance set to %t: %s\n", req.Enabled, req.Reason)
+
+	return &proto.SetMaintenanceModeRes{
+		Enabled: s.inventory.InMaintenance(),
+	}, nil
+}
+
+// FreezeEvent rejects writes for a single event while reads keep working
+func (s *AdminService) FreezeEvent(ctx context.Context, req *proto.FreezeEventReq) (*proto.FreezeEventRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	if err := s.repo.SetEventFrozen(ctx, req.EventId, true, req.Reason); err != nil {
+		return nil, fmt.Errorf("failed to freeze event: %w", err)
+	}
+
+	fmt.Printf("Froze event %s: %s\n", req.EventId, req.Reason)
+
+	return &proto.FreezeEventRes{
+		Frozen: true,
+	}, nil
+}
+
+// UnfreezeEvent lifts a per-event freeze
+func (s *AdminService) UnfreezeEvent(ctx context.Context, req *proto.UnfreezeEventReq) (*proto.UnfreezeEventRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	if err := s.repo.SetEventFrozen(ctx, req.EventId, false, ""); err != nil {
+		return nil, fmt.Errorf("failed to unfreeze event: %w", err)
+	}
+
+	fmt.Printf("Unfroze event %s\n", req.EventId)
+
+	return &proto.UnfreezeEventRes{
+		Frozen: false,
+	}, nil
+}
+
+// ReleaseEventHolds releases all held seats of an event in transactional chunks,
+// calling report with cumulative progress after every chunk and once more when done.
+// A chunk that fails (e.g. a seat was sold meanwhile) is counted as failed and skipped;
+// the operation can simply be re-run to retry. Its seats are reported in FailedSeats,
+// with the chunk's error passed to report so the caller can fill in the status code.
+func (s *AdminService) ReleaseEventHolds(ctx context.Context, req *proto.ReleaseEventHoldsReq, report func(progress *proto.ReleaseEventHoldsProgress, failure error) error) error {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return err
+	}
+
+	if req.EventId == "" {
+		return errors.New("invalid request: event_id is required")
+	}
+
+	chunkSize := int(req.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultReleaseChunkSize
+	}
+	// Each seat is released with the deletion of its hold record
+	if chunkSize > maxSeatsPerTransaction/2 {
+		return fmt.Errorf("invalid request: chunk_size must be at most %d", maxSeatsPerTransaction/2)
+	}
+
+	var updatedBefore time.Time
+	if req.OlderThanSeconds > 0 {
+		updatedBefore = time.Now().Add(-time.Duration(req.OlderThanSeconds) * time.Second)
+	}
+
+	progress := &proto.ReleaseEventHoldsProgress{}
+	var startKey map[string]types.AttributeValue
+	for {
+		seats, nextKey, err := s.repo.QuerySeatsByStatus(ctx, req.EventId, seatHold, updatedBefore, startKey, int32(chunkSize*4))
+		if err != nil {
+			return fmt.Errorf("failed to list held seats: %w", err)
+		}
+		scanned := progress.Scanned
+		progress.Scanned += int32(len(seats))
+
+		for start := 0; start < len(seats); start += chunkSize {
+			chunk := seats[start:min(start+chunkSize, len(seats))]
+			progress.FailedSeats = nil
+			failure := s.repo.ReleaseHeldSeats(ctx, chunk)
+			if failure != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				fmt.Printf("Warning: failed to release %d held seats for event %s: %v\n", len(chunk), req.EventId, failure)
+				progress.Failed += int32(len(chunk))
+				for i, seat := range chunk {
+					progress.FailedSeats = append(progress.FailedSeats, &proto.BatchResult{
+						Index:     scanned + int32(start+i),
+						Id:        seat.SeatID,
+						Error:     failure.Error(),
+						ErrorCode: ErrorCode(failure),
+					})
+				}
+			} else {
+				progress.Released += int32(len(chunk))
+				releasedSeatIDs := seatIDsOf(chunk)
+				s.inventory.cacheSeatStatus(ctx, req.EventId, releasedSeatIDs, seatAvailable)
+				s.inventory.restock.SeatsReturned(ctx, req.EventId, releasedSeatIDs, "RELEASED")
+			}
+
+			if err := report(progress, failure); err != nil {
+				return err
+			}
+		}
+
+		if nextKey == nil {
+			break
+		}
+		startKey = nextKey
+	}
+
+	fmt.Printf("Released %d held seats for event %s (%d failed)\n", progress.Released, req.EventId, progress.Failed)
+
+	progress.Done = true
+	progress.FailedSeats = nil
+	return report(progress, nil)
+}
+
+// ListStuckHolds lists holds that outlived the hold TTL plus grace
+func (s *AdminService) ListStuckHolds(ctx context.Context, req *proto.ListStuckHoldsReq) (*proto.ListStuckHoldsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	seats, err := s.stuckHolds.ListStuckHolds(ctx, req.EventId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stuck holds: %w", err)
+	}
+
+	holds := make([]*proto.StuckHold, 0, len(seats))
+	for _, seat := range seats {
+		eventID, performanceID := repo.SplitPerformanceKey(seat.EventID)
+		holds = append(holds, &proto.StuckHold{
+			EventId:       eventID,
+			PerformanceId: performanceID,
+			SeatId:        seat.SeatID,
+			ReservationId: seat.ReservationID,
+			HeldSince:     timestamppb.New(seat.UpdatedAt),
+		})
+	}
+
+	return &proto.ListStuckHoldsRes{
+		Holds: holds,
+	}, nil
+}
+
+// transitionSeats atomically moves all given seats from one of the given statuses to
+// another under a section lease (empty for none), returning the event's new seat map version
+func (s *AdminService) transitionSeats(ctx context.Context, eventID string, seatRefs []*proto.SeatRef, from []string, to string, expectedVersion int32, leaseID string) (int32, error) {
+	if eventID == "" || len(seatRefs) == 0 {
+		return 0, errors.New("invalid request: event_id and seat_ids are required")
+	}
+	if len(seatRefs) > maxSeatsPerTransaction {
+		return 0, fmt.Errorf("invalid request: at most %d seats per call", maxSeatsPerTransaction)
+	}
+	if len(from) == 0 {
+		return 0, fmt.Errorf("precondition failed: seats cannot change to %s", to)
+	}
+	if err := checkSeatTransitions(from, to); err != nil {
+		return 0, err
+	}
+
+	seatIDs := make([]string, len(seatRefs))
+	for i, seatRef := range seatRefs {
+		seatIDs[i] = seatRef.SeatId
+	}
+	if err := s.checkSectionLocks(ctx, eventID, seatIDs, leaseID); err != nil {
+		return 0, err
+	}
+
+	version, err := s.repo.BumpSeatMapVersion(ctx, eventID, expectedVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	seatUpdates := make([]*repo.SeatItem, 0, len(seatRefs))
+	for _, seatRef := range seatRefs {
+		seatUpdates = append(seatUpdates, &repo.SeatItem{
+			EventID:   eventID,
+			SeatID:    seatRef.SeatId,
+			Status:    to,
+			UpdatedAt: time.Now(),
+		})
+	}
+
+	placeholders := make([]string, len(from))
+	exprValues := make(map[string]types.AttributeValue, len(from))
+	for i, status := range from {
+		placeholders[i] = fmt.Sprintf(":from%d", i)
+		exprValues[placeholders[i]] = &types.AttributeValueMemberS{Value: status}
+	}
+	conditionExpr := "#status IN (" + strings.Join(placeholders, ", ") + ")"
+
+	exprNames := map[string]string{
+		"#status": "status",
+	}
+
+	err = s.repo.TransactWriteSeats(ctx, seatUpdates, conditionExpr, exprValues, exprNames)
+	if err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return 0, fmt.Errorf("one or more seats are not available for event %s", eventID)
+		}
+		return 0, fmt.Errorf("failed to update seat status: %w", err)
+	}
+	s.inventory.cacheSeatStatus(ctx, eventID, seatIDsOf(seatUpdates), to)
+
+	return version, nil
+}
+
+// seatIDsOf returns the seat IDs of the given seats
+func seatIDsOf(seats []*repo.SeatItem) []string {
+	seatIDs := make([]string, len(seats))
+	for i, seat := range seats {
+		seatIDs[i] = seat.SeatID
+	}
+	return seatIDs
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// callerIDHeader identifies the end caller when requests arrive through a gateway;
+// without it the peer address is used
+const callerIDHeader = "x-caller-id"
+
+// Anomaly kinds
+const (
+	anomalyVelocitySpike       = "velocity_spike"
+	anomalyCallerConcentration = "caller_concentration"
+	anomalyNegativeDrift       = "negative_drift"
+)
+
+// AnomalyDetector watches per-event sales velocity on this instance and flags abuse signals:
+//   - velocity_spike: an event sells SpikeFactor times its trailing average in one window
+//   - caller_concentration: one caller buys CallerShare or more of an event's window sales
+//   - negative_drift: a caller takes seats out of sale with holds but converts few into sales
+//
+// Flagged callers are optionally throttled. A nil detector is a no-op.
+type AnomalyDetector struct {
+	config  appconfig.AnomalyConfig
+	metrics *observability.Metrics
+
+	mu        sync.Mutex
+	events    map[string]*eventWindow
+	baselines map[string]float64
+	throttled map[string]time.Time
+}
+
+// eventWindow aggregates one event's activity during the current window
+type eventWindow struct {
+	sold    int
+	callers map[string]*callerWindow
+}
+
+// callerWindow aggregates one caller's activity on an event during the current window
+type callerWindow struct {
+	sold int
+	held int
+}
+
+// NewAnomalyDetector creates an anomaly detector, or returns nil when detection is disabled
+func NewAnomalyDetector(cfg *appconfig.Config, metrics *observability.Metrics) *AnomalyDetector {
+	if !cfg.Anomaly.Enabled {
+		return nil
+	}
+	return &AnomalyDetector{
+		config:    cfg.Anomaly,
+		metrics:   metrics,
+		events:    make(map[string]*eventWindow),
+		baselines: make(map[string]float64),
+		throttled: make(map[string]time.Time),
+	}
+}
+
+// RecordSale counts tickets sold to the request's caller
+func (d *AnomalyDetector) RecordSale(ctx context.Context, eventID string, tickets int) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	window := d.window(eventID)
+	window.sold += tickets
+	window.caller(callerID(ctx)).sold += tickets
+}
+
+// RecordHold counts seats held by the request's caller
+func (d *AnomalyDetector) RecordHold(ctx context.Context, eventID string, seats int) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.window(eventID).caller(callerID(ctx)).held += seats
+}
+
+// CheckCaller rejects requests from a throttled caller
+func (d *AnomalyDetector) CheckCaller(ctx context.Context) error {
+	if d == nil || !d.config.ThrottleEnabled {
+		return nil
+	}
+	caller := callerID(ctx)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if until, ok := d.throttled[caller]; ok && time.Now().Before(until) {
+		err := fmt.Errorf("caller %s is rate limited after anomalous activity until %s", caller, until.Format(time.RFC3339))
+		return apperrors.RetryAfter(err, time.Until(until))
+	}
+	return nil
+}
+
+// Run evaluates and resets the window periodically until ctx is canceled
+func (d *AnomalyDetector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.Window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.EvaluateOnce()
+		}
+	}
+}
+
+// EvaluateOnce flags anomalies in the current window and starts a new one
+func (d *AnomalyDetector) EvaluateOnce() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	for eventID, window := range d.events {
+		baseline, seen := d.baselines[eventID]
+		if seen && window.sold >= d.config.MinVolume && float64(window.sold) > d.config.SpikeFactor*baseline {
+			d.flag(anomalyVelocitySpike, eventID, "", fmt.Sprintf("sold %d vs trailing average %.1f", window.sold, baseline))
+		}
+		// Exponentially weighted average of sales per window
+		d.baselines[eventID] = 0.8*baseline + 0.2*float64(window.sold)
+
+		for caller, activity := range window.callers {
+			if activity.sold >= d.config.MinVolume && float64(activity.sold) >= d.config.CallerShare*float64(window.sold) {
+				d.flag(anomalyCallerConcentration, eventID, caller, fmt.Sprintf("bought %d of %d", activity.sold, window.sold))
+				d.throttle(caller, now)
+			}
+			if activity.held >= d.config.MinVolume && float64(activity.sold) < d.config.HoldConversion*float64(activity.held) {
+				d.flag(anomalyNegativeDrift, eventID, caller, fmt.Sprintf("held %d, bought %d", activity.held, activity.sold))
+				d.throttle(caller, now)
+			}
+		}
+	}
+	// Events without sales this window decay toward zero and are eventually forgotten
+	for eventID, baseline := range d.baselines {
+		if _, active := d.events[eventID]; !active {
+			if baseline *= 0.8; baseline < 0.1 {
+				delete(d.baselines, eventID)
+			} else {
+				d.baselines[eventID] = baseline
+			}
+		}
+	}
+	d.events = make(map[string]*eventWindow)
+
+	for caller, until := range d.throttled {
+		if now.After(until) {
+			delete(d.throttled, caller)
+		}
+	}
+	d.metrics.SetThrottledCallers(len(d.throttled))
+}
+
+// flag records and logs an anomaly. Callers hold mu.
+func (d *AnomalyDetector) flag(kind, eventID, caller, detail string) {
+	d.metrics.RecordAnomaly(kind)
+	fmt.Printf("Anomaly %s on event %s (caller %q): %s\n", kind, eventID, caller, detail)
+}
+
+// throttle rejects a caller's writes for the throttle duration, when enabled. Callers hold mu.
+func (d *AnomalyDetector) throttle(caller string, now time.Time) {
+	if d.config.ThrottleEnabled {
+		d.throttled[caller] = now.Add(d.config.ThrottleDuration)
+	}
+}
+
+// window returns the current window of an event, creating it if needed. Callers hold mu.
+func (d *AnomalyDetector) window(eventID string) *eventWindow {
+	w, ok := d.events[eventID]
+	if !ok {
+		w = &eventWindow{callers: make(map[string]*callerWindow)}
+		d.events[eventID] = w
+	}
+	return w
+}
+
+// caller returns a caller's activity in the window, creating it if needed
+func (w *eventWindow) caller(caller string) *callerWindow {
+	c, ok := w.callers[caller]
+	if !ok {
+		c = &callerWindow{}
+		w.callers[caller] = c
+	}
+	return c
+}
+
+// callerID identifies the caller of a request by its x-caller-id header or peer host.
+// The header is asserted by the caller, so callerID must not decide whose limits apply.
+func callerID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(callerIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return peerHost(ctx)
+}
+
+// peerHost returns the host of the connection a request arrived on
+func peerHost(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
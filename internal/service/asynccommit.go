@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// asyncCommitStatusTTL is how long the status of an asynchronous commit can be polled
+const asyncCommitStatusTTL = 24 * time.Hour
+
+// asyncStatusWriteTimeout bounds recording an asynchronous commit's outcome, which
+// must happen even when the commit itself ran out of time
+const asyncStatusWriteTimeout = 5 * time.Second
+
+// CommitReservationAsync queues a commit on the commit pool and returns its order ID
+// with status PENDING. The outcome is recorded for GetCommitStatus.
+func (s *InventoryService) CommitReservationAsync(ctx context.Context, req *proto.CommitReq) (*proto.CommitRes, error) {
+	if s.commits == nil {
+		return nil, errors.New("precondition failed: asynchronous commits require the commit pool (COMMIT_WORKERS)")
+	}
+
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if err := s.anomalies.CheckCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	orderID := fmt.Sprintf("ord_%s", uuid.New().String()[:12])
+
+	// Validation runs in the request's deadline budget, like a synchronous commit's
+	idempotencyKey := fmt.Sprintf("commit:%s", req.ReservationId)
+	replayed, releaseOrder, err := s.admitCommit(startBudget(ctx, s.config.DeadlineBudget), req, idempotencyKey)
+	if err != nil || replayed != nil {
+		return replayed, err
+	}
+
+	now := time.Now()
+	status := &repo.CommitStatusItem{
+		OrderID:       orderID,
+		ReservationID: req.ReservationId,
+		EventID:       req.EventId,
+		Status:        "PENDING",
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(asyncCommitStatusTTL).Unix(),
+	}
+	if err := s.repo.PutCommitStatus(ctx, status); err != nil {
+		releaseOrder(false)
+		return nil, err
+	}
+
+	// The commit outlives the request but keeps its trace, baggage and caller. Its
+	// mutation and outcome get a budget of their own, out of the asynchronous timeout.
+	commitCtx, cancel := context.WithTimeout(repo.WithoutCostMeter(context.WithoutCancel(ctx)), s.config.CommitPool.AsyncTimeout)
+	commitCtx = startBudget(commitCtx, s.config.DeadlineBudget)
+	// The outcome is recorded by the completion hook, which also runs when the commit is
+	// dropped from the queue, so no order stays PENDING or counts against the buyer
+	var res *proto.CommitRes
+	err = s.commits.Submit(commitCtx, func(ctx context.Context) error {
+		return inBudgetPhase(ctx, budgetDynamoDB, func(ctx context.Context) error {
+			var err error
+			res, err = s.commit(ctx, req, orderID, idempotencyKey)
+			return err
+		})
+	}, func(err error) {
+		defer cancel()
+		releaseOrder(err == nil)
+		s.recordAsyncCommit(commitCtx, status, res, err)
+	})
+	if err != nil {
+		cancel()
+		releaseOrder(false)
+		s.recordAsyncCommit(ctx, status, nil, err)
+		return nil, err
+	}
+
+	return &proto.CommitRes{
+		OrderId: orderID,
+		Status:  "PENDING",
+	}, nil
+}
+
+// recordAsyncCommit records the outcome of an asynchronous commit, logging failures
+func (s *InventoryService) recordAsyncCommit(ctx context.Context, status *repo.CommitStatusItem, res *proto.CommitRes, commitErr error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), asyncStatusWriteTimeout)
+	defer cancel()
+
+	status.UpdatedAt = time.Now()
+	if commitErr != nil {
+		status.Status = "FAILED"
+		status.Error = commitErr.Error()
+		if code := ErrorCode(commitErr); code != proto.ErrorCode_ERROR_CODE_UNSPECIFIED {
+			status.ErrorCode = code.String()
+		}
+	} else {
+		status.Status = "CONFIRMED"
+		if res.OrderId != status.OrderID {
+			status.ConfirmedOrderID = res.OrderId
+		}
+	}
+
+	if err := s.repo.PutCommitStatus(ctx, status); err != nil {
+		fmt.Printf("Warning: failed to record status %s of order %s: %v\n", status.Status, status.OrderID, err)
+	}
+}
+
+// GetCommitStatus returns the status of an asynchronous commit
+func (s *InventoryService) GetCommitStatus(ctx context.Context, req *proto.GetCommitStatusReq) (*proto.GetCommitStatusRes, error) {
+	if req.OrderId == "" {
+		return nil, errors.New("invalid request: order_id is required")
+	}
+
+	status, err := s.repo.GetCommitStatus(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("order %s not found", req.OrderId)
+	}
+
+	orderID := status.OrderID
+	if status.ConfirmedOrderID != "" {
+		orderID = status.ConfirmedOrderID
+	}
+
+	return &proto.GetCommitStatusRes{
+		OrderId:   orderID,
+		Status:    status.Status,
+		Error:     status.Error,
+		UpdatedAt: timestamppb.New(status.UpdatedAt),
+		ErrorCode: proto.ErrorCode(proto.ErrorCode_value[status.ErrorCode]),
+	}, nil
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/cache"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// cacheRemainingDelta applies a committed quantity change to the availability counter.
+// If the counter can't be updated it is invalidated so reads fall back to DynamoDB.
+func (s *InventoryService) cacheRemainingDelta(ctx context.Context, eventID string, delta int32) {
+	if s.counter == nil {
+		return
+	}
+	if err := s.counter.AddRemaining(ctx, eventID, delta); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		s.invalidateCache(ctx, eventID)
+	}
+}
+
+// cacheSeatStatus applies committed seat status changes to the availability counter
+func (s *InventoryService) cacheSeatStatus(ctx context.Context, eventID string, seatIDs []string, status string) {
+	if s.counter == nil || len(seatIDs) == 0 {
+		return
+	}
+
+	statuses := make(map[string]string, len(seatIDs))
+	for _, seatID := range seatIDs {
+		statuses[seatID] = status
+	}
+	if err := s.counter.SetSeatStatuses(ctx, eventID, statuses); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		s.invalidateCache(ctx, eventID)
+	}
+}
+
+// invalidateCache drops cached availability of an event, logging failures
+func (s *InventoryService) invalidateCache(ctx context.Context, eventID string) {
+	if err := s.counter.Invalidate(ctx, eventID); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
+
+// AvailabilityReconciler periodically re-reads cached events from DynamoDB so the
+// Redis counter converges even after missed updates or releases done outside the
+// inventory service (hold expiry, stuck hold release).
+type AvailabilityReconciler struct {
+	repo     *repo.DynamoDBRepository
+	counter  *cache.AvailabilityCounter
+	repairer *CounterRepairer
+	config   appconfig.RedisConfig
+}
+
+// NewAvailabilityReconciler creates a new availability reconciler
+func NewAvailabilityReconciler(repo *repo.DynamoDBRepository, counter *cache.AvailabilityCounter, repairer *CounterRepairer, cfg *appconfig.Config) *AvailabilityReconciler {
+	return &AvailabilityReconciler{
+		repo:     repo,
+		counter:  counter,
+		repairer: repairer,
+		config:   cfg.Redis,
+	}
+}
+
+// Run reconciles periodically until ctx is canceled
+func (r *AvailabilityReconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.ReconcileOnce(ctx); err != nil {
+				fmt.Printf("Warning: availability reconciliation failed: %v\n", err)
+			}
+		}
+	}
+}
+
+// ReconcileOnce overwrites the cached state of every cached event with DynamoDB's
+func (r *AvailabilityReconciler) ReconcileOnce(ctx context.Context) error {
+	events, err := r.counter.Events(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, eventID := range events {
+		if err := r.reconcileEvent(ctx, eventID); err != nil {
+			fmt.Printf("Warning: failed to reconcile availability of event %s: %v\n", eventID, err)
+			if err := r.counter.Invalidate(ctx, eventID); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileEvent refreshes the cached remaining quantity and seat statuses of one event
+func (r *AvailabilityReconciler) reconcileEvent(ctx context.Context, eventID string) error {
+	if _, cached, err := r.counter.Remaining(ctx, eventID); err != nil {
+		return err
+	} else if cached {
+		inventory, err := r.repo.GetInventory(ctx, eventID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return r.counter.Invalidate(ctx, eventID)
+			}
+			return err
+		}
+		if err := r.counter.SetRemaining(ctx, eventID, inventory.Remaining); err != nil {
+			return err
+		}
+	}
+
+	cached, err := r.counter.HasSeats(ctx, eventID)
+	if err != nil || !cached {
+		return err
+	}
+
+	seats, err := r.repo.ListEventSeats(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	statuses := make(map[string]string, len(seats))
+	available := int32(0)
+	for _, seat := range seats {
+		statuses[seat.SeatID] = seat.Status
+		if seat.Status == seatAvailable {
+			available++
+		}
+	}
+	r.repairer.Check(ctx, eventID, available, "reconcile")
+	return r.counter.ReplaceSeatStatuses(ctx, eventID, statuses)
+}
+
+// remaining returns an event's remaining quantity, from the counter when cached
+func (s *InventoryService) remaining(ctx context.Context, eventID string) (int32, error) {
+	if s.counter != nil {
+		remaining, ok, err := s.counter.Remaining(ctx, eventID)
+		if err == nil && ok {
+			return remaining, nil
+		}
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	if s.counter != nil {
+		if err := s.counter.SetRemaining(ctx, eventID, inventory.Remaining); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+	return inventory.Remaining, nil
+}
+
+// seatStatuses returns the statuses of the given seats, from the seat replica of a
+// replicated event, or from the counter when all are cached.
+// Seats that don't exist are absent from the result.
+func (s *InventoryService) seatStatuses(ctx context.Context, eventID string, seatIDs []string) (map[string]string, error) {
+	if statuses, ok := s.replica.Statuses(eventID, seatIDs); ok {
+		return statuses, nil
+	}
+
+	if s.counter != nil {
+		statuses, ok, err := s.counter.SeatStatuses(ctx, eventID, seatIDs)
+		if err == nil && ok {
+			return statuses, nil
+		}
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+
+	// Reject reads of many seats up front rather than after spending the budget
+	if err := repo.ReserveItemReads(ctx, len(seatIDs)); err != nil {
+		return nil, err
+	}
+
+	seats, err := s.repo.GetSeats(ctx, eventID, seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seats: %w", err)
+	}
+
+	statuses := make(map[string]string, len(seats))
+	for _, seat := range seats {
+		statuses[seat.SeatID] = seat.Status
+	}
+
+	if s.counter != nil {
+		if err := s.counter.SetSeatStatuses(ctx, eventID, statuses); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxBatchCheckEvents bounds the events of one BatchCheckAvailability call
+const maxBatchCheckEvents = 100
+
+// BatchCheckAvailability checks the quantity availability of several events concurrently,
+// at most BatchCheckConcurrency at a time. Each event is checked like CheckAvailability;
+// an event whose check fails gets its error and error code in its result, and its error
+// at the same index of errs, from which the caller fills in the status code.
+func (s *InventoryService) BatchCheckAvailability(ctx context.Context, req *proto.BatchCheckAvailabilityReq) (res *proto.BatchCheckAvailabilityRes, errs []error, err error) {
+	if len(req.Events) == 0 {
+		return nil, nil, errors.New("invalid request: events are required")
+	}
+	if len(req.Events) > maxBatchCheckEvents {
+		return nil, nil, fmt.Errorf("invalid request: at most %d events per batch", maxBatchCheckEvents)
+	}
+
+	results := make([]*proto.EventCheckResult, len(req.Events))
+	errs = make([]error, len(req.Events))
+	fail := func(i int, err error) {
+		errs[i] = err
+		results[i].Result.Error = err.Error()
+		results[i].Result.ErrorCode = ErrorCode(err)
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(s.config.Inventory.BatchCheckConcurrency, 1))
+	for i, check := range req.Events {
+		results[i] = &proto.EventCheckResult{
+			EventId:       check.EventId,
+			PerformanceId: check.PerformanceId,
+			Result:        &proto.BatchResult{Index: int32(i)},
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			fail(i, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, check *proto.EventCheck) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			availability, err := s.checkEvent(ctx, check)
+			if err != nil {
+				fail(i, err)
+				return
+			}
+			results[i].Availability = availability
+		}(i, check)
+	}
+	wg.Wait()
+
+	return &proto.BatchCheckAvailabilityRes{Results: results}, errs, nil
+}
+
+// checkEvent checks one event of a batch check
+func (s *InventoryService) checkEvent(ctx context.Context, check *proto.EventCheck) (*proto.CheckRes, error) {
+	if check.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+	if check.Qty < 0 {
+		return nil, errors.New("invalid request: qty must not be negative")
+	}
+
+	return s.CheckAvailability(ctx, &proto.CheckReq{
+		EventId:       check.EventId,
+		PerformanceId: check.PerformanceId,
+		Qty:           max(check.Qty, 1),
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// Deadline budget phases of a commit, in order
+const (
+	// budgetValidation covers caller checks, the idempotency lookup and pre-authorization
+	budgetValidation = "validation"
+	// budgetDynamoDB covers the inventory mutation
+	budgetDynamoDB = "dynamodb"
+	// budgetPublish covers recording the outcome: bundle idempotency records, caches and notifications
+	budgetPublish = "publish"
+)
+
+// budgetPhases lists the phases in the order they run
+var budgetPhases = []string{budgetValidation, budgetDynamoDB, budgetPublish}
+
+type budgetKey struct{}
+
+// deadlineBudget divides what is left of a request's deadline, less a safety margin,
+// among the phases of a commit. Each phase ends at a fixed share of the budget from its
+// start, so time an early phase doesn't use carries over to the later ones.
+type deadlineBudget struct {
+	base  context.Context
+	start time.Time
+	total time.Duration
+	ends  map[string]time.Time
+
+	mu    sync.Mutex
+	spent map[string]time.Duration
+}
+
+// startBudget attaches a deadline budget to a request context. Requests without a
+// deadline, or with budgets disabled, get none and their phases run unbounded.
+func startBudget(ctx context.Context, cfg appconfig.DeadlineBudgetConfig) context.Context {
+	deadline, ok := ctx.Deadline()
+	if !cfg.Enabled || !ok {
+		return ctx
+	}
+
+	b := &deadlineBudget{
+		base:  ctx,
+		start: time.Now(),
+		total: time.Until(deadline) - cfg.SafetyMargin,
+		ends:  make(map[string]time.Time, len(budgetPhases)),
+		spent: make(map[string]time.Duration, len(budgetPhases)),
+	}
+	shares := map[string]float64{
+		budgetValidation: cfg.ValidationShare,
+		budgetDynamoDB:   cfg.DynamoDBShare,
+		budgetPublish:    cfg.PublishShare,
+	}
+	var sum, cumulative float64
+	for _, share := range shares {
+		sum += share
+	}
+	if sum <= 0 {
+		return ctx
+	}
+	for _, phase := range budgetPhases {
+		cumulative += shares[phase]
+		b.ends[phase] = b.start.Add(time.Duration(float64(b.total) * cumulative / sum))
+	}
+	observability.AddSpanAttributes(ctx, attribute.Int64("deadline_budget.total_ms", b.total.Milliseconds()))
+	return context.WithValue(ctx, budgetKey{}, b)
+}
+
+// enterBudgetPhase returns a context ending with the phase's share of the request's
+// budget, and a function to call when the phase is done. It fails with a deadline error
+// if earlier phases used up the phase's share.
+func enterBudgetPhase(ctx context.Context, phase string) (context.Context, func(), error) {
+	b, ok := ctx.Value(budgetKey{}).(*deadlineBudget)
+	if !ok {
+		return ctx, func() {}, nil
+	}
+
+	end := b.ends[phase]
+	if left := time.Until(end); left <= 0 {
+		b.annotate(ctx)
+		observability.AddSpanAttributes(ctx, attribute.String("deadline_budget.exhausted", phase))
+		return nil, nil, apperrors.New(apperrors.ErrDeadlineTooClose, "deadline budget exhausted before %s: %s of %s used", phase, time.Since(b.start).Round(time.Millisecond), b.total)
+	}
+
+	// Phases are bounded by the request, not by the phase before them
+	phaseCtx, cancel := context.WithDeadline(context.WithoutCancel(ctx), end)
+	stop := context.AfterFunc(b.base, cancel)
+	started := time.Now()
+	return phaseCtx, func() {
+		stop()
+		cancel()
+		b.mu.Lock()
+		b.spent[phase] += time.Since(started)
+		b.mu.Unlock()
+		b.annotate(ctx)
+	}, nil
+}
+
+// inBudgetPhase runs fn in a phase of the request's deadline budget
+func inBudgetPhase(ctx context.Context, phase string, fn func(ctx context.Context) error) error {
+	phaseCtx, done, err := enterBudgetPhase(ctx, phase)
+	if err != nil {
+		return err
+	}
+	defer done()
+	return fn(phaseCtx)
+}
+
+// publishPhase enters the publish phase once a commit succeeded. The outcome is recorded
+// either way, so without time left in the phase the caller keeps its context.
+func publishPhase(ctx context.Context) (context.Context, func()) {
+	phaseCtx, done, err := enterBudgetPhase(ctx, budgetPublish)
+	if err != nil {
+		return ctx, func() {}
+	}
+	return phaseCtx, done
+}
+
+// annotate records the allotment and use of each phase on the current span
+func (b *deadlineBudget) annotate(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	phaseStart := b.start
+	for _, phase := range budgetPhases {
+		observability.AddSpanAttributes(ctx,
+			attribute.Int64("deadline_budget."+phase+".allotted_ms", b.ends[phase].Sub(phaseStart).Milliseconds()),
+			attribute.Int64("deadline_budget."+phase+".spent_ms", b.spent[phase].Milliseconds()),
+		)
+		phaseStart = b.ends[phase]
+	}
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxBundleLineItems bounds the events one bundle commit spans
+const maxBundleLineItems = 10
+
+// commitBundle commits the line items of a bundle as a saga: each line item is committed
+// in turn, and when one fails the line items committed before it are returned to sale, so
+// the bundle commits all or none. Each committed line item is recorded under its own
+// idempotency key, so retrying a bundle whose instance died mid-saga resumes it instead
+// of selling the same line item twice.
+func (s *InventoryService) commitBundle(ctx context.Context, req *proto.CommitReq, orderID, idempotencyKey string) (*proto.CommitRes, error) {
+	items, err := bundleLineItems(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Line items committed by an earlier attempt are found with one read for the bundle
+	lineKeys := make([]string, len(items))
+	for i := range items {
+		lineKeys[i] = bundleLineKey(idempotencyKey, i)
+	}
+	recorded, err := s.repo.BatchGetIdempotency(ctx, lineKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check idempotency: %w", err)
+	}
+	// A resumed bundle keeps the order its committed line items were sold under
+	lineRecords := make([]*repo.IdempotencyItem, len(items))
+	for i, key := range lineKeys {
+		lineRecords[i] = recorded[key]
+	}
+	for _, record := range lineRecords {
+		if record != nil {
+			orderID = record.Operation
+			break
+		}
+	}
+
+	lines, err := runBundle(items, lineRecords,
+		func(i int, item *proto.CommitReq) (*proto.CommitRes, error) {
+			return s.commit(ctx, item, orderID, lineKeys[i])
+		},
+		func(i int, item *proto.CommitReq) {
+			s.compensateLineItem(ctx, idempotencyKey, i, item)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, done := publishPhase(ctx)
+	defer done()
+
+	res := confirmedCommit(orderID, lines)
+
+	err = s.repo.PutIdempotency(ctx, commitRecord(idempotencyKey, req, res))
+	if err != nil {
+		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
+	}
+
+	return res, nil
+}
+
+// runBundle commits the line items of a bundle in turn and returns their order lines.
+// Line items with a record from an earlier attempt are replayed from it rather than
+// committed again. When a line item fails, the line items before it are compensated,
+// last first, and its error is returned.
+func runBundle(items []*proto.CommitReq, recorded []*repo.IdempotencyItem, commit func(int, *proto.CommitReq) (*proto.CommitRes, error), compensate func(int, *proto.CommitReq)) ([]*proto.OrderLine, error) {
+	var lines []*proto.OrderLine
+	for i, item := range items {
+		var res *proto.CommitRes
+		var err error
+		if recorded[i] != nil {
+			res, err = replayCommit(recorded[i], item)
+		} else {
+			res, err = commit(i, item)
+		}
+		if err != nil {
+			for j := i - 1; j >= 0; j-- {
+				compensate(j, items[j])
+			}
+			return nil, fmt.Errorf("bundle line item %d (event %s): %w", i, item.EventId, err)
+		}
+		lines = append(lines, res.Lines...)
+	}
+	return lines, nil
+}
+
+// compensateLineItem returns what a committed line item of a failed bundle sold and
+// forgets its commit so the bundle can be retried. A line item that can't be returned
+// keeps its commit record and is logged for manual repair.
+func (s *InventoryService) compensateLineItem(ctx context.Context, idempotencyKey string, i int, item *proto.CommitReq) {
+	// Compensation must finish even if the request was canceled
+	ctx = context.WithoutCancel(ctx)
+
+	if err := s.uncommit(ctx, item); err != nil {
+		fmt.Printf("Warning: failed to compensate bundle line item %d (event %s) of reservation %s: %v\n", i, item.EventId, item.ReservationId, err)
+		return
+	}
+	if _, err := s.repo.DeleteIdempotencyKey(ctx, bundleLineKey(idempotencyKey, i)); err != nil {
+		fmt.Printf("Warning: failed to forget compensated bundle line item %d of reservation %s: %v\n", i, item.ReservationId, err)
+	}
+}
+
+// uncommit undoes a commit: its seats move from SOLD back to AVAILABLE, on condition
+// they are still sold to the reservation, and its quantities return to their pools
+func (s *InventoryService) uncommit(ctx context.Context, item *proto.CommitReq) error {
+	if len(item.SeatIds) == 0 && len(item.SectionQtys) == 0 {
+		return s.uncommitQuantity(ctx, item)
+	}
+
+	if err := checkSeatTransitions(compensationSources, seatAvailable); err != nil {
+		return err
+	}
+	seatIDs := make([]string, len(item.SeatIds))
+	seatUpdates := make([]*repo.SeatItem, len(item.SeatIds))
+	for i, seatRef := range item.SeatIds {
+		seatIDs[i] = seatRef.SeatId
+		seatUpdates[i] = &repo.SeatItem{
+			EventID:   item.EventId,
+			SeatID:    seatRef.SeatId,
+			Status:    seatAvailable,
+			UpdatedAt: time.Now(),
+		}
+	}
+	conditionExpr := "#status = :sold AND reservation_id = :reservation_id"
+	exprValues := map[string]types.AttributeValue{
+		":sold":           &types.AttributeValueMemberS{Value: seatSold},
+		":reservation_id": &types.AttributeValueMemberS{Value: item.ReservationId},
+	}
+
+	var err error
+	if len(item.SectionQtys) > 0 {
+		sectionDeltas, deltaErr := hybridSectionDeltas(item.Qty, item.SectionQtys, len(seatIDs), 1)
+		if deltaErr != nil {
+			return deltaErr
+		}
+		err = s.repo.TransactWriteSeatsAndSections(ctx, item.EventId, seatUpdates, conditionExpr, exprValues, nil, sectionDeltas, nil, nil)
+	} else {
+		err = s.repo.TransactWriteSeats(ctx, seatUpdates, conditionExpr, exprValues, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to return sold seats: %w", err)
+	}
+
+	s.cacheSeatStatus(ctx, item.EventId, seatIDs, seatAvailable)
+	s.restock.SeatsReturned(ctx, item.EventId, seatIDs, "RELEASED")
+	return nil
+}
+
+// uncommitQuantity returns the tickets of a quantity commit to the event's pool. Unlike a
+// release it ignores freezes: the tickets were never really sold.
+func (s *InventoryService) uncommitQuantity(ctx context.Context, item *proto.CommitReq) error {
+	updateExpr := "SET remaining = remaining + :qty, version = version + 1, updated_at = :updated_at"
+	exprValues := map[string]types.AttributeValue{
+		":qty":        &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", item.Qty)},
+		":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+	}
+
+	previous, err := s.repo.UpdateInventoryConditionallyReturnOld(ctx, item.EventId, updateExpr, "attribute_exists(remaining)", exprValues, nil)
+	if err != nil {
+		return fmt.Errorf("failed to return quantity: %w", err)
+	}
+
+	s.cacheRemainingDelta(ctx, item.EventId, item.Qty)
+	s.restock.QuantityReturned(ctx, item.EventId, previous.Remaining, item.Qty, "RELEASED")
+	return nil
+}
+
+// bundleLineItems validates a bundle and returns the commit of each line item under the
+// bundle's reservation
+func bundleLineItems(req *proto.CommitReq) ([]*proto.CommitReq, error) {
+	if req.EventId != "" || req.PerformanceId != "" || req.Qty != 0 || len(req.SeatIds) > 0 || len(req.SectionQtys) > 0 {
+		return nil, errors.New("invalid request: a bundle commit takes its events and seats from line_items only")
+	}
+	if len(req.LineItems) > maxBundleLineItems {
+		return nil, fmt.Errorf("invalid request: at most %d line items per bundle", maxBundleLineItems)
+	}
+
+	items := make([]*proto.CommitReq, len(req.LineItems))
+	seen := make(map[string]bool, len(req.LineItems))
+	for i, line := range req.LineItems {
+		item := &proto.CommitReq{
+			ReservationId:   req.ReservationId,
+			EventId:         line.EventId,
+			Qty:             line.Qty,
+			SeatIds:         line.SeatIds,
+			PaymentIntentId: req.PaymentIntentId,
+			SectionQtys:     line.SectionQtys,
+		}
+		if item.EventId == "" {
+			return nil, fmt.Errorf("invalid request: line item %d has no event_id", i)
+		}
+		if item.Qty <= 0 && len(item.SeatIds) == 0 && len(item.SectionQtys) == 0 {
+			return nil, fmt.Errorf("invalid request: line item %d commits nothing", i)
+		}
+		if err := usePerformanceKey(&item.EventId, line.PerformanceId); err != nil {
+			return nil, err
+		}
+		if seen[item.EventId] {
+			return nil, fmt.Errorf("invalid request: event %s appears in more than one line item", item.EventId)
+		}
+		seen[item.EventId] = true
+		items[i] = item
+	}
+	return items, nil
+}
+
+// bundleLineKey returns the idempotency key recording the commit of one line item of a bundle
+func bundleLineKey(idempotencyKey string, line int) string {
+	return fmt.Sprintf("%s#%d", idempotencyKey, line)
+}
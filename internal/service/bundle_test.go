@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+func TestRunBundle(t *testing.T) {
+	seatLine := &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-1", SeatIds: seatRefs("A-1-1", "A-1-2")}
+	quantityLine := &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-2", Qty: 2}
+	hybridLine := &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-3", SeatIds: seatRefs("B-1-1"), SectionQtys: []*proto.SectionQty{{Section: "GA", Qty: 1}}}
+	items := []*proto.CommitReq{seatLine, quantityLine, hybridLine}
+
+	committed := func(item *proto.CommitReq) *proto.CommitRes {
+		return confirmedCommit("ord-1", []*proto.OrderLine{{EventId: item.EventId}})
+	}
+	recordOf := func(item *proto.CommitReq) *repo.IdempotencyItem {
+		return commitRecord("commit:rsv-1#0", item, committed(item))
+	}
+
+	tests := []struct {
+		name     string
+		recorded []*repo.IdempotencyItem
+		fail     int
+		// commits and compensated list the line items committed and compensated, in order
+		commits     []int
+		compensated []int
+		wantErr     string
+	}{
+		{
+			name:    "all commit",
+			fail:    -1,
+			commits: []int{0, 1, 2},
+		},
+		{
+			name:        "second fails after a seat line",
+			fail:        1,
+			commits:     []int{0, 1},
+			compensated: []int{0},
+			wantErr:     "bundle line item 1 (event evt-2)",
+		},
+		{
+			name:        "last fails",
+			fail:        2,
+			commits:     []int{0, 1, 2},
+			compensated: []int{1, 0},
+			wantErr:     "bundle line item 2 (event evt-3)",
+		},
+		{
+			name:    "first fails",
+			fail:    0,
+			commits: []int{0},
+			wantErr: "bundle line item 0 (event evt-1)",
+		},
+		{
+			name:     "resumed",
+			recorded: []*repo.IdempotencyItem{recordOf(seatLine), nil, nil},
+			fail:     -1,
+			commits:  []int{1, 2},
+		},
+		{
+			name:        "resumed and failing",
+			recorded:    []*repo.IdempotencyItem{recordOf(seatLine), nil, nil},
+			fail:        2,
+			commits:     []int{1, 2},
+			compensated: []int{1, 0},
+			wantErr:     "bundle line item 2",
+		},
+		{
+			name:        "recorded for another request",
+			recorded:    []*repo.IdempotencyItem{nil, recordOf(hybridLine), nil},
+			fail:        -1,
+			commits:     []int{0},
+			compensated: []int{0},
+			wantErr:     "committed with a different request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorded := tt.recorded
+			if recorded == nil {
+				recorded = make([]*repo.IdempotencyItem, len(items))
+			}
+			var commits, compensated []int
+			commit := func(i int, item *proto.CommitReq) (*proto.CommitRes, error) {
+				commits = append(commits, i)
+				if i == tt.fail {
+					return nil, errors.New("conflict")
+				}
+				return committed(item), nil
+			}
+			compensate := func(i int, item *proto.CommitReq) {
+				if item != items[i] {
+					t.Errorf("line item %d compensated with another request", i)
+				}
+				compensated = append(compensated, i)
+			}
+
+			lines, err := runBundle(items, recorded, commit, compensate)
+			if !slices.Equal(commits, tt.commits) {
+				t.Errorf("committed line items %v, want %v", commits, tt.commits)
+			}
+			if !slices.Equal(compensated, tt.compensated) {
+				t.Errorf("compensated line items %v, want %v", compensated, tt.compensated)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("runBundle() = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runBundle() = %v", err)
+			}
+			var events []string
+			for _, line := range lines {
+				events = append(events, line.EventId)
+			}
+			if want := []string{"evt-1", "evt-2", "evt-3"}; !slices.Equal(events, want) {
+				t.Fatalf("order lines of events %v, want %v", events, want)
+			}
+		})
+	}
+}
+
+func TestCompensationTransitions(t *testing.T) {
+	if err := checkSeatTransitions(compensationSources, seatAvailable); err != nil {
+		t.Fatalf("compensation can't return sold seats: %v", err)
+	}
+	if slices.Contains(releaseSources, seatSold) {
+		t.Fatalf("releases may return sold seats")
+	}
+	if slices.Contains(seatTransitionSources(seatAvailable), seatSold) {
+		t.Fatalf("operators may return sold seats")
+	}
+}
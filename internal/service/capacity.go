@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Capacity planning defaults and DynamoDB limits
+const (
+	defaultPlanLookback     = 5 * time.Minute
+	defaultPlanPeakFactor   = 3.0
+	defaultPlanAvgOrderSize = 2.0
+
+	// partitionWriteLimit is the DynamoDB write capacity units per second a single partition sustains
+	partitionWriteLimit = 1000.0
+)
+
+// PlanCapacity projects sell-out time and peak write throughput for an event. Without a
+// requested velocity it projects from the event's demand forecast, if one was pushed.
+//
+// Write model per order: a quantity commit is one counter update plus one idempotency
+// record; a seat commit is a transaction costing two write units per seat plus one
+// idempotency record. All writes of an event land on a single partition key, so the
+// recommended shard count is the peak write rate divided by the per-partition limit.
+func (s *AdminService) PlanCapacity(ctx context.Context, req *proto.PlanCapacityReq) (*proto.PlanCapacityRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+	if req.SalesPerSecond < 0 || req.PeakFactor < 0 || req.AvgOrderSize < 0 || req.LookbackSeconds < 0 {
+		return nil, errors.New("invalid request: planning parameters must not be negative")
+	}
+
+	peakFactor := req.PeakFactor
+	if peakFactor == 0 {
+		peakFactor = defaultPlanPeakFactor
+	}
+	avgOrderSize := req.AvgOrderSize
+	if avgOrderSize == 0 {
+		avgOrderSize = defaultPlanAvgOrderSize
+	}
+	lookback := defaultPlanLookback
+	if req.LookbackSeconds > 0 {
+		lookback = time.Duration(req.LookbackSeconds) * time.Second
+	}
+
+	inventoryType, remaining, err := s.eventRemaining(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	res := &proto.PlanCapacityRes{
+		InventoryType: inventoryType,
+		Remaining:     remaining,
+	}
+
+	var forecast *repo.DemandForecast
+	if req.SalesPerSecond == 0 {
+		if forecast, err = s.demandForecast(ctx, req.EventId); err != nil {
+			return nil, err
+		}
+	}
+
+	peakSalesPerSecond := 0.0
+	switch {
+	case req.SalesPerSecond > 0:
+		res.SalesPerSecond = req.SalesPerSecond
+		res.VelocitySource = "REQUEST"
+	case forecast != nil:
+		// The forecast projects sell-out itself and carries its own peak
+		projection := projectForecast(forecast, res.Remaining, time.Now())
+		res.SalesPerSecond = projection.salesPerSecond
+		res.VelocitySource = "FORECAST"
+		peakSalesPerSecond = projection.peakSalesPerSecond
+		if !projection.sellout.IsZero() {
+			res.SecondsToSellout = int64(math.Ceil(time.Until(projection.sellout).Seconds()))
+			res.ProjectedSellout = timestamppb.New(projection.sellout)
+		}
+	case res.InventoryType == "SEAT":
+		sold, err := s.repo.CountSeatsByStatus(ctx, req.EventId, seatSold, time.Now().Add(-lookback))
+		if err != nil {
+			return nil, fmt.Errorf("failed to count sold seats: %w", err)
+		}
+		res.SalesPerSecond = float64(sold) / lookback.Seconds()
+		res.VelocitySource = "MEASURED"
+	default:
+		// Quantity commits keep no per-sale history to measure from
+		res.VelocitySource = "NONE"
+	}
+
+	if forecast == nil && res.SalesPerSecond > 0 && res.Remaining > 0 {
+		secondsToSellout := math.Ceil(float64(res.Remaining) / res.SalesPerSecond)
+		res.SecondsToSellout = int64(secondsToSellout)
+		res.ProjectedSellout = timestamppb.New(time.Now().Add(time.Duration(secondsToSellout) * time.Second))
+	}
+
+	if forecast == nil {
+		peakSalesPerSecond = res.SalesPerSecond * peakFactor
+	}
+	ordersPerSecond := peakSalesPerSecond / avgOrderSize
+	writesPerOrder := 2.0
+	if res.InventoryType == "SEAT" {
+		writesPerOrder = 2*avgOrderSize + 1
+	}
+	res.PeakWriteUnitsPerSecond = ordersPerSecond * writesPerOrder
+	res.RecommendedShards = int32(max(1, math.Ceil(res.PeakWriteUnitsPerSecond/partitionWriteLimit)))
+
+	return res, nil
+}
+
+// eventRemaining returns an event's inventory type and remaining tickets.
+// Seat events are recognized by their available seats; anything else falls back to the counter.
+func (s *AdminService) eventRemaining(ctx context.Context, eventID string) (string, int32, error) {
+	available, err := s.repo.CountSeatsByStatus(ctx, eventID, seatAvailable, time.Time{})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to count available seats: %w", err)
+	}
+	if available > 0 {
+		s.repairer.Check(ctx, eventID, int32(available), "read")
+		return "SEAT", int32(available), nil
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			// Sold-out seat event
+			return "SEAT", 0, nil
+		}
+		return "", 0, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	if inventory.TotalSeats > 0 {
+		// Sold-out seat event instantiated from a venue template
+		s.repairer.Check(ctx, eventID, 0, "read")
+		return "SEAT", 0, nil
+	}
+	return "QUANTITY", inventory.Remaining, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+const (
+	// maxCloseChunkSize leaves room for the ledger entry in a transaction
+	maxCloseChunkSize = maxSeatsPerTransaction - 1
+	// defaultCloseSeatsPerSecond paces CloseEvent to limit contention with live traffic
+	defaultCloseSeatsPerSecond = 200
+)
+
+// CloseEvent moves all available seats of an event to SOLD or CLOSED in transactional
+// chunks paced to a seat rate, writing a ledger entry with every chunk and calling report
+// with cumulative progress after every chunk and once more when done. A chunk that fails
+// (e.g. a seat was held meanwhile) is counted as failed and skipped; rerunning the
+// operation closes the seats still available.
+func (s *AdminService) CloseEvent(ctx context.Context, req *proto.CloseEventReq, report func(*proto.CloseEventProgress) error) error {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return err
+	}
+
+	if req.EventId == "" {
+		return errors.New("invalid request: event_id is required")
+	}
+	to := seatStatusName(req.Status)
+	if to != seatSold && to != seatClosed {
+		return errors.New("invalid request: status must be SEAT_STATUS_SOLD or SEAT_STATUS_CLOSED")
+	}
+	chunkSize := int(req.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultReleaseChunkSize
+	}
+	if chunkSize > maxCloseChunkSize {
+		return fmt.Errorf("invalid request: chunk_size must be at most %d", maxCloseChunkSize)
+	}
+	seatsPerSecond := int(req.SeatsPerSecond)
+	if seatsPerSecond <= 0 {
+		seatsPerSecond = defaultCloseSeatsPerSecond
+	}
+
+	exprValues := map[string]types.AttributeValue{
+		":available": &types.AttributeValueMemberS{Value: seatAvailable},
+	}
+	exprNames := map[string]string{
+		"#status": "status",
+	}
+
+	progress := &proto.CloseEventProgress{
+		CloseId: fmt.Sprintf("close_%s", uuid.New().String()[:12]),
+	}
+	var startKey map[string]types.AttributeValue
+	for {
+		seats, nextKey, err := s.repo.QuerySeatsByStatus(ctx, req.EventId, seatAvailable, time.Time{}, startKey, int32(chunkSize*4))
+		if err != nil {
+			return fmt.Errorf("failed to list available seats: %w", err)
+		}
+		progress.Scanned += int32(len(seats))
+
+		for start := 0; start < len(seats); start += chunkSize {
+			now := time.Now()
+			chunk := seats[start:min(start+chunkSize, len(seats))]
+			for _, seat := range chunk {
+				seat.Status = to
+				seat.UpdatedAt = now
+			}
+
+			entry := &repo.LedgerEntry{
+				EventID:    req.EventId,
+				EntryID:    fmt.Sprintf("%s#%s#%04d", now.UTC().Format(time.RFC3339Nano), progress.CloseId, progress.LedgerEntries),
+				Operation:  progress.CloseId,
+				Kind:       operationCloseEvent,
+				SeatIDs:    seatIDsOf(chunk),
+				FromStatus: seatAvailable,
+				ToStatus:   to,
+				Reason:     req.Reason,
+				CreatedAt:  now,
+			}
+			err := s.repo.TransactWriteSeatsWithLedger(ctx, chunk, "#status = :available", exprValues, exprNames, entry)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				fmt.Printf("Warning: failed to close %d seats for event %s: %v\n", len(chunk), req.EventId, err)
+				progress.Failed += int32(len(chunk))
+			} else {
+				progress.Closed += int32(len(chunk))
+				progress.LedgerEntries++
+				s.inventory.cacheSeatStatus(ctx, req.EventId, entry.SeatIDs, to)
+			}
+
+			if err := report(progress); err != nil {
+				return err
+			}
+			if err := pace(ctx, time.Duration(len(chunk))*time.Second/time.Duration(seatsPerSecond)); err != nil {
+				return err
+			}
+		}
+
+		if nextKey == nil {
+			break
+		}
+		startKey = nextKey
+	}
+
+	fmt.Printf("Closed %d seats of event %s as %s (%d failed, close %s): %s\n",
+		progress.Closed, req.EventId, to, progress.Failed, progress.CloseId, req.Reason)
+
+	progress.Done = true
+	return report(progress)
+}
+
+// pace waits for d unless ctx is canceled first
+func pace(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
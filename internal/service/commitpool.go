@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// CommitPool runs commits on a fixed number of workers so a burst of commits queues
+// briefly instead of exceeding table throughput with concurrent transactions.
+// It is independent of the gRPC stream limit, which also admits reads.
+// A nil pool runs commits on the calling goroutine.
+type CommitPool struct {
+	config  appconfig.CommitPoolConfig
+	metrics *observability.Metrics
+	jobs    chan commitJob
+	// stopped is closed once every worker has exited
+	stopped chan struct{}
+	// mu orders enqueues before the queue is drained on shutdown; closed is set then
+	mu     sync.RWMutex
+	closed bool
+}
+
+// errCommitPoolStopped fails commits queued when the server shuts down
+var errCommitPoolStopped = apperrors.New(apperrors.ErrUnavailable, "commit pool is shutting down")
+
+// commitJob is a queued commit. finish is called exactly once with its result, also when
+// it never runs because its caller gave up or the pool shut down.
+type commitJob struct {
+	ctx      context.Context
+	run      func(ctx context.Context) error
+	finish   func(err error)
+	enqueued time.Time
+}
+
+// NewCommitPool creates a commit pool, or returns nil when it is disabled
+func NewCommitPool(cfg *appconfig.Config, metrics *observability.Metrics) *CommitPool {
+	if cfg.CommitPool.Workers <= 0 {
+		return nil
+	}
+	return &CommitPool{
+		config:  cfg.CommitPool,
+		metrics: metrics,
+		jobs:    make(chan commitJob, cfg.CommitPool.QueueSize),
+		stopped: make(chan struct{}),
+	}
+}
+
+// Run starts the workers and blocks until ctx is canceled and in-flight commits finish.
+// Commits still queued then fail, so their callers don't wait forever.
+func (p *CommitPool) Run(ctx context.Context) {
+	defer p.drain()
+
+	done := make(chan struct{})
+	for i := 0; i < p.config.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					done <- struct{}{}
+					return
+				case job := <-p.jobs:
+					p.process(job)
+				}
+			}
+		}()
+	}
+	for i := 0; i < p.config.Workers; i++ {
+		<-done
+	}
+}
+
+// drain stops accepting commits once the workers exited and fails the queued ones
+func (p *CommitPool) drain() {
+	close(p.stopped)
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	for {
+		select {
+		case job := <-p.jobs:
+			job.finish(errCommitPoolStopped)
+		default:
+			return
+		}
+	}
+}
+
+// process runs one job unless its caller has given up while it was queued
+func (p *CommitPool) process(job commitJob) {
+	p.metrics.RecordCommitQueueWait(time.Since(job.enqueued))
+	if err := job.ctx.Err(); err != nil {
+		job.finish(err)
+		return
+	}
+	job.finish(job.run(job.ctx))
+}
+
+// Do runs a commit on a worker and returns its result. It fails fast if the request's
+// deadline leaves too little time to commit, and fails if no worker accepts the commit
+// within the queue wait.
+func (p *CommitPool) Do(ctx context.Context, run func(ctx context.Context) error) error {
+	if p == nil {
+		return run(ctx)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline); left < p.config.MinTimeLeft {
+			p.metrics.RecordCommitRejected("deadline")
+			return apperrors.New(apperrors.ErrDeadlineTooClose, "deadline too close to commit: %s left", left)
+		}
+	}
+
+	done := make(chan error, 1)
+	if err := p.enqueue(ctx, run, func(err error) { done <- err }); err != nil {
+		return err
+	}
+	return <-done
+}
+
+// QueueFill returns the share of the commit queue in use, 0 for a nil pool
+func (p *CommitPool) QueueFill() float64 {
+	if p == nil || cap(p.jobs) == 0 {
+		return 0
+	}
+	return float64(len(p.jobs)) / float64(cap(p.jobs))
+}
+
+// Submit queues a commit without waiting for it to run. The commit runs with ctx,
+// which should not be canceled with the request that submitted it. Once queued, finish
+// is called with the commit's result, or with why it didn't run: ctx ending while it was
+// queued or the pool shutting down.
+func (p *CommitPool) Submit(ctx context.Context, run func(ctx context.Context) error, finish func(err error)) error {
+	return p.enqueue(ctx, run, finish)
+}
+
+// enqueue queues a commit, waiting at most the queue wait for a slot
+func (p *CommitPool) enqueue(ctx context.Context, run func(ctx context.Context) error, finish func(err error)) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errCommitPoolStopped
+	}
+
+	job := commitJob{
+		ctx:      ctx,
+		run:      run,
+		finish:   finish,
+		enqueued: time.Now(),
+	}
+
+	wait := time.NewTimer(p.config.QueueWait)
+	defer wait.Stop()
+	select {
+	case p.jobs <- job:
+		return nil
+	case <-wait.C:
+		p.metrics.RecordCommitRejected("queue_full")
+		return apperrors.New(apperrors.ErrOverloaded, "commit queue full: %d commits waiting", len(p.jobs))
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.stopped:
+		return errCommitPoolStopped
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// newTestCommitPool returns a commit pool whose workers aren't started yet, with
+// unregistered metrics
+func newTestCommitPool(cfg appconfig.CommitPoolConfig) *CommitPool {
+	return NewCommitPool(&appconfig.Config{CommitPool: cfg}, &observability.Metrics{
+		CommitQueueWait:     prometheus.NewHistogram(prometheus.HistogramOpts{Name: "commit_queue_wait_seconds"}),
+		CommitRejectedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "commit_rejected_total"}, []string{"reason"}),
+	})
+}
+
+func TestCommitPoolDo(t *testing.T) {
+	failed := errors.New("conflict")
+	deadline := func(left time.Duration) func() (context.Context, context.CancelFunc) {
+		return func() (context.Context, context.CancelFunc) {
+			return context.WithTimeout(context.Background(), left)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		result  error
+		ran     bool
+		wantErr error
+		// rejected is the reason the pool records for rejecting the commit, if any
+		rejected string
+	}{
+		{name: "commits", ran: true},
+		{name: "returns the commit's error", result: failed, ran: true, wantErr: failed},
+		{name: "time left", ctx: deadline(time.Minute), ran: true},
+		{name: "deadline too close", ctx: deadline(time.Millisecond), wantErr: apperrors.ErrDeadlineTooClose, rejected: "deadline"},
+		{
+			name: "caller gone",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestCommitPool(appconfig.CommitPoolConfig{Workers: 2, QueueSize: 4, QueueWait: time.Second, MinTimeLeft: 50 * time.Millisecond})
+			workers, stop := context.WithCancel(context.Background())
+			defer stop()
+			go p.Run(workers)
+
+			ctx, cancel := context.Background(), context.CancelFunc(func() {})
+			if tt.ctx != nil {
+				ctx, cancel = tt.ctx()
+			}
+			defer cancel()
+
+			ran := false
+			err := p.Do(ctx, func(ctx context.Context) error {
+				ran = true
+				return tt.result
+			})
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Fatalf("Do() = %v, want %v", err, tt.wantErr)
+			}
+			if ran != tt.ran {
+				t.Fatalf("commit ran = %v, want %v", ran, tt.ran)
+			}
+			if tt.rejected != "" {
+				if got := testutil.ToFloat64(p.metrics.CommitRejectedTotal.WithLabelValues(tt.rejected)); got != 1 {
+					t.Fatalf("%s rejections = %v, want 1", tt.rejected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestNilCommitPoolRunsInline(t *testing.T) {
+	var p *CommitPool
+	want := errors.New("conflict")
+	if err := p.Do(context.Background(), func(context.Context) error { return want }); err != want {
+		t.Fatalf("Do() = %v, want %v", err, want)
+	}
+	if fill := p.QueueFill(); fill != 0 {
+		t.Fatalf("QueueFill() = %v, want 0", fill)
+	}
+}
+
+func TestCommitPoolQueueFull(t *testing.T) {
+	// Without workers, the queue fills up
+	p := newTestCommitPool(appconfig.CommitPoolConfig{Workers: 1, QueueSize: 2, QueueWait: 10 * time.Millisecond})
+	run := func(context.Context) error { return nil }
+	for i := 0; i < 2; i++ {
+		if err := p.Submit(context.Background(), run, func(error) {}); err != nil {
+			t.Fatalf("Submit() #%d = %v, want queued", i, err)
+		}
+	}
+	if fill := p.QueueFill(); fill != 1 {
+		t.Fatalf("QueueFill() = %v, want 1", fill)
+	}
+
+	err := p.Submit(context.Background(), run, func(error) { t.Error("rejected commit finished") })
+	if !errors.Is(err, apperrors.ErrOverloaded) {
+		t.Fatalf("Submit() to a full queue = %v, want ErrOverloaded", err)
+	}
+	if got := testutil.ToFloat64(p.metrics.CommitRejectedTotal.WithLabelValues("queue_full")); got != 1 {
+		t.Fatalf("queue_full rejections = %v, want 1", got)
+	}
+}
+
+func TestCommitPoolDrain(t *testing.T) {
+	p := newTestCommitPool(appconfig.CommitPoolConfig{Workers: 1, QueueSize: 4, QueueWait: 10 * time.Millisecond})
+
+	results := make(chan error, 2)
+	ran := make(chan struct{}, 2)
+	run := func(context.Context) error {
+		ran <- struct{}{}
+		return nil
+	}
+	for i := 0; i < 2; i++ {
+		if err := p.Submit(context.Background(), run, func(err error) { results <- err }); err != nil {
+			t.Fatalf("Submit() = %v", err)
+		}
+	}
+
+	// Workers exiting leave the queued commits to the drain
+	p.drain()
+
+	if len(ran) != 0 {
+		t.Fatalf("%d commits ran after the workers exited", len(ran))
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-results:
+			if !errors.Is(err, apperrors.ErrUnavailable) {
+				t.Fatalf("drained commit finished with %v, want ErrUnavailable", err)
+			}
+		default:
+			t.Fatalf("%d of 2 queued commits finished", i)
+		}
+	}
+
+	err := p.Submit(context.Background(), run, func(error) { t.Error("commit submitted after the drain finished") })
+	if !errors.Is(err, apperrors.ErrUnavailable) {
+		t.Fatalf("Submit() after the drain = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestCommitPoolSkipsCommitsOfGoneCallers(t *testing.T) {
+	p := newTestCommitPool(appconfig.CommitPoolConfig{Workers: 1, QueueSize: 1, QueueWait: time.Second})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	finished := make(chan error, 1)
+	if err := p.Submit(ctx, func(context.Context) error {
+		t.Error("commit of a gone caller ran")
+		return nil
+	}, func(err error) { finished <- err }); err != nil {
+		t.Fatalf("Submit() = %v", err)
+	}
+	cancel()
+
+	workers, stop := context.WithCancel(context.Background())
+	defer stop()
+	go p.Run(workers)
+
+	select {
+	case err := <-finished:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("commit finished with %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("commit of a gone caller never finished")
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	gproto "google.golang.org/protobuf/proto"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// commitRecord returns the idempotency record of a confirmed commit: its order ID, the
+// hash of the request and the response, which retries get back verbatim. The order ID is
+// kept out of the stored response, in the operation field, which field encryption seals.
+func commitRecord(idempotencyKey string, req *proto.CommitReq, res *proto.CommitRes) *repo.IdempotencyItem {
+	item := &repo.IdempotencyItem{
+		Key:         idempotencyKey,
+		Operation:   res.OrderId,
+		EventID:     req.EventId,
+		CreatedAt:   time.Now(),
+		RequestHash: commitRequestHash(req),
+	}
+
+	stored := gproto.Clone(res).(*proto.CommitRes)
+	stored.OrderId = ""
+	response, err := gproto.Marshal(stored)
+	if err != nil {
+		fmt.Printf("Warning: failed to record the response of order %s: %v\n", res.OrderId, err)
+		return item
+	}
+	item.Response = response
+	return item
+}
+
+// replayCommit returns the response recorded for a commit, or ErrIdempotencyConflict if
+// req isn't the request that made it. Records written before responses were stored only
+// hold the order ID.
+func replayCommit(item *repo.IdempotencyItem, req *proto.CommitReq) (*proto.CommitRes, error) {
+	if item.RequestHash != "" && item.RequestHash != commitRequestHash(req) {
+		return nil, apperrors.New(apperrors.ErrIdempotencyConflict, "idempotency conflict: reservation %s was committed with a different request", req.ReservationId)
+	}
+
+	res := &proto.CommitRes{Status: "CONFIRMED"}
+	if len(item.Response) > 0 {
+		if err := gproto.Unmarshal(item.Response, res); err != nil {
+			return nil, fmt.Errorf("failed to read the recorded commit response: %w", err)
+		}
+	}
+	res.OrderId = item.Operation
+	return res, nil
+}
+
+// replayRecordedCommit answers a commit whose transaction found its idempotency record
+// already written, by a concurrent attempt of the same reservation, with that record's
+// response. conflict is returned if the record expired in between.
+func (s *InventoryService) replayRecordedCommit(ctx context.Context, idempotencyKey string, req *proto.CommitReq, conflict error) (*proto.CommitRes, error) {
+	item, err := s.repo.GetIdempotency(ctx, idempotencyKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check idempotency: %w", err)
+	}
+	if item == nil {
+		return nil, conflict
+	}
+	return replayCommit(item, req)
+}
+
+// commitRequestHash hashes a commit request without its pre-authorization token, which a
+// retry may carry reissued
+func commitRequestHash(req *proto.CommitReq) string {
+	hashed := gproto.Clone(req).(*proto.CommitReq)
+	hashed.PreauthToken = ""
+	data, err := gproto.MarshalOptions{Deterministic: true}.Marshal(hashed)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
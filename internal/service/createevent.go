@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+const (
+	// maxEventSeats bounds the seats of a layout provisioned by CreateEvent
+	maxEventSeats = 200000
+	// provisionReportSeats is how many seats CreateEvent writes between progress reports
+	provisionReportSeats = 1000
+)
+
+// CreateEvent creates an event's inventory item and provisions its seats from a seat
+// layout, calling report with cumulative progress after every batch of seats and once
+// more when done. The event is created frozen, so nothing can be held or sold until every
+// seat is written; rerunning with the same layout rewrites the seats and completes an
+// interrupted run.
+func (s *AdminService) CreateEvent(ctx context.Context, req *proto.CreateEventReq, report func(*proto.CreateEventProgress) error) error {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return err
+	}
+
+	if req.EventId == "" || len(req.Sections) == 0 {
+		return errors.New("invalid request: event_id and sections are required")
+	}
+	seatIDs, err := layoutSeatIDs(req.Sections)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = s.repo.CreateProvisioningInventory(ctx, &repo.InventoryItem{
+		EventID:        req.EventId,
+		Remaining:      int32(len(seatIDs)),
+		Version:        1,
+		UpdatedAt:      now,
+		TotalSeats:     int32(len(seatIDs)),
+		SeatMapVersion: 1,
+	})
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return fmt.Errorf("event %s conflict: already exists", req.EventId)
+		}
+		return err
+	}
+
+	adjacency := layoutAdjacency(req.Sections)
+	progress := &proto.CreateEventProgress{TotalSeats: int32(len(seatIDs))}
+	for start := 0; start < len(seatIDs); start += provisionReportSeats {
+		chunk := seatIDs[start:min(start+provisionReportSeats, len(seatIDs))]
+		seats := make([]*repo.SeatItem, len(chunk))
+		for i, seatID := range chunk {
+			seats[i] = &repo.SeatItem{
+				EventID:   req.EventId,
+				SeatID:    seatID,
+				Status:    seatAvailable,
+				UpdatedAt: now,
+				Adjacency: adjacency[seatID],
+			}
+		}
+		if err := s.repo.PutSeats(ctx, seats); err != nil {
+			return fmt.Errorf("failed to provision seats: %w", err)
+		}
+		s.inventory.cacheSeatStatus(ctx, req.EventId, chunk, seatAvailable)
+		progress.Provisioned += int32(len(chunk))
+
+		if err := report(progress); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.SetEventFrozen(ctx, req.EventId, false, ""); err != nil {
+		return fmt.Errorf("failed to unfreeze provisioned event: %w", err)
+	}
+
+	fmt.Printf("Created event %s with %d seats in %d sections\n", req.EventId, len(seatIDs), len(req.Sections))
+
+	progress.Done = true
+	return report(progress)
+}
+
+// layoutSeatIDs validates a seat layout and returns its seat IDs, "<section>-<row>-<number>"
+func layoutSeatIDs(sections []*proto.SeatLayoutSection) ([]string, error) {
+	total := 0
+	seenSections := make(map[string]bool, len(sections))
+	for _, section := range sections {
+		if section.Section == "" || strings.Contains(section.Section, "-") {
+			return nil, errors.New(`invalid request: section names must be non-empty and not contain "-"`)
+		}
+		if seenSections[section.Section] {
+			return nil, fmt.Errorf("invalid request: duplicate section %s", section.Section)
+		}
+		seenSections[section.Section] = true
+		if len(section.Rows) == 0 || section.SeatsPerRow <= 0 {
+			return nil, fmt.Errorf("invalid request: section %s needs rows and a positive seats_per_row", section.Section)
+		}
+
+		seenRows := make(map[string]bool, len(section.Rows))
+		for _, row := range section.Rows {
+			if row == "" {
+				return nil, fmt.Errorf("invalid request: section %s has an empty row name", section.Section)
+			}
+			if seenRows[row] {
+				return nil, fmt.Errorf("invalid request: duplicate row %s in section %s", row, section.Section)
+			}
+			seenRows[row] = true
+		}
+		for _, aisle := range section.AislesAfter {
+			if aisle < 1 || aisle >= section.SeatsPerRow {
+				return nil, fmt.Errorf("invalid request: section %s has an aisle after seat %d outside its rows", section.Section, aisle)
+			}
+		}
+
+		total += len(section.Rows) * int(section.SeatsPerRow)
+		if total > maxEventSeats {
+			return nil, fmt.Errorf("invalid request: at most %d seats per event", maxEventSeats)
+		}
+	}
+
+	seatIDs := make([]string, 0, total)
+	for _, section := range sections {
+		for _, row := range section.Rows {
+			for number := 1; number <= int(section.SeatsPerRow); number++ {
+				seatIDs = append(seatIDs, fmt.Sprintf("%s-%s-%d", section.Section, row, number))
+			}
+		}
+	}
+	return seatIDs, nil
+}
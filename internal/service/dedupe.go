@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	gproto "google.golang.org/protobuf/proto"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+)
+
+// RequestDeduper collapses identical requests from the same caller that arrive within a
+// short window, typically gateway retransmits, into one backend execution whose result
+// they all share. It complements the idempotency table, which only catches requests
+// arriving after the first one finished. A nil deduper executes every request.
+type RequestDeduper struct {
+	window  time.Duration
+	metrics *observability.Metrics
+
+	mu    sync.Mutex
+	calls map[[sha256.Size]byte]*dedupeCall
+}
+
+// dedupeCall is an execution shared by identical requests
+type dedupeCall struct {
+	done chan struct{}
+	res  gproto.Message
+	err  error
+}
+
+// NewRequestDeduper creates a request deduper, or returns nil when the window is 0
+func NewRequestDeduper(cfg *appconfig.Config, metrics *observability.Metrics) *RequestDeduper {
+	if cfg.Idempotency.DedupeWindow <= 0 {
+		return nil
+	}
+	return &RequestDeduper{
+		window:  cfg.Idempotency.DedupeWindow,
+		metrics: metrics,
+		calls:   make(map[[sha256.Size]byte]*dedupeCall),
+	}
+}
+
+// dedupe runs fn for req unless an identical request of the same caller is running or
+// finished less than the window ago, in which case it returns a copy of that result.
+// Executions that failed with a context error aren't shared; waiters run fn themselves.
+func dedupe[T gproto.Message](ctx context.Context, d *RequestDeduper, method string, req gproto.Message, fn func() (T, error)) (T, error) {
+	if d == nil {
+		return fn()
+	}
+
+	body, err := gproto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return fn()
+	}
+	key := sha256.Sum256(append([]byte(method+"\x00"+callerID(ctx)+"\x00"), body...))
+
+	d.mu.Lock()
+	if call, ok := d.calls[key]; ok {
+		d.mu.Unlock()
+		d.metrics.RecordDedupedRequest(method)
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+		if call.err == nil {
+			return gproto.Clone(call.res).(T), nil
+		}
+		if !errors.Is(call.err, context.Canceled) && !errors.Is(call.err, context.DeadlineExceeded) {
+			var zero T
+			return zero, call.err
+		}
+		return fn()
+	}
+
+	call := &dedupeCall{done: make(chan struct{})}
+	d.calls[key] = call
+	d.mu.Unlock()
+
+	res, err := fn()
+	call.res, call.err = res, err
+	close(call.done)
+
+	time.AfterFunc(d.window, func() {
+		d.mu.Lock()
+		delete(d.calls, key)
+		d.mu.Unlock()
+	})
+
+	return res, err
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Degraded mode keeps browsing alive while DynamoDB is down. The health monitor marks
+// the service degraded when DynamoDB probes fail; with degraded mode enabled,
+// CheckAvailability is then answered from the seat replica snapshot or the Redis
+// counter without touching DynamoDB and flagged stale, while writes fail fast.
+// Writes only reach the counter after succeeding in DynamoDB, so cached values are
+// as of the outage start at the latest.
+
+// SetDegraded records whether DynamoDB is down
+func (s *InventoryService) SetDegraded(down bool) {
+	if !down {
+		s.degradedSince.Store(nil)
+		return
+	}
+	now := time.Now()
+	s.degradedSince.CompareAndSwap(nil, &now)
+}
+
+// degraded returns since when DynamoDB has been down; ok is false unless degraded mode
+// is enabled and active
+func (s *InventoryService) degraded() (since time.Time, ok bool) {
+	if !s.config.Inventory.DegradedMode {
+		return time.Time{}, false
+	}
+	if since := s.degradedSince.Load(); since != nil {
+		return *since, true
+	}
+	return time.Time{}, false
+}
+
+// checkAvailabilityDegraded answers an availability check from last-known state only
+func (s *InventoryService) checkAvailabilityDegraded(ctx context.Context, req *proto.CheckReq, since time.Time) (*proto.CheckRes, error) {
+	if len(req.SeatIds) == 0 {
+		if s.counter != nil {
+			remaining, ok, err := s.counter.Remaining(ctx, req.EventId)
+			if err == nil && ok {
+				reported, approximate := s.reportedRemaining(remaining)
+				return &proto.CheckRes{
+					Available:            remaining >= req.Qty,
+					Remaining:            reported,
+					RemainingApproximate: approximate,
+					Stale:                true,
+					AsOf:                 timestamppb.New(since),
+				}, nil
+			}
+		}
+		return nil, apperrors.New(apperrors.ErrUnavailable, "availability of event %s is unknown in degraded mode: not cached", req.EventId)
+	}
+
+	seatIDs := make([]string, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatIDs[i] = seatRef.SeatId
+	}
+
+	statuses, asOf, ok := s.replica.SnapshotStatuses(req.EventId, seatIDs)
+	if ok {
+		if since.Before(asOf) {
+			asOf = since
+		}
+	} else if s.counter != nil {
+		var err error
+		statuses, ok, err = s.counter.SeatStatuses(ctx, req.EventId, seatIDs)
+		if err != nil {
+			ok = false
+		}
+		if ok {
+			asOf = since
+		}
+	}
+	if !ok {
+		return nil, apperrors.New(apperrors.ErrUnavailable, "availability of event %s is unknown in degraded mode: seats not cached", req.EventId)
+	}
+
+	var unavailableSeats []string
+	for _, seatID := range seatIDs {
+		if status, ok := statuses[seatID]; ok && status != seatAvailable {
+			unavailableSeats = append(unavailableSeats, seatID)
+		}
+	}
+	seatMapVersion, _ := s.replica.SeatMapVersion(req.EventId)
+
+	return &proto.CheckRes{
+		Available:        len(unavailableSeats) == 0,
+		UnavailableSeats: unavailableSeats,
+		SeatMapVersion:   seatMapVersion,
+		Seats:            seatAvailabilities(seatIDs, statuses, nil),
+		Stale:            true,
+		AsOf:             timestamppb.New(asOf),
+	}, nil
+}
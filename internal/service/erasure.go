@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxErasureReferences bounds the reservations erased per call
+const maxErasureReferences = 100
+
+// erasureTokenPrefix marks reservation identifiers replaced by an erasure
+const erasureTokenPrefix = "erased_"
+
+// EraseSubject replaces a data subject's reservation IDs with opaque tokens on sold seats
+// and asynchronous commit statuses, and deletes the idempotency records keyed by them.
+// Seats still held are left alone, so their reservation can complete; the erasure is
+// reported incomplete and can be run again later. Erasing twice is harmless.
+func (s *AdminService) EraseSubject(ctx context.Context, req *proto.EraseSubjectReq) (*proto.EraseSubjectRes, error) {
+	if req.ErasureId == "" {
+		return nil, errors.New("invalid request: erasure_id is required")
+	}
+	if len(req.References) == 0 || len(req.References) > maxErasureReferences {
+		return nil, fmt.Errorf("invalid request: between 1 and %d references are required", maxErasureReferences)
+	}
+	for _, ref := range req.References {
+		if ref.ReservationId == "" {
+			return nil, errors.New("invalid request: every reference needs a reservation_id")
+		}
+	}
+
+	res := &proto.EraseSubjectRes{ErasureId: req.ErasureId}
+	for _, ref := range req.References {
+		if err := s.eraseReservation(ctx, ref, res); err != nil {
+			return nil, fmt.Errorf("erasure %s stopped: %w", req.ErasureId, err)
+		}
+	}
+	res.Complete = res.SeatsHeld == 0
+	res.CompletedAt = timestamppb.Now()
+
+	// Only counts are recorded, so the audit log holds no reservation identifiers either
+	s.audit.Record("subject_erasure", "", map[string]interface{}{
+		"erasure_id":                  req.ErasureId,
+		"references":                  len(req.References),
+		"seats_tokenized":             res.SeatsTokenized,
+		"commit_statuses_tokenized":   res.CommitStatusesTokenized,
+		"idempotency_records_deleted": res.IdempotencyRecordsDeleted,
+		"seats_held":                  res.SeatsHeld,
+		"complete":                    res.Complete,
+	})
+
+	return res, nil
+}
+
+// eraseReservation erases one reservation and adds the changes to res
+func (s *AdminService) eraseReservation(ctx context.Context, ref *proto.ErasureReference, res *proto.EraseSubjectRes) error {
+	token := s.erasureToken(ref.ReservationId)
+
+	if ref.EventId != "" {
+		eventID := ref.EventId
+		if err := usePerformanceKey(&eventID, ref.PerformanceId); err != nil {
+			return err
+		}
+		tokenized, held, err := s.repo.TokenizeSeatReservation(ctx, eventID, ref.ReservationId, token)
+		if err != nil {
+			return err
+		}
+		res.SeatsTokenized += int32(tokenized)
+		res.SeatsHeld += int32(held)
+	}
+
+	// The commit idempotency record names the order, so its status is found even without order_id
+	commitKey := fmt.Sprintf("commit:%s", ref.ReservationId)
+	orderIDs := []string{}
+	if ref.OrderId != "" {
+		orderIDs = append(orderIDs, ref.OrderId)
+	}
+	commit, err := s.repo.GetIdempotency(ctx, commitKey)
+	if err != nil {
+		return err
+	}
+	if commit != nil && commit.Operation != ref.OrderId {
+		orderIDs = append(orderIDs, commit.Operation)
+	}
+	for _, orderID := range orderIDs {
+		tokenized, err := s.repo.TokenizeCommitStatus(ctx, orderID, ref.ReservationId, token)
+		if err != nil {
+			return err
+		}
+		if tokenized {
+			res.CommitStatusesTokenized++
+		}
+	}
+
+	for _, key := range []string{commitKey, fmt.Sprintf("release:%s", ref.ReservationId)} {
+		deleted, err := s.repo.DeleteIdempotencyKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		if deleted {
+			res.IdempotencyRecordsDeleted++
+		}
+	}
+
+	return nil
+}
+
+// erasureToken returns the token replacing a reservation ID. With a token key the token is
+// a keyed hash, so records of one reservation stay linkable to each other but not to the
+// ID; without one it is random.
+func (s *AdminService) erasureToken(reservationID string) string {
+	if len(s.erasureKey) == 0 {
+		return erasureTokenPrefix + uuid.New().String()
+	}
+	mac := hmac.New(sha256.New, s.erasureKey)
+	mac.Write([]byte(reservationID))
+	return erasureTokenPrefix + hex.EncodeToString(mac.Sum(nil))[:32]
+}
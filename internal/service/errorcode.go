@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// errorCodes maps domain error kinds to the error codes reported to clients, in the
+// order they are checked
+var errorCodes = []struct {
+	kind error
+	code proto.ErrorCode
+}{
+	{apperrors.ErrInsufficientInventory, proto.ErrorCode_ERROR_CODE_INSUFFICIENT_INVENTORY},
+	{apperrors.ErrSeatConflict, proto.ErrorCode_ERROR_CODE_SEAT_CONFLICT},
+	{apperrors.ErrHoldExpired, proto.ErrorCode_ERROR_CODE_HOLD_EXPIRED},
+	{apperrors.ErrEventNotOnSale, proto.ErrorCode_ERROR_CODE_EVENT_NOT_ON_SALE},
+	{apperrors.ErrLimitExceeded, proto.ErrorCode_ERROR_CODE_LIMIT_EXCEEDED},
+	{apperrors.ErrVelocityLimitExceeded, proto.ErrorCode_ERROR_CODE_VELOCITY_LIMIT_EXCEEDED},
+	{apperrors.ErrOrderLimitExceeded, proto.ErrorCode_ERROR_CODE_ORDER_LIMIT_EXCEEDED},
+}
+
+// ErrorCode classifies a commit, release or hold failure; errors of other kinds are
+// unspecified
+func ErrorCode(err error) proto.ErrorCode {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.kind) {
+			return c.code
+		}
+	}
+	return proto.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// GetEventInventory returns an event's aggregate inventory item as stored, so downstream
+// services don't read the inventory table directly. Quantities are exact, unlike the
+// rounded remaining of public availability checks.
+func (s *InventoryService) GetEventInventory(ctx context.Context, req *proto.GetEventInventoryReq) (*proto.EventInventory, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	event, performance := repo.SplitPerformanceKey(req.EventId)
+	return &proto.EventInventory{
+		EventId:        event,
+		PerformanceId:  performance,
+		Remaining:      inventory.Remaining,
+		TotalSeats:     inventory.TotalSeats,
+		Version:        inventory.Version,
+		SeatMapVersion: inventory.SeatMapVersion,
+		Sections:       sectionInventories(inventory.Sections),
+		Frozen:         inventory.Frozen,
+		UpdatedAt:      timestamppb.New(inventory.UpdatedAt),
+	}, nil
+}
+
+// sectionInventories converts the general-admission sections of an inventory item,
+// stored as sections.<name>.remaining, ordered by name
+func sectionInventories(sections map[string]interface{}) []*proto.SectionInventory {
+	result := make([]*proto.SectionInventory, 0, len(sections))
+	for _, name := range slices.Sorted(maps.Keys(sections)) {
+		section, ok := sections[name].(map[string]interface{})
+		if !ok {
+			fmt.Printf("Warning: malformed inventory section %s\n", name)
+			continue
+		}
+		remaining, _ := section["remaining"].(float64)
+		result = append(result, &proto.SectionInventory{
+			Section:   name,
+			Remaining: int32(remaining),
+		})
+	}
+	return result
+}
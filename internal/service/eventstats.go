@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventStats counts commits, conflicts and the hold funnel per event on this instance.
+// Dashboards combine the streams of all instances for fleet-wide rates; the funnel is
+// also exported as metrics without an event label.
+type EventStats struct {
+	metrics *observability.Metrics
+
+	mu     sync.Mutex
+	events map[string]*eventCounters
+}
+
+// eventCounters holds cumulative counters for one event
+type eventCounters struct {
+	commits   uint64
+	conflicts uint64
+
+	holdsCreated   uint64
+	holdsExpired   uint64
+	holdsCommitted uint64
+	// holdToCommit is the total hold-to-commit latency of the committed holds, kept as a
+	// sum so averages stay exact however many holds were committed
+	holdToCommit time.Duration
+}
+
+// HoldFunnel is the checkout funnel of an event's reservation holds on this instance
+type HoldFunnel struct {
+	Created   uint64
+	Expired   uint64
+	Committed uint64
+	// AvgHoldToCommit is the mean time from holding seats to committing them
+	AvgHoldToCommit time.Duration
+}
+
+// Hold funnel stages, used as metric labels
+const (
+	holdStageCreated   = "created"
+	holdStageExpired   = "expired"
+	holdStageCommitted = "committed"
+)
+
+// Outcomes of quantity commit version conflicts, used as metric labels
+const (
+	versionRetried   = "retried"
+	versionExhausted = "exhausted"
+)
+
+// NewEventStats creates an empty event stats tracker
+func NewEventStats(metrics *observability.Metrics) *EventStats {
+	return &EventStats{
+		metrics: metrics,
+		events:  make(map[string]*eventCounters),
+	}
+}
+
+// RecordCommit counts a successful commit for an event
+func (s *EventStats) RecordCommit(eventID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters(eventID).commits++
+}
+
+// RecordConflict counts a commit rejected for lack of inventory for an event
+func (s *EventStats) RecordConflict(eventID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters(eventID).conflicts++
+}
+
+// RecordVersionRetry records a quantity commit that lost a version race, by whether it
+// was retried
+func (s *EventStats) RecordVersionRetry(retried bool) {
+	if retried {
+		s.metrics.RecordQuantityVersionRetry(versionRetried)
+		return
+	}
+	s.metrics.RecordQuantityVersionRetry(versionExhausted)
+}
+
+// RecordHoldCreated counts a reservation newly holding seats of an event
+func (s *EventStats) RecordHoldCreated(eventID string) {
+	s.mu.Lock()
+	s.counters(eventID).holdsCreated++
+	s.mu.Unlock()
+	s.metrics.RecordHoldFunnel(holdStageCreated)
+}
+
+// RecordHoldExpired counts a reservation's hold on seats of an event reclaimed after it expired
+func (s *EventStats) RecordHoldExpired(eventID string) {
+	s.mu.Lock()
+	s.counters(eventID).holdsExpired++
+	s.mu.Unlock()
+	s.metrics.RecordHoldFunnel(holdStageExpired)
+}
+
+// RecordHoldCommitted counts a reservation committing the seats it held on an event,
+// heldFor after holding them
+func (s *EventStats) RecordHoldCommitted(eventID string, heldFor time.Duration) {
+	s.mu.Lock()
+	c := s.counters(eventID)
+	c.holdsCommitted++
+	c.holdToCommit += heldFor
+	s.mu.Unlock()
+	s.metrics.RecordHoldFunnel(holdStageCommitted)
+	s.metrics.RecordHoldToCommit(heldFor)
+}
+
+// Funnel returns the cumulative hold funnel of an event
+func (s *EventStats) Funnel(eventID string) HoldFunnel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.events[eventID]
+	if !ok {
+		return HoldFunnel{}
+	}
+	funnel := HoldFunnel{
+		Created:   c.holdsCreated,
+		Expired:   c.holdsExpired,
+		Committed: c.holdsCommitted,
+	}
+	if c.holdsCommitted > 0 {
+		funnel.AvgHoldToCommit = c.holdToCommit / time.Duration(c.holdsCommitted)
+	}
+	return funnel
+}
+
+// Snapshot returns the cumulative commit and conflict counts of an event
+func (s *EventStats) Snapshot(eventID string) (commits, conflicts uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.events[eventID]; ok {
+		return c.commits, c.conflicts
+	}
+	return 0, 0
+}
+
+// counters returns the counters of an event, creating them if needed. Callers hold mu.
+func (s *EventStats) counters(eventID string) *eventCounters {
+	c, ok := s.events[eventID]
+	if !ok {
+		c = &eventCounters{}
+		s.events[eventID] = c
+	}
+	return c
+}
+
+// Stats streaming limits
+const (
+	defaultStatsInterval   = 5 * time.Second
+	maxStatsStreamedEvents = 20
+)
+
+// StreamEventStats pushes rolled-up stats for the subscribed events every interval
+// until ctx is canceled or send fails. Commit and conflict rates are the deltas of
+// this instance's counters over the interval.
+func (s *AdminService) StreamEventStats(ctx context.Context, req *proto.StreamEventStatsReq, send func(*proto.EventStatsUpdate) error) error {
+	eventKeys := append([]string(nil), req.EventIds...)
+	for _, performance := range req.Performances {
+		eventKey := performance.EventId
+		if err := usePerformanceKey(&eventKey, performance.PerformanceId); err != nil {
+			return err
+		}
+		eventKeys = append(eventKeys, eventKey)
+	}
+
+	if len(eventKeys) == 0 {
+		return errors.New("invalid request: event_ids or performances is required")
+	}
+	if len(eventKeys) > maxStatsStreamedEvents {
+		return fmt.Errorf("invalid request: at most %d events per stream", maxStatsStreamedEvents)
+	}
+
+	interval := defaultStatsInterval
+	if req.IntervalSeconds > 0 {
+		interval = time.Duration(req.IntervalSeconds) * time.Second
+	}
+
+	type counts struct{ commits, conflicts uint64 }
+	previous := make(map[string]counts, len(eventKeys))
+	for _, eventID := range eventKeys {
+		commits, conflicts := s.inventory.stats.Snapshot(eventID)
+		previous[eventID] = counts{commits, conflicts}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		update := &proto.EventStatsUpdate{
+			At:     timestamppb.Now(),
+			Events: make([]*proto.EventStats, 0, len(eventKeys)),
+		}
+		for _, eventID := range eventKeys {
+			stats, err := s.eventStats(ctx, eventID)
+			if err != nil {
+				return err
+			}
+
+			commits, conflicts := s.inventory.stats.Snapshot(eventID)
+			stats.CommitsPerSecond = float64(commits-previous[eventID].commits) / interval.Seconds()
+			stats.ConflictsPerSecond = float64(conflicts-previous[eventID].conflicts) / interval.Seconds()
+			previous[eventID] = counts{commits, conflicts}
+
+			update.Events = append(update.Events, stats)
+		}
+
+		if err := send(update); err != nil {
+			return err
+		}
+	}
+}
+
+// GetEventStats returns the current stats of an event. Rates are only reported by
+// StreamEventStats, which measures them over its interval.
+func (s *AdminService) GetEventStats(ctx context.Context, req *proto.GetEventStatsReq) (*proto.EventStats, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	return s.eventStats(ctx, req.EventId)
+}
+
+// eventStats reads the current remaining and hold counts of an event along with this
+// instance's hold funnel
+func (s *AdminService) eventStats(ctx context.Context, eventID string) (*proto.EventStats, error) {
+	inventoryType, remaining, err := s.eventRemaining(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, performanceID := repo.SplitPerformanceKey(eventID)
+	funnel := s.inventory.stats.Funnel(eventID)
+	stats := &proto.EventStats{
+		EventId:                event,
+		PerformanceId:          performanceID,
+		InventoryType:          inventoryType,
+		Remaining:              remaining,
+		HoldsCreated:           funnel.Created,
+		HoldsExpired:           funnel.Expired,
+		HoldsCommitted:         funnel.Committed,
+		AvgHoldToCommitSeconds: funnel.AvgHoldToCommit.Seconds(),
+	}
+	if inventoryType == "SEAT" {
+		holds, err := s.repo.CountSeatsByStatus(ctx, eventID, seatHold, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count held seats: %w", err)
+		}
+		stats.Holds = int32(holds)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// WrapFieldEncryptionKey returns the field encryption data key wrapped under a KMS key.
+// Re-wrapping leaves the data key, and so every sealed value, unchanged; instances pick
+// up the new wrapped key on their next deployment.
+func (s *AdminService) WrapFieldEncryptionKey(ctx context.Context, req *proto.WrapFieldEncryptionKeyReq) (*proto.WrapFieldEncryptionKeyRes, error) {
+	if req.KmsKeyId == "" {
+		return nil, errors.New("invalid request: kms_key_id is required")
+	}
+
+	wrapped, generated, err := s.repo.WrapFieldEncryptionKey(ctx, req.KmsKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record("field_encryption_key_wrapped", "", map[string]interface{}{
+		"kms_key_id": req.KmsKeyId,
+		"generated":  generated,
+	})
+
+	return &proto.WrapFieldEncryptionKeyRes{
+		WrappedDataKey: wrapped,
+		Generated:      generated,
+	}, nil
+}
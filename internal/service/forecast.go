@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxForecastPoints bounds the points of a demand curve, which is stored with the event
+const maxForecastPoints = 1000
+
+// PutDemandForecast stores the demand curve a forecasting system expects for an event's
+// on-sale, or clears it when the request has no points
+func (s *AdminService) PutDemandForecast(ctx context.Context, req *proto.PutDemandForecastReq) (*proto.PutDemandForecastRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+	if len(req.Points) == 0 {
+		if err := s.repo.SetDemandForecast(ctx, req.EventId, nil); err != nil {
+			return nil, fmt.Errorf("failed to clear demand forecast: %w", err)
+		}
+		return &proto.PutDemandForecastRes{Status: "CLEARED"}, nil
+	}
+	if req.OnSaleAt == nil {
+		return nil, errors.New("invalid request: on_sale_at is required")
+	}
+	if len(req.Points) > maxForecastPoints {
+		return nil, fmt.Errorf("invalid request: at most %d forecast points", maxForecastPoints)
+	}
+
+	forecast := &repo.DemandForecast{
+		OnSaleAt:  req.OnSaleAt.AsTime(),
+		Source:    req.Source,
+		UpdatedAt: time.Now(),
+	}
+	peak := 0.0
+	for i, point := range req.Points {
+		if point.OffsetSeconds < 0 || (i > 0 && point.OffsetSeconds <= req.Points[i-1].OffsetSeconds) {
+			return nil, errors.New("invalid request: forecast offsets must be increasing and not negative")
+		}
+		if point.SalesPerSecond < 0 || math.IsNaN(point.SalesPerSecond) || math.IsInf(point.SalesPerSecond, 0) {
+			return nil, errors.New("invalid request: forecast sales rates must be finite and not negative")
+		}
+		forecast.Points = append(forecast.Points, repo.DemandPoint{
+			OffsetSeconds:  point.OffsetSeconds,
+			SalesPerSecond: point.SalesPerSecond,
+		})
+		peak = max(peak, point.SalesPerSecond)
+	}
+
+	if err := s.repo.SetDemandForecast(ctx, req.EventId, forecast); err != nil {
+		return nil, fmt.Errorf("failed to set demand forecast: %w", err)
+	}
+
+	return &proto.PutDemandForecastRes{
+		Status:             "UPDATED",
+		PeakSalesPerSecond: peak,
+	}, nil
+}
+
+// demandForecast returns an event's demand forecast, or nil if it has none
+func (s *AdminService) demandForecast(ctx context.Context, eventID string) (*repo.DemandForecast, error) {
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	return inventory.Forecast, nil
+}
+
+// forecastProjection is what a demand forecast expects of the rest of an on-sale
+type forecastProjection struct {
+	// salesPerSecond is the average expected rate from the on-sale start, or now if later,
+	// until sell-out or the end of the curve
+	salesPerSecond float64
+	// peakSalesPerSecond is the highest rate still ahead
+	peakSalesPerSecond float64
+	// sellout is when the remaining tickets are expected to be sold; zero if never
+	sellout time.Time
+}
+
+// projectForecast walks the part of a demand curve still ahead of now, selling remaining
+// tickets at each point's rate
+func projectForecast(forecast *repo.DemandForecast, remaining int32, now time.Time) forecastProjection {
+	var projection forecastProjection
+	start := forecast.OnSaleAt
+	if now.After(start) {
+		start = now
+	}
+
+	left := float64(remaining)
+	sold, elapsed := 0.0, 0.0
+	for i, point := range forecast.Points {
+		from := forecast.OnSaleAt.Add(time.Duration(point.OffsetSeconds) * time.Second)
+		if from.Before(start) {
+			from = start
+		}
+		// The last point lasts until sell-out
+		seconds := math.Inf(1)
+		if i+1 < len(forecast.Points) {
+			to := forecast.OnSaleAt.Add(time.Duration(forecast.Points[i+1].OffsetSeconds) * time.Second)
+			if !to.After(from) {
+				continue
+			}
+			seconds = to.Sub(from).Seconds()
+		}
+		projection.peakSalesPerSecond = max(projection.peakSalesPerSecond, point.SalesPerSecond)
+
+		if left <= 0 || !projection.sellout.IsZero() {
+			continue
+		}
+		if point.SalesPerSecond > 0 && left <= point.SalesPerSecond*seconds {
+			needed := left / point.SalesPerSecond
+			projection.sellout = from.Add(time.Duration(needed * float64(time.Second)))
+			sold += left
+			elapsed += needed
+			left = 0
+			continue
+		}
+		if !math.IsInf(seconds, 1) {
+			sold += point.SalesPerSecond * seconds
+			left -= point.SalesPerSecond * seconds
+			elapsed += seconds
+		}
+	}
+	if elapsed > 0 {
+		projection.salesPerSecond = sold / elapsed
+	}
+	return projection
+}
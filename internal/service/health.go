@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/traffictacos/inventory-api/internal/cache"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Health service names of dependencies, for clients that watch them individually
+const (
+	healthDynamoDB = "dynamodb"
+	healthRedis    = "redis"
+)
+
+// healthProbe checks one dependency. Critical dependencies take the whole service out
+// of SERVING; others only report their own status.
+type healthProbe struct {
+	name     string
+	critical bool
+	check    func(ctx context.Context) error
+}
+
+// HealthMonitor probes dependencies and publishes their state through the gRPC health
+// service, whose Watch streams every transition to mesh sidecars and smart clients:
+//   - "" and inventory.v1.Inventory are NOT_SERVING while DynamoDB is down, unless
+//     degraded mode keeps serving availability checks meanwhile
+//   - "dynamodb" and "redis" report each dependency; Redis is not critical, since
+//     availability falls back to DynamoDB and quotas are let through without it
+//
+// A dependency is down after FailureThreshold consecutive failed probes and up again
+// after one successful probe.
+type HealthMonitor struct {
+	health    *health.Server
+	inventory *InventoryService
+	metrics   *observability.Metrics
+	config    appconfig.HealthConfig
+	probes    []healthProbe
+	// degradedMode keeps the service SERVING while DynamoDB is down
+	degradedMode bool
+
+	failures map[string]int
+	serving  map[string]bool
+}
+
+// NewHealthMonitor creates a health monitor publishing to the given health server, or
+// returns nil when probing is disabled. DynamoDB outages are reported to the inventory
+// service for degraded mode. The counter and quotas may be nil.
+func NewHealthMonitor(healthServer *health.Server, repository *repo.DynamoDBRepository, inventory *InventoryService, counter *cache.AvailabilityCounter, quotas *QuotaEnforcer, metrics *observability.Metrics, cfg *appconfig.Config) *HealthMonitor {
+	if cfg.Health.ProbeInterval <= 0 {
+		return nil
+	}
+
+	probes := []healthProbe{{name: healthDynamoDB, critical: true, check: repository.Ping}}
+	switch {
+	case counter != nil:
+		probes = append(probes, healthProbe{name: healthRedis, check: counter.Ping})
+	case quotas != nil:
+		probes = append(probes, healthProbe{name: healthRedis, check: quotas.Ping})
+	}
+
+	m := &HealthMonitor{
+		health:    healthServer,
+		inventory: inventory,
+		metrics:   metrics,
+		config:    cfg.Health,
+		probes:    probes,
+		failures:  make(map[string]int, len(probes)),
+		serving:   make(map[string]bool, len(probes)),
+
+		degradedMode: cfg.Inventory.DegradedMode,
+	}
+	for _, probe := range probes {
+		m.serving[probe.name] = true
+		healthServer.SetServingStatus(probe.name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthServer.SetServingStatus(proto.Inventory_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	return m
+}
+
+// Run probes dependencies periodically until ctx is canceled
+func (m *HealthMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.ProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		m.ProbeOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProbeOnce probes every dependency and updates the published statuses
+func (m *HealthMonitor) ProbeOnce(ctx context.Context) {
+	serving := true
+	for _, probe := range m.probes {
+		probeCtx, cancel := context.WithTimeout(ctx, m.config.ProbeTimeout)
+		err := probe.check(probeCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		up := m.record(probe.name, err)
+		if probe.name == healthDynamoDB {
+			m.inventory.SetDegraded(!up)
+		}
+		if !up && probe.critical && !m.degradedMode {
+			serving = false
+		}
+	}
+
+	m.setStatus(serving, "", proto.Inventory_ServiceDesc.ServiceName)
+}
+
+// record counts a probe result and publishes the dependency's status; it returns whether the dependency is up
+func (m *HealthMonitor) record(name string, err error) bool {
+	if err == nil {
+		m.failures[name] = 0
+	} else {
+		m.failures[name]++
+	}
+
+	up := m.failures[name] < max(m.config.FailureThreshold, 1)
+	if up != m.serving[name] {
+		if up {
+			fmt.Printf("Health: %s recovered\n", name)
+		} else {
+			fmt.Printf("Warning: health: %s is down after %d failed probes: %v\n", name, m.failures[name], err)
+		}
+		m.serving[name] = up
+	}
+
+	m.metrics.SetDependencyUp(name, up)
+	m.setStatus(up, name)
+	return up
+}
+
+// setStatus publishes a status for the given health service names; the health
+// server only notifies watchers when a status changes
+func (m *HealthMonitor) setStatus(serving bool, names ...string) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	for _, name := range names {
+		m.health.SetServingStatus(name, status)
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// holdPolicy is the effective hold policy of an event
+type holdPolicy struct {
+	ttl           time.Duration
+	maxExtensions int32
+	maxSeats      int
+}
+
+// eventHoldPolicy returns an event's hold policy, with global defaults for unset fields.
+// It also rejects holds on frozen events, so HoldSeats reads the inventory item only once.
+func (s *InventoryService) eventHoldPolicy(ctx context.Context, eventID string) (holdPolicy, error) {
+	policy := holdPolicy{
+		ttl:           s.config.Holds.TTL,
+		maxExtensions: int32(s.config.Holds.MaxExtensions),
+		maxSeats:      s.config.Holds.MaxSeats,
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return policy, nil
+		}
+		return policy, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	if inventory.Frozen {
+		return policy, apperrors.New(apperrors.ErrEventNotOnSale, "event %s is frozen: %s", eventID, inventory.FrozenReason)
+	}
+
+	if override := inventory.HoldPolicy; override != nil {
+		if override.TTLSeconds > 0 {
+			policy.ttl = min(time.Duration(override.TTLSeconds)*time.Second, s.config.Holds.MaxTTL)
+		}
+		if override.MaxExtensions > 0 {
+			policy.maxExtensions = override.MaxExtensions
+		}
+		if override.MaxSeats > 0 {
+			policy.maxSeats = int(override.MaxSeats)
+		}
+	}
+	// Every seat takes two transaction items: the seat update and its hold record
+	policy.maxSeats = min(policy.maxSeats, maxSeatsPerTransaction/2)
+
+	return policy, nil
+}
+
+// holdExtensions returns how many times the reservation has re-held the seats, counting
+// this request: 0 for a new hold, one more than the live hold records' count otherwise
+func (s *InventoryService) holdExtensions(ctx context.Context, eventID, reservationID string, seatIDs []string) (int32, error) {
+	holds, err := s.repo.GetHolds(ctx, eventID, seatIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().Unix()
+	extensions := int32(0)
+	for _, hold := range holds {
+		if hold.ReservationID == reservationID && hold.ExpiresAt > now {
+			extensions = max(extensions, hold.Extensions+1)
+		}
+	}
+	return extensions, nil
+}
+
+// SetHoldPolicy sets an event's hold TTL, extension and size limits, or clears them
+// so the event uses the global defaults again
+func (s *AdminService) SetHoldPolicy(ctx context.Context, req *proto.SetHoldPolicyReq) (*proto.SetHoldPolicyRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+	if req.TtlSeconds < 0 || req.MaxExtensions < 0 || req.MaxSeats < 0 {
+		return nil, errors.New("invalid request: hold policy values must not be negative")
+	}
+	if maxTTL := s.inventory.config.Holds.MaxTTL; time.Duration(req.TtlSeconds)*time.Second > maxTTL {
+		return nil, fmt.Errorf("invalid request: ttl_seconds exceeds the maximum hold TTL %s", maxTTL)
+	}
+	if req.MaxSeats > maxSeatsPerTransaction/2 {
+		return nil, fmt.Errorf("invalid request: at most %d seats per hold", maxSeatsPerTransaction/2)
+	}
+
+	var policy *repo.HoldPolicy
+	if req.TtlSeconds > 0 || req.MaxExtensions > 0 || req.MaxSeats > 0 {
+		policy = &repo.HoldPolicy{
+			TTLSeconds:    req.TtlSeconds,
+			MaxExtensions: req.MaxExtensions,
+			MaxSeats:      req.MaxSeats,
+		}
+	}
+
+	if err := s.repo.SetHoldPolicy(ctx, req.EventId, policy); err != nil {
+		return nil, fmt.Errorf("failed to set hold policy: %w", err)
+	}
+
+	return &proto.SetHoldPolicyRes{
+		Status: "UPDATED",
+	}, nil
+}
+
+// liveHoldCheck verifies that the seats the reservation holds are still within their hold,
+// allowing for clock skew, and returns the check to repeat inside the commit transaction.
+// Seats that are AVAILABLE are not checked; it returns nil when no seat is held. heldSince
+// is when the earliest of the holds was placed, for hold-to-commit latency.
+func (s *InventoryService) liveHoldCheck(ctx context.Context, eventID, reservationID string, seats []*repo.SeatItem, otherItems int) (check *repo.LiveHoldCheck, heldSince time.Time, err error) {
+	var heldSeatIDs []string
+	for _, seat := range seats {
+		if seat.Status == seatHold && seat.ReservationID == reservationID {
+			heldSeatIDs = append(heldSeatIDs, seat.SeatID)
+		}
+	}
+	if len(heldSeatIDs) == 0 {
+		return nil, time.Time{}, nil
+	}
+	if otherItems+len(heldSeatIDs) > maxSeatsPerTransaction {
+		// Each held seat adds a hold check to the commit transaction
+		return nil, time.Time{}, fmt.Errorf("invalid request: seats plus held seats exceed %d transaction items", maxSeatsPerTransaction)
+	}
+
+	expiresAfter := time.Now().Add(-s.config.Holds.CommitClockSkew)
+	holds, err := s.repo.GetHolds(ctx, eventID, heldSeatIDs)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get holds: %w", err)
+	}
+	live := 0
+	for _, hold := range holds {
+		if hold.ReservationID == reservationID && hold.ExpiresAt > expiresAfter.Unix() {
+			live++
+			if heldSince.IsZero() || hold.CreatedAt.Before(heldSince) {
+				heldSince = hold.CreatedAt
+			}
+		}
+	}
+	if live < len(heldSeatIDs) {
+		return nil, time.Time{}, fmt.Errorf("hold expired for reservation %s", reservationID)
+	}
+
+	return &repo.LiveHoldCheck{
+		EventID:       eventID,
+		ReservationID: reservationID,
+		SeatIDs:       heldSeatIDs,
+		ExpiresAfter:  expiresAfter,
+	}, heldSince, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Hybrid events mix reserved seats (seats table) with general-admission sections whose
+// quantity pools live in the event's inventory item. A hybrid commit or release writes
+// all seats and one inventory update in a single transaction.
+
+// commitHybridReservation atomically sells the requested seats and general-admission quantities
+func (s *InventoryService) commitHybridReservation(ctx context.Context, req *proto.CommitReq, orderID, idempotencyKey string) (*proto.CommitRes, error) {
+	sectionDeltas, err := hybridSectionDeltas(req.Qty, req.SectionQtys, len(req.SeatIds), -1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]string, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatIDs[i] = seatRef.SeatId
+	}
+
+	// Held seats are only sold while their hold is live
+	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seats: %w", err)
+	}
+	if err := checkSeatTransitions(commitSources, seatSold); err != nil {
+		return nil, err
+	}
+	for _, seat := range seats {
+		if checkSeatTransition(seat.Status, seatSold) != nil {
+			s.stats.RecordConflict(req.EventId)
+			return nil, &apperrors.SeatConflictError{EventID: req.EventId, SeatIDs: []string{seat.SeatID}, Status: seat.Status}
+		}
+	}
+	holdCheck, heldSince, err := s.liveHoldCheck(ctx, req.EventId, req.ReservationId, seats, len(seatIDs)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	seatUpdates := make([]*repo.SeatItem, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatUpdates[i] = &repo.SeatItem{
+			EventID:       req.EventId,
+			SeatID:        seatRef.SeatId,
+			Status:        seatSold,
+			ReservationID: req.ReservationId,
+			UpdatedAt:     time.Now(),
+		}
+	}
+
+	conditionExpr := "#status = :available OR (#status = :hold AND reservation_id = :reservation_id)"
+	exprValues := map[string]types.AttributeValue{
+		":available":      &types.AttributeValueMemberS{Value: seatAvailable},
+		":hold":           &types.AttributeValueMemberS{Value: seatHold},
+		":reservation_id": &types.AttributeValueMemberS{Value: req.ReservationId},
+	}
+	exprNames := map[string]string{
+		"#status": "status",
+	}
+
+	lines := seatOrderLines(req.EventId, seatIDs, seats)
+	for _, section := range slices.Sorted(maps.Keys(sectionDeltas)) {
+		lines = append(lines, quantityOrderLine(req.EventId, section, -sectionDeltas[section]))
+	}
+	res := confirmedCommit(orderID, lines)
+
+	err = s.repo.TransactWriteSeatsAndSections(ctx, req.EventId, seatUpdates, conditionExpr, exprValues, exprNames, sectionDeltas, holdCheck, commitRecord(idempotencyKey, req, res))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrIdempotencyConflict) {
+			return s.replayRecordedCommit(ctx, idempotencyKey, req, err)
+		}
+		if errors.Is(err, apperrors.ErrHoldExpired) {
+			return nil, apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s", req.ReservationId)
+		}
+		if errors.Is(err, apperrors.ErrSeatConflict) || errors.Is(err, apperrors.ErrInsufficientInventory) {
+			s.stats.RecordConflict(req.EventId)
+			return nil, err
+		}
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			s.stats.RecordConflict(req.EventId)
+			return nil, apperrors.SeatConflict(req.EventId)
+		}
+		return nil, fmt.Errorf("failed to commit hybrid reservation: %w", err)
+	}
+	ctx, done := publishPhase(ctx)
+	defer done()
+	s.stats.RecordCommit(req.EventId)
+	if holdCheck != nil {
+		s.stats.RecordHoldCommitted(req.EventId, time.Since(heldSince))
+	}
+	tickets := len(seatIDs)
+	for _, delta := range sectionDeltas {
+		tickets += int(-delta)
+	}
+	s.anomalies.RecordSale(ctx, req.EventId, tickets)
+	s.cacheSeatStatus(ctx, req.EventId, seatIDs, seatSold)
+
+	return res, nil
+}
+
+// releaseHybridHold atomically returns the reservation's seats and general-admission quantities
+func (s *InventoryService) releaseHybridHold(ctx context.Context, req *proto.ReleaseReq, idempotencyKey string) (*proto.ReleaseRes, error) {
+	sectionDeltas, err := hybridSectionDeltas(req.Qty, req.SectionQtys, len(req.SeatIds), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]string, len(req.SeatIds))
+	seatUpdates := make([]*repo.SeatItem, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatIDs[i] = seatRef.SeatId
+		seatUpdates[i] = &repo.SeatItem{
+			EventID:   req.EventId,
+			SeatID:    seatRef.SeatId,
+			Status:    seatAvailable,
+			UpdatedAt: time.Now(),
+		}
+	}
+
+	// Only seats this reservation holds may be returned
+	if err := checkSeatTransitions(releaseSources, seatAvailable); err != nil {
+		return nil, err
+	}
+	conditionExpr := "#status = :hold AND reservation_id = :reservation_id"
+	exprValues := map[string]types.AttributeValue{
+		":hold":           &types.AttributeValueMemberS{Value: seatHold},
+		":reservation_id": &types.AttributeValueMemberS{Value: req.ReservationId},
+	}
+
+	err = s.repo.TransactWriteSeatsAndSections(ctx, req.EventId, seatUpdates, conditionExpr, exprValues, nil, sectionDeltas, nil, nil)
+	if err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, apperrors.New(apperrors.ErrSeatConflict, "release conflict for reservation %s: seats not held by it or unknown section", req.ReservationId)
+		}
+		return nil, fmt.Errorf("failed to release hybrid hold: %w", err)
+	}
+	s.cacheSeatStatus(ctx, req.EventId, seatIDs, seatAvailable)
+	s.restock.SeatsReturned(ctx, req.EventId, seatIDs, "RELEASED")
+
+	// Store idempotency record
+	err = s.repo.PutIdempotency(ctx, &repo.IdempotencyItem{
+		Key:       idempotencyKey,
+		Operation: "RELEASED",
+		EventID:   req.EventId,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
+	}
+
+	return &proto.ReleaseRes{
+		Status: "RELEASED",
+	}, nil
+}
+
+// hybridSectionDeltas validates a hybrid request and sums its section quantities,
+// multiplied by sign (-1 to commit, 1 to release)
+func hybridSectionDeltas(qty int32, sectionQtys []*proto.SectionQty, seatCount int, sign int32) (map[string]int32, error) {
+	if qty > 0 {
+		return nil, errors.New("invalid request: qty can't be combined with section_qtys; set the quantity per section")
+	}
+	// Seats plus the single inventory update must fit in one transaction
+	if seatCount+1 > maxSeatsPerTransaction {
+		return nil, fmt.Errorf("invalid request: at most %d seats per hybrid request", maxSeatsPerTransaction-1)
+	}
+
+	deltas := make(map[string]int32, len(sectionQtys))
+	for _, sectionQty := range sectionQtys {
+		if sectionQty.Section == "" || sectionQty.Qty <= 0 {
+			return nil, errors.New("invalid request: section_qtys need a section and a positive qty")
+		}
+		deltas[sectionQty.Section] += sign * sectionQty.Qty
+	}
+	return deltas, nil
+}
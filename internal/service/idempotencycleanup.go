@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// idempotencyCleanupBatchSize is the number of items deleted per batch write
+const idempotencyCleanupBatchSize = 25
+
+// IdempotencyCleaner deletes expired idempotency table items for deployments where
+// DynamoDB TTL can't be enabled on the table. Deletes are paced to the configured rate
+// so sweeps don't compete with request traffic for table capacity.
+type IdempotencyCleaner struct {
+	repo    *repo.DynamoDBRepository
+	scanner *repo.TableScanner
+	metrics *observability.Metrics
+	config  appconfig.IdempotencyConfig
+}
+
+// NewIdempotencyCleaner creates an idempotency cleaner, or returns nil when cleanup is disabled
+func NewIdempotencyCleaner(repo *repo.DynamoDBRepository, scanner *repo.TableScanner, metrics *observability.Metrics, cfg *appconfig.Config) *IdempotencyCleaner {
+	if cfg.Idempotency.CleanupInterval <= 0 {
+		return nil
+	}
+	return &IdempotencyCleaner{
+		repo:    repo,
+		scanner: scanner,
+		metrics: metrics,
+		config:  cfg.Idempotency,
+	}
+}
+
+// Run sweeps periodically until ctx is canceled
+func (c *IdempotencyCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.config.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := c.CleanOnce(ctx)
+			if err != nil {
+				fmt.Printf("Warning: idempotency cleanup failed: %v\n", err)
+			}
+			if deleted > 0 {
+				fmt.Printf("Deleted %d expired idempotency records\n", deleted)
+			}
+		}
+	}
+}
+
+// CleanOnce scans the idempotency table and deletes expired items in rate-limited
+// batches, returning the number of items deleted
+func (c *IdempotencyCleaner) CleanOnce(ctx context.Context) (int, error) {
+	now := time.Now()
+	createdBefore := now.Add(-c.config.TTLDuration)
+
+	// One batch is deleted per tick across all scan segments, so batches of 25 keep
+	// deletes at the configured rate
+	pace := time.NewTicker(time.Second * idempotencyCleanupBatchSize / time.Duration(max(c.config.CleanupRate, 1)))
+	defer pace.Stop()
+
+	var deleted atomic.Int64
+	job := c.repo.ExpiredIdempotencyScan(now, createdBefore, func(ctx context.Context, keys []string) error {
+		for start := 0; start < len(keys); start += idempotencyCleanupBatchSize {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-pace.C:
+			}
+
+			batch := keys[start:min(start+idempotencyCleanupBatchSize, len(keys))]
+			if err := c.repo.DeleteIdempotency(ctx, batch); err != nil {
+				return err
+			}
+			deleted.Add(int64(len(batch)))
+			c.metrics.RecordIdempotencyRecordsDeleted(len(batch))
+		}
+		return nil
+	})
+	err := c.scanner.Run(ctx, job)
+	return int(deleted.Load()), err
+}
@@ -2,53 +2,226 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/google/uuid"
+	"github.com/traffictacos/inventory-api/internal/cache"
 	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
 	"github.com/traffictacos/inventory-api/internal/repo"
 	"github.com/traffictacos/inventory-api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// notFrozenCondition guards quantity updates against per-event freezes without an extra read
+const notFrozenCondition = "(attribute_not_exists(frozen) OR frozen = :not_frozen)"
+
 // InventoryService handles inventory business logic
 type InventoryService struct {
-	repo   *repo.DynamoDBRepository
-	config *appconfig.Config
+	repo    *repo.DynamoDBRepository
+	config  *appconfig.Config
+	restock *RestockNotifier
+	stats   *EventStats
+
+	// counter serves availability checks from Redis; nil when disabled
+	counter *cache.AvailabilityCounter
+	// anomalies flags and throttles abusive callers; nil when disabled
+	anomalies *AnomalyDetector
+	// commits bounds concurrent commit transactions; nil when disabled
+	commits *CommitPool
+	// replica serves seat checks of flash-sale events from memory; nil when disabled
+	replica *SeatReplica
+	// deduper collapses gateway retransmits of commits and releases; nil when disabled
+	deduper *RequestDeduper
+	// velocity limits the orders of a buyer per event; nil when disabled
+	velocity *VelocityLimiter
+	// orderLimits caps the tickets of one order
+	orderLimits orderLimitPolicy
+
+	// maintenance rejects all inventory writes on this instance while set
+	maintenance atomic.Bool
+	// degradedSince is set while health probes find DynamoDB down
+	degradedSince atomic.Pointer[time.Time]
 }
 
 // NewInventoryService creates a new inventory service
-func NewInventoryService(repo *repo.DynamoDBRepository, cfg *appconfig.Config) *InventoryService {
+func NewInventoryService(repo *repo.DynamoDBRepository, cfg *appconfig.Config, metrics *observability.Metrics, restock *RestockNotifier, counter *cache.AvailabilityCounter, anomalies *AnomalyDetector, commits *CommitPool, replica *SeatReplica, deduper *RequestDeduper, velocity *VelocityLimiter) *InventoryService {
 	return &InventoryService{
-		repo:   repo,
-		config: cfg,
+		repo:      repo,
+		config:    cfg,
+		restock:   restock,
+		stats:     NewEventStats(metrics),
+		counter:   counter,
+		anomalies: anomalies,
+		commits:   commits,
+		replica:   replica,
+		deduper:   deduper,
+		velocity:  velocity,
+
+		orderLimits: newOrderLimitPolicy(cfg.OrderLimits),
+	}
+}
+
+// Stats returns the per-event stats of this instance
+func (s *InventoryService) Stats() *EventStats {
+	return s.stats
+}
+
+// SetInstanceMaintenance enables or disables maintenance mode of this instance. Other
+// instances keep their own flag, and it resets on restart.
+func (s *InventoryService) SetInstanceMaintenance(enabled bool) {
+	s.maintenance.Store(enabled)
+}
+
+// InMaintenance reports whether maintenance mode is enabled on this instance
+func (s *InventoryService) InMaintenance() bool {
+	return s.maintenance.Load()
+}
+
+// InDegradedMode reports whether degraded mode is active because DynamoDB is down
+func (s *InventoryService) InDegradedMode() bool {
+	_, ok := s.degraded()
+	return ok
+}
+
+// checkWritable rejects writes while maintenance or degraded mode is active
+func (s *InventoryService) checkWritable() error {
+	if s.maintenance.Load() {
+		return apperrors.New(apperrors.ErrUnavailable, "inventory writes are disabled: maintenance mode")
+	}
+	if _, ok := s.degraded(); ok {
+		return apperrors.New(apperrors.ErrUnavailable, "inventory writes are disabled: degraded mode, DynamoDB is unavailable")
 	}
+	return nil
+}
+
+// checkEventWritable rejects writes for an event that has been frozen by an operator.
+// Events without an inventory row (seat-only events that were never frozen) are writable.
+func (s *InventoryService) checkEventWritable(ctx context.Context, eventID string) error {
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	if inventory.Frozen {
+		return apperrors.New(apperrors.ErrEventNotOnSale, "event %s is frozen: %s", eventID, inventory.FrozenReason)
+	}
+	return nil
+}
+
+// explainQuantityConflict distinguishes a frozen event from insufficient inventory
+// after a conditional quantity update fails
+func (s *InventoryService) explainQuantityConflict(ctx context.Context, eventID string) error {
+	if err := s.checkEventWritable(ctx, eventID); err != nil {
+		return err
+	}
+	return apperrors.InsufficientInventory(eventID)
 }
 
 // CommitReservation commits a reservation by reducing inventory
 // This operation guarantees zero oversell through conditional updates/transactions
 func (s *InventoryService) CommitReservation(ctx context.Context, req *proto.CommitReq) (*proto.CommitRes, error) {
+	return dedupe(ctx, s.deduper, "commit", req, func() (*proto.CommitRes, error) {
+		return s.commitReservation(ctx, req)
+	})
+}
+
+// commitReservation executes a commit that wasn't collapsed into an identical request
+func (s *InventoryService) commitReservation(ctx context.Context, req *proto.CommitReq) (*proto.CommitRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if err := s.anomalies.CheckCaller(ctx); err != nil {
+		return nil, err
+	}
+
 	// Generate order ID
 	orderID := fmt.Sprintf("ord_%s", uuid.New().String()[:12])
 
-	// Check idempotency
+	// The deadline left is divided among validation, the mutation and recording its outcome
+	ctx = startBudget(ctx, s.config.DeadlineBudget)
+
 	idempotencyKey := fmt.Sprintf("commit:%s", req.ReservationId)
-	idempotencyItem, err := s.repo.GetIdempotency(ctx, idempotencyKey)
+	replayed, releaseOrder, err := s.admitCommit(ctx, req, idempotencyKey)
+	if err != nil || replayed != nil {
+		return replayed, err
+	}
+
+	var res *proto.CommitRes
+	err = s.commits.Do(ctx, func(ctx context.Context) error {
+		return inBudgetPhase(ctx, budgetDynamoDB, func(ctx context.Context) error {
+			var err error
+			res, err = s.commit(ctx, req, orderID, idempotencyKey)
+			return err
+		})
+	})
+	releaseOrder(err == nil)
+	return res, err
+}
+
+// admitCommit runs the checks a commit passes before its mutation, in this order: the
+// idempotency lookup and pre-authorization, in the validation phase of ctx's deadline
+// budget, then the order limits and the buyer's velocity. A commit made before returns
+// its recorded response. Otherwise the order counts against the buyer's velocity, and
+// the returned release must be called with the outcome of the commit.
+func (s *InventoryService) admitCommit(ctx context.Context, req *proto.CommitReq, idempotencyKey string) (*proto.CommitRes, func(committed bool), error) {
+	var idempotencyItem *repo.IdempotencyItem
+	err := inBudgetPhase(ctx, budgetValidation, func(ctx context.Context) error {
+		var err error
+		idempotencyItem, err = s.repo.GetIdempotency(ctx, idempotencyKey)
+		if err != nil {
+			return fmt.Errorf("failed to check idempotency: %w", err)
+		}
+		if idempotencyItem != nil {
+			return nil
+		}
+		return s.checkPreauth(ctx, req)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to check idempotency: %w", err)
+		return nil, nil, err
 	}
 
 	// If already processed, return the previous result
 	if idempotencyItem != nil {
-		return &proto.CommitRes{
-			OrderId: idempotencyItem.Operation, // Store order_id in operation field
-			Status:  "CONFIRMED",
-		}, nil
+		res, err := replayCommit(idempotencyItem, req)
+		return res, nil, err
 	}
 
-	// Determine inventory type and process accordingly
-	if len(req.SeatIds) > 0 {
+	if err := s.checkOrderLimits(req); err != nil {
+		return nil, nil, err
+	}
+
+	releaseOrder, err := s.velocity.Acquire(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, releaseOrder, nil
+}
+
+// commit commits a reservation according to its inventory type
+func (s *InventoryService) commit(ctx context.Context, req *proto.CommitReq, orderID, idempotencyKey string) (*proto.CommitRes, error) {
+	if len(req.LineItems) > 0 {
+		// Bundle of several events
+		return s.commitBundle(ctx, req, orderID, idempotencyKey)
+	} else if len(req.SectionQtys) > 0 {
+		// Hybrid seats + general admission
+		return s.commitHybridReservation(ctx, req, orderID, idempotencyKey)
+	} else if len(req.SeatIds) > 0 {
 		// Seat-based inventory
 		return s.commitSeatReservation(ctx, req, orderID, idempotencyKey)
 	} else {
@@ -59,60 +232,113 @@ func (s *InventoryService) CommitReservation(ctx context.Context, req *proto.Com
 
 // commitQuantityReservation handles quantity-based inventory reservation
 func (s *InventoryService) commitQuantityReservation(ctx context.Context, req *proto.CommitReq, orderID, idempotencyKey string) (*proto.CommitRes, error) {
-	// Build update expression for conditional quantity reduction
+	// Build update expression for conditional quantity reduction.
+	// The remaining guard alone is enough to prevent oversell; the version is
+	// still bumped so admin adjustments can keep using optimistic locking.
 	updateExpr := "SET remaining = remaining - :qty, version = version + 1, updated_at = :updated_at"
-	conditionExpr := "remaining >= :qty AND version = :current_version"
-
-	// Get current inventory to check version
-	currentInventory, err := s.repo.GetInventory(ctx, req.EventId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get current inventory: %w", err)
-	}
+	conditionExpr := "remaining >= :qty AND " + notFrozenCondition
 
 	exprValues := map[string]types.AttributeValue{
 		":qty": &types.AttributeValueMemberN{
 			Value: fmt.Sprintf("%d", req.Qty),
 		},
-		":current_version": &types.AttributeValueMemberN{
-			Value: fmt.Sprintf("%d", currentInventory.Version),
-		},
 		":updated_at": &types.AttributeValueMemberS{
 			Value: time.Now().Format(time.RFC3339),
 		},
+		":not_frozen": &types.AttributeValueMemberBOOL{
+			Value: false,
+		},
 	}
 
-	// Attempt conditional update
-	err = s.repo.UpdateInventoryConditionally(ctx, req.EventId, updateExpr, conditionExpr, exprValues, nil)
-	if err != nil {
-		// Check if it's a conditional check failure (insufficient inventory)
+	// With the version check, a commit losing the version to a concurrent writer re-reads
+	// the inventory and is retried while enough tickets remain
+	versionCheck := s.config.Inventory.QuantityVersionCheck
+	var current *repo.InventoryItem
+	if versionCheck {
+		var err error
+		current, err = s.repo.GetInventory(ctx, req.EventId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current inventory: %w", err)
+		}
+		conditionExpr += " AND version = :current_version"
+	}
+
+	res := confirmedCommit(orderID, []*proto.OrderLine{quantityOrderLine(req.EventId, "", req.Qty)})
+	record := commitRecord(idempotencyKey, req, res)
+
+	for attempt := 0; ; attempt++ {
+		if versionCheck {
+			exprValues[":current_version"] = &types.AttributeValueMemberN{
+				Value: fmt.Sprintf("%d", current.Version),
+			}
+		}
+
+		// Attempt conditional update, recording the commit in the same transaction
+		err := s.repo.UpdateInventoryWithRecord(ctx, req.EventId, updateExpr, conditionExpr, exprValues, nil, record)
+		if err == nil {
+			break
+		}
+		if errors.Is(err, apperrors.ErrIdempotencyConflict) {
+			return s.replayRecordedCommit(ctx, idempotencyKey, req, err)
+		}
+		// Check if it's a conditional check failure (insufficient inventory or a lost version race)
 		var conditionalCheckFailed *types.ConditionalCheckFailedException
-		if err == conditionalCheckFailed {
-			return nil, fmt.Errorf("insufficient inventory for event %s", req.EventId)
+		if !errors.As(err, &conditionalCheckFailed) {
+			return nil, fmt.Errorf("failed to commit quantity reservation: %w", err)
 		}
-		return nil, fmt.Errorf("failed to commit quantity reservation: %w", err)
+		if versionCheck {
+			previous := current.Version
+			if current, err = s.repo.GetInventory(ctx, req.EventId); err != nil {
+				return nil, fmt.Errorf("failed to get current inventory: %w", err)
+			}
+			if raced, retry := versionRetryDecision(current, previous, req.Qty, attempt, s.config.Inventory.VersionRetries); raced {
+				s.stats.RecordVersionRetry(retry)
+				if retry {
+					if err := pace(ctx, versionRetryDelay(s.config.Inventory.VersionRetryDelay, attempt)); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				s.stats.RecordConflict(req.EventId)
+				return nil, apperrors.New(apperrors.ErrVersionConflict, "version conflict: quantity commit for event %s lost the inventory version to concurrent writers %d times", req.EventId, attempt+1)
+			}
+		}
+		s.stats.RecordConflict(req.EventId)
+		return nil, s.explainQuantityConflict(ctx, req.EventId)
 	}
+	s.stats.RecordCommit(req.EventId)
+	ctx, done := publishPhase(ctx)
+	defer done()
+	s.anomalies.RecordSale(ctx, req.EventId, int(req.Qty))
+	s.cacheRemainingDelta(ctx, req.EventId, -req.Qty)
 
-	// Store idempotency record
-	err = s.repo.PutIdempotency(ctx, &repo.IdempotencyItem{
-		Key:       idempotencyKey,
-		Operation: orderID,
-		EventID:   req.EventId,
-		CreatedAt: time.Now(),
-	})
-	if err != nil {
-		// Log error but don't fail the operation since the inventory was already committed
-		// In production, you might want to implement a retry mechanism or dead letter queue
-		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
+	return res, nil
+}
+
+// versionRetryDecision decides how a quantity commit of qty tickets goes on after its
+// conditional update failed on the given attempt, from the inventory read since: raced is
+// whether it lost the version to a concurrent writer, rather than finding too few tickets
+// left or the event frozen, and retry whether a raced commit has retries left
+func versionRetryDecision(current *repo.InventoryItem, previousVersion, qty int32, attempt, retries int) (raced, retry bool) {
+	if current.Remaining < qty || current.Frozen || current.Version == previousVersion {
+		return false, false
 	}
+	return true, attempt < retries
+}
 
-	return &proto.CommitRes{
-		OrderId: orderID,
-		Status:  "CONFIRMED",
-	}, nil
+// versionRetryDelay returns the backoff before retry attempt+1 of a quantity commit: delay
+// doubled per earlier retry, half of it random so racing commits spread out
+func versionRetryDelay(delay time.Duration, attempt int) time.Duration {
+	delay <<= attempt
+	return delay/2 + rand.N(delay/2+1)
 }
 
 // commitSeatReservation handles seat-based inventory reservation
 func (s *InventoryService) commitSeatReservation(ctx context.Context, req *proto.CommitReq, orderID, idempotencyKey string) (*proto.CommitRes, error) {
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
 	seatIDs := make([]string, len(req.SeatIds))
 	for i, seatRef := range req.SeatIds {
 		seatIDs[i] = seatRef.SeatId
@@ -125,68 +351,246 @@ func (s *InventoryService) commitSeatReservation(ctx context.Context, req *proto
 	}
 
 	// Check if all seats are available or held by this reservation
+	if err := checkSeatTransitions(commitSources, seatSold); err != nil {
+		return nil, err
+	}
 	for _, seat := range seats {
-		if seat.Status != "AVAILABLE" && seat.ReservationID != req.ReservationId {
-			return nil, fmt.Errorf("seat %s is not available", seat.SeatID)
+		if (seat.Status != seatAvailable && seat.ReservationID != req.ReservationId) || checkSeatTransition(seat.Status, seatSold) != nil {
+			s.stats.RecordConflict(req.EventId)
+			return nil, &apperrors.SeatConflictError{EventID: req.EventId, SeatIDs: []string{seat.SeatID}, Status: seat.Status}
 		}
 	}
 
+	// Held seats are only sold while their hold is live
+	holdCheck, heldSince, err := s.liveHoldCheck(ctx, req.EventId, req.ReservationId, seats, len(seatIDs))
+	if err != nil {
+		return nil, err
+	}
+
 	// Prepare seat updates for transaction
 	var seatUpdates []*repo.SeatItem
 	for _, seatID := range seatIDs {
 		seatUpdates = append(seatUpdates, &repo.SeatItem{
 			EventID:       req.EventId,
 			SeatID:        seatID,
-			Status:        "SOLD",
+			Status:        seatSold,
 			ReservationID: req.ReservationId,
 			UpdatedAt:     time.Now(),
 		})
 	}
 
-	// Build condition expression for transaction
-	conditionExpr := "attribute_not_exists(seat_id) OR (status = :available OR (status = :hold AND reservation_id = :reservation_id))"
+	// Only existing seats that are available or held by this reservation are sold
+	conditionExpr := "#status = :available OR (#status = :hold AND reservation_id = :reservation_id)"
 
 	exprValues := map[string]types.AttributeValue{
 		":available": &types.AttributeValueMemberS{
-			Value: "AVAILABLE",
+			Value: seatAvailable,
 		},
 		":hold": &types.AttributeValueMemberS{
-			Value: "HOLD",
+			Value: seatHold,
 		},
 		":reservation_id": &types.AttributeValueMemberS{
 			Value: req.ReservationId,
 		},
 	}
 
-	// Execute transaction
-	err = s.repo.TransactWriteSeats(ctx, seatUpdates, conditionExpr, exprValues)
+	res := confirmedCommit(orderID, seatOrderLines(req.EventId, seatIDs, seats))
+
+	// Execute transaction, recording the commit in it
+	err = s.repo.TransactWriteSeatsWithHolds(ctx, seatUpdates, conditionExpr, exprValues, nil, holdCheck, commitRecord(idempotencyKey, req, res))
 	if err != nil {
-		var conditionalCheckFailed *types.ConditionalCheckFailedException
-		if err == conditionalCheckFailed {
-			return nil, fmt.Errorf("one or more seats are not available for event %s", req.EventId)
+		if errors.Is(err, apperrors.ErrIdempotencyConflict) {
+			return s.replayRecordedCommit(ctx, idempotencyKey, req, err)
+		}
+		if errors.Is(err, apperrors.ErrHoldExpired) {
+			return nil, apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s", req.ReservationId)
+		}
+		if errors.Is(err, apperrors.ErrSeatConflict) {
+			s.stats.RecordConflict(req.EventId)
+			return nil, err
 		}
 		return nil, fmt.Errorf("failed to commit seat reservation: %w", err)
 	}
+	ctx, done := publishPhase(ctx)
+	defer done()
+	s.stats.RecordCommit(req.EventId)
+	if holdCheck != nil {
+		s.stats.RecordHoldCommitted(req.EventId, time.Since(heldSince))
+	}
+	s.anomalies.RecordSale(ctx, req.EventId, len(seatIDs))
+	s.cacheSeatStatus(ctx, req.EventId, seatIDs, seatSold)
 
-	// Store idempotency record
-	err = s.repo.PutIdempotency(ctx, &repo.IdempotencyItem{
-		Key:       idempotencyKey,
-		Operation: orderID,
-		EventID:   req.EventId,
-		CreatedAt: time.Now(),
-	})
+	return res, nil
+}
+
+// HoldSeats places a TTL-limited hold on seats for a reservation.
+// Holding seats already held by the same reservation refreshes the hold.
+func (s *InventoryService) HoldSeats(ctx context.Context, req *proto.HoldReq) (*proto.HoldRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.ReservationId == "" || req.EventId == "" || len(req.SeatIds) == 0 {
+		return nil, errors.New("invalid request: reservation_id, event_id and seat_ids are required")
+	}
+
+	if err := s.anomalies.CheckCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.eventHoldPolicy(ctx, req.EventId)
 	if err != nil {
-		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
+		return nil, err
+	}
+	if len(req.SeatIds) > policy.maxSeats {
+		return nil, apperrors.New(apperrors.ErrLimitExceeded, "limit exceeded: at most %d seats per hold", policy.maxSeats)
+	}
+
+	seatIDs := make([]string, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatIDs[i] = seatRef.SeatId
 	}
 
-	return &proto.CommitRes{
-		OrderId: orderID,
-		Status:  "CONFIRMED",
+	hidden, err := s.hiddenSeatIDs(ctx, req.EventId, seatIDs, req.AccessCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(hidden) > 0 {
+		// Hidden seats aren't named, so holds don't reveal them
+		return nil, apperrors.SeatConflict(req.EventId)
+	}
+
+	extensions, err := s.holdExtensions(ctx, req.EventId, req.ReservationId, seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holds: %w", err)
+	}
+	if extensions > policy.maxExtensions {
+		return nil, apperrors.New(apperrors.ErrLimitExceeded, "hold of reservation %s reached the extension limit of %d", req.ReservationId, policy.maxExtensions)
+	}
+
+	if err := checkSeatTransitions(holdSources, seatHold); err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(policy.ttl)
+	err = s.repo.HoldSeats(ctx, req.EventId, req.ReservationId, seatIDs, expiresAt, extensions)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSeatConflict) {
+			return nil, err
+		}
+		// Transactions also cancel on concurrent writes to the same seats
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, apperrors.SeatConflict(req.EventId)
+		}
+		return nil, fmt.Errorf("failed to hold seats: %w", err)
+	}
+	if extensions == 0 {
+		s.stats.RecordHoldCreated(req.EventId)
+	}
+	s.anomalies.RecordHold(ctx, req.EventId, len(seatIDs))
+	s.cacheSeatStatus(ctx, req.EventId, seatIDs, seatHold)
+
+	return &proto.HoldRes{
+		Status:              seatHold,
+		ExpiresAt:           timestamppb.New(expiresAt),
+		ExtensionsRemaining: policy.maxExtensions - extensions,
 	}, nil
 }
 
+// ExtendHold moves the expiry of a reservation's live hold on seats to a full hold TTL
+// from now. It counts against the event's extension limit like holding the seats again,
+// but never picks up seats the reservation doesn't hold.
+func (s *InventoryService) ExtendHold(ctx context.Context, req *proto.ExtendHoldReq) (*proto.HoldRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.ReservationId == "" || req.EventId == "" || len(req.SeatIds) == 0 {
+		return nil, errors.New("invalid request: reservation_id, event_id and seat_ids are required")
+	}
+
+	policy, err := s.eventHoldPolicy(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.SeatIds) > policy.maxSeats {
+		return nil, apperrors.New(apperrors.ErrLimitExceeded, "limit exceeded: at most %d seats per hold", policy.maxSeats)
+	}
+
+	seatIDs := make([]string, len(req.SeatIds))
+	for i, seatRef := range req.SeatIds {
+		seatIDs[i] = seatRef.SeatId
+	}
+
+	extensions, err := s.holdExtensions(ctx, req.EventId, req.ReservationId, seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holds: %w", err)
+	}
+	if extensions == 0 {
+		return nil, s.explainExtendConflict(ctx, req.EventId, req.ReservationId, seatIDs)
+	}
+	if extensions > policy.maxExtensions {
+		return nil, apperrors.New(apperrors.ErrLimitExceeded, "hold of reservation %s reached the extension limit of %d", req.ReservationId, policy.maxExtensions)
+	}
+
+	expiresAt := time.Now().Add(policy.ttl)
+	err = s.repo.ExtendHolds(ctx, req.EventId, req.ReservationId, seatIDs, expiresAt, extensions)
+	if err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, s.explainExtendConflict(ctx, req.EventId, req.ReservationId, seatIDs)
+		}
+		return nil, fmt.Errorf("failed to extend hold: %w", err)
+	}
+
+	return &proto.HoldRes{
+		Status:              seatHold,
+		ExpiresAt:           timestamppb.New(expiresAt),
+		ExtensionsRemaining: policy.maxExtensions - extensions,
+	}, nil
+}
+
+// explainExtendConflict distinguishes seats taken by another reservation from a hold
+// that expired after an extension fails. Both are conflicts: the caller must hold again.
+func (s *InventoryService) explainExtendConflict(ctx context.Context, eventID, reservationID string, seatIDs []string) error {
+	holds, err := s.repo.GetHolds(ctx, eventID, seatIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get holds: %w", err)
+	}
+	now := time.Now().Unix()
+	for _, hold := range holds {
+		if hold.ReservationID != reservationID && hold.ExpiresAt > now {
+			return apperrors.New(apperrors.ErrSeatConflict, "conflict: one or more seats of reservation %s are held by another reservation", reservationID)
+		}
+	}
+	return apperrors.New(apperrors.ErrSeatConflict, "conflict: hold of reservation %s on event %s expired before it was extended", reservationID, eventID)
+}
+
 // ReleaseHold releases a hold on inventory (idempotent operation)
 func (s *InventoryService) ReleaseHold(ctx context.Context, req *proto.ReleaseReq) (*proto.ReleaseRes, error) {
+	return dedupe(ctx, s.deduper, "release", req, func() (*proto.ReleaseRes, error) {
+		return s.releaseHold(ctx, req)
+	})
+}
+
+// releaseHold executes a release that wasn't collapsed into an identical request
+func (s *InventoryService) releaseHold(ctx context.Context, req *proto.ReleaseReq) (*proto.ReleaseRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
 	// Check idempotency
 	idempotencyKey := fmt.Sprintf("release:%s", req.ReservationId)
 	idempotencyItem, err := s.repo.GetIdempotency(ctx, idempotencyKey)
@@ -201,8 +605,15 @@ func (s *InventoryService) ReleaseHold(ctx context.Context, req *proto.ReleaseRe
 		}, nil
 	}
 
-	// Determine inventory type and process accordingly
-	if len(req.SeatIds) > 0 {
+	return s.release(ctx, req, idempotencyKey)
+}
+
+// release releases a hold according to its inventory type
+func (s *InventoryService) release(ctx context.Context, req *proto.ReleaseReq, idempotencyKey string) (*proto.ReleaseRes, error) {
+	if len(req.SectionQtys) > 0 {
+		// Hybrid seats + general admission
+		return s.releaseHybridHold(ctx, req, idempotencyKey)
+	} else if len(req.SeatIds) > 0 {
 		// Seat-based inventory
 		return s.releaseSeatHold(ctx, req, idempotencyKey)
 	} else {
@@ -224,13 +635,23 @@ func (s *InventoryService) releaseQuantityHold(ctx context.Context, req *proto.R
 		":updated_at": &types.AttributeValueMemberS{
 			Value: time.Now().Format(time.RFC3339),
 		},
+		":not_frozen": &types.AttributeValueMemberBOOL{
+			Value: false,
+		},
 	}
 
-	err := s.repo.UpdateInventoryConditionally(ctx, req.EventId, updateExpr, "", exprValues, nil)
+	previous, err := s.repo.UpdateInventoryConditionallyReturnOld(ctx, req.EventId, updateExpr, notFrozenCondition, exprValues, nil)
 	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil, apperrors.New(apperrors.ErrEventNotOnSale, "event %s is frozen", req.EventId)
+		}
 		return nil, fmt.Errorf("failed to release quantity hold: %w", err)
 	}
 
+	s.cacheRemainingDelta(ctx, req.EventId, req.Qty)
+	s.restock.QuantityReturned(ctx, req.EventId, previous.Remaining, req.Qty, "RELEASED")
+
 	// Store idempotency record
 	err = s.repo.PutIdempotency(ctx, &repo.IdempotencyItem{
 		Key:       idempotencyKey,
@@ -239,7 +660,7 @@ func (s *InventoryService) releaseQuantityHold(ctx context.Context, req *proto.R
 		CreatedAt: time.Now(),
 	})
 	if err != nil {
-		fmt.Printf("Warning: failed to store idempotency record: %w", err)
+		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
 	}
 
 	return &proto.ReleaseRes{
@@ -249,6 +670,10 @@ func (s *InventoryService) releaseQuantityHold(ctx context.Context, req *proto.R
 
 // releaseSeatHold handles seat-based inventory hold release
 func (s *InventoryService) releaseSeatHold(ctx context.Context, req *proto.ReleaseReq, idempotencyKey string) (*proto.ReleaseRes, error) {
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
 	seatIDs := make([]string, len(req.SeatIds))
 	for i, seatRef := range req.SeatIds {
 		seatIDs[i] = seatRef.SeatId
@@ -260,15 +685,22 @@ func (s *InventoryService) releaseSeatHold(ctx context.Context, req *proto.Relea
 		return nil, fmt.Errorf("failed to get seats: %w", err)
 	}
 
+	if err := checkSeatTransitions(releaseSources, seatAvailable); err != nil {
+		return nil, err
+	}
+
 	// Prepare seat updates for transaction
 	var seatUpdates []*repo.SeatItem
 	for _, seat := range seats {
 		// Only update if the seat is held by this reservation
 		if seat.ReservationID == req.ReservationId {
+			if !slices.Contains(releaseSources, seat.Status) {
+				return nil, fmt.Errorf("precondition failed: seat %s is %s and can't be released", seat.SeatID, seat.Status)
+			}
 			seatUpdates = append(seatUpdates, &repo.SeatItem{
 				EventID:       req.EventId,
 				SeatID:        seat.SeatID,
-				Status:        "AVAILABLE",
+				Status:        seatAvailable,
 				ReservationID: "", // Clear reservation ID
 				UpdatedAt:     time.Now(),
 			})
@@ -282,12 +714,21 @@ func (s *InventoryService) releaseSeatHold(ctx context.Context, req *proto.Relea
 		}, nil
 	}
 
-	// Execute transaction without condition (since we're releasing)
-	err = s.repo.TransactWriteSeats(ctx, seatUpdates, "", nil)
+	// The seats must still be held by this reservation when they are released
+	conditionExpr := "#status = :hold AND reservation_id = :reservation_id"
+	exprValues := map[string]types.AttributeValue{
+		":hold":           &types.AttributeValueMemberS{Value: seatHold},
+		":reservation_id": &types.AttributeValueMemberS{Value: req.ReservationId},
+	}
+	err = s.repo.TransactWriteSeats(ctx, seatUpdates, conditionExpr, exprValues, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to release seat hold: %w", err)
 	}
 
+	releasedSeatIDs := seatIDsOf(seatUpdates)
+	s.cacheSeatStatus(ctx, req.EventId, releasedSeatIDs, seatAvailable)
+	s.restock.SeatsReturned(ctx, req.EventId, releasedSeatIDs, "RELEASED")
+
 	// Store idempotency record
 	err = s.repo.PutIdempotency(ctx, &repo.IdempotencyItem{
 		Key:       idempotencyKey,
@@ -296,7 +737,7 @@ func (s *InventoryService) releaseSeatHold(ctx context.Context, req *proto.Relea
 		CreatedAt: time.Now(),
 	})
 	if err != nil {
-		fmt.Printf("Warning: failed to store idempotency record: %w", err)
+		fmt.Printf("Warning: failed to store idempotency record: %v\n", err)
 	}
 
 	return &proto.ReleaseRes{
@@ -306,6 +747,14 @@ func (s *InventoryService) releaseSeatHold(ctx context.Context, req *proto.Relea
 
 // CheckAvailability checks if inventory is available for the given request
 func (s *InventoryService) CheckAvailability(ctx context.Context, req *proto.CheckReq) (*proto.CheckRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if since, ok := s.degraded(); ok {
+		return s.checkAvailabilityDegraded(ctx, req, since)
+	}
+
 	if len(req.SeatIds) > 0 {
 		// Seat-based availability check
 		return s.checkSeatAvailability(ctx, req)
@@ -317,22 +766,31 @@ func (s *InventoryService) CheckAvailability(ctx context.Context, req *proto.Che
 
 // checkQuantityAvailability handles quantity-based availability check
 func (s *InventoryService) checkQuantityAvailability(ctx context.Context, req *proto.CheckReq) (*proto.CheckRes, error) {
-	inventory, err := s.repo.GetInventory(ctx, req.EventId)
+	remaining, err := s.remaining(ctx, req.EventId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get inventory: %w", err)
-	}
-
-	if inventory.Remaining >= req.Qty {
-		return &proto.CheckRes{
-			Available: true,
-		}, nil
+		return nil, err
 	}
 
+	reported, approximate := s.reportedRemaining(remaining)
 	return &proto.CheckRes{
-		Available: false,
+		Available:            remaining >= req.Qty,
+		Remaining:            reported,
+		RemainingApproximate: approximate,
 	}, nil
 }
 
+// reportedRemaining returns the remaining quantity a quantity check reports: exact up to
+// the configured threshold, and rounded down to the configured step above it, so
+// repeated checks don't reveal exact stock levels
+func (s *InventoryService) reportedRemaining(remaining int32) (int32, bool) {
+	threshold := s.config.Inventory.RemainingExactThreshold
+	if threshold <= 0 || remaining <= threshold {
+		return max(remaining, 0), false
+	}
+	step := max(s.config.Inventory.RemainingStep, 1)
+	return max(remaining-remaining%step, threshold), true
+}
+
 // checkSeatAvailability handles seat-based availability check
 func (s *InventoryService) checkSeatAvailability(ctx context.Context, req *proto.CheckReq) (*proto.CheckRes, error) {
 	seatIDs := make([]string, len(req.SeatIds))
@@ -340,20 +798,49 @@ func (s *InventoryService) checkSeatAvailability(ctx context.Context, req *proto
 		seatIDs[i] = seatRef.SeatId
 	}
 
-	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	statuses, err := s.seatStatuses(ctx, req.EventId, seatIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get seats: %w", err)
+		return nil, err
+	}
+
+	hidden, err := s.hiddenSeatIDs(ctx, req.EventId, seatIDs, req.AccessCode)
+	if err != nil {
+		return nil, err
 	}
 
 	var unavailableSeats []string
-	for _, seat := range seats {
-		if seat.Status != "AVAILABLE" {
-			unavailableSeats = append(unavailableSeats, seat.SeatID)
+	for _, seatID := range seatIDs {
+		if status, ok := statuses[seatID]; (ok && status != seatAvailable) || slices.Contains(hidden, seatID) {
+			unavailableSeats = append(unavailableSeats, seatID)
 		}
 	}
 
+	seatMapVersion, err := s.seatMapVersion(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
 	return &proto.CheckRes{
 		Available:        len(unavailableSeats) == 0,
 		UnavailableSeats: unavailableSeats,
+		SeatMapVersion:   seatMapVersion,
+		Seats:            seatAvailabilities(seatIDs, statuses, hidden),
 	}, nil
 }
+
+// seatMapVersion returns an event's seat map version, which the seat replica caches for
+// replicated events; 0 for events without an inventory item
+func (s *InventoryService) seatMapVersion(ctx context.Context, eventID string) (int32, error) {
+	if version, ok := s.replica.SeatMapVersion(eventID); ok {
+		return version, nil
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	return inventory.SeatMapVersion, nil
+}
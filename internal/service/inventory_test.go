@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+func TestVersionRetryDecision(t *testing.T) {
+	const previous = 7
+
+	tests := []struct {
+		name    string
+		current repo.InventoryItem
+		qty     int32
+		attempt int
+		raced   bool
+		retry   bool
+	}{
+		{
+			name:    "lost the version with tickets left",
+			current: repo.InventoryItem{Remaining: 10, Version: 8},
+			qty:     2,
+			raced:   true,
+			retry:   true,
+		},
+		{
+			name:    "exactly enough tickets left",
+			current: repo.InventoryItem{Remaining: 2, Version: 8},
+			qty:     2,
+			attempt: 2,
+			raced:   true,
+			retry:   true,
+		},
+		{
+			name:    "out of retries",
+			current: repo.InventoryItem{Remaining: 10, Version: 9},
+			qty:     2,
+			attempt: 3,
+			raced:   true,
+		},
+		{
+			name:    "too few tickets left",
+			current: repo.InventoryItem{Remaining: 1, Version: 8},
+			qty:     2,
+		},
+		{
+			name:    "frozen meanwhile",
+			current: repo.InventoryItem{Remaining: 10, Version: 8, Frozen: true},
+			qty:     2,
+		},
+		{
+			name:    "version unchanged",
+			current: repo.InventoryItem{Remaining: 10, Version: previous},
+			qty:     2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raced, retry := versionRetryDecision(&tt.current, previous, tt.qty, tt.attempt, 3)
+			if raced != tt.raced || retry != tt.retry {
+				t.Fatalf("versionRetryDecision() = %v, %v; want %v, %v", raced, retry, tt.raced, tt.retry)
+			}
+		})
+	}
+}
+
+func TestVersionRetryDelay(t *testing.T) {
+	const delay = 20 * time.Millisecond
+
+	for attempt := 0; attempt < 4; attempt++ {
+		full := delay << attempt
+		for i := 0; i < 100; i++ {
+			if got := versionRetryDelay(delay, attempt); got < full/2 || got > full {
+				t.Fatalf("versionRetryDelay(%s, %d) = %s, want within [%s, %s]", delay, attempt, got, full/2, full)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// ListSeats page sizes
+const (
+	defaultListSeatsPageSize = 100
+	maxListSeatsPageSize     = 1000
+)
+
+// ListSeats returns a page of an event's seats in seat ID order, optionally only those of
+// a section or with given statuses. Seats hidden by visibility rules are left out, and
+// operator notes are not exposed.
+func (s *InventoryService) ListSeats(ctx context.Context, req *proto.ListSeatsReq) (*proto.ListSeatsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultListSeatsPageSize
+	}
+	if pageSize > maxListSeatsPageSize {
+		return nil, fmt.Errorf("invalid request: page_size must be at most %d", maxListSeatsPageSize)
+	}
+
+	statuses := make([]string, 0, len(req.StatusFilter))
+	for _, status := range req.StatusFilter {
+		if status == proto.SeatStatus_SEAT_STATUS_UNSPECIFIED {
+			return nil, errors.New("invalid request: status_filter can't contain SEAT_STATUS_UNSPECIFIED")
+		}
+		statuses = append(statuses, seatStatusName(status))
+	}
+
+	startSeatID, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+	if err != nil {
+		return nil, errors.New("invalid request: malformed page_token")
+	}
+
+	seatPrefix := ""
+	if req.Section != "" {
+		seatPrefix = req.Section + "-"
+	}
+
+	seats, nextSeatID, err := s.repo.QuerySeatsPage(ctx, req.EventId, seatPrefix, statuses, string(startSeatID), pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]string, len(seats))
+	for i, seat := range seats {
+		seatIDs[i] = seat.SeatID
+	}
+	hidden, err := s.hiddenSeatIDs(ctx, req.EventId, seatIDs, req.AccessCode)
+	if err != nil {
+		return nil, err
+	}
+
+	seatMapVersion, err := s.seatMapVersion(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := seatsToProto(seats)
+	listed = slices.DeleteFunc(listed, func(seat *proto.Seat) bool {
+		return slices.Contains(hidden, seat.SeatId)
+	})
+	for _, seat := range listed {
+		seat.Note = ""
+	}
+
+	return &proto.ListSeatsRes{
+		Seats:          listed,
+		NextPageToken:  base64.RawURLEncoding.EncodeToString([]byte(nextSeatID)),
+		SeatMapVersion: seatMapVersion,
+	}, nil
+}
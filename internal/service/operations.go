@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Operation kinds
+const (
+	operationReleaseEventHolds        = "RELEASE_EVENT_HOLDS"
+	operationInstantiateVenueTemplate = "INSTANTIATE_VENUE_TEMPLATE"
+	operationCloseEvent               = "CLOSE_EVENT"
+)
+
+const (
+	// operationHeartbeat is how often a running operation persists its progress and
+	// checks whether it has been cancelled
+	operationHeartbeat = 2 * time.Second
+	// operationStaleAfter is how long a RUNNING operation may go without persisting
+	// progress before it is reported as abandoned by its instance
+	operationStaleAfter = 15 * operationHeartbeat
+	// operationRetention is how long finished operations can be polled
+	operationRetention = 7 * 24 * time.Hour
+	// operationWriteTimeout bounds persisting an operation's progress or outcome
+	operationWriteTimeout = 5 * time.Second
+)
+
+// OperationRunner runs long-running admin operations in the background of the instance
+// that started them. Their state lives in DynamoDB so any instance can report or
+// cancel them; an operation stops with the instance that runs it.
+type OperationRunner struct {
+	repo *repo.DynamoDBRepository
+
+	mu sync.Mutex
+	// ctx is the lifetime of operations, set by Run
+	ctx context.Context
+}
+
+// operationProgress is the progress of an operation running on this instance
+type operationProgress struct {
+	mu        sync.Mutex
+	total     int32
+	processed int32
+	failed    int32
+}
+
+// NewOperationRunner creates a new operation runner
+func NewOperationRunner(repo *repo.DynamoDBRepository) *OperationRunner {
+	return &OperationRunner{repo: repo}
+}
+
+// Run accepts operations until ctx is canceled, which interrupts running operations
+func (r *OperationRunner) Run(ctx context.Context) {
+	r.mu.Lock()
+	r.ctx = ctx
+	r.mu.Unlock()
+
+	<-ctx.Done()
+}
+
+// start persists a new operation and runs fn in the background
+func (r *OperationRunner) start(ctx context.Context, kind, eventID string, fn func(context.Context, *operationProgress) error) (*repo.OperationItem, error) {
+	r.mu.Lock()
+	runCtx := r.ctx
+	r.mu.Unlock()
+	if runCtx == nil || runCtx.Err() != nil {
+		return nil, apperrors.New(apperrors.ErrUnavailable, "operations are unavailable: instance is shutting down")
+	}
+
+	now := time.Now()
+	item := &repo.OperationItem{
+		OperationID: fmt.Sprintf("op_%s", uuid.New().String()[:12]),
+		Kind:        kind,
+		EventID:     eventID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ExpiresAt:   now.Add(operationRetention).Unix(),
+	}
+	if err := r.repo.CreateOperation(ctx, item); err != nil {
+		return nil, err
+	}
+
+	opCtx, cancel := context.WithCancel(runCtx)
+	go r.run(opCtx, cancel, item, fn)
+
+	return item, nil
+}
+
+// run runs an operation, persisting its progress every heartbeat and its outcome when done
+func (r *OperationRunner) run(ctx context.Context, cancel context.CancelFunc, item *repo.OperationItem, fn func(context.Context, *operationProgress) error) {
+	defer cancel()
+
+	progress := &operationProgress{}
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx, progress)
+	}()
+
+	ticker := time.NewTicker(operationHeartbeat)
+	defer ticker.Stop()
+
+	cancelled := false
+	for {
+		select {
+		case err := <-done:
+			r.finish(item, progress, err, cancelled)
+			return
+		case <-ticker.C:
+			writeCtx, cancelWrite := context.WithTimeout(context.WithoutCancel(ctx), operationWriteTimeout)
+			total, processed, failed := progress.get()
+			cancelRequested, err := r.repo.UpdateOperationProgress(writeCtx, item.OperationID, total, processed, failed)
+			cancelWrite()
+			if err != nil {
+				fmt.Printf("Warning: failed to record progress of operation %s: %v\n", item.OperationID, err)
+				continue
+			}
+			if cancelRequested && !cancelled {
+				cancelled = true
+				cancel()
+			}
+		}
+	}
+}
+
+// finish records the outcome of an operation
+func (r *OperationRunner) finish(item *repo.OperationItem, progress *operationProgress, err error, cancelled bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationWriteTimeout)
+	defer cancel()
+
+	state := repo.OperationSucceeded
+	errMsg := ""
+	switch {
+	case err == nil:
+	case cancelled:
+		state = repo.OperationCancelled
+	default:
+		state = repo.OperationFailed
+		errMsg = err.Error()
+		if r.ctx.Err() != nil {
+			errMsg = "interrupted by instance shutdown; start the operation again to resume"
+		}
+	}
+
+	total, processed, failed := progress.get()
+	if err := r.repo.FinishOperation(ctx, item.OperationID, state, total, processed, failed, errMsg); err != nil {
+		fmt.Printf("Warning: failed to record outcome %s of operation %s: %v\n", state, item.OperationID, err)
+		return
+	}
+
+	fmt.Printf("Operation %s (%s, event %s) %s: %d processed, %d failed\n", item.OperationID, item.Kind, item.EventID, state, processed, failed)
+}
+
+// set replaces the progress of an operation
+func (p *operationProgress) set(total, processed, failed int32) {
+	p.mu.Lock()
+	p.total, p.processed, p.failed = total, processed, failed
+	p.mu.Unlock()
+}
+
+// get returns the progress of an operation
+func (p *operationProgress) get() (total, processed, failed int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.total, p.processed, p.failed
+}
+
+// StartOperation runs a bulk admin operation in the background
+func (s *AdminService) StartOperation(ctx context.Context, req *proto.StartOperationReq) (*proto.Operation, error) {
+	var item *repo.OperationItem
+
+	switch request := req.Request.(type) {
+	case *proto.StartOperationReq_ReleaseEventHolds:
+		release := request.ReleaseEventHolds
+		eventID, err := operationEventID(release.EventId, release.PerformanceId)
+		if err != nil {
+			return nil, err
+		}
+		item, err = s.operations.start(ctx, operationReleaseEventHolds, eventID, func(ctx context.Context, progress *operationProgress) error {
+			return s.ReleaseEventHolds(ctx, release, func(p *proto.ReleaseEventHoldsProgress, _ error) error {
+				// The number of held seats is only known once all have been scanned
+				total := int32(0)
+				if p.Done {
+					total = p.Scanned
+				}
+				progress.set(total, p.Released, p.Failed)
+				return ctx.Err()
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *proto.StartOperationReq_InstantiateVenueTemplate:
+		instantiate := request.InstantiateVenueTemplate
+		if instantiate.TemplateId == "" {
+			return nil, errors.New("invalid request: event_id and template_id are required")
+		}
+		eventID, err := operationEventID(instantiate.EventId, instantiate.PerformanceId)
+		if err != nil {
+			return nil, err
+		}
+		item, err = s.operations.start(ctx, operationInstantiateVenueTemplate, eventID, func(ctx context.Context, progress *operationProgress) error {
+			res, err := s.InstantiateVenueTemplate(ctx, instantiate)
+			if err != nil {
+				return err
+			}
+			progress.set(res.SeatsCreated, res.SeatsCreated, 0)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *proto.StartOperationReq_CloseEvent:
+		closeEvent := request.CloseEvent
+		eventID, err := operationEventID(closeEvent.EventId, closeEvent.PerformanceId)
+		if err != nil {
+			return nil, err
+		}
+		item, err = s.operations.start(ctx, operationCloseEvent, eventID, func(ctx context.Context, progress *operationProgress) error {
+			return s.CloseEvent(ctx, closeEvent, func(p *proto.CloseEventProgress) error {
+				// The number of available seats is only known once all have been scanned
+				total := int32(0)
+				if p.Done {
+					total = p.Scanned
+				}
+				progress.set(total, p.Closed, p.Failed)
+				return ctx.Err()
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("invalid request: an operation request is required")
+	}
+
+	return operationToProto(item), nil
+}
+
+// GetOperation reports the state of a long-running admin operation
+func (s *AdminService) GetOperation(ctx context.Context, req *proto.GetOperationReq) (*proto.Operation, error) {
+	item, err := s.getOperation(ctx, req.OperationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return operationToProto(item), nil
+}
+
+// CancelOperation asks a running operation to stop
+func (s *AdminService) CancelOperation(ctx context.Context, req *proto.CancelOperationReq) (*proto.Operation, error) {
+	if req.OperationId == "" {
+		return nil, errors.New("invalid request: operation_id is required")
+	}
+
+	if err := s.repo.RequestOperationCancel(ctx, req.OperationId); err != nil {
+		return nil, err
+	}
+
+	item, err := s.getOperation(ctx, req.OperationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return operationToProto(item), nil
+}
+
+// getOperation reads an operation, reporting RUNNING operations that stopped
+// persisting progress as abandoned
+func (s *AdminService) getOperation(ctx context.Context, operationID string) (*repo.OperationItem, error) {
+	if operationID == "" {
+		return nil, errors.New("invalid request: operation_id is required")
+	}
+
+	item, err := s.repo.GetOperation(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("operation %s not found", operationID)
+	}
+
+	if item.State == repo.OperationRunning && time.Since(item.UpdatedAt) > operationStaleAfter {
+		item.State = repo.OperationFailed
+		item.Error = "abandoned: the instance running it stopped; start the operation again to resume"
+	}
+
+	return item, nil
+}
+
+// operationEventID validates an operation's event and returns its partition key
+func operationEventID(eventID, performanceID string) (string, error) {
+	if eventID == "" {
+		return "", errors.New("invalid request: event_id is required")
+	}
+	if err := usePerformanceKey(&eventID, performanceID); err != nil {
+		return "", err
+	}
+	return eventID, nil
+}
+
+// operationToProto converts an operation item to its API representation
+func operationToProto(item *repo.OperationItem) *proto.Operation {
+	return &proto.Operation{
+		OperationId:     item.OperationID,
+		Kind:            item.Kind,
+		EventId:         item.EventID,
+		State:           item.State,
+		CancelRequested: item.CancelRequested,
+		Total:           item.Total,
+		Processed:       item.Processed,
+		Failed:          item.Failed,
+		Error:           item.Error,
+		CreatedAt:       timestamppb.New(item.CreatedAt),
+		UpdatedAt:       timestamppb.New(item.UpdatedAt),
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// orderLimits are the most tickets (seats plus quantities) an order may commit of one
+// event, and over all events of a reservation's commit; 0 is unlimited
+type orderLimits struct {
+	perOrder       int
+	perReservation int
+}
+
+// orderLimitPolicy holds the default order limits and the overrides of single events
+type orderLimitPolicy struct {
+	defaults  orderLimits
+	overrides map[string]orderLimits
+}
+
+// newOrderLimitPolicy reads the order limits from the configuration, skipping malformed overrides
+func newOrderLimitPolicy(cfg appconfig.OrderLimitsConfig) orderLimitPolicy {
+	policy := orderLimitPolicy{
+		defaults:  orderLimits{perOrder: cfg.MaxTickets, perReservation: cfg.MaxTicketsPerReservation},
+		overrides: make(map[string]orderLimits, len(cfg.Overrides)),
+	}
+	for _, entry := range cfg.Overrides {
+		eventID, limits, err := parseOrderLimitOverride(entry)
+		if err != nil {
+			fmt.Printf("Warning: ignoring order limit override %q: %v\n", entry, err)
+			continue
+		}
+		policy.overrides[eventID] = limits
+	}
+	return policy
+}
+
+// limits returns the order limits of an event or performance key; performances share
+// the limits of their event
+func (p orderLimitPolicy) limits(eventID string) orderLimits {
+	eventID, _, _ = strings.Cut(eventID, "#")
+	if limits, ok := p.overrides[eventID]; ok {
+		return limits
+	}
+	return p.defaults
+}
+
+// checkOrderLimits rejects a commit buying more tickets of an event, or in total, than
+// the events allow. The reservation limit of a bundle is the strictest of its events'.
+func (s *InventoryService) checkOrderLimits(req *proto.CommitReq) error {
+	type line struct {
+		eventID string
+		tickets int
+	}
+	lines := []line{{req.EventId, commitTickets(req.SeatIds, req.Qty, req.SectionQtys)}}
+	if len(req.LineItems) > 0 {
+		lines = lines[:0]
+		for _, item := range req.LineItems {
+			lines = append(lines, line{item.EventId, commitTickets(item.SeatIds, item.Qty, item.SectionQtys)})
+		}
+	}
+
+	total, reservationLimit := 0, 0
+	for _, l := range lines {
+		limits := s.orderLimits.limits(l.eventID)
+		if limits.perOrder > 0 && l.tickets > limits.perOrder {
+			return apperrors.New(apperrors.ErrOrderLimitExceeded, "invalid request: at most %d tickets of event %s per order", limits.perOrder, l.eventID)
+		}
+		if limits.perReservation > 0 && (reservationLimit == 0 || limits.perReservation < reservationLimit) {
+			reservationLimit = limits.perReservation
+		}
+		total += l.tickets
+	}
+	if reservationLimit > 0 && total > reservationLimit {
+		return apperrors.New(apperrors.ErrOrderLimitExceeded, "invalid request: at most %d tickets per reservation", reservationLimit)
+	}
+	return nil
+}
+
+// commitTickets counts the seats and quantities of a commit or line item
+func commitTickets(seatIDs []*proto.SeatRef, qty int32, sectionQtys []*proto.SectionQty) int {
+	tickets := len(seatIDs) + int(qty)
+	for _, sectionQty := range sectionQtys {
+		tickets += int(sectionQty.Qty)
+	}
+	return tickets
+}
+
+// parseOrderLimitOverride parses an "<event_id>=<per order>/<per reservation>" override
+func parseOrderLimitOverride(entry string) (string, orderLimits, error) {
+	eventID, limits, ok := strings.Cut(entry, "=")
+	if !ok || eventID == "" {
+		return "", orderLimits{}, errors.New("expected <event_id>=<per order>/<per reservation>")
+	}
+	perOrder, perReservation, ok := strings.Cut(limits, "/")
+	if !ok {
+		return "", orderLimits{}, errors.New("limits must be <per order>/<per reservation>")
+	}
+	order, err := strconv.Atoi(perOrder)
+	if err != nil || order < 0 {
+		return "", orderLimits{}, fmt.Errorf("malformed per order limit %q", perOrder)
+	}
+	reservation, err := strconv.Atoi(perReservation)
+	if err != nil || reservation < 0 {
+		return "", orderLimits{}, fmt.Errorf("malformed per reservation limit %q", perReservation)
+	}
+	return eventID, orderLimits{perOrder: order, perReservation: reservation}, nil
+}
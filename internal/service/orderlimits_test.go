@@ -0,0 +1,139 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+func TestCheckOrderLimits(t *testing.T) {
+	s := &InventoryService{orderLimits: newOrderLimitPolicy(appconfig.OrderLimitsConfig{
+		MaxTickets:               4,
+		MaxTicketsPerReservation: 6,
+		Overrides:                []string{"evt-vip=2/3", "evt-open=0/0", "malformed"},
+	})}
+	line := func(eventID string, seats int, qty int32) *proto.CommitLineItem {
+		item := &proto.CommitLineItem{EventId: eventID, Qty: qty}
+		for i := 0; i < seats; i++ {
+			item.SeatIds = append(item.SeatIds, &proto.SeatRef{SeatId: strings.Repeat("A", i+1)})
+		}
+		return item
+	}
+
+	tests := []struct {
+		name    string
+		req     *proto.CommitReq
+		wantErr string
+	}{
+		{
+			name: "within the default limit",
+			req:  &proto.CommitReq{EventId: "evt-1", SeatIds: seatRefs("A-1", "A-2", "A-3", "A-4")},
+		},
+		{
+			name:    "seats over the default limit",
+			req:     &proto.CommitReq{EventId: "evt-1", SeatIds: seatRefs("A-1", "A-2", "A-3", "A-4", "A-5")},
+			wantErr: "at most 4 tickets of event evt-1 per order",
+		},
+		{
+			name:    "quantity over the default limit",
+			req:     &proto.CommitReq{EventId: "evt-1", Qty: 5},
+			wantErr: "at most 4 tickets of event evt-1 per order",
+		},
+		{
+			name: "seats and sections counted together",
+			req: &proto.CommitReq{EventId: "evt-1", SeatIds: seatRefs("A-1", "A-2"), SectionQtys: []*proto.SectionQty{
+				{Section: "GA", Qty: 2}, {Section: "FLOOR", Qty: 1},
+			}},
+			wantErr: "at most 4 tickets of event evt-1 per order",
+		},
+		{
+			name:    "override of the event",
+			req:     &proto.CommitReq{EventId: "evt-vip", Qty: 3},
+			wantErr: "at most 2 tickets of event evt-vip per order",
+		},
+		{
+			name:    "override shared by performances",
+			req:     &proto.CommitReq{EventId: "evt-vip#perf-1", Qty: 3},
+			wantErr: "at most 2 tickets of event evt-vip#perf-1 per order",
+		},
+		{
+			name: "unlimited override",
+			req:  &proto.CommitReq{EventId: "evt-open", Qty: 100},
+		},
+		{
+			name: "bundle within the reservation limit",
+			req:  &proto.CommitReq{LineItems: []*proto.CommitLineItem{line("evt-1", 3, 0), line("evt-2", 0, 3)}},
+		},
+		{
+			name:    "bundle over the reservation limit",
+			req:     &proto.CommitReq{LineItems: []*proto.CommitLineItem{line("evt-1", 4, 0), line("evt-2", 0, 3)}},
+			wantErr: "at most 6 tickets per reservation",
+		},
+		{
+			name:    "bundle takes the strictest reservation limit",
+			req:     &proto.CommitReq{LineItems: []*proto.CommitLineItem{line("evt-1", 2, 0), line("evt-vip", 0, 2)}},
+			wantErr: "at most 3 tickets per reservation",
+		},
+		{
+			name:    "bundle line item over its event's limit",
+			req:     &proto.CommitReq{LineItems: []*proto.CommitLineItem{line("evt-1", 1, 0), line("evt-vip", 0, 3)}},
+			wantErr: "at most 2 tickets of event evt-vip per order",
+		},
+		{
+			name:    "unlimited events of a bundle don't lift the limit of others",
+			req:     &proto.CommitReq{LineItems: []*proto.CommitLineItem{line("evt-open", 0, 50), line("evt-1", 0, 4)}},
+			wantErr: "at most 6 tickets per reservation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkOrderLimits(tt.req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkOrderLimits() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkOrderLimits() = %v, want error containing %q", err, tt.wantErr)
+			}
+			if !errors.Is(err, apperrors.ErrOrderLimitExceeded) {
+				t.Fatalf("checkOrderLimits() = %v, want ErrOrderLimitExceeded", err)
+			}
+		})
+	}
+}
+
+func TestParseOrderLimitOverride(t *testing.T) {
+	tests := []struct {
+		entry   string
+		eventID string
+		limits  orderLimits
+		wantErr bool
+	}{
+		{entry: "evt-1=4/8", eventID: "evt-1", limits: orderLimits{perOrder: 4, perReservation: 8}},
+		{entry: "evt-1=0/0", eventID: "evt-1"},
+		{entry: "evt-1=4", wantErr: true},
+		{entry: "=4/8", wantErr: true},
+		{entry: "evt-1", wantErr: true},
+		{entry: "evt-1=-1/8", wantErr: true},
+		{entry: "evt-1=4/x", wantErr: true},
+	}
+	for _, tt := range tests {
+		eventID, limits, err := parseOrderLimitOverride(tt.entry)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseOrderLimitOverride(%q) = nil error, want error", tt.entry)
+			}
+			continue
+		}
+		if err != nil || eventID != tt.eventID || limits != tt.limits {
+			t.Errorf("parseOrderLimitOverride(%q) = %q, %+v, %v; want %q, %+v", tt.entry, eventID, limits, err, tt.eventID, tt.limits)
+		}
+	}
+}
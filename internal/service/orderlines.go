@@ -0,0 +1,63 @@
+package service
+
+import (
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Seat metadata keys copied onto order lines
+const (
+	seatMetadataSection = "section"
+	seatMetadataTier    = "tier"
+	seatMetadataPrice   = "price"
+)
+
+// seatOrderLines returns one order line per committed seat, with the section, tier and
+// price metadata the seats had when they were read for the commit
+func seatOrderLines(eventID string, seatIDs []string, seats []*repo.SeatItem) []*proto.OrderLine {
+	metadata := make(map[string]map[string]string, len(seats))
+	for _, seat := range seats {
+		metadata[seat.SeatID] = seat.Metadata
+	}
+
+	event, performance := repo.SplitPerformanceKey(eventID)
+	lines := make([]*proto.OrderLine, len(seatIDs))
+	for i, seatID := range seatIDs {
+		lines[i] = &proto.OrderLine{
+			EventId:       event,
+			PerformanceId: performance,
+			SeatId:        seatID,
+			Section:       metadata[seatID][seatMetadataSection],
+			Tier:          metadata[seatID][seatMetadataTier],
+			Price:         metadata[seatID][seatMetadataPrice],
+			Qty:           1,
+		}
+	}
+	return lines
+}
+
+// quantityOrderLine returns the order line of a quantity committed from an event or
+// from a general-admission section ("" for the event's own quantity)
+func quantityOrderLine(eventID, section string, qty int32) *proto.OrderLine {
+	event, performance := repo.SplitPerformanceKey(eventID)
+	return &proto.OrderLine{
+		EventId:       event,
+		PerformanceId: performance,
+		Section:       section,
+		Qty:           qty,
+	}
+}
+
+// confirmedCommit returns the response of a commit confirmed with the given lines
+func confirmedCommit(orderID string, lines []*proto.OrderLine) *proto.CommitRes {
+	qty := int32(0)
+	for _, line := range lines {
+		qty += line.Qty
+	}
+	return &proto.CommitRes{
+		OrderId:      orderID,
+		Status:       "CONFIRMED",
+		CommittedQty: qty,
+		Lines:        lines,
+	}
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// usePerformanceKey replaces a request's event ID with the partition key of the requested
+// performance, so the rest of a request flow addresses storage by event ID unchanged.
+// Single-performance requests (no performance ID) are left as they are.
+func usePerformanceKey(eventID *string, performanceID string) error {
+	if performanceID == "" {
+		return nil
+	}
+	if *eventID == "" {
+		return errors.New("invalid request: event_id is required with performance_id")
+	}
+	if strings.Contains(*eventID, "#") || strings.Contains(performanceID, "#") {
+		return errors.New("invalid request: event_id and performance_id must not contain '#'")
+	}
+
+	*eventID = repo.PerformanceKey(*eventID, performanceID)
+	return nil
+}
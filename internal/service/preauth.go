@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// preauthClaims is the signed payload of a commit pre-authorization token
+type preauthClaims struct {
+	ReservationID string `json:"r"`
+	// Commit is the digest of the pre-authorized commit request
+	Commit string `json:"c"`
+	// Prices is the digest of the seats' price metadata when the commit was pre-authorized
+	Prices    string `json:"p,omitempty"`
+	ExpiresAt int64  `json:"x"`
+}
+
+// PreauthorizeCommit validates that a reservation can commit exactly the seats or quantity
+// of req and returns a short-lived token signed over the request and the seats' prices.
+// Seats must all be held by the reservation. A commit presenting the token fails unless
+// it requests the same seats or quantity at the same prices before the token expires.
+// Hybrid and bundle commits can't be pre-authorized.
+func (s *InventoryService) PreauthorizeCommit(ctx context.Context, req *proto.CommitReq) (*proto.PreauthorizeCommitRes, error) {
+	if len(s.config.Preauth.Key) == 0 {
+		return nil, errors.New("precondition failed: commit pre-authorization is disabled (COMMIT_PREAUTH_KEY)")
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.ReservationId == "" || req.EventId == "" {
+		return nil, errors.New("invalid request: reservation_id and event_id are required")
+	}
+	if len(req.LineItems) > 0 || len(req.SectionQtys) > 0 {
+		return nil, errors.New("invalid request: only seat and quantity commits can be pre-authorized")
+	}
+	if len(req.SeatIds) == 0 && req.Qty <= 0 {
+		return nil, errors.New("invalid request: seat_ids or a positive qty is required")
+	}
+	if err := s.checkOrderLimits(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
+	claims := preauthClaims{
+		ReservationID: req.ReservationId,
+		Commit:        commitDigest(req),
+		ExpiresAt:     time.Now().Add(s.config.Preauth.TTL).Unix(),
+	}
+	var lines []*proto.OrderLine
+	if len(req.SeatIds) > 0 {
+		seatIDs := seatRefIDs(req.SeatIds)
+		seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get seats: %w", err)
+		}
+		held := make(map[string]bool, len(seats))
+		for _, seat := range seats {
+			held[seat.SeatID] = seat.Status == seatHold && seat.ReservationID == req.ReservationId
+		}
+		for _, seatID := range seatIDs {
+			if !held[seatID] {
+				return nil, fmt.Errorf("precondition failed: seat %s is not held by reservation %s", seatID, req.ReservationId)
+			}
+		}
+		if _, _, err := s.liveHoldCheck(ctx, req.EventId, req.ReservationId, seats, len(seatIDs)); err != nil {
+			return nil, err
+		}
+
+		lines = seatOrderLines(req.EventId, seatIDs, seats)
+		claims.Prices = pricesDigest(lines)
+	} else {
+		remaining, err := s.remaining(ctx, req.EventId)
+		if err != nil {
+			return nil, err
+		}
+		if remaining < req.Qty {
+			return nil, fmt.Errorf("insufficient inventory for event %s", req.EventId)
+		}
+		lines = []*proto.OrderLine{quantityOrderLine(req.EventId, "", req.Qty)}
+	}
+
+	token, err := s.signPreauth(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PreauthorizeCommitRes{
+		PreauthToken: token,
+		ExpiresAt:    timestamppb.New(time.Unix(claims.ExpiresAt, 0)),
+		Lines:        lines,
+	}, nil
+}
+
+// checkPreauth verifies the pre-authorization token of a commit, which is required when
+// configured. Prices are compared against the seats as they are now, right before the
+// commit. A commit without a token passes when tokens aren't required.
+func (s *InventoryService) checkPreauth(ctx context.Context, req *proto.CommitReq) error {
+	if req.PreauthToken == "" {
+		if s.config.Preauth.Required {
+			return errors.New("precondition failed: commit requires a pre-authorization token (PreauthorizeCommit)")
+		}
+		return nil
+	}
+	if len(s.config.Preauth.Key) == 0 {
+		return errors.New("precondition failed: commit pre-authorization is disabled (COMMIT_PREAUTH_KEY)")
+	}
+	if len(req.LineItems) > 0 || len(req.SectionQtys) > 0 {
+		return errors.New("invalid request: only seat and quantity commits can be pre-authorized")
+	}
+
+	claims, err := s.verifyPreauth(req.PreauthToken)
+	if err != nil {
+		return err
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		return fmt.Errorf("precondition failed: pre-authorization of reservation %s expired", claims.ReservationID)
+	}
+	if claims.ReservationID != req.ReservationId || claims.Commit != commitDigest(req) {
+		return fmt.Errorf("precondition failed: commit differs from the one pre-authorized for reservation %s", claims.ReservationID)
+	}
+	if claims.Prices == "" {
+		return nil
+	}
+
+	seatIDs := seatRefIDs(req.SeatIds)
+	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get seats: %w", err)
+	}
+	if pricesDigest(seatOrderLines(req.EventId, seatIDs, seats)) != claims.Prices {
+		return fmt.Errorf("precondition failed: seat prices changed since reservation %s was pre-authorized", req.ReservationId)
+	}
+	return nil
+}
+
+// signPreauth encodes claims as a token: the base64url JSON payload and its HMAC-SHA256
+// under the configured key, joined by "."
+func (s *InventoryService) signPreauth(claims preauthClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pre-authorization: %w", err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.preauthMAC(encoded)), nil
+}
+
+// verifyPreauth returns the claims of a token signed with the configured key
+func (s *InventoryService) verifyPreauth(token string) (*preauthClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.New("invalid request: malformed pre-authorization token")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.preauthMAC(encoded)) {
+		return nil, errors.New("precondition failed: pre-authorization token is not valid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("invalid request: malformed pre-authorization token")
+	}
+	var claims preauthClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New("invalid request: malformed pre-authorization token")
+	}
+	return &claims, nil
+}
+
+// preauthMAC returns the signature of an encoded token payload
+func (s *InventoryService) preauthMAC(encoded string) []byte {
+	mac := hmac.New(sha256.New, []byte(s.config.Preauth.Key))
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// commitDigest identifies what a commit request buys: its event, quantity and seats.
+// Seat order doesn't matter.
+func commitDigest(req *proto.CommitReq) string {
+	seatIDs := slices.Sorted(slices.Values(seatRefIDs(req.SeatIds)))
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\n%d\n%s", req.EventId, req.Qty, strings.Join(seatIDs, ","))))
+	return hex.EncodeToString(sum[:])
+}
+
+// pricesDigest identifies the price of every seat line
+func pricesDigest(lines []*proto.OrderLine) string {
+	prices := make([]string, len(lines))
+	for i, line := range lines {
+		prices[i] = line.SeatId + "=" + line.Price
+	}
+	slices.Sort(prices)
+	sum := sha256.Sum256([]byte(strings.Join(prices, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// seatRefIDs returns the IDs of seat references
+func seatRefIDs(refs []*proto.SeatRef) []string {
+	seatIDs := make([]string, len(refs))
+	for i, ref := range refs {
+		seatIDs[i] = ref.SeatId
+	}
+	return seatIDs
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// newPreauthService returns a service signing pre-authorization tokens with key
+func newPreauthService(key string, required bool) *InventoryService {
+	return &InventoryService{config: &appconfig.Config{
+		Preauth: appconfig.PreauthConfig{Key: key, TTL: time.Minute, Required: required},
+	}}
+}
+
+// seatRefs returns references to seats
+func seatRefs(seatIDs ...string) []*proto.SeatRef {
+	refs := make([]*proto.SeatRef, len(seatIDs))
+	for i, seatID := range seatIDs {
+		refs[i] = &proto.SeatRef{SeatId: seatID}
+	}
+	return refs
+}
+
+func TestCheckPreauth(t *testing.T) {
+	s := newPreauthService("preauth-key", false)
+	authorized := &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-1", SeatIds: seatRefs("A-1-1", "A-1-2")}
+	sign := func(t *testing.T, s *InventoryService, claims preauthClaims) string {
+		t.Helper()
+		token, err := s.signPreauth(claims)
+		if err != nil {
+			t.Fatalf("signPreauth: %v", err)
+		}
+		return token
+	}
+	valid := preauthClaims{
+		ReservationID: authorized.ReservationId,
+		Commit:        commitDigest(authorized),
+		ExpiresAt:     time.Now().Add(time.Minute).Unix(),
+	}
+	token := sign(t, s, valid)
+	payload, signature, _ := strings.Cut(token, ".")
+
+	expired := valid
+	expired.ExpiresAt = time.Now().Add(-time.Second).Unix()
+	otherReservation := valid
+	otherReservation.ReservationID = "rsv-2"
+	forged, _ := s.signPreauth(otherReservation)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name    string
+		service *InventoryService
+		token   string
+		req     *proto.CommitReq
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			token: token,
+			req:   authorized,
+		},
+		{
+			name:  "seats in another order",
+			token: token,
+			req:   &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-1", SeatIds: seatRefs("A-1-2", "A-1-1")},
+		},
+		{
+			name:  "no token when not required",
+			token: "",
+			req:   authorized,
+		},
+		{
+			name:    "no token when required",
+			service: newPreauthService("preauth-key", true),
+			token:   "",
+			req:     authorized,
+			wantErr: "requires a pre-authorization token",
+		},
+		{
+			name:    "pre-authorization disabled",
+			service: newPreauthService("", false),
+			token:   token,
+			req:     authorized,
+			wantErr: "pre-authorization is disabled",
+		},
+		{
+			name:    "tampered payload",
+			token:   forgedPayload + "." + signature,
+			req:     authorized,
+			wantErr: "token is not valid",
+		},
+		{
+			name:    "tampered signature",
+			token:   payload + "." + base64.RawURLEncoding.EncodeToString([]byte("not the signature")),
+			req:     authorized,
+			wantErr: "token is not valid",
+		},
+		{
+			name:    "signed with another key",
+			token:   sign(t, newPreauthService("other-key", false), valid),
+			req:     authorized,
+			wantErr: "token is not valid",
+		},
+		{
+			name:    "malformed",
+			token:   "no-separator",
+			req:     authorized,
+			wantErr: "malformed pre-authorization token",
+		},
+		{
+			name:    "expired",
+			token:   sign(t, s, expired),
+			req:     authorized,
+			wantErr: "expired",
+		},
+		{
+			name:    "other reservation",
+			token:   token,
+			req:     &proto.CommitReq{ReservationId: "rsv-2", EventId: "evt-1", SeatIds: seatRefs("A-1-1", "A-1-2")},
+			wantErr: "differs from the one pre-authorized",
+		},
+		{
+			name:    "other seats",
+			token:   token,
+			req:     &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-1", SeatIds: seatRefs("A-1-1", "A-1-3")},
+			wantErr: "differs from the one pre-authorized",
+		},
+		{
+			name:    "other event",
+			token:   token,
+			req:     &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-2", SeatIds: seatRefs("A-1-1", "A-1-2")},
+			wantErr: "differs from the one pre-authorized",
+		},
+		{
+			name:    "quantity instead of seats",
+			token:   token,
+			req:     &proto.CommitReq{ReservationId: "rsv-1", EventId: "evt-1", Qty: 2},
+			wantErr: "differs from the one pre-authorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := tt.service
+			if service == nil {
+				service = s
+			}
+			req := &proto.CommitReq{
+				ReservationId: tt.req.ReservationId,
+				EventId:       tt.req.EventId,
+				SeatIds:       tt.req.SeatIds,
+				Qty:           tt.req.Qty,
+				PreauthToken:  tt.token,
+			}
+
+			err := service.checkPreauth(context.Background(), req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkPreauth() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkPreauth() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPricesDigest(t *testing.T) {
+	lines := []*proto.OrderLine{{SeatId: "A-1-1", Price: "100"}, {SeatId: "A-1-2", Price: "120"}}
+
+	tests := []struct {
+		name  string
+		lines []*proto.OrderLine
+		same  bool
+	}{
+		{name: "same prices in another order", lines: []*proto.OrderLine{lines[1], lines[0]}, same: true},
+		{name: "changed price", lines: []*proto.OrderLine{lines[0], {SeatId: "A-1-2", Price: "150"}}},
+		{name: "missing seat", lines: lines[:1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pricesDigest(tt.lines) == pricesDigest(lines); got != tt.same {
+				t.Fatalf("digests equal = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
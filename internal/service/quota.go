@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/cache"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"google.golang.org/grpc/metadata"
+)
+
+// Quota kinds
+const (
+	quotaRequests = "requests"
+	quotaSeats    = "seats"
+)
+
+// quotaIdentityHeader carries the quota identity the gateway signed for a request
+const quotaIdentityHeader = "x-quota-identity"
+
+// quotaIdentity is the signed payload of a quota identity: the subject whose quota the
+// request counts against and when the identity expires, in epoch seconds
+type quotaIdentity struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// QuotaLimits are the requests and committed seats a subject may use per window; 0 is unlimited
+type QuotaLimits struct {
+	Requests int64
+	Seats    int64
+}
+
+// QuotaEnforcer enforces partner API contracts: requests and committed seats per window,
+// per tenant or caller. Subjects come from identities the gateway signs with the quota
+// identity key, never from what a caller asserts; requests without a valid identity are
+// counted per peer address under the default limits. Unlike anomaly throttling,
+// quotas are fixed limits agreed with partners. Counters live in Redis so a quota holds
+// across instances; while Redis fails requests are let through. A nil enforcer is a no-op.
+type QuotaEnforcer struct {
+	counter   *cache.QuotaCounter
+	metrics   *observability.Metrics
+	window    time.Duration
+	defaults  QuotaLimits
+	overrides map[string]QuotaLimits
+	// identityKey verifies quota identities; empty counts every request per peer address
+	identityKey []byte
+}
+
+// NewQuotaEnforcer creates a quota enforcer, or returns nil when quotas are disabled
+func NewQuotaEnforcer(cfg *appconfig.Config, metrics *observability.Metrics) *QuotaEnforcer {
+	if !cfg.Quota.Enabled {
+		return nil
+	}
+
+	overrides := make(map[string]QuotaLimits, len(cfg.Quota.Overrides))
+	for _, entry := range cfg.Quota.Overrides {
+		subject, limits, err := parseQuotaOverride(entry)
+		if err != nil {
+			fmt.Printf("Warning: ignoring quota override %q: %v\n", entry, err)
+			continue
+		}
+		overrides[subject] = limits
+	}
+
+	return &QuotaEnforcer{
+		counter: cache.NewQuotaCounter(cfg),
+		metrics: metrics,
+		window:  cfg.Quota.Window,
+		defaults: QuotaLimits{
+			Requests: int64(cfg.Quota.Requests),
+			Seats:    int64(cfg.Quota.Seats),
+		},
+		overrides:   overrides,
+		identityKey: []byte(cfg.Quota.IdentityKey),
+	}
+}
+
+// Close closes the quota counter
+func (q *QuotaEnforcer) Close() error {
+	return q.counter.Close()
+}
+
+// Acquire counts a request of the caller and reserves the seats it would commit, failing
+// once either exceeds the caller's quota. release must be called with the request's
+// outcome; the seats of a request that committed nothing are returned to the quota.
+func (q *QuotaEnforcer) Acquire(ctx context.Context, seats int) (release func(committed bool), err error) {
+	release = func(bool) {}
+	if q == nil {
+		return release, nil
+	}
+
+	subject := q.subject(ctx)
+	limits := q.limits(subject)
+	windowStart := time.Now().Truncate(q.window)
+
+	if limits.Requests > 0 {
+		used, err := q.counter.Add(ctx, quotaRequests, subject, windowStart, 2*q.window, 1)
+		if err != nil {
+			fmt.Printf("Warning: quota check failed for %s: %v\n", subject, err)
+			return release, nil
+		}
+		if used > limits.Requests {
+			q.metrics.RecordQuotaRejection(quotaRequests)
+			return nil, apperrors.New(apperrors.ErrLimitExceeded, "quota exceeded: %s is limited to %d requests per %s", subject, limits.Requests, q.window)
+		}
+	}
+
+	if seats == 0 || limits.Seats == 0 {
+		return release, nil
+	}
+	used, err := q.counter.Add(ctx, quotaSeats, subject, windowStart, 2*q.window, int64(seats))
+	if err != nil {
+		fmt.Printf("Warning: quota check failed for %s: %v\n", subject, err)
+		return release, nil
+	}
+	if used > limits.Seats {
+		q.returnSeats(ctx, subject, windowStart, seats)
+		q.metrics.RecordQuotaRejection(quotaSeats)
+		return nil, apperrors.New(apperrors.ErrLimitExceeded, "quota exceeded: %s is limited to %d committed seats per %s", subject, limits.Seats, q.window)
+	}
+
+	return func(committed bool) {
+		if !committed {
+			q.returnSeats(ctx, subject, windowStart, seats)
+		}
+	}, nil
+}
+
+// Ping checks that the quota counters can be reached
+func (q *QuotaEnforcer) Ping(ctx context.Context) error {
+	return q.counter.Ping(ctx)
+}
+
+// RetryAfter returns the time until the current quota window ends
+func (q *QuotaEnforcer) RetryAfter() time.Duration {
+	now := time.Now()
+	return now.Truncate(q.window).Add(q.window).Sub(now)
+}
+
+// returnSeats returns reserved seats to a subject's quota, even after the request was canceled
+func (q *QuotaEnforcer) returnSeats(ctx context.Context, subject string, windowStart time.Time, seats int) {
+	_, err := q.counter.Add(context.WithoutCancel(ctx), quotaSeats, subject, windowStart, 2*q.window, -int64(seats))
+	if err != nil {
+		fmt.Printf("Warning: failed to return %d seats to the quota of %s: %v\n", seats, subject, err)
+	}
+}
+
+// limits returns the quota of a subject
+func (q *QuotaEnforcer) limits(subject string) QuotaLimits {
+	if limits, ok := q.overrides[subject]; ok {
+		return limits
+	}
+	return q.defaults
+}
+
+// subject identifies whose quota a request counts against: the subject of its signed
+// quota identity when that is valid, otherwise its peer address, which the caller can't
+// choose. Tenant baggage and caller ID headers are asserted by the caller and not used.
+func (q *QuotaEnforcer) subject(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(q.identityKey) > 0 {
+		if values := md.Get(quotaIdentityHeader); len(values) > 0 {
+			identity, err := q.verifyIdentity(values[0], time.Now())
+			if err == nil {
+				return identity.Subject
+			}
+			fmt.Printf("Warning: ignoring quota identity: %v\n", err)
+		}
+	}
+	return "peer:" + peerHost(ctx)
+}
+
+// verifyIdentity returns the quota identity of a token signed like pre-authorization
+// tokens, the base64url JSON payload and its HMAC-SHA256 joined by ".", if it is valid
+func (q *QuotaEnforcer) verifyIdentity(token string, now time.Time) (*quotaIdentity, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.New("malformed quota identity")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, q.identityMAC(encoded)) {
+		return nil, errors.New("quota identity signature is not valid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("malformed quota identity")
+	}
+	var identity quotaIdentity
+	if err := json.Unmarshal(payload, &identity); err != nil {
+		return nil, errors.New("malformed quota identity")
+	}
+	if now.Unix() > identity.ExpiresAt {
+		return nil, fmt.Errorf("quota identity of %s expired", identity.Subject)
+	}
+	if !strings.HasPrefix(identity.Subject, "tenant:") && !strings.HasPrefix(identity.Subject, "caller:") {
+		return nil, fmt.Errorf("quota identity subject %q is not tenant:<tenant> or caller:<caller_id>", identity.Subject)
+	}
+	return &identity, nil
+}
+
+// identityMAC returns the signature of an encoded quota identity payload
+func (q *QuotaEnforcer) identityMAC(encoded string) []byte {
+	mac := hmac.New(sha256.New, q.identityKey)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// parseQuotaOverride parses a "<subject>=<requests>/<seats>" quota override
+func parseQuotaOverride(entry string) (string, QuotaLimits, error) {
+	subject, limits, ok := strings.Cut(entry, "=")
+	if !ok || (!strings.HasPrefix(subject, "tenant:") && !strings.HasPrefix(subject, "caller:")) {
+		return "", QuotaLimits{}, errors.New("expected tenant:<tenant>=<requests>/<seats> or caller:<caller_id>=<requests>/<seats>")
+	}
+
+	requestsLimit, seatsLimit, ok := strings.Cut(limits, "/")
+	if !ok {
+		return "", QuotaLimits{}, errors.New("limits must be <requests>/<seats>")
+	}
+	requests, err := strconv.ParseInt(requestsLimit, 10, 64)
+	if err != nil || requests < 0 {
+		return "", QuotaLimits{}, fmt.Errorf("malformed requests limit %q", requestsLimit)
+	}
+	seats, err := strconv.ParseInt(seatsLimit, 10, 64)
+	if err != nil || seats < 0 {
+		return "", QuotaLimits{}, fmt.Errorf("malformed seats limit %q", seatsLimit)
+	}
+
+	return subject, QuotaLimits{Requests: requests, Seats: seats}, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// CounterRepairer corrects drift between the remaining counter of a seat event's
+// inventory item and its AVAILABLE seats, which are authoritative. Only events with a
+// total seat count (instantiated from a venue template) have such a counter.
+// A nil repairer is a no-op.
+type CounterRepairer struct {
+	repo    *repo.DynamoDBRepository
+	metrics *observability.Metrics
+	audit   *observability.AuditLog
+}
+
+// NewCounterRepairer creates a counter repairer, or returns nil when read-repair is disabled
+func NewCounterRepairer(repo *repo.DynamoDBRepository, metrics *observability.Metrics, audit *observability.AuditLog, cfg *appconfig.Config) *CounterRepairer {
+	if !cfg.Inventory.CounterReadRepair {
+		return nil
+	}
+	return &CounterRepairer{
+		repo:    repo,
+		metrics: metrics,
+		audit:   audit,
+	}
+}
+
+// Check compares an event's remaining counter with the number of AVAILABLE seats just
+// read from source ("read" or "reconcile") and corrects the counter if they disagree.
+// The update is conditioned on the counter still holding the drifted value, so a
+// concurrent change is never overwritten. Failures are logged, never returned.
+func (c *CounterRepairer) Check(ctx context.Context, eventID string, available int32, source string) {
+	if c == nil {
+		return
+	}
+
+	inventory, err := c.repo.GetInventory(ctx, eventID)
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			fmt.Printf("Warning: failed to check remaining counter of event %s: %v\n", eventID, err)
+		}
+		return
+	}
+	if inventory.TotalSeats == 0 || inventory.Remaining == available {
+		return
+	}
+
+	updateExpr := "SET remaining = :actual, version = version + :one, updated_at = :updated_at"
+	conditionExpr := "remaining = :observed"
+	exprValues := map[string]types.AttributeValue{
+		":actual":     &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", available)},
+		":observed":   &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", inventory.Remaining)},
+		":one":        &types.AttributeValueMemberN{Value: "1"},
+		":updated_at": &types.AttributeValueMemberS{Value: time.Now().Format(time.RFC3339)},
+	}
+
+	err = c.repo.UpdateInventoryConditionally(ctx, eventID, updateExpr, conditionExpr, exprValues, nil)
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			// The counter changed since it was read; the next read or reconciliation re-checks it
+			c.metrics.RecordCounterDrift("conflict")
+			return
+		}
+		c.metrics.RecordCounterDrift("error")
+		fmt.Printf("Warning: failed to repair remaining counter of event %s: %v\n", eventID, err)
+		return
+	}
+
+	c.metrics.RecordCounterDrift("repaired")
+	c.audit.Record("counter_read_repair", eventID, map[string]interface{}{
+		"observed": inventory.Remaining,
+		"actual":   available,
+		"source":   source,
+	})
+}
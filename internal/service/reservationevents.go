@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/notify"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Outcomes of handling a reservation lifecycle event
+const (
+	reservationEventApplied  = "applied"
+	reservationEventRejected = "rejected"
+	reservationEventRetried  = "retried"
+	reservationEventIgnored  = "ignored"
+)
+
+// reservationEventRetryDelay is how long the consumer backs off after a failed receive
+const reservationEventRetryDelay = 5 * time.Second
+
+// ReservationEventConsumer holds and releases seats on reservation-api lifecycle
+// events instead of synchronous calls: ReservationCreated holds the reservation's
+// seats, ReservationExpired and ReservationCancelled release them. Events are handled
+// through the same idempotent paths as the RPCs, so redeliveries are harmless.
+// A nil consumer is a no-op.
+type ReservationEventConsumer struct {
+	inventory *InventoryService
+	queue     *notify.ReservationEventQueue
+	metrics   *observability.Metrics
+	timeout   time.Duration
+}
+
+// NewReservationEventConsumer creates a reservation event consumer, or returns nil when no queue is configured
+func NewReservationEventConsumer(inventory *InventoryService, queue *notify.ReservationEventQueue, metrics *observability.Metrics, cfg *appconfig.Config) *ReservationEventConsumer {
+	if queue == nil {
+		return nil
+	}
+	return &ReservationEventConsumer{
+		inventory: inventory,
+		queue:     queue,
+		metrics:   metrics,
+		timeout:   cfg.ReservationEvents.HandleTimeout,
+	}
+}
+
+// Run consumes reservation events until ctx is canceled
+func (c *ReservationEventConsumer) Run(ctx context.Context) {
+	if c == nil {
+		return
+	}
+
+	for ctx.Err() == nil {
+		messages, err := c.queue.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Printf("Warning: reservation event receive failed: %v\n", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(reservationEventRetryDelay):
+			}
+			continue
+		}
+
+		for _, message := range messages {
+			c.handle(ctx, message)
+		}
+	}
+}
+
+// handle applies one event and acknowledges it unless it should be retried
+func (c *ReservationEventConsumer) handle(ctx context.Context, message *notify.ReservationEventMessage) {
+	handleCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	outcome := reservationEventApplied
+	err := c.apply(handleCtx, message)
+	switch {
+	case errors.Is(err, errReservationEventIgnored):
+		outcome = reservationEventIgnored
+	case err != nil && permanentReservationEventError(err):
+		outcome = reservationEventRejected
+		fmt.Printf("Warning: rejected %s event: %v\n", message.Type, err)
+	case err != nil:
+		// Left on the queue to be delivered again after its visibility timeout
+		c.metrics.RecordReservationEvent(message.Type, reservationEventRetried)
+		fmt.Printf("Warning: failed to handle %s event, will retry: %v\n", message.Type, err)
+		return
+	}
+	c.metrics.RecordReservationEvent(message.Type, outcome)
+
+	if err := c.queue.Delete(ctx, message); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
+
+// errReservationEventIgnored marks events that don't concern inventory
+var errReservationEventIgnored = errors.New("reservation event ignored")
+
+// apply holds or releases the seats of a reservation event
+func (c *ReservationEventConsumer) apply(ctx context.Context, message *notify.ReservationEventMessage) error {
+	event := message.Event
+	if event == nil {
+		return errors.New("invalid request: malformed reservation event")
+	}
+
+	seatRefs := make([]*proto.SeatRef, len(event.SeatIDs))
+	for i, seatID := range event.SeatIDs {
+		seatRefs[i] = &proto.SeatRef{SeatId: seatID}
+	}
+
+	switch message.Type {
+	case notify.ReservationCreated:
+		// Quantity reservations are only counted at commit, so only seats are held
+		if len(seatRefs) == 0 {
+			return errReservationEventIgnored
+		}
+		_, err := c.inventory.HoldSeats(ctx, &proto.HoldReq{
+			ReservationId: event.ReservationID,
+			EventId:       event.EventID,
+			PerformanceId: event.PerformanceID,
+			SeatIds:       seatRefs,
+		})
+		return err
+	case notify.ReservationExpired, notify.ReservationCancelled:
+		_, err := c.inventory.ReleaseHold(ctx, &proto.ReleaseReq{
+			ReservationId: event.ReservationID,
+			EventId:       event.EventID,
+			PerformanceId: event.PerformanceID,
+			Qty:           event.Qty,
+			SeatIds:       seatRefs,
+			SectionQtys:   sectionQtys(event.SectionQtys),
+		})
+		return err
+	default:
+		return errReservationEventIgnored
+	}
+}
+
+// sectionQtys converts general-admission quantities by section to their API form
+func sectionQtys(qtys map[string]int32) []*proto.SectionQty {
+	sections := make([]string, 0, len(qtys))
+	for section := range qtys {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	result := make([]*proto.SectionQty, 0, len(sections))
+	for _, section := range sections {
+		result = append(result, &proto.SectionQty{Section: section, Qty: qtys[section]})
+	}
+	return result
+}
+
+// permanentReservationEventError reports whether redelivering an event can't succeed,
+// e.g. its seats were sold to another reservation meanwhile
+func permanentReservationEventError(err error) bool {
+	for _, permanent := range []string{"invalid request", "not available", "insufficient", "conflict", "not found", "extension limit", "hold expired"} {
+		if strings.Contains(err.Error(), permanent) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// GetReservationStatus reports what a reservation ended up with, for services reconciling
+// their own state. Seats come from the seats table's reservation_id index and the order
+// from the reservation's commit record; a reservation is COMMITTED once either shows a
+// sale, HELD while it holds seats, and RELEASED once released with nothing left.
+func (s *InventoryService) GetReservationStatus(ctx context.Context, req *proto.GetReservationStatusReq) (*proto.GetReservationStatusRes, error) {
+	if req.ReservationId == "" {
+		return nil, errors.New("invalid request: reservation_id is required")
+	}
+
+	seats, err := s.repo.QuerySeatsByReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+	committed, err := s.repo.GetIdempotency(ctx, fmt.Sprintf("commit:%s", req.ReservationId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit record: %w", err)
+	}
+	released, err := s.repo.GetIdempotency(ctx, fmt.Sprintf("release:%s", req.ReservationId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release record: %w", err)
+	}
+
+	res := &proto.GetReservationStatusRes{ReservationId: req.ReservationId}
+	slices.SortFunc(seats, func(a, b *repo.SeatItem) int {
+		return cmp.Or(cmp.Compare(a.EventID, b.EventID), cmp.Compare(a.SeatID, b.SeatID))
+	})
+	for _, seat := range seats {
+		event, performance := repo.SplitPerformanceKey(seat.EventID)
+		ref := &proto.ReservationSeat{EventId: event, PerformanceId: performance, SeatId: seat.SeatID}
+		switch seat.Status {
+		case seatHold:
+			res.HeldSeats = append(res.HeldSeats, ref)
+		case seatSold:
+			res.SoldSeats = append(res.SoldSeats, ref)
+		}
+	}
+
+	switch {
+	case committed != nil:
+		res.Status = "COMMITTED"
+		res.OrderId = committed.Operation // Store order_id in operation field
+	case len(res.SoldSeats) > 0:
+		res.Status = "COMMITTED"
+	case len(res.HeldSeats) > 0:
+		res.Status = "HELD"
+	case released != nil:
+		res.Status = "RELEASED"
+	default:
+		return nil, fmt.Errorf("reservation %s not found", req.ReservationId)
+	}
+	return res, nil
+}
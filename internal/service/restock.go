@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/notify"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// RestockNotifier publishes a notification when cancellations or expired holds
+// return inventory to an event that was sold out. Notifications are sent in the
+// background so they never add latency to or fail the operation that freed inventory.
+type RestockNotifier struct {
+	repo      *repo.DynamoDBRepository
+	publisher *notify.RestockPublisher
+}
+
+// NewRestockNotifier creates a restock notifier; a nil publisher disables notifications
+func NewRestockNotifier(repo *repo.DynamoDBRepository, publisher *notify.RestockPublisher) *RestockNotifier {
+	return &RestockNotifier{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// QuantityReturned notifies when qty tickets were returned to an event that had none remaining
+func (n *RestockNotifier) QuantityReturned(ctx context.Context, eventID string, previousRemaining, qty int32, reason string) {
+	if n == nil || n.publisher == nil || previousRemaining > 0 || qty <= 0 {
+		return
+	}
+
+	event, performanceID := repo.SplitPerformanceKey(eventID)
+	n.publish(ctx, &notify.RestockNotification{
+		EventID:       event,
+		PerformanceID: performanceID,
+		Quantity:      qty,
+		Reason:        reason,
+		RestockedAt:   time.Now(),
+	})
+}
+
+// SeatsReturned notifies when the given seats were returned to an event that had no available seats.
+// The event was sold out if the returned seats are the only available ones now.
+func (n *RestockNotifier) SeatsReturned(ctx context.Context, eventID string, seatIDs []string, reason string) {
+	if n == nil || n.publisher == nil || len(seatIDs) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		available, err := n.repo.CountSeatsByStatus(ctx, eventID, seatAvailable, time.Time{})
+		if err != nil {
+			fmt.Printf("Warning: failed to check restock for event %s: %v\n", eventID, err)
+			return
+		}
+		if available > len(seatIDs) {
+			return
+		}
+
+		event, performanceID := repo.SplitPerformanceKey(eventID)
+		n.send(ctx, &notify.RestockNotification{
+			EventID:       event,
+			PerformanceID: performanceID,
+			Quantity:      int32(len(seatIDs)),
+			Sections:      seatSections(seatIDs),
+			Reason:        reason,
+			RestockedAt:   time.Now(),
+		})
+	}()
+}
+
+// publish sends a notification in the background
+func (n *RestockNotifier) publish(ctx context.Context, notification *notify.RestockNotification) {
+	ctx = context.WithoutCancel(ctx)
+	go n.send(ctx, notification)
+}
+
+// send publishes a notification, logging failures
+func (n *RestockNotifier) send(ctx context.Context, notification *notify.RestockNotification) {
+	if err := n.publisher.Publish(ctx, notification); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		return
+	}
+	fmt.Printf("Published restock notification for event %s (%d returned)\n", notification.EventID, notification.Quantity)
+}
+
+// seatSections returns the distinct sections of the given seats.
+// Seat IDs are "<section>-<number>" (e.g. "A-12"); IDs without a section are skipped.
+func seatSections(seatIDs []string) []string {
+	seen := make(map[string]bool)
+	var sections []string
+	for _, seatID := range seatIDs {
+		section, _, ok := strings.Cut(seatID, "-")
+		if !ok || seen[section] {
+			continue
+		}
+		seen[section] = true
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	return sections
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// AllocateSeason allocates the same seats in every performance of a series in one
+// transaction. The seats stay ALLOCATED until each performance is materialized into an
+// order or released; retrying with the same allocation ID returns the allocation.
+func (s *InventoryService) AllocateSeason(ctx context.Context, req *proto.AllocateSeasonReq) (*proto.SeasonAllocation, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if req.AllocationId == "" || len(req.PerformanceIds) == 0 {
+		return nil, errors.New("invalid request: allocation_id and performance_ids are required")
+	}
+	seatIDs, err := adminSeatIDs(req.EventId, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+	// Every seat of every performance and the allocation record are one transaction
+	if len(req.PerformanceIds)*len(seatIDs)+1 > maxSeatsPerTransaction {
+		return nil, fmt.Errorf("invalid request: an allocation covers at most %d seats across all performances", maxSeatsPerTransaction-1)
+	}
+	seen := make(map[string]bool, len(req.PerformanceIds))
+	for _, performanceID := range req.PerformanceIds {
+		eventID := req.EventId
+		if err := usePerformanceKey(&eventID, performanceID); err != nil {
+			return nil, err
+		}
+		if performanceID == "" || seen[performanceID] {
+			return nil, errors.New("invalid request: performance_ids must be non-empty and unique")
+		}
+		seen[performanceID] = true
+		if err := s.checkEventWritable(ctx, eventID); err != nil {
+			return nil, err
+		}
+	}
+
+	item := &repo.SeasonAllocationItem{
+		AllocationID: req.AllocationId,
+		EventID:      req.EventId,
+		Performances: req.PerformanceIds,
+		SeatIDs:      seatIDs,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	err = s.repo.CreateSeasonAllocation(ctx, item)
+	if err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if !errors.As(err, &txCanceled) {
+			return nil, err
+		}
+		// A retry of an allocation that was already made fails its condition on the record
+		existing, getErr := s.repo.GetSeasonAllocation(ctx, req.AllocationId)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if existing != nil {
+			return seasonAllocationToProto(existing), nil
+		}
+		return nil, fmt.Errorf("one or more seats are not available in every performance of event %s", req.EventId)
+	}
+
+	for _, performanceID := range item.Performances {
+		s.cacheSeatStatus(ctx, repo.PerformanceKey(item.EventID, performanceID), seatIDs, seatAllocated)
+	}
+
+	return seasonAllocationToProto(item), nil
+}
+
+// MaterializeSeason sells the allocated seats of one performance under a new order.
+// Materializing a performance again returns its order.
+func (s *InventoryService) MaterializeSeason(ctx context.Context, req *proto.MaterializeSeasonReq) (*proto.MaterializeSeasonRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	item, err := s.activeSeasonPerformance(ctx, req.AllocationId, req.PerformanceId)
+	if err != nil {
+		return nil, err
+	}
+	if orderID, ok := item.Materialized[req.PerformanceId]; ok {
+		return &proto.MaterializeSeasonRes{OrderId: orderID, Status: "CONFIRMED"}, nil
+	}
+
+	orderID := fmt.Sprintf("ord_%s", uuid.New().String()[:12])
+	if err := s.repo.MaterializeSeasonPerformance(ctx, item, req.PerformanceId, orderID); err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if !errors.As(err, &txCanceled) {
+			return nil, err
+		}
+		// A concurrent call may have materialized or released the performance first
+		current, getErr := s.activeSeasonPerformance(ctx, req.AllocationId, req.PerformanceId)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if orderID, ok := current.Materialized[req.PerformanceId]; ok {
+			return &proto.MaterializeSeasonRes{OrderId: orderID, Status: "CONFIRMED"}, nil
+		}
+		return nil, fmt.Errorf("conflict: season allocation %s changed while materializing performance %s", req.AllocationId, req.PerformanceId)
+	}
+
+	eventID := repo.PerformanceKey(item.EventID, req.PerformanceId)
+	s.stats.RecordCommit(eventID)
+	s.anomalies.RecordSale(ctx, eventID, len(item.SeatIDs))
+	s.cacheSeatStatus(ctx, eventID, item.SeatIDs, seatSold)
+
+	return &proto.MaterializeSeasonRes{OrderId: orderID, Status: "CONFIRMED"}, nil
+}
+
+// ReleaseSeason returns the allocated seats of one performance to sale, or of every
+// performance not yet materialized, which ends the allocation
+func (s *InventoryService) ReleaseSeason(ctx context.Context, req *proto.ReleaseSeasonReq) (*proto.SeasonAllocation, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	var item *repo.SeasonAllocationItem
+	var performanceIDs []string
+	end := req.PerformanceId == ""
+	if end {
+		var err error
+		item, err = s.getSeasonAllocation(ctx, req.AllocationId)
+		if err != nil {
+			return nil, err
+		}
+		if item.State == repo.SeasonAllocationReleased {
+			return seasonAllocationToProto(item), nil
+		}
+		for _, performanceID := range item.Performances {
+			if _, ok := item.Materialized[performanceID]; !ok && !slices.Contains(item.Released, performanceID) {
+				performanceIDs = append(performanceIDs, performanceID)
+			}
+		}
+	} else {
+		var err error
+		item, err = s.activeSeasonPerformance(ctx, req.AllocationId, req.PerformanceId)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := item.Materialized[req.PerformanceId]; ok {
+			return nil, fmt.Errorf("precondition failed: performance %s of season allocation %s is already sold", req.PerformanceId, req.AllocationId)
+		}
+		performanceIDs = []string{req.PerformanceId}
+	}
+
+	if err := s.repo.ReleaseSeasonPerformances(ctx, item, performanceIDs, end); err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, fmt.Errorf("conflict: season allocation %s changed while releasing", req.AllocationId)
+		}
+		return nil, err
+	}
+
+	for _, performanceID := range performanceIDs {
+		eventID := repo.PerformanceKey(item.EventID, performanceID)
+		s.cacheSeatStatus(ctx, eventID, item.SeatIDs, seatAvailable)
+		s.restock.SeatsReturned(ctx, eventID, item.SeatIDs, "RELEASED")
+	}
+
+	item.Released = append(item.Released, performanceIDs...)
+	if end {
+		item.State = repo.SeasonAllocationReleased
+	}
+	return seasonAllocationToProto(item), nil
+}
+
+// getSeasonAllocation returns a season allocation, or a not-found error
+func (s *InventoryService) getSeasonAllocation(ctx context.Context, allocationID string) (*repo.SeasonAllocationItem, error) {
+	if allocationID == "" {
+		return nil, errors.New("invalid request: allocation_id is required")
+	}
+	item, err := s.repo.GetSeasonAllocation(ctx, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("season allocation %s not found", allocationID)
+	}
+	return item, nil
+}
+
+// activeSeasonPerformance returns a season allocation covering a performance that has
+// been materialized or is still allocated
+func (s *InventoryService) activeSeasonPerformance(ctx context.Context, allocationID, performanceID string) (*repo.SeasonAllocationItem, error) {
+	if performanceID == "" || strings.Contains(performanceID, "#") {
+		return nil, errors.New("invalid request: performance_id is required and must not contain '#'")
+	}
+	item, err := s.getSeasonAllocation(ctx, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(item.Performances, performanceID) {
+		return nil, fmt.Errorf("invalid request: season allocation %s does not cover performance %s", allocationID, performanceID)
+	}
+	if _, sold := item.Materialized[performanceID]; sold {
+		return item, nil
+	}
+	if item.State != repo.SeasonAllocationActive || slices.Contains(item.Released, performanceID) {
+		return nil, fmt.Errorf("precondition failed: performance %s of season allocation %s was released", performanceID, allocationID)
+	}
+	return item, nil
+}
+
+// seasonAllocationToProto converts a season allocation item to its API form
+func seasonAllocationToProto(item *repo.SeasonAllocationItem) *proto.SeasonAllocation {
+	seatRefs := make([]*proto.SeatRef, len(item.SeatIDs))
+	for i, seatID := range item.SeatIDs {
+		seatRefs[i] = &proto.SeatRef{SeatId: seatID}
+	}
+	return &proto.SeasonAllocation{
+		AllocationId:   item.AllocationID,
+		EventId:        item.EventID,
+		PerformanceIds: item.Performances,
+		SeatIds:        seatRefs,
+		Materialized:   item.Materialized,
+		Released:       item.Released,
+		State:          item.State,
+		CreatedAt:      timestamppb.New(item.CreatedAt),
+	}
+}
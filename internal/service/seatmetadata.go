@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// Seat metadata limits keep annotations small next to the seat status they ride along with
+const (
+	maxSeatMetadataEntries = 20
+	maxSeatMetadataKey     = 64
+	maxSeatMetadataValue   = 256
+	maxSeatNote            = 1024
+)
+
+// SetSeatMetadata replaces the metadata and note of seats. Annotations don't change
+// availability, so the seat map version is left alone.
+func (s *AdminService) SetSeatMetadata(ctx context.Context, req *proto.SetSeatMetadataReq) (*proto.SetSeatMetadataRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	seatIDs, err := adminSeatIDs(req.EventId, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Metadata) > maxSeatMetadataEntries {
+		return nil, fmt.Errorf("invalid request: at most %d metadata entries", maxSeatMetadataEntries)
+	}
+	for key, value := range req.Metadata {
+		if key == "" || len(key) > maxSeatMetadataKey || len(value) > maxSeatMetadataValue {
+			return nil, fmt.Errorf("invalid request: metadata keys must be 1-%d bytes and values at most %d bytes", maxSeatMetadataKey, maxSeatMetadataValue)
+		}
+	}
+	if len(req.Note) > maxSeatNote {
+		return nil, fmt.Errorf("invalid request: note must be at most %d bytes", maxSeatNote)
+	}
+
+	if err := s.checkSectionLocks(ctx, req.EventId, seatIDs, req.LeaseId); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SetSeatMetadata(ctx, req.EventId, seatIDs, req.Metadata, req.Note); err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, fmt.Errorf("one or more seats not found for event %s", req.EventId)
+		}
+		return nil, err
+	}
+
+	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SetSeatMetadataRes{
+		Seats: seatsToProto(seats),
+	}, nil
+}
+
+// GetSeats returns seats with their status and annotations
+func (s *AdminService) GetSeats(ctx context.Context, req *proto.GetSeatsReq) (*proto.GetSeatsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	seatIDs, err := adminSeatIDs(req.EventId, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetSeatsRes{
+		Seats: seatsToProto(seats),
+	}, nil
+}
+
+// adminSeatIDs validates the seats of an admin seat request and returns their IDs
+func adminSeatIDs(eventID string, seatRefs []*proto.SeatRef) ([]string, error) {
+	if eventID == "" || len(seatRefs) == 0 {
+		return nil, errors.New("invalid request: event_id and seat_ids are required")
+	}
+	if len(seatRefs) > maxSeatsPerTransaction {
+		return nil, fmt.Errorf("invalid request: at most %d seats per call", maxSeatsPerTransaction)
+	}
+
+	seatIDs := make([]string, 0, len(seatRefs))
+	seen := make(map[string]bool, len(seatRefs))
+	for _, seatRef := range seatRefs {
+		if seatRef.SeatId == "" || seen[seatRef.SeatId] {
+			return nil, errors.New("invalid request: seat_ids must be non-empty and unique")
+		}
+		seen[seatRef.SeatId] = true
+		seatIDs = append(seatIDs, seatRef.SeatId)
+	}
+	return seatIDs, nil
+}
+
+// seatsToProto converts seat items to API seats ordered by seat ID
+func seatsToProto(seats []*repo.SeatItem) []*proto.Seat {
+	result := make([]*proto.Seat, len(seats))
+	for i, seat := range seats {
+		result[i] = &proto.Seat{
+			SeatId:    seat.SeatID,
+			Status:    parseSeatStatus(seat.Status),
+			Metadata:  seat.Metadata,
+			Note:      seat.Note,
+			UpdatedAt: timestamppb.New(seat.UpdatedAt),
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SeatId < result[j].SeatId })
+	return result
+}
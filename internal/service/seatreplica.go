@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// seatMapRefreshInterval is how often the replica re-reads the seat map versions of its events
+const seatMapRefreshInterval = time.Second
+
+// SeatReplica keeps an in-memory copy of the seat states of flash-sale events, updated
+// from the seats table stream, so availability checks of those events don't read
+// DynamoDB. Holds and commits still go through DynamoDB conditions, so a lagging
+// replica can only make a check briefly wrong, never oversell. A nil replica is a no-op.
+type SeatReplica struct {
+	repo    *repo.DynamoDBRepository
+	stream  *repo.SeatChangeStream
+	metrics *observability.Metrics
+	config  appconfig.SeatReplicaConfig
+
+	mu sync.RWMutex
+	// seats holds the replicated seats of every loaded event by seat ID
+	seats map[string]map[string]replicaSeat
+	// polledAt is when the stream was last read successfully
+	polledAt time.Time
+	// seatMapVersions caches the seat map version of every loaded event
+	seatMapVersions   map[string]int32
+	seatMapVersionsAt time.Time
+}
+
+// replicaSeat is the replicated state of a seat
+type replicaSeat struct {
+	status    string
+	updatedAt time.Time
+}
+
+// NewSeatReplica creates a seat replica, or returns nil when no events are replicated
+func NewSeatReplica(repo *repo.DynamoDBRepository, metrics *observability.Metrics, cfg *appconfig.Config) *SeatReplica {
+	if len(cfg.SeatReplica.Events) == 0 {
+		return nil
+	}
+	return &SeatReplica{
+		repo:            repo,
+		stream:          repo.NewSeatChangeStream(),
+		metrics:         metrics,
+		config:          cfg.SeatReplica,
+		seats:           make(map[string]map[string]replicaSeat),
+		seatMapVersions: make(map[string]int32),
+	}
+}
+
+// Run loads the replicated events and applies the seats table stream until ctx is canceled
+func (r *SeatReplica) Run(ctx context.Context) {
+	// The stream is positioned before the snapshots are read, so no change is missed
+	for {
+		err := r.stream.Start(ctx)
+		if err == nil {
+			break
+		}
+		fmt.Printf("Warning: failed to start seat replica stream: %v\n", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.config.MaxLag):
+		}
+	}
+	r.markPolled()
+
+	for _, eventID := range r.config.Events {
+		if err := r.load(ctx, eventID); err != nil {
+			fmt.Printf("Warning: failed to load seat replica of event %s: %v\n", eventID, err)
+		}
+	}
+	r.refreshSeatMapVersions(ctx)
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.PollOnce(ctx); err != nil {
+				fmt.Printf("Warning: seat replica stream poll failed: %v\n", err)
+			}
+			if time.Since(r.seatMapVersionsAt) >= seatMapRefreshInterval {
+				r.refreshSeatMapVersions(ctx)
+			}
+		}
+	}
+}
+
+// load reads the snapshot of one event's seats into the replica
+func (r *SeatReplica) load(ctx context.Context, eventID string) error {
+	covered, err := r.stream.Covers(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if !covered {
+		return fmt.Errorf("seats are being migrated to another table")
+	}
+
+	seats, err := r.repo.ListEventSeats(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Changes applied from the stream while the snapshot was read are kept if newer
+	replicated := r.seats[eventID]
+	if replicated == nil {
+		replicated = make(map[string]replicaSeat, len(seats))
+	}
+	for _, seat := range seats {
+		r.apply(replicated, seat)
+	}
+	r.seats[eventID] = replicated
+
+	fmt.Printf("Loaded seat replica of event %s: %d seats\n", eventID, len(seats))
+	return nil
+}
+
+// PollOnce applies one batch of seat changes of replicated events
+func (r *SeatReplica) PollOnce(ctx context.Context) error {
+	changes, err := r.stream.Poll(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	for _, change := range changes {
+		if replicated, ok := r.seats[change.EventID]; ok {
+			r.apply(replicated, change)
+		}
+	}
+	r.polledAt = time.Now()
+	r.mu.Unlock()
+
+	return nil
+}
+
+// apply sets a replicated seat unless the replica already holds a newer state (CAS on
+// updated_at). Writes to a seat within the same updated_at are applied in stream order.
+func (r *SeatReplica) apply(replicated map[string]replicaSeat, seat *repo.SeatItem) {
+	if current, ok := replicated[seat.SeatID]; ok && current.updatedAt.After(seat.UpdatedAt) {
+		return
+	}
+	replicated[seat.SeatID] = replicaSeat{status: seat.Status, updatedAt: seat.UpdatedAt}
+}
+
+// refreshSeatMapVersions re-reads the seat map versions of the loaded events
+func (r *SeatReplica) refreshSeatMapVersions(ctx context.Context) {
+	r.mu.RLock()
+	eventIDs := make([]string, 0, len(r.seats))
+	for eventID := range r.seats {
+		eventIDs = append(eventIDs, eventID)
+	}
+	r.mu.RUnlock()
+
+	versions := make(map[string]int32, len(eventIDs))
+	for _, eventID := range eventIDs {
+		inventory, err := r.repo.GetInventory(ctx, eventID)
+		if err != nil {
+			if !errors.Is(err, apperrors.ErrNotFound) {
+				fmt.Printf("Warning: failed to refresh seat map version of event %s: %v\n", eventID, err)
+				continue
+			}
+			inventory = &repo.InventoryItem{}
+		}
+		versions[eventID] = inventory.SeatMapVersion
+	}
+
+	r.mu.Lock()
+	for eventID, version := range versions {
+		r.seatMapVersions[eventID] = version
+	}
+	r.mu.Unlock()
+	r.seatMapVersionsAt = time.Now()
+}
+
+// SeatMapVersion returns the cached seat map version of a replicated event
+func (r *SeatReplica) SeatMapVersion(eventID string) (int32, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	version, ok := r.seatMapVersions[eventID]
+	return version, ok
+}
+
+// markPolled records a successful stream read
+func (r *SeatReplica) markPolled() {
+	r.mu.Lock()
+	r.polledAt = time.Now()
+	r.mu.Unlock()
+}
+
+// Statuses returns the statuses of the given seats of a replicated event. ok is false
+// when the event isn't replicated or the stream lags by more than MaxLag. Seats that
+// don't exist are absent from the result.
+func (r *SeatReplica) Statuses(eventID string, seatIDs []string) (statuses map[string]string, ok bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	replicated, loaded := r.seats[eventID]
+	if !loaded {
+		return nil, false
+	}
+	if time.Since(r.polledAt) > r.config.MaxLag {
+		r.metrics.RecordSeatReplicaRead("stale")
+		return nil, false
+	}
+
+	statuses = make(map[string]string, len(seatIDs))
+	for _, seatID := range seatIDs {
+		// Deleted seats remain in the replica with an empty status
+		if seat, ok := replicated[seatID]; ok && seat.status != "" {
+			statuses[seatID] = seat.status
+		}
+	}
+	r.metrics.RecordSeatReplicaRead("hit")
+	return statuses, true
+}
+
+// SnapshotStatuses returns the statuses of the given seats of a replicated event as of
+// the last successful stream read, however long ago that was
+func (r *SeatReplica) SnapshotStatuses(eventID string, seatIDs []string) (statuses map[string]string, asOf time.Time, ok bool) {
+	if r == nil {
+		return nil, time.Time{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	replicated, loaded := r.seats[eventID]
+	if !loaded {
+		return nil, time.Time{}, false
+	}
+
+	statuses = make(map[string]string, len(seatIDs))
+	for _, seatID := range seatIDs {
+		if seat, ok := replicated[seatID]; ok && seat.status != "" {
+			statuses[seatID] = seat.status
+		}
+	}
+	return statuses, r.polledAt, true
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// SetSeatsMigration moves an event's seats between the original and new seats tables
+func (s *AdminService) SetSeatsMigration(ctx context.Context, req *proto.SetSeatsMigrationReq) (*proto.SetSeatsMigrationRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	if err := s.repo.SetSeatsMigration(ctx, req.EventId, req.State); err != nil {
+		return nil, fmt.Errorf("failed to set seats migration: %w", err)
+	}
+
+	fmt.Printf("Set seats migration of event %s to %q\n", req.EventId, req.State)
+
+	return &proto.SetSeatsMigrationRes{
+		State:     req.State,
+		SettledAt: timestamppb.New(time.Now().Add(s.repo.SeatsMigrationStateTTL())),
+	}, nil
+}
+
+// VerifySeatsMigration compares an event's seats in both seats tables
+func (s *AdminService) VerifySeatsMigration(ctx context.Context, req *proto.VerifySeatsMigrationReq) (*proto.VerifySeatsMigrationRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" {
+		return nil, errors.New("invalid request: event_id is required")
+	}
+
+	report, err := s.repo.VerifySeatsMigration(ctx, req.EventId, req.Repair)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify seats migration: %w", err)
+	}
+
+	fmt.Printf("Verified seats migration of event %s: %d missing, %d mismatched, %d extra, %d repaired\n",
+		req.EventId, report.Missing, report.Mismatched, report.Extra, report.Repaired)
+
+	return &proto.VerifySeatsMigrationRes{
+		State:       report.State,
+		SourceSeats: int32(report.SourceSeats),
+		TargetSeats: int32(report.TargetSeats),
+		Missing:     int32(report.Missing),
+		Mismatched:  int32(report.Mismatched),
+		Extra:       int32(report.Extra),
+		Repaired:    int32(report.Repaired),
+	}, nil
+}
+
+// GetCanaryReport reports the storage canary's comparisons on this instance
+func (s *AdminService) GetCanaryReport(ctx context.Context, req *proto.GetCanaryReportReq) (*proto.GetCanaryReportRes, error) {
+	report := s.repo.CanaryReport()
+	if report == nil {
+		return nil, errors.New("precondition failed: the storage canary is not enabled")
+	}
+
+	return &proto.GetCanaryReportRes{
+		Comparisons:      report.Comparisons,
+		Divergences:      report.Divergences,
+		CleanSince:       timestamppb.New(report.CleanSince),
+		CleanComparisons: report.CleanComparisons,
+		LastDivergence:   report.LastDivergence,
+		CutoverReady:     report.CutoverReady,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// seatStatusPrefix is stripped from SeatStatus enum names to get stored status names
+const seatStatusPrefix = "SEAT_STATUS_"
+
+// Seat statuses as stored on seat items
+const (
+	seatAvailable        = repo.SeatAvailable
+	seatHold             = repo.SeatHold
+	seatSold             = repo.SeatSold
+	seatBlocked          = repo.SeatBlocked
+	seatKilled           = repo.SeatKilled
+	seatReservedInternal = repo.SeatReservedInternal
+	seatAllocated        = repo.SeatAllocated
+	seatClosed           = repo.SeatClosed
+)
+
+// seatTransitions lists the statuses each status may move to. HOLD and SOLD are only
+// entered and left through the reservation lifecycle (hold, commit, release, expiry), and
+// ALLOCATED only through season allocations. SOLD seats only return to AVAILABLE when a
+// failed bundle compensates the commit of a line item. CLOSED is only entered by closing
+// an event.
+var seatTransitions = map[string][]string{
+	seatAvailable:        {seatHold, seatSold, seatBlocked, seatKilled, seatReservedInternal, seatAllocated, seatClosed},
+	seatHold:             {seatHold, seatSold, seatAvailable},
+	seatSold:             {seatAvailable},
+	seatBlocked:          {seatAvailable, seatKilled, seatReservedInternal},
+	seatKilled:           {seatAvailable, seatBlocked},
+	seatReservedInternal: {seatAvailable, seatBlocked, seatKilled},
+	seatAllocated:        {seatSold, seatAvailable},
+	seatClosed:           {seatAvailable},
+}
+
+// Statuses the reservation lifecycle moves seats from: holds take available seats or
+// refresh their own, commits sell available or held seats, releases return held ones and
+// bundle compensation returns sold ones
+var (
+	holdSources         = []string{seatAvailable, seatHold}
+	commitSources       = []string{seatAvailable, seatHold}
+	releaseSources      = []string{seatHold}
+	compensationSources = []string{seatSold}
+)
+
+// operatorSeatStatuses are the statuses operators may move seats to directly
+var operatorSeatStatuses = []string{seatAvailable, seatBlocked, seatKilled, seatReservedInternal}
+
+// seatHolders names who keeps a seat in each unavailable status
+var seatHolders = map[string]proto.SeatHolder{
+	seatHold:             proto.SeatHolder_SEAT_HOLDER_HOLD,
+	seatSold:             proto.SeatHolder_SEAT_HOLDER_SOLD,
+	seatAllocated:        proto.SeatHolder_SEAT_HOLDER_SEASON,
+	seatBlocked:          proto.SeatHolder_SEAT_HOLDER_OPERATOR,
+	seatKilled:           proto.SeatHolder_SEAT_HOLDER_OPERATOR,
+	seatReservedInternal: proto.SeatHolder_SEAT_HOLDER_OPERATOR,
+	seatClosed:           proto.SeatHolder_SEAT_HOLDER_OPERATOR,
+}
+
+// seatAvailabilities returns the state of each requested seat of a seat check in
+// request order. Hidden seats and seats missing from statuses report no status.
+func seatAvailabilities(seatIDs []string, statuses map[string]string, hidden []string) []*proto.SeatAvailability {
+	seats := make([]*proto.SeatAvailability, len(seatIDs))
+	for i, seatID := range seatIDs {
+		seat := &proto.SeatAvailability{SeatId: seatID}
+		if slices.Contains(hidden, seatID) {
+			seat.Hidden = true
+		} else if status, ok := statuses[seatID]; ok {
+			seat.Status = parseSeatStatus(status)
+			seat.Holder = seatHolders[status]
+		}
+		seats[i] = seat
+	}
+	return seats
+}
+
+// seatStatusName returns the stored name of a seat status
+func seatStatusName(status proto.SeatStatus) string {
+	return strings.TrimPrefix(status.String(), seatStatusPrefix)
+}
+
+// parseSeatStatus returns the enum value of a stored seat status name
+func parseSeatStatus(name string) proto.SeatStatus {
+	return proto.SeatStatus(proto.SeatStatus_value[seatStatusPrefix+name])
+}
+
+// checkSeatTransition returns an error unless a seat may move from one status to another
+func checkSeatTransition(from, to string) error {
+	allowed, ok := seatTransitions[from]
+	if !ok {
+		return fmt.Errorf("invalid request: unknown seat status %q", from)
+	}
+	if !slices.Contains(allowed, to) {
+		return fmt.Errorf("precondition failed: seat status %s cannot change to %s", from, to)
+	}
+	return nil
+}
+
+// checkSeatTransitions returns an error unless seats may move from each of the given
+// statuses to another
+func checkSeatTransitions(from []string, to string) error {
+	for _, status := range from {
+		if err := checkSeatTransition(status, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seatTransitionSources returns the operator-managed statuses seats may move to the given status from
+func seatTransitionSources(to string) []string {
+	var sources []string
+	for _, from := range operatorSeatStatuses {
+		if checkSeatTransition(from, to) == nil {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// maxSeatUpsertPage is the largest page of a bulk seat upsert
+const maxSeatUpsertPage = 1000
+
+// seatUploadRetention is how long an upload can be resumed after its last page
+const seatUploadRetention = 7 * 24 * time.Hour
+
+// UpsertSeats applies one page of a bulk seat upsert. Pages are applied in cursor
+// order; a page whose cursor was already acknowledged is acknowledged again unapplied.
+// Every page is checked against its own checksum and the running totals against the
+// manifest of the first page; the last page is only applied if the whole upload
+// matches, which records the manifest as the event's verified seat layout.
+// Remaining counters are not adjusted; read-repair corrects them for template events.
+func (s *AdminService) UpsertSeats(ctx context.Context, req *proto.UpsertSeatsReq) (*proto.UpsertSeatsRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" || req.UploadId == "" {
+		return nil, errors.New("invalid request: event_id and upload_id are required")
+	}
+	if len(req.Seats) == 0 || len(req.Seats) > maxSeatUpsertPage {
+		return nil, fmt.Errorf("invalid request: a page must have between 1 and %d seats", maxSeatUpsertPage)
+	}
+
+	page, err := parseSeatUploadCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seats := make([]*repo.SeatItem, 0, len(req.Seats))
+	seen := make(map[string]bool, len(req.Seats))
+	for _, seat := range req.Seats {
+		if seat.SeatId == "" || seen[seat.SeatId] {
+			return nil, errors.New("invalid request: seat_ids must be non-empty and unique within a page")
+		}
+		seen[seat.SeatId] = true
+
+		status := seat.Status
+		if status == "" {
+			status = seatAvailable
+		}
+		if status != seatAvailable && status != seatBlocked {
+			return nil, fmt.Errorf("invalid request: seat %s status must be AVAILABLE or BLOCKED", seat.SeatId)
+		}
+
+		seats = append(seats, &repo.SeatItem{
+			EventID:   req.EventId,
+			SeatID:    seat.SeatId,
+			Status:    status,
+			UpdatedAt: now,
+		})
+	}
+
+	upload, err := s.repo.GetSeatUpload(ctx, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	nextPage := int32(0)
+	if upload != nil {
+		if upload.EventID != req.EventId {
+			return nil, fmt.Errorf("invalid request: upload %s belongs to event %s", req.UploadId, upload.EventID)
+		}
+		nextPage = upload.NextPage
+	}
+	if page < nextPage {
+		seatMapVersion, err := s.inventory.seatMapVersion(ctx, req.EventId)
+		if err != nil {
+			return nil, err
+		}
+		// The verification record may not have been written when the last page was first sent
+		verified := upload.VerifiedAt != nil && upload.Manifest != nil && page == upload.Manifest.Pages-1
+		if verified {
+			if err := s.recordSeatManifest(ctx, upload); err != nil {
+				return nil, err
+			}
+		}
+		return &proto.UpsertSeatsRes{
+			NextCursor:     seatUploadCursor(page + 1),
+			Duplicate:      true,
+			TotalUpserted:  upload.Upserted,
+			TotalSkipped:   upload.Skipped,
+			SeatMapVersion: seatMapVersion,
+			Verified:       verified,
+		}, nil
+	}
+	if page > nextPage {
+		return nil, fmt.Errorf("precondition failed: upload %s expects cursor %q", req.UploadId, seatUploadCursor(nextPage))
+	}
+
+	manifest, err := seatUploadManifest(req, upload)
+	if err != nil {
+		return nil, err
+	}
+	if page >= manifest.Pages {
+		return nil, fmt.Errorf("invalid request: upload %s declares %d pages", req.UploadId, manifest.Pages)
+	}
+
+	pageDigest := sha256.New()
+	digest, err := resumeSeatUploadDigest(upload)
+	if err != nil {
+		return nil, err
+	}
+	for _, seat := range seats {
+		row := seat.SeatID + "," + seat.Status + "\n"
+		pageDigest.Write([]byte(row))
+		digest.Write([]byte(row))
+	}
+	if hex.EncodeToString(pageDigest.Sum(nil)) != strings.ToLower(req.PageSha256) {
+		return nil, fmt.Errorf("invalid request: page %d of upload %s does not match page_sha256; resend it", page, req.UploadId)
+	}
+
+	rows := int64(len(seats))
+	if upload != nil {
+		rows += upload.Upserted + upload.Skipped
+	}
+	if rows > manifest.Rows {
+		return nil, fmt.Errorf("invalid request: upload %s exceeds the %d rows of its manifest", req.UploadId, manifest.Rows)
+	}
+	// The last page completes the upload, so it is refused unless the upload matches
+	// as a whole; a refused upload is never recorded and must be restarted
+	verified := page == manifest.Pages-1
+	if verified {
+		if rows != manifest.Rows {
+			return nil, fmt.Errorf("precondition failed: upload %s has %d rows but its manifest declares %d", req.UploadId, rows, manifest.Rows)
+		}
+		if hex.EncodeToString(digest.Sum(nil)) != manifest.SHA256 {
+			return nil, fmt.Errorf("precondition failed: upload %s does not match its manifest checksum", req.UploadId)
+		}
+	}
+	digestState, err := digest.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to save upload digest: %w", err)
+	}
+
+	if err := s.checkSectionLocks(ctx, req.EventId, seatIDsOf(seats), req.LeaseId); err != nil {
+		return nil, err
+	}
+
+	seatMapVersion, err := s.repo.BumpSeatMapVersion(ctx, req.EventId, req.ExpectedSeatMapVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	skipped, err := s.repo.UpsertSeats(ctx, seats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert seats: %w", err)
+	}
+
+	upserted := len(seats) - len(skipped)
+	upload, err = s.repo.AdvanceSeatUpload(ctx, req.UploadId, req.EventId, page, upserted, len(skipped), manifest, digestState, verified, seatUploadRetention)
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil, fmt.Errorf("upload %s conflict: page applied concurrently", req.UploadId)
+		}
+		return nil, err
+	}
+	if verified {
+		if err := s.recordSeatManifest(ctx, upload); err != nil {
+			return nil, err
+		}
+	}
+
+	skippedSet := make(map[string]bool, len(skipped))
+	for _, seatID := range skipped {
+		skippedSet[seatID] = true
+	}
+	applied := map[string][]string{}
+	for _, seat := range seats {
+		if !skippedSet[seat.SeatID] {
+			applied[seat.Status] = append(applied[seat.Status], seat.SeatID)
+		}
+	}
+	for status, seatIDs := range applied {
+		s.inventory.cacheSeatStatus(ctx, req.EventId, seatIDs, status)
+	}
+
+	results := make([]*proto.BatchResult, len(seats))
+	for i, seat := range seats {
+		results[i] = &proto.BatchResult{Index: int32(i)}
+		if skippedSet[seat.SeatID] {
+			results[i].Code = int32(codes.FailedPrecondition)
+			results[i].Error = fmt.Sprintf("seat %s is held, sold or reserved", seat.SeatID)
+		}
+	}
+
+	return &proto.UpsertSeatsRes{
+		NextCursor:     seatUploadCursor(page + 1),
+		Upserted:       int32(upserted),
+		SkippedSeatIds: skipped,
+		TotalUpserted:  upload.Upserted,
+		TotalSkipped:   upload.Skipped,
+		SeatMapVersion: seatMapVersion,
+		Results:        results,
+		Verified:       verified,
+	}, nil
+}
+
+// GetSeatUpload returns the cursor of a bulk seat upsert
+func (s *AdminService) GetSeatUpload(ctx context.Context, req *proto.GetSeatUploadReq) (*proto.GetSeatUploadRes, error) {
+	if req.UploadId == "" {
+		return nil, errors.New("invalid request: upload_id is required")
+	}
+
+	upload, err := s.repo.GetSeatUpload(ctx, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, fmt.Errorf("upload %s not found", req.UploadId)
+	}
+
+	eventID, performanceID := repo.SplitPerformanceKey(upload.EventID)
+	res := &proto.GetSeatUploadRes{
+		EventId:       eventID,
+		PerformanceId: performanceID,
+		NextCursor:    seatUploadCursor(upload.NextPage),
+		TotalUpserted: upload.Upserted,
+		TotalSkipped:  upload.Skipped,
+		UpdatedAt:     timestamppb.New(upload.UpdatedAt),
+	}
+	if upload.Manifest != nil {
+		res.Manifest = &proto.SeatManifest{
+			TotalRows:  upload.Manifest.Rows,
+			TotalPages: upload.Manifest.Pages,
+			Sha256:     upload.Manifest.SHA256,
+		}
+	}
+	if upload.VerifiedAt != nil {
+		res.VerifiedAt = timestamppb.New(*upload.VerifiedAt)
+	}
+	return res, nil
+}
+
+// seatUploadManifest returns the manifest of an upload: the one stored with its first
+// page, or the one declared by the request for the first page
+func seatUploadManifest(req *proto.UpsertSeatsReq, upload *repo.SeatUploadItem) (*repo.SeatUploadManifest, error) {
+	var declared *repo.SeatUploadManifest
+	if req.Manifest != nil {
+		declared = &repo.SeatUploadManifest{
+			SHA256: strings.ToLower(req.Manifest.Sha256),
+			Rows:   req.Manifest.TotalRows,
+			Pages:  req.Manifest.TotalPages,
+		}
+	}
+
+	if upload != nil {
+		if upload.Manifest == nil {
+			return nil, fmt.Errorf("precondition failed: upload %s was started without a manifest; restart it with a new upload_id", req.UploadId)
+		}
+		if declared != nil && *declared != *upload.Manifest {
+			return nil, fmt.Errorf("invalid request: manifest of upload %s differs from its first page", req.UploadId)
+		}
+		return upload.Manifest, nil
+	}
+
+	if declared == nil {
+		return nil, errors.New("invalid request: the first page of an upload requires a manifest")
+	}
+	if declared.Rows <= 0 || declared.Pages <= 0 {
+		return nil, errors.New("invalid request: manifest total_rows and total_pages must be positive")
+	}
+	if checksum, err := hex.DecodeString(declared.SHA256); err != nil || len(checksum) != sha256.Size {
+		return nil, errors.New("invalid request: manifest sha256 must be a hex SHA-256 digest")
+	}
+	return declared, nil
+}
+
+// resumeSeatUploadDigest returns the running digest of an upload after its applied pages
+func resumeSeatUploadDigest(upload *repo.SeatUploadItem) (hash.Hash, error) {
+	digest := sha256.New()
+	if upload == nil || len(upload.Digest) == 0 {
+		return digest, nil
+	}
+	if err := digest.(encoding.BinaryUnmarshaler).UnmarshalBinary(upload.Digest); err != nil {
+		return nil, fmt.Errorf("failed to restore upload digest: %w", err)
+	}
+	return digest, nil
+}
+
+// recordSeatManifest stores the verification record of a verified upload on its event
+// and writes it to the audit log
+func (s *AdminService) recordSeatManifest(ctx context.Context, upload *repo.SeatUploadItem) error {
+	record := &repo.SeatManifestRecord{
+		UploadID:   upload.UploadID,
+		SHA256:     upload.Manifest.SHA256,
+		Rows:       upload.Manifest.Rows,
+		Pages:      upload.Manifest.Pages,
+		VerifiedAt: *upload.VerifiedAt,
+	}
+	if err := s.repo.RecordSeatManifest(ctx, upload.EventID, record); err != nil {
+		return err
+	}
+
+	s.audit.Record("seat_manifest_verified", upload.EventID, map[string]interface{}{
+		"upload_id": record.UploadID,
+		"sha256":    record.SHA256,
+		"rows":      record.Rows,
+		"pages":     record.Pages,
+	})
+	return nil
+}
+
+// seatUploadCursor returns the cursor of a page of an upload
+func seatUploadCursor(page int32) string {
+	return strconv.Itoa(int(page))
+}
+
+// parseSeatUploadCursor returns the page a cursor refers to; empty is the first page
+func parseSeatUploadCursor(cursor string) (int32, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	page, err := strconv.ParseInt(cursor, 10, 32)
+	if err != nil || page < 0 {
+		return 0, fmt.Errorf("invalid request: malformed cursor %q", cursor)
+	}
+	return int32(page), nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/internal/repo"
+)
+
+// stuckHoldScanPageSize bounds the items read per query page of per-event listings
+const stuckHoldScanPageSize = 200
+
+// StuckHoldMonitor finds HOLD seats that outlived the maximum hold TTL plus a grace period.
+// Such holds have no live hold behind them (a live hold is released, committed or
+// extended before its TTL) and would otherwise keep seats out of sale forever.
+type StuckHoldMonitor struct {
+	repo    *repo.DynamoDBRepository
+	scanner *repo.TableScanner
+	metrics *observability.Metrics
+	restock *RestockNotifier
+	// inventory keeps the availability counter in step with released holds
+	inventory *InventoryService
+	config    appconfig.HoldsConfig
+
+	mu       sync.RWMutex
+	lastScan []*repo.SeatItem
+}
+
+// NewStuckHoldMonitor creates a new stuck hold monitor
+func NewStuckHoldMonitor(repo *repo.DynamoDBRepository, scanner *repo.TableScanner, metrics *observability.Metrics, restock *RestockNotifier, inventory *InventoryService, cfg *appconfig.Config) *StuckHoldMonitor {
+	return &StuckHoldMonitor{
+		repo:      repo,
+		scanner:   scanner,
+		metrics:   metrics,
+		restock:   restock,
+		inventory: inventory,
+		config:    cfg.Holds,
+	}
+}
+
+// Run scans periodically until ctx is canceled
+func (m *StuckHoldMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.StuckScanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.ScanOnce(ctx); err != nil {
+				fmt.Printf("Warning: stuck hold scan failed: %v\n", err)
+			}
+		}
+	}
+}
+
+// ScanOnce scans all events for stuck holds, updates metrics and, when enabled, releases them.
+// A scan resumed after an interruption only reports the holds found since it resumed.
+func (m *StuckHoldMonitor) ScanOnce(ctx context.Context) error {
+	var found sync.Mutex
+	var stuck []*repo.SeatItem
+	job := m.repo.SeatsByStatusScan("stuck-holds", seatHold, m.cutoff(), func(ctx context.Context, seats []*repo.SeatItem) error {
+		found.Lock()
+		defer found.Unlock()
+		stuck = append(stuck, seats...)
+		return nil
+	})
+	if err := m.scanner.Run(ctx, job); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.lastScan = stuck
+	m.mu.Unlock()
+
+	m.metrics.SetStuckHolds(len(stuck))
+	if len(stuck) > 0 {
+		fmt.Printf("Found %d stuck holds\n", len(stuck))
+	}
+
+	if m.config.StuckAutoRelease {
+		m.release(ctx, stuck)
+	}
+
+	return nil
+}
+
+// ListStuckHolds returns stuck holds for an event, or the result of the last scan when eventID is empty
+func (m *StuckHoldMonitor) ListStuckHolds(ctx context.Context, eventID string) ([]*repo.SeatItem, error) {
+	if eventID == "" {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		return m.lastScan, nil
+	}
+
+	var stuck []*repo.SeatItem
+	var startKey map[string]types.AttributeValue
+	for {
+		seats, nextKey, err := m.repo.QuerySeatsByStatus(ctx, eventID, seatHold, m.cutoff(), startKey, stuckHoldScanPageSize)
+		if err != nil {
+			return nil, err
+		}
+		stuck = append(stuck, seats...)
+
+		if nextKey == nil {
+			return stuck, nil
+		}
+		startKey = nextKey
+	}
+}
+
+// cutoff returns the last-updated time before which a hold is considered stuck.
+// Events may set a longer hold TTL than the default, so the longest allowed TTL is used.
+func (m *StuckHoldMonitor) cutoff() time.Time {
+	return time.Now().Add(-(max(m.config.TTL, m.config.MaxTTL) + m.config.StuckGrace))
+}
+
+// release returns stuck holds to sale one seat at a time, so a hold that was
+// committed or refreshed meanwhile only fails its own condition
+func (m *StuckHoldMonitor) release(ctx context.Context, stuck []*repo.SeatItem) {
+	released := 0
+	releasedByEvent := make(map[string][]string)
+	for _, seat := range stuck {
+		if err := m.repo.ReleaseHeldSeats(ctx, []*repo.SeatItem{seat}); err != nil {
+			fmt.Printf("Warning: failed to release stuck hold %s/%s: %v\n", seat.EventID, seat.SeatID, err)
+			continue
+		}
+		released++
+		releasedByEvent[seat.EventID] = append(releasedByEvent[seat.EventID], seat.SeatID)
+	}
+
+	for eventID, seatIDs := range releasedByEvent {
+		m.inventory.cacheSeatStatus(ctx, eventID, seatIDs, seatAvailable)
+		m.restock.SeatsReturned(ctx, eventID, seatIDs, "EXPIRED")
+	}
+
+	m.metrics.RecordStuckHoldsReleased(released)
+	if released > 0 {
+		fmt.Printf("Released %d stuck holds\n", released)
+	}
+}
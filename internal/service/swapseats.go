@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// SwapSeats exchanges seats a reservation holds or bought for available seats in one
+// transaction, so there is no window where the reservation has both or neither. The
+// acquired seats take the status of the released ones; held replacements keep the
+// expiry and extension count of the hold they replace. Sold seats stay under the
+// reservation's order.
+func (s *InventoryService) SwapSeats(ctx context.Context, req *proto.SwapSeatsReq) (*proto.SwapSeatsRes, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.ReservationId == "" || req.EventId == "" || len(req.ReleaseSeatIds) == 0 || len(req.AcquireSeatIds) == 0 {
+		return nil, errors.New("invalid request: reservation_id, event_id, release_seat_ids and acquire_seat_ids are required")
+	}
+	releaseIDs := seatRefIDs(req.ReleaseSeatIds)
+	acquireIDs := seatRefIDs(req.AcquireSeatIds)
+	seatIDs := append(append([]string(nil), releaseIDs...), acquireIDs...)
+	if len(slices.Compact(slices.Sorted(slices.Values(seatIDs)))) < len(seatIDs) {
+		return nil, errors.New("invalid request: a seat appears more than once in the swap")
+	}
+	if len(seatIDs) > maxSeatsPerTransaction {
+		return nil, fmt.Errorf("invalid request: at most %d seats per swap", maxSeatsPerTransaction)
+	}
+
+	if err := s.anomalies.CheckCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEventWritable(ctx, req.EventId); err != nil {
+		return nil, err
+	}
+
+	seats, err := s.repo.GetSeats(ctx, req.EventId, seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seats: %w", err)
+	}
+	byID := make(map[string]*repo.SeatItem, len(seats))
+	for _, seat := range seats {
+		byID[seat.SeatID] = seat
+	}
+
+	status := ""
+	releaseSeats := make([]*repo.SeatItem, 0, len(releaseIDs))
+	for _, seatID := range releaseIDs {
+		seat := byID[seatID]
+		if seat == nil || seat.ReservationID != req.ReservationId || (seat.Status != seatHold && seat.Status != seatSold) {
+			return nil, fmt.Errorf("precondition failed: seat %s is not held or sold by reservation %s", seatID, req.ReservationId)
+		}
+		if status != "" && seat.Status != status {
+			return nil, errors.New("invalid request: seats to release must all be held or all be sold")
+		}
+		status = seat.Status
+		releaseSeats = append(releaseSeats, seat)
+	}
+	for _, seatID := range acquireIDs {
+		if seat := byID[seatID]; seat == nil || seat.Status != seatAvailable {
+			s.stats.RecordConflict(req.EventId)
+			return nil, apperrors.SeatConflict(req.EventId, seatID)
+		}
+	}
+
+	hidden, err := s.hiddenSeatIDs(ctx, req.EventId, acquireIDs, req.AccessCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(hidden) > 0 {
+		return nil, fmt.Errorf("one or more seats are not available for event %s", req.EventId)
+	}
+
+	swap := &repo.SeatSwap{
+		EventID:       req.EventId,
+		ReservationID: req.ReservationId,
+		Status:        status,
+		Release:       releaseIDs,
+		Acquire:       acquireIDs,
+	}
+	if status == seatHold {
+		if err := s.swapHolds(ctx, swap, releaseSeats); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repo.SwapSeats(ctx, swap)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrHoldExpired) {
+			return nil, apperrors.New(apperrors.ErrHoldExpired, "hold expired for reservation %s", req.ReservationId)
+		}
+		if strings.Contains(err.Error(), "precondition failed") {
+			return nil, fmt.Errorf("precondition failed: seats of reservation %s changed before the swap", req.ReservationId)
+		}
+		if errors.Is(err, apperrors.ErrSeatConflict) {
+			s.stats.RecordConflict(req.EventId)
+			return nil, err
+		}
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			s.stats.RecordConflict(req.EventId)
+			return nil, apperrors.SeatConflict(req.EventId)
+		}
+		return nil, fmt.Errorf("failed to swap seats: %w", err)
+	}
+	s.cacheSeatStatus(ctx, req.EventId, releaseIDs, seatAvailable)
+	s.cacheSeatStatus(ctx, req.EventId, acquireIDs, status)
+	s.restock.SeatsReturned(ctx, req.EventId, releaseIDs, "SWAPPED")
+
+	fmt.Printf("Swapped %d seats of reservation %s for %d seats on event %s: %s\n",
+		len(releaseIDs), req.ReservationId, len(acquireIDs), req.EventId, req.Reason)
+
+	res := &proto.SwapSeatsRes{
+		Status: status,
+		Lines:  seatOrderLines(req.EventId, acquireIDs, seats),
+	}
+	if status == seatHold {
+		res.ExpiresAt = timestamppb.New(swap.HoldExpiresAt)
+	}
+	return res, nil
+}
+
+// swapHolds checks that the holds of the released seats are live and carries their
+// earliest expiry and highest extension count over to the acquired seats
+func (s *InventoryService) swapHolds(ctx context.Context, swap *repo.SeatSwap, releaseSeats []*repo.SeatItem) error {
+	policy, err := s.eventHoldPolicy(ctx, swap.EventID)
+	if err != nil {
+		return err
+	}
+	if len(swap.Acquire) > policy.maxSeats {
+		return fmt.Errorf("invalid request: at most %d seats per hold", policy.maxSeats)
+	}
+
+	// Every seat adds a hold record write to the transaction
+	check, _, err := s.liveHoldCheck(ctx, swap.EventID, swap.ReservationID, releaseSeats, len(swap.Release)+2*len(swap.Acquire))
+	if err != nil {
+		return err
+	}
+	swap.Holds = check
+
+	holds, err := s.repo.GetHolds(ctx, swap.EventID, swap.Release)
+	if err != nil {
+		return fmt.Errorf("failed to get holds: %w", err)
+	}
+	for _, hold := range holds {
+		expiresAt := time.Unix(hold.ExpiresAt, 0)
+		if swap.HoldExpiresAt.IsZero() || expiresAt.Before(swap.HoldExpiresAt) {
+			swap.HoldExpiresAt = expiresAt
+		}
+		swap.HoldExtensions = max(swap.HoldExtensions, hold.Extensions)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxTemplateSeats keeps a template version within the 400KB DynamoDB item limit
+const maxTemplateSeats = 20000
+
+// PutVenueTemplate stores a venue seat layout as the next version of the template
+func (s *AdminService) PutVenueTemplate(ctx context.Context, req *proto.PutVenueTemplateReq) (*proto.PutVenueTemplateRes, error) {
+	if req.TemplateId == "" || len(req.SeatIds) == 0 {
+		return nil, errors.New("invalid request: template_id and seat_ids are required")
+	}
+	if len(req.SeatIds) > maxTemplateSeats {
+		return nil, fmt.Errorf("invalid request: at most %d seats per venue template", maxTemplateSeats)
+	}
+	seen := make(map[string]struct{}, len(req.SeatIds))
+	for _, seatID := range req.SeatIds {
+		if seatID == "" {
+			return nil, errors.New("invalid request: seat_ids must not be empty")
+		}
+		if _, ok := seen[seatID]; ok {
+			return nil, fmt.Errorf("invalid request: duplicate seat %s", seatID)
+		}
+		seen[seatID] = struct{}{}
+	}
+
+	version := int32(1)
+	latest, err := s.repo.GetVenueTemplate(ctx, req.TemplateId, 0)
+	if err == nil {
+		version = latest.Version + 1
+	} else if !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get venue template: %w", err)
+	}
+
+	err = s.repo.PutVenueTemplate(ctx, &repo.VenueTemplateItem{
+		TemplateID: req.TemplateId,
+		Version:    version,
+		Name:       req.Name,
+		SeatIDs:    req.SeatIds,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil, fmt.Errorf("venue template %s version %d conflict: written concurrently", req.TemplateId, version)
+		}
+		return nil, fmt.Errorf("failed to put venue template: %w", err)
+	}
+
+	fmt.Printf("Stored venue template %s version %d with %d seats\n", req.TemplateId, version, len(req.SeatIds))
+
+	return &proto.PutVenueTemplateRes{
+		Version: version,
+	}, nil
+}
+
+// GetVenueTemplate returns one version of a venue template
+func (s *AdminService) GetVenueTemplate(ctx context.Context, req *proto.GetVenueTemplateReq) (*proto.GetVenueTemplateRes, error) {
+	if req.TemplateId == "" {
+		return nil, errors.New("invalid request: template_id is required")
+	}
+
+	template, err := s.repo.GetVenueTemplate(ctx, req.TemplateId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetVenueTemplateRes{
+		TemplateId: template.TemplateID,
+		Version:    template.Version,
+		Name:       template.Name,
+		SeatIds:    template.SeatIDs,
+		CreatedAt:  timestamppb.New(template.CreatedAt),
+	}, nil
+}
+
+// InstantiateVenueTemplate creates an event's seats from a venue template version.
+// The event is bound to the template version first, so an event can't end up with
+// seats of two layouts; rerunning with the same version completes a partial run.
+func (s *AdminService) InstantiateVenueTemplate(ctx context.Context, req *proto.InstantiateVenueTemplateReq) (*proto.InstantiateVenueTemplateRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" || req.TemplateId == "" {
+		return nil, errors.New("invalid request: event_id and template_id are required")
+	}
+
+	template, err := s.repo.GetVenueTemplate(ctx, req.TemplateId, req.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSectionLocks(ctx, req.EventId, template.SeatIDs, ""); err != nil {
+		return nil, err
+	}
+
+	seatMapVersion, err := s.repo.BumpSeatMapVersion(ctx, req.EventId, req.ExpectedSeatMapVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetEventTemplate(ctx, req.EventId, template.TemplateID, template.Version, int32(len(template.SeatIDs)))
+	if err != nil {
+		var conditionalCheckFailed *types.ConditionalCheckFailedException
+		if errors.As(err, &conditionalCheckFailed) {
+			return nil, fmt.Errorf("event %s conflict: already instantiated from another venue template", req.EventId)
+		}
+		return nil, fmt.Errorf("failed to instantiate venue template: %w", err)
+	}
+
+	err = s.repo.CreateEventSeats(ctx, req.EventId, template.SeatIDs, maxSeatsPerTransaction)
+	if err != nil {
+		var txCanceled *types.TransactionCanceledException
+		if errors.As(err, &txCanceled) {
+			return nil, fmt.Errorf("event %s conflict: seats already in use", req.EventId)
+		}
+		return nil, fmt.Errorf("failed to create seats: %w", err)
+	}
+	s.inventory.cacheSeatStatus(ctx, req.EventId, template.SeatIDs, seatAvailable)
+
+	fmt.Printf("Instantiated venue template %s version %d for event %s\n", template.TemplateID, template.Version, req.EventId)
+
+	return &proto.InstantiateVenueTemplateRes{
+		TemplateVersion: template.Version,
+		SeatsCreated:    int32(len(template.SeatIDs)),
+		SeatMapVersion:  seatMapVersion,
+	}, nil
+}
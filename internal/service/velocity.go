@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/cache"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+	"github.com/traffictacos/inventory-api/internal/observability"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// velocityQuotaKind prefixes the counter kind of each velocity rule, by window
+const velocityQuotaKind = "velocity"
+
+// VelocityRule allows a buyer fingerprint at most MaxOrders orders of an event per window
+type VelocityRule struct {
+	Window    time.Duration
+	MaxOrders int64
+}
+
+// VelocityLimiter limits the orders a buyer places per event, to keep scalpers from
+// buying up a sale through many reservations. Buyers are identified by the fingerprint
+// reservation-api passes on commit; orders are counted in fixed windows in Redis, so the
+// limits hold across instances, and while Redis fails commits are let through. A nil
+// limiter is a no-op.
+type VelocityLimiter struct {
+	counter *cache.QuotaCounter
+	metrics *observability.Metrics
+	rules   []VelocityRule
+}
+
+// NewVelocityLimiter creates a velocity limiter, or returns nil when velocity limits are
+// disabled or no rule is valid
+func NewVelocityLimiter(cfg *appconfig.Config, metrics *observability.Metrics) *VelocityLimiter {
+	if !cfg.Velocity.Enabled {
+		return nil
+	}
+
+	var rules []VelocityRule
+	for _, entry := range cfg.Velocity.Rules {
+		rule, err := parseVelocityRule(entry)
+		if err != nil {
+			fmt.Printf("Warning: ignoring velocity rule %q: %v\n", entry, err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &VelocityLimiter{
+		counter: cache.NewQuotaCounter(cfg),
+		metrics: metrics,
+		rules:   rules,
+	}
+}
+
+// Close closes the velocity counter
+func (v *VelocityLimiter) Close() error {
+	return v.counter.Close()
+}
+
+// Acquire counts a commit as an order of its buyer for each event it commits, failing with
+// ErrVelocityLimitExceeded once any rule is exceeded. release must be called with the
+// commit's outcome; an order that wasn't committed is taken back.
+func (v *VelocityLimiter) Acquire(ctx context.Context, req *proto.CommitReq) (release func(committed bool), err error) {
+	release = func(bool) {}
+	if v == nil || req.BuyerFingerprint == "" {
+		return release, nil
+	}
+
+	type counted struct {
+		kind, subject string
+		windowStart   time.Time
+		ttl           time.Duration
+	}
+	var orders []counted
+	takeBack := func() {
+		for _, order := range orders {
+			if _, err := v.counter.Add(context.WithoutCancel(ctx), order.kind, order.subject, order.windowStart, order.ttl, -1); err != nil {
+				fmt.Printf("Warning: failed to take back an order of %s: %v\n", order.subject, err)
+			}
+		}
+	}
+
+	buyer := fingerprintHash(req.BuyerFingerprint)
+	now := time.Now()
+	for _, eventID := range commitEventIDs(req) {
+		subject := eventID + ":" + buyer
+		for _, rule := range v.rules {
+			order := counted{
+				kind:        velocityQuotaKind + ":" + rule.Window.String(),
+				subject:     subject,
+				windowStart: now.Truncate(rule.Window),
+				ttl:         2 * rule.Window,
+			}
+			used, err := v.counter.Add(ctx, order.kind, order.subject, order.windowStart, order.ttl, 1)
+			if err != nil {
+				fmt.Printf("Warning: velocity check failed for event %s: %v\n", eventID, err)
+				continue
+			}
+			orders = append(orders, order)
+			if used > rule.MaxOrders {
+				takeBack()
+				v.metrics.RecordVelocityRejection(rule.Window)
+				return nil, apperrors.New(apperrors.ErrVelocityLimitExceeded, "velocity limit exceeded: a buyer may place %d orders of event %s per %s", rule.MaxOrders, eventID, rule.Window)
+			}
+		}
+	}
+
+	return func(committed bool) {
+		if !committed {
+			takeBack()
+		}
+	}, nil
+}
+
+// commitEventIDs returns the events a commit buys from, once each
+func commitEventIDs(req *proto.CommitReq) []string {
+	if len(req.LineItems) == 0 {
+		return []string{req.EventId}
+	}
+	var eventIDs []string
+	seen := make(map[string]bool, len(req.LineItems))
+	for _, item := range req.LineItems {
+		if !seen[item.EventId] {
+			seen[item.EventId] = true
+			eventIDs = append(eventIDs, item.EventId)
+		}
+	}
+	return eventIDs
+}
+
+// fingerprintHash shortens a buyer fingerprint to a fixed-size Redis key component
+func fingerprintHash(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(sum[:16])
+}
+
+// parseVelocityRule parses a "<window>=<max orders>" velocity rule
+func parseVelocityRule(entry string) (VelocityRule, error) {
+	window, maxOrders, ok := strings.Cut(entry, "=")
+	if !ok {
+		return VelocityRule{}, errors.New("expected <window>=<max orders>")
+	}
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		return VelocityRule{}, fmt.Errorf("malformed window %q", window)
+	}
+	limit, err := strconv.ParseInt(maxOrders, 10, 64)
+	if err != nil || limit < 1 {
+		return VelocityRule{}, fmt.Errorf("malformed max orders %q", maxOrders)
+	}
+	return VelocityRule{Window: duration, MaxOrders: limit}, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// hiddenSeatIDs returns the seats an event's visibility rules hide from a caller
+// presenting accessCode (empty for none)
+func (s *InventoryService) hiddenSeatIDs(ctx context.Context, eventID string, seatIDs []string, accessCode string) ([]string, error) {
+	rules, err := s.repo.GetVisibilityRules(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	var hidden []string
+	for _, seatID := range seatIDs {
+		if seatHidden(rules, seatID, accessCode, now) {
+			hidden = append(hidden, seatID)
+		}
+	}
+	return hidden, nil
+}
+
+// seatHidden reports whether any rule of a segment containing the seat still hides it
+// from a caller presenting accessCode
+func seatHidden(rules map[string]repo.VisibilityRule, seatID, accessCode string, now time.Time) bool {
+	for segment, rule := range rules {
+		if !strings.HasPrefix(seatID, segment) {
+			continue
+		}
+		if !rule.RevealAt.IsZero() && !now.Before(rule.RevealAt) {
+			continue
+		}
+		if rule.AccessCodeHash != "" && accessCode != "" &&
+			subtle.ConstantTimeCompare([]byte(accessCodeHash(accessCode)), []byte(rule.AccessCodeHash)) == 1 {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// accessCodeHash returns the stored form of a presale access code
+func accessCodeHash(accessCode string) string {
+	sum := sha256.Sum256([]byte(accessCode))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetVisibilityRule hides a segment of an event's seats from public checks and holds
+// until its reveal time, or until RevealSegment when it has none
+func (s *AdminService) SetVisibilityRule(ctx context.Context, req *proto.SetVisibilityRuleReq) (*proto.SetVisibilityRuleRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" || req.Segment == "" {
+		return nil, errors.New("invalid request: event_id and segment are required")
+	}
+
+	rule := repo.VisibilityRule{
+		CreatedAt: time.Now(),
+	}
+	if req.RevealAt != nil {
+		rule.RevealAt = req.RevealAt.AsTime()
+		if !rule.RevealAt.After(rule.CreatedAt) {
+			return nil, errors.New("invalid request: reveal_at must be in the future")
+		}
+	}
+	if req.AccessCode != "" {
+		rule.AccessCodeHash = accessCodeHash(req.AccessCode)
+	}
+
+	if err := s.repo.PutVisibilityRule(ctx, req.EventId, req.Segment, rule); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Hid segment %q of event %s until %v\n", req.Segment, req.EventId, rule.RevealAt)
+
+	return &proto.SetVisibilityRuleRes{
+		Status: "UPDATED",
+	}, nil
+}
+
+// RevealSegment removes a segment's visibility rule. Other instances stop hiding the
+// segment within VISIBILITY_RULES_CACHE_TTL.
+func (s *AdminService) RevealSegment(ctx context.Context, req *proto.RevealSegmentReq) (*proto.RevealSegmentRes, error) {
+	if err := usePerformanceKey(&req.EventId, req.PerformanceId); err != nil {
+		return nil, err
+	}
+
+	if req.EventId == "" || req.Segment == "" {
+		return nil, errors.New("invalid request: event_id and segment are required")
+	}
+
+	if err := s.repo.RemoveVisibilityRule(ctx, req.EventId, req.Segment); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Revealed segment %q of event %s\n", req.Segment, req.EventId)
+
+	return &proto.RevealSegmentRes{
+		Status: "REVEALED",
+	}, nil
+}
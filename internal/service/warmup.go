@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	apperrors "github.com/traffictacos/inventory-api/internal/errors"
+)
+
+// WarmUp preloads hot events before the instance serves traffic: it reads each event's
+// inventory item, which also opens DynamoDB connections ahead of the on-sale, and loads
+// the remaining quantity and seat statuses into the availability cache when enabled.
+// Failures are logged; an event that isn't warmed is simply loaded on first use.
+func (s *InventoryService) WarmUp(ctx context.Context, eventIDs []string, concurrency int) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	start := time.Now()
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		warmed int
+	)
+	sem := make(chan struct{}, max(concurrency, 1))
+	for _, eventID := range eventIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(eventID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := s.warmEvent(ctx, eventID); err != nil {
+				fmt.Printf("Warning: failed to warm up event %s: %v\n", eventID, err)
+				return
+			}
+			mu.Lock()
+			warmed++
+			mu.Unlock()
+		}(eventID)
+	}
+	wg.Wait()
+
+	fmt.Printf("Warmed up %d of %d events in %s\n", warmed, len(eventIDs), time.Since(start).Round(time.Millisecond))
+}
+
+// warmEvent loads one event's inventory and, for seat events, its seat statuses
+func (s *InventoryService) warmEvent(ctx context.Context, eventID string) error {
+	// Seat events don't always have an inventory item
+	inventory, err := s.repo.GetInventory(ctx, eventID)
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		return err
+	}
+	if s.counter == nil {
+		return nil
+	}
+
+	if inventory != nil {
+		if err := s.counter.SetRemaining(ctx, eventID, inventory.Remaining); err != nil {
+			return err
+		}
+	}
+
+	seats, err := s.repo.ListEventSeats(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return nil
+	}
+
+	statuses := make(map[string]string, len(seats))
+	for _, seat := range seats {
+		statuses[seat.SeatID] = seat.Status
+	}
+	return s.counter.ReplaceSeatStatuses(ctx, eventID, statuses)
+}
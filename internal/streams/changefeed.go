@@ -0,0 +1,218 @@
+package streams
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/proto"
+)
+
+// ChangeFeed buffers the changes read from the inventory and seats table streams and fans
+// them out to subscribers, so sibling services can follow inventory without a message
+// broker. Every instance reads the same streams, so a resume token issued by one instance
+// resumes on any other while the change is still buffered there.
+type ChangeFeed struct {
+	stream   *repo.ChangeStream
+	interval time.Duration
+	size     int
+
+	mu sync.Mutex
+	// changes are the buffered changes, oldest first; offset is the feed position of changes[0]
+	changes []*proto.InventoryChange
+	offset  int
+	// appended is closed and replaced whenever changes are appended
+	appended chan struct{}
+}
+
+// NewChangeFeed creates a change feed, or returns nil when the feed is disabled
+func NewChangeFeed(repo *repo.DynamoDBRepository, cfg *appconfig.Config) *ChangeFeed {
+	if !cfg.ChangeFeed.Enabled {
+		return nil
+	}
+	return &ChangeFeed{
+		stream:   repo.NewChangeStream(),
+		interval: cfg.ChangeFeed.PollInterval,
+		size:     max(cfg.ChangeFeed.BufferSize, 1),
+		appended: make(chan struct{}),
+	}
+}
+
+// Run polls the table streams until ctx is canceled
+func (f *ChangeFeed) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.PollOnce(ctx); err != nil {
+				fmt.Printf("Warning: change feed poll failed: %v\n", err)
+			}
+		}
+	}
+}
+
+// PollOnce reads one batch of changes into the buffer and wakes subscribers
+func (f *ChangeFeed) PollOnce(ctx context.Context) error {
+	records, err := f.stream.Poll(ctx)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, record := range records {
+		f.changes = append(f.changes, changeToProto(record))
+	}
+	if excess := len(f.changes) - f.size; excess > 0 {
+		f.changes = slices.Delete(f.changes, 0, excess)
+		f.offset += excess
+	}
+
+	close(f.appended)
+	f.appended = make(chan struct{})
+	return nil
+}
+
+// Subscribe sends the changes of the requested events until ctx is canceled, starting
+// after the resume token's change or with the next change
+func (f *ChangeFeed) Subscribe(ctx context.Context, req *proto.SubscribeChangesReq, send func(*proto.InventoryChange) error) error {
+	if f == nil {
+		return errors.New("precondition failed: the change feed is not enabled")
+	}
+
+	events := make(map[string]bool, len(req.EventIds))
+	for _, eventID := range req.EventIds {
+		events[eventID] = true
+	}
+
+	position, err := f.resumePosition(req.ResumeToken)
+	if err != nil {
+		return err
+	}
+
+	for {
+		f.mu.Lock()
+		if position < f.offset {
+			f.mu.Unlock()
+			return errors.New("precondition failed: subscriber fell behind the change buffer; read the current state and subscribe again")
+		}
+		pending := slices.Clone(f.changes[position-f.offset:])
+		position = f.offset + len(f.changes)
+		appended := f.appended
+		f.mu.Unlock()
+
+		for _, change := range pending {
+			if len(events) > 0 && !events[change.EventId] {
+				continue
+			}
+			if err := send(change); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-appended:
+		}
+	}
+}
+
+// resumePosition returns the feed position following a resume token, or the end of the
+// feed for an empty token. A token whose change is no longer buffered resumes at the
+// first change recorded at the same time or later, replaying a few changes at worst.
+func (f *ChangeFeed) resumePosition(token string) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if token == "" {
+		return f.offset + len(f.changes), nil
+	}
+
+	at, changeID, err := parseResumeToken(token)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(f.changes) - 1; i >= 0; i-- {
+		if f.changes[i].ChangeId == changeID {
+			return f.offset + i + 1, nil
+		}
+	}
+	if len(f.changes) == 0 || at.Before(f.changes[0].ChangedAt.AsTime()) {
+		return 0, errors.New("precondition failed: resume token expired; read the current state and subscribe again")
+	}
+	// Polls are ordered by time only within themselves, so take the first change not earlier
+	i := slices.IndexFunc(f.changes, func(change *proto.InventoryChange) bool {
+		return !change.ChangedAt.AsTime().Before(at)
+	})
+	if i < 0 {
+		i = len(f.changes)
+	}
+	return f.offset + i, nil
+}
+
+// changeToProto converts a stream change to an API change with its resume token
+func changeToProto(record *repo.ChangeRecord) *proto.InventoryChange {
+	change := &proto.InventoryChange{
+		ChangeId:    record.ID,
+		ChangedAt:   timestamppb.New(record.At),
+		ResumeToken: resumeToken(record.At, record.ID),
+	}
+
+	if record.Seat != nil {
+		change.EventId, change.PerformanceId = repo.SplitPerformanceKey(record.Seat.EventID)
+		change.Change = &proto.InventoryChange_Seat{Seat: &proto.SeatChanged{
+			SeatId: record.Seat.SeatID,
+			Status: proto.SeatStatus(proto.SeatStatus_value["SEAT_STATUS_"+record.Seat.Status]),
+		}}
+	} else {
+		change.EventId, change.PerformanceId = repo.SplitPerformanceKey(record.Inventory.EventID)
+		change.Change = &proto.InventoryChange_Inventory{Inventory: &proto.InventoryChanged{
+			Remaining:  record.Inventory.Remaining,
+			TotalSeats: record.Inventory.TotalSeats,
+			Version:    record.Inventory.Version,
+		}}
+	}
+	return change
+}
+
+// resumeToken encodes the position of a change as "<unix nanos>/<change ID>"
+func resumeToken(at time.Time, changeID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(at.UnixNano(), 10) + "/" + changeID))
+}
+
+// parseResumeToken decodes a resume token
+func parseResumeToken(token string) (time.Time, string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", errors.New("invalid request: malformed resume_token")
+	}
+	nanos, changeID, ok := strings.Cut(string(decoded), "/")
+	if !ok {
+		return time.Time{}, "", errors.New("invalid request: malformed resume_token")
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", errors.New("invalid request: malformed resume_token")
+	}
+	return time.Unix(0, n), changeID, nil
+}
@@ -0,0 +1,153 @@
+package streams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/traffictacos/inventory-api/internal/cache"
+	appconfig "github.com/traffictacos/inventory-api/internal/config"
+	"github.com/traffictacos/inventory-api/internal/notify"
+	"github.com/traffictacos/inventory-api/internal/repo"
+	"github.com/traffictacos/inventory-api/internal/service"
+)
+
+// HoldExpiryProcessor returns seats to sale when DynamoDB TTL deletes their hold record,
+// so expired holds are reclaimed within seconds without scanning the holds table.
+// Releases are conditioned on the seat still being held by the same reservation, so
+// holds that were committed, released or refreshed meanwhile are left untouched. For
+// each reclaimed reservation it updates the availability counter and publishes a
+// hold_expired event.
+type HoldExpiryProcessor struct {
+	repo      *repo.DynamoDBRepository
+	stream    *repo.HoldExpiryStream
+	restock   *service.RestockNotifier
+	counter   *cache.AvailabilityCounter
+	publisher *notify.HoldEventPublisher
+	stats     *service.EventStats
+	interval  time.Duration
+}
+
+// NewHoldExpiryProcessor creates a hold expiry processor; a nil counter or publisher
+// skips counter updates or events
+func NewHoldExpiryProcessor(repo *repo.DynamoDBRepository, restock *service.RestockNotifier, counter *cache.AvailabilityCounter, publisher *notify.HoldEventPublisher, stats *service.EventStats, cfg *appconfig.Config) *HoldExpiryProcessor {
+	return &HoldExpiryProcessor{
+		repo:      repo,
+		stream:    repo.NewHoldExpiryStream(),
+		restock:   restock,
+		counter:   counter,
+		publisher: publisher,
+		stats:     stats,
+		interval:  cfg.Holds.ExpiryStreamPollInterval,
+	}
+}
+
+// Run polls the holds stream until ctx is canceled
+func (p *HoldExpiryProcessor) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.PollOnce(ctx); err != nil {
+				fmt.Printf("Warning: hold expiry stream poll failed: %v\n", err)
+			}
+		}
+	}
+}
+
+// expiredReservation is the seats of one reservation on one event reclaimed in a poll
+type expiredReservation struct {
+	eventID       string
+	reservationID string
+}
+
+// PollOnce reads one batch of TTL deletions from each leased shard and releases the
+// corresponding seats
+func (p *HoldExpiryProcessor) PollOnce(ctx context.Context) error {
+	return p.stream.Poll(ctx, func(expired []*repo.HoldItem) error {
+		return p.release(ctx, expired)
+	})
+}
+
+// release returns the seats of expired holds to sale. Seats no longer held by the expired
+// hold are skipped; any other failure is returned after the released seats were
+// announced, so the batch is retried without advancing the shard's checkpoint.
+func (p *HoldExpiryProcessor) release(ctx context.Context, expired []*repo.HoldItem) error {
+	var failed error
+	released := make(map[expiredReservation][]string)
+	versions := make(map[expiredReservation]int64)
+	for _, hold := range expired {
+		ok, err := p.repo.ReleaseExpiredHold(ctx, hold)
+		if err != nil {
+			failed = errors.Join(failed, err)
+			continue
+		}
+		if !ok {
+			// The hold was committed, released or refreshed before TTL removed the record
+			continue
+		}
+		key := expiredReservation{eventID: hold.EventID, reservationID: hold.ReservationID}
+		released[key] = append(released[key], hold.SeatID)
+		versions[key] = max(versions[key], hold.ExpiresAt)
+	}
+
+	byEvent := make(map[string][]string)
+	for key, seatIDs := range released {
+		p.updateCounter(ctx, key.eventID, seatIDs)
+		p.publish(ctx, key, versions[key], seatIDs)
+		p.stats.RecordHoldExpired(key.eventID)
+		byEvent[key.eventID] = append(byEvent[key.eventID], seatIDs...)
+	}
+	for eventID, seatIDs := range byEvent {
+		fmt.Printf("Released %d expired holds for event %s\n", len(seatIDs), eventID)
+		p.restock.SeatsReturned(ctx, eventID, seatIDs, "EXPIRED")
+	}
+	return failed
+}
+
+// updateCounter marks reclaimed seats available in the availability counter, dropping
+// the event's cached availability when that fails so it is reloaded from DynamoDB
+func (p *HoldExpiryProcessor) updateCounter(ctx context.Context, eventID string, seatIDs []string) {
+	if p.counter == nil {
+		return
+	}
+
+	statuses := make(map[string]string, len(seatIDs))
+	for _, seatID := range seatIDs {
+		statuses[seatID] = repo.SeatAvailable
+	}
+	if err := p.counter.SetSeatStatuses(ctx, eventID, statuses); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		if err := p.counter.Invalidate(ctx, eventID); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+}
+
+// publish announces the reclaimed seats of a reservation, logging failures. The hold's
+// expiry identifies the version of the hold, so an expiry replayed from the stream keeps
+// its dedup key while a later hold of the same reservation gets a new one.
+func (p *HoldExpiryProcessor) publish(ctx context.Context, key expiredReservation, expiresAt int64, seatIDs []string) {
+	if p.publisher == nil {
+		return
+	}
+
+	event, performanceID := repo.SplitPerformanceKey(key.eventID)
+	err := p.publisher.Publish(ctx, &notify.HoldEvent{
+		Type:          notify.HoldEventExpired,
+		EventID:       event,
+		PerformanceID: performanceID,
+		ReservationID: key.reservationID,
+		SeatIDs:       seatIDs,
+		OccurredAt:    time.Now(),
+		DedupKey:      notify.DedupKey(notify.HoldEventExpired+":"+key.eventID, key.reservationID, expiresAt),
+	})
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
@@ -0,0 +1,5829 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: proto/admin.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdjustCapacityReq represents a request to adjust an event's remaining quantity
+type AdjustCapacityReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Positive values add inventory, negative values remove it
+	Delta int32 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Version observed by the caller; the adjustment fails if it has changed
+	ExpectedVersion int32  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	PerformanceId   string `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdjustCapacityReq) Reset() {
+	*x = AdjustCapacityReq{}
+	mi := &file_proto_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustCapacityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustCapacityReq) ProtoMessage() {}
+
+func (x *AdjustCapacityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustCapacityReq.ProtoReflect.Descriptor instead.
+func (*AdjustCapacityReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdjustCapacityReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AdjustCapacityReq) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustCapacityReq) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *AdjustCapacityReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// AdjustCapacityRes represents the response to capacity adjustment
+type AdjustCapacityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remaining     int32                  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustCapacityRes) Reset() {
+	*x = AdjustCapacityRes{}
+	mi := &file_proto_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustCapacityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustCapacityRes) ProtoMessage() {}
+
+func (x *AdjustCapacityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustCapacityRes.ProtoReflect.Descriptor instead.
+func (*AdjustCapacityRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdjustCapacityRes) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *AdjustCapacityRes) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// BlockSeatsReq represents a request to block seats
+type BlockSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat map version observed by the caller; the change fails if it has changed
+	ExpectedSeatMapVersion int32 `protobuf:"varint,5,opt,name=expected_seat_map_version,json=expectedSeatMapVersion,proto3" json:"expected_seat_map_version,omitempty"`
+	// Lease of a locked section the change is made under
+	LeaseId       string `protobuf:"bytes,6,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSeatsReq) Reset() {
+	*x = BlockSeatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsReq) ProtoMessage() {}
+
+func (x *BlockSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsReq.ProtoReflect.Descriptor instead.
+func (*BlockSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *BlockSeatsReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *BlockSeatsReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *BlockSeatsReq) GetExpectedSeatMapVersion() int32 {
+	if x != nil {
+		return x.ExpectedSeatMapVersion
+	}
+	return 0
+}
+
+func (x *BlockSeatsReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// BlockSeatsRes represents the response to seat blocking
+type BlockSeatsRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "BLOCKED"
+	// Seat map version after the change
+	SeatMapVersion int32 `protobuf:"varint,2,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BlockSeatsRes) Reset() {
+	*x = BlockSeatsRes{}
+	mi := &file_proto_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsRes) ProtoMessage() {}
+
+func (x *BlockSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsRes.ProtoReflect.Descriptor instead.
+func (*BlockSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockSeatsRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BlockSeatsRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// UnblockSeatsReq represents a request to unblock seats
+type UnblockSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat map version observed by the caller; the change fails if it has changed
+	ExpectedSeatMapVersion int32 `protobuf:"varint,4,opt,name=expected_seat_map_version,json=expectedSeatMapVersion,proto3" json:"expected_seat_map_version,omitempty"`
+	// Lease of a locked section the change is made under
+	LeaseId       string `protobuf:"bytes,5,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockSeatsReq) Reset() {
+	*x = UnblockSeatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsReq) ProtoMessage() {}
+
+func (x *UnblockSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatsReq.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnblockSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *UnblockSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *UnblockSeatsReq) GetExpectedSeatMapVersion() int32 {
+	if x != nil {
+		return x.ExpectedSeatMapVersion
+	}
+	return 0
+}
+
+func (x *UnblockSeatsReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// UnblockSeatsRes represents the response to seat unblocking
+type UnblockSeatsRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "AVAILABLE"
+	// Seat map version after the change
+	SeatMapVersion int32 `protobuf:"varint,2,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnblockSeatsRes) Reset() {
+	*x = UnblockSeatsRes{}
+	mi := &file_proto_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsRes) ProtoMessage() {}
+
+func (x *UnblockSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatsRes.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnblockSeatsRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// SetSeatStatusReq represents a request to change the status of seats
+type SetSeatStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Status        SeatStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,5,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat map version observed by the caller; the change fails if it has changed
+	ExpectedSeatMapVersion int32 `protobuf:"varint,6,opt,name=expected_seat_map_version,json=expectedSeatMapVersion,proto3" json:"expected_seat_map_version,omitempty"`
+	// Lease of a locked section the change is made under
+	LeaseId       string `protobuf:"bytes,7,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeatStatusReq) Reset() {
+	*x = SetSeatStatusReq{}
+	mi := &file_proto_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatStatusReq) ProtoMessage() {}
+
+func (x *SetSeatStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatStatusReq.ProtoReflect.Descriptor instead.
+func (*SetSeatStatusReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetSeatStatusReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetSeatStatusReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *SetSeatStatusReq) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SetSeatStatusReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetSeatStatusReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SetSeatStatusReq) GetExpectedSeatMapVersion() int32 {
+	if x != nil {
+		return x.ExpectedSeatMapVersion
+	}
+	return 0
+}
+
+func (x *SetSeatStatusReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// SetSeatStatusRes represents the response to a seat status change
+type SetSeatStatusRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status SeatStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	// Seat map version after the change
+	SeatMapVersion int32 `protobuf:"varint,2,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetSeatStatusRes) Reset() {
+	*x = SetSeatStatusRes{}
+	mi := &file_proto_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatStatusRes) ProtoMessage() {}
+
+func (x *SetSeatStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatStatusRes.ProtoReflect.Descriptor instead.
+func (*SetSeatStatusRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetSeatStatusRes) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SetSeatStatusRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// SetSeatMetadataReq represents a request to annotate seats. The metadata and note
+// replace those of every seat; empty values clear them.
+type SetSeatMetadataReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,5,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Lease of a locked section the change is made under
+	LeaseId       string `protobuf:"bytes,6,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeatMetadataReq) Reset() {
+	*x = SetSeatMetadataReq{}
+	mi := &file_proto_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatMetadataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatMetadataReq) ProtoMessage() {}
+
+func (x *SetSeatMetadataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatMetadataReq.ProtoReflect.Descriptor instead.
+func (*SetSeatMetadataReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetSeatMetadataReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetSeatMetadataReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *SetSeatMetadataReq) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SetSeatMetadataReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *SetSeatMetadataReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SetSeatMetadataReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// SetSeatMetadataRes represents the annotated seats
+type SetSeatMetadataRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seats         []*Seat                `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeatMetadataRes) Reset() {
+	*x = SetSeatMetadataRes{}
+	mi := &file_proto_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatMetadataRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatMetadataRes) ProtoMessage() {}
+
+func (x *SetSeatMetadataRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatMetadataRes.ProtoReflect.Descriptor instead.
+func (*SetSeatMetadataRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetSeatMetadataRes) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+// GetSeatsReq represents a request to read seats
+type GetSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatsReq) Reset() {
+	*x = GetSeatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatsReq) ProtoMessage() {}
+
+func (x *GetSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatsReq.ProtoReflect.Descriptor instead.
+func (*GetSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetSeatsReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *GetSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// GetSeatsRes represents the seats found; unknown seats are omitted
+type GetSeatsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seats         []*Seat                `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatsRes) Reset() {
+	*x = GetSeatsRes{}
+	mi := &file_proto_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatsRes) ProtoMessage() {}
+
+func (x *GetSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatsRes.ProtoReflect.Descriptor instead.
+func (*GetSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSeatsRes) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+// SetMaintenanceModeReq represents a request to toggle maintenance mode of one instance
+type SetMaintenanceModeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeReq) Reset() {
+	*x = SetMaintenanceModeReq{}
+	mi := &file_proto_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeReq) ProtoMessage() {}
+
+func (x *SetMaintenanceModeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeReq.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetMaintenanceModeReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SetMaintenanceModeRes represents the response to maintenance mode toggle
+type SetMaintenanceModeRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether maintenance mode is enabled on the instance that served the call
+	Enabled       bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeRes) Reset() {
+	*x = SetMaintenanceModeRes{}
+	mi := &file_proto_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRes) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRes.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetMaintenanceModeRes) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// FreezeEventReq represents a request to freeze writes for an event
+type FreezeEventReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeEventReq) Reset() {
+	*x = FreezeEventReq{}
+	mi := &file_proto_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeEventReq) ProtoMessage() {}
+
+func (x *FreezeEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeEventReq.ProtoReflect.Descriptor instead.
+func (*FreezeEventReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FreezeEventReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FreezeEventReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeEventReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// FreezeEventRes represents the response to event freeze
+type FreezeEventRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frozen        bool                   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeEventRes) Reset() {
+	*x = FreezeEventRes{}
+	mi := &file_proto_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeEventRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeEventRes) ProtoMessage() {}
+
+func (x *FreezeEventRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeEventRes.ProtoReflect.Descriptor instead.
+func (*FreezeEventRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FreezeEventRes) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+// UnfreezeEventReq represents a request to lift an event freeze
+type UnfreezeEventReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeEventReq) Reset() {
+	*x = UnfreezeEventReq{}
+	mi := &file_proto_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeEventReq) ProtoMessage() {}
+
+func (x *UnfreezeEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeEventReq.ProtoReflect.Descriptor instead.
+func (*UnfreezeEventReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnfreezeEventReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UnfreezeEventReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// UnfreezeEventRes represents the response to event unfreeze
+type UnfreezeEventRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frozen        bool                   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeEventRes) Reset() {
+	*x = UnfreezeEventRes{}
+	mi := &file_proto_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeEventRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeEventRes) ProtoMessage() {}
+
+func (x *UnfreezeEventRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeEventRes.ProtoReflect.Descriptor instead.
+func (*UnfreezeEventRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnfreezeEventRes) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+// ReleaseEventHoldsReq represents a request to release an event's held seats
+type ReleaseEventHoldsReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// If > 0, only release holds last updated more than this many seconds ago
+	OlderThanSeconds int32 `protobuf:"varint,2,opt,name=older_than_seconds,json=olderThanSeconds,proto3" json:"older_than_seconds,omitempty"`
+	// Seats released per transaction (default 25, max 50)
+	ChunkSize     int32  `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	PerformanceId string `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseEventHoldsReq) Reset() {
+	*x = ReleaseEventHoldsReq{}
+	mi := &file_proto_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseEventHoldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseEventHoldsReq) ProtoMessage() {}
+
+func (x *ReleaseEventHoldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseEventHoldsReq.ProtoReflect.Descriptor instead.
+func (*ReleaseEventHoldsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseEventHoldsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReleaseEventHoldsReq) GetOlderThanSeconds() int32 {
+	if x != nil {
+		return x.OlderThanSeconds
+	}
+	return 0
+}
+
+func (x *ReleaseEventHoldsReq) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ReleaseEventHoldsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// ReleaseEventHoldsProgress reports cumulative progress of a bulk hold release
+type ReleaseEventHoldsProgress struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Scanned  int32                  `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Released int32                  `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	Failed   int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Done     bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	// Seats that failed to release since the previous message, with their position in the
+	// scan, seat ID and error
+	FailedSeats   []*BatchResult `protobuf:"bytes,5,rep,name=failed_seats,json=failedSeats,proto3" json:"failed_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseEventHoldsProgress) Reset() {
+	*x = ReleaseEventHoldsProgress{}
+	mi := &file_proto_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseEventHoldsProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseEventHoldsProgress) ProtoMessage() {}
+
+func (x *ReleaseEventHoldsProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseEventHoldsProgress.ProtoReflect.Descriptor instead.
+func (*ReleaseEventHoldsProgress) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseEventHoldsProgress) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *ReleaseEventHoldsProgress) GetReleased() int32 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+func (x *ReleaseEventHoldsProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReleaseEventHoldsProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ReleaseEventHoldsProgress) GetFailedSeats() []*BatchResult {
+	if x != nil {
+		return x.FailedSeats
+	}
+	return nil
+}
+
+// ListStuckHoldsReq represents a request to list stuck holds
+type ListStuckHoldsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckHoldsReq) Reset() {
+	*x = ListStuckHoldsReq{}
+	mi := &file_proto_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckHoldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckHoldsReq) ProtoMessage() {}
+
+func (x *ListStuckHoldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckHoldsReq.ProtoReflect.Descriptor instead.
+func (*ListStuckHoldsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListStuckHoldsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListStuckHoldsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// StuckHold describes a seat held past its TTL without being released
+type StuckHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatId        string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	HeldSince     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=held_since,json=heldSince,proto3" json:"held_since,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,5,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StuckHold) Reset() {
+	*x = StuckHold{}
+	mi := &file_proto_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckHold) ProtoMessage() {}
+
+func (x *StuckHold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckHold.ProtoReflect.Descriptor instead.
+func (*StuckHold) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StuckHold) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StuckHold) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *StuckHold) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *StuckHold) GetHeldSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeldSince
+	}
+	return nil
+}
+
+func (x *StuckHold) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// ListStuckHoldsRes represents the response to stuck hold listing
+type ListStuckHoldsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*StuckHold           `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckHoldsRes) Reset() {
+	*x = ListStuckHoldsRes{}
+	mi := &file_proto_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckHoldsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckHoldsRes) ProtoMessage() {}
+
+func (x *ListStuckHoldsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckHoldsRes.ProtoReflect.Descriptor instead.
+func (*ListStuckHoldsRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListStuckHoldsRes) GetHolds() []*StuckHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+// PlanCapacityReq represents a capacity planning what-if request
+type PlanCapacityReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Expected sales velocity in tickets per second. If 0, the event's demand forecast
+	// is used, or without one it is measured from seats sold during the lookback window
+	// (seat events only).
+	SalesPerSecond float64 `protobuf:"fixed64,2,opt,name=sales_per_second,json=salesPerSecond,proto3" json:"sales_per_second,omitempty"`
+	// Window used to measure velocity (default 300)
+	LookbackSeconds int32 `protobuf:"varint,3,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+	// Ratio of peak to average velocity during the on-sale (default 3); forecasts
+	// carry their own peak
+	PeakFactor float64 `protobuf:"fixed64,4,opt,name=peak_factor,json=peakFactor,proto3" json:"peak_factor,omitempty"`
+	// Average tickets per order (default 2)
+	AvgOrderSize  float64 `protobuf:"fixed64,5,opt,name=avg_order_size,json=avgOrderSize,proto3" json:"avg_order_size,omitempty"`
+	PerformanceId string  `protobuf:"bytes,6,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCapacityReq) Reset() {
+	*x = PlanCapacityReq{}
+	mi := &file_proto_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCapacityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCapacityReq) ProtoMessage() {}
+
+func (x *PlanCapacityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCapacityReq.ProtoReflect.Descriptor instead.
+func (*PlanCapacityReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlanCapacityReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PlanCapacityReq) GetSalesPerSecond() float64 {
+	if x != nil {
+		return x.SalesPerSecond
+	}
+	return 0
+}
+
+func (x *PlanCapacityReq) GetLookbackSeconds() int32 {
+	if x != nil {
+		return x.LookbackSeconds
+	}
+	return 0
+}
+
+func (x *PlanCapacityReq) GetPeakFactor() float64 {
+	if x != nil {
+		return x.PeakFactor
+	}
+	return 0
+}
+
+func (x *PlanCapacityReq) GetAvgOrderSize() float64 {
+	if x != nil {
+		return x.AvgOrderSize
+	}
+	return 0
+}
+
+func (x *PlanCapacityReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// PlanCapacityRes represents a capacity planning projection
+type PlanCapacityRes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Remaining      int32                  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	InventoryType  string                 `protobuf:"bytes,2,opt,name=inventory_type,json=inventoryType,proto3" json:"inventory_type,omitempty"` // "QUANTITY", "SEAT"
+	SalesPerSecond float64                `protobuf:"fixed64,3,opt,name=sales_per_second,json=salesPerSecond,proto3" json:"sales_per_second,omitempty"`
+	VelocitySource string                 `protobuf:"bytes,4,opt,name=velocity_source,json=velocitySource,proto3" json:"velocity_source,omitempty"` // "REQUEST", "FORECAST", "MEASURED", "NONE"
+	// Unset when velocity is 0
+	ProjectedSellout *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=projected_sellout,json=projectedSellout,proto3" json:"projected_sellout,omitempty"`
+	SecondsToSellout int64                  `protobuf:"varint,6,opt,name=seconds_to_sellout,json=secondsToSellout,proto3" json:"seconds_to_sellout,omitempty"`
+	// Peak write capacity units per second on the event's partition
+	PeakWriteUnitsPerSecond float64 `protobuf:"fixed64,7,opt,name=peak_write_units_per_second,json=peakWriteUnitsPerSecond,proto3" json:"peak_write_units_per_second,omitempty"`
+	// Counter/partition shards needed to stay under the per-partition write limit
+	RecommendedShards int32 `protobuf:"varint,8,opt,name=recommended_shards,json=recommendedShards,proto3" json:"recommended_shards,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlanCapacityRes) Reset() {
+	*x = PlanCapacityRes{}
+	mi := &file_proto_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCapacityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCapacityRes) ProtoMessage() {}
+
+func (x *PlanCapacityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCapacityRes.ProtoReflect.Descriptor instead.
+func (*PlanCapacityRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlanCapacityRes) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *PlanCapacityRes) GetInventoryType() string {
+	if x != nil {
+		return x.InventoryType
+	}
+	return ""
+}
+
+func (x *PlanCapacityRes) GetSalesPerSecond() float64 {
+	if x != nil {
+		return x.SalesPerSecond
+	}
+	return 0
+}
+
+func (x *PlanCapacityRes) GetVelocitySource() string {
+	if x != nil {
+		return x.VelocitySource
+	}
+	return ""
+}
+
+func (x *PlanCapacityRes) GetProjectedSellout() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedSellout
+	}
+	return nil
+}
+
+func (x *PlanCapacityRes) GetSecondsToSellout() int64 {
+	if x != nil {
+		return x.SecondsToSellout
+	}
+	return 0
+}
+
+func (x *PlanCapacityRes) GetPeakWriteUnitsPerSecond() float64 {
+	if x != nil {
+		return x.PeakWriteUnitsPerSecond
+	}
+	return 0
+}
+
+func (x *PlanCapacityRes) GetRecommendedShards() int32 {
+	if x != nil {
+		return x.RecommendedShards
+	}
+	return 0
+}
+
+// PutDemandForecastReq represents an event's expected demand curve. Without points the
+// event's forecast is cleared.
+type PutDemandForecastReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Start of the on-sale the curve's offsets are relative to
+	OnSaleAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`
+	// Points of the curve in increasing offset order (at most 1000)
+	Points []*DemandPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	// Forecasting system or model that produced the curve, kept for reference
+	Source        string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDemandForecastReq) Reset() {
+	*x = PutDemandForecastReq{}
+	mi := &file_proto_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDemandForecastReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDemandForecastReq) ProtoMessage() {}
+
+func (x *PutDemandForecastReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDemandForecastReq.ProtoReflect.Descriptor instead.
+func (*PutDemandForecastReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PutDemandForecastReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PutDemandForecastReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *PutDemandForecastReq) GetOnSaleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OnSaleAt
+	}
+	return nil
+}
+
+func (x *PutDemandForecastReq) GetPoints() []*DemandPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *PutDemandForecastReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// DemandPoint is a point of a demand curve: the sales rate expected from its offset until
+// the next point's. The last point's rate lasts until sell-out.
+type DemandPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OffsetSeconds int32                  `protobuf:"varint,1,opt,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"`
+	// Expected sales in tickets per second
+	SalesPerSecond float64 `protobuf:"fixed64,2,opt,name=sales_per_second,json=salesPerSecond,proto3" json:"sales_per_second,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DemandPoint) Reset() {
+	*x = DemandPoint{}
+	mi := &file_proto_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemandPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemandPoint) ProtoMessage() {}
+
+func (x *DemandPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemandPoint.ProtoReflect.Descriptor instead.
+func (*DemandPoint) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DemandPoint) GetOffsetSeconds() int32 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return 0
+}
+
+func (x *DemandPoint) GetSalesPerSecond() float64 {
+	if x != nil {
+		return x.SalesPerSecond
+	}
+	return 0
+}
+
+// PutDemandForecastRes represents the response to storing a demand forecast
+type PutDemandForecastRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "UPDATED", "CLEARED"
+	// Highest expected sales rate of the curve, in tickets per second
+	PeakSalesPerSecond float64 `protobuf:"fixed64,2,opt,name=peak_sales_per_second,json=peakSalesPerSecond,proto3" json:"peak_sales_per_second,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PutDemandForecastRes) Reset() {
+	*x = PutDemandForecastRes{}
+	mi := &file_proto_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDemandForecastRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDemandForecastRes) ProtoMessage() {}
+
+func (x *PutDemandForecastRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDemandForecastRes.ProtoReflect.Descriptor instead.
+func (*PutDemandForecastRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PutDemandForecastRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PutDemandForecastRes) GetPeakSalesPerSecond() float64 {
+	if x != nil {
+		return x.PeakSalesPerSecond
+	}
+	return 0
+}
+
+// StreamEventStatsReq represents a subscription to per-event stats
+type StreamEventStatsReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventIds []string               `protobuf:"bytes,1,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	// Push interval (default 5, min 1)
+	IntervalSeconds int32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Individual performances of multi-performance events
+	Performances  []*PerformanceRef `protobuf:"bytes,3,rep,name=performances,proto3" json:"performances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventStatsReq) Reset() {
+	*x = StreamEventStatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventStatsReq) ProtoMessage() {}
+
+func (x *StreamEventStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventStatsReq.ProtoReflect.Descriptor instead.
+func (*StreamEventStatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StreamEventStatsReq) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *StreamEventStatsReq) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *StreamEventStatsReq) GetPerformances() []*PerformanceRef {
+	if x != nil {
+		return x.Performances
+	}
+	return nil
+}
+
+// PerformanceRef references one performance of an event
+type PerformanceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformanceRef) Reset() {
+	*x = PerformanceRef{}
+	mi := &file_proto_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceRef) ProtoMessage() {}
+
+func (x *PerformanceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceRef.ProtoReflect.Descriptor instead.
+func (*PerformanceRef) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PerformanceRef) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PerformanceRef) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// EventStats holds rolled-up stats of one event over the last interval
+type EventStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EventId            string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	InventoryType      string                 `protobuf:"bytes,2,opt,name=inventory_type,json=inventoryType,proto3" json:"inventory_type,omitempty"` // "QUANTITY", "SEAT"
+	CommitsPerSecond   float64                `protobuf:"fixed64,3,opt,name=commits_per_second,json=commitsPerSecond,proto3" json:"commits_per_second,omitempty"`
+	ConflictsPerSecond float64                `protobuf:"fixed64,4,opt,name=conflicts_per_second,json=conflictsPerSecond,proto3" json:"conflicts_per_second,omitempty"`
+	Remaining          int32                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// Seats currently on hold (seat events only)
+	Holds         int32  `protobuf:"varint,6,opt,name=holds,proto3" json:"holds,omitempty"`
+	PerformanceId string `protobuf:"bytes,7,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Hold funnel of this instance since it started: reservations that held seats, whose
+	// hold expired, and that committed held seats, with their mean hold-to-commit time
+	HoldsCreated           uint64  `protobuf:"varint,8,opt,name=holds_created,json=holdsCreated,proto3" json:"holds_created,omitempty"`
+	HoldsExpired           uint64  `protobuf:"varint,9,opt,name=holds_expired,json=holdsExpired,proto3" json:"holds_expired,omitempty"`
+	HoldsCommitted         uint64  `protobuf:"varint,10,opt,name=holds_committed,json=holdsCommitted,proto3" json:"holds_committed,omitempty"`
+	AvgHoldToCommitSeconds float64 `protobuf:"fixed64,11,opt,name=avg_hold_to_commit_seconds,json=avgHoldToCommitSeconds,proto3" json:"avg_hold_to_commit_seconds,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EventStats) Reset() {
+	*x = EventStats{}
+	mi := &file_proto_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStats) ProtoMessage() {}
+
+func (x *EventStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStats.ProtoReflect.Descriptor instead.
+func (*EventStats) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EventStats) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventStats) GetInventoryType() string {
+	if x != nil {
+		return x.InventoryType
+	}
+	return ""
+}
+
+func (x *EventStats) GetCommitsPerSecond() float64 {
+	if x != nil {
+		return x.CommitsPerSecond
+	}
+	return 0
+}
+
+func (x *EventStats) GetConflictsPerSecond() float64 {
+	if x != nil {
+		return x.ConflictsPerSecond
+	}
+	return 0
+}
+
+func (x *EventStats) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *EventStats) GetHolds() int32 {
+	if x != nil {
+		return x.Holds
+	}
+	return 0
+}
+
+func (x *EventStats) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *EventStats) GetHoldsCreated() uint64 {
+	if x != nil {
+		return x.HoldsCreated
+	}
+	return 0
+}
+
+func (x *EventStats) GetHoldsExpired() uint64 {
+	if x != nil {
+		return x.HoldsExpired
+	}
+	return 0
+}
+
+func (x *EventStats) GetHoldsCommitted() uint64 {
+	if x != nil {
+		return x.HoldsCommitted
+	}
+	return 0
+}
+
+func (x *EventStats) GetAvgHoldToCommitSeconds() float64 {
+	if x != nil {
+		return x.AvgHoldToCommitSeconds
+	}
+	return 0
+}
+
+// GetEventStatsReq represents a request for an event's stats
+type GetEventStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventStatsReq) Reset() {
+	*x = GetEventStatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsReq) ProtoMessage() {}
+
+func (x *GetEventStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsReq.ProtoReflect.Descriptor instead.
+func (*GetEventStatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEventStatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetEventStatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// EventStatsUpdate is one push of stats for all subscribed events
+type EventStatsUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	Events        []*EventStats          `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStatsUpdate) Reset() {
+	*x = EventStatsUpdate{}
+	mi := &file_proto_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStatsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStatsUpdate) ProtoMessage() {}
+
+func (x *EventStatsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStatsUpdate.ProtoReflect.Descriptor instead.
+func (*EventStatsUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EventStatsUpdate) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *EventStatsUpdate) GetEvents() []*EventStats {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// PutVenueTemplateReq represents a new version of a venue seat layout
+type PutVenueTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutVenueTemplateReq) Reset() {
+	*x = PutVenueTemplateReq{}
+	mi := &file_proto_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutVenueTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutVenueTemplateReq) ProtoMessage() {}
+
+func (x *PutVenueTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutVenueTemplateReq.ProtoReflect.Descriptor instead.
+func (*PutVenueTemplateReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PutVenueTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *PutVenueTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutVenueTemplateReq) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+// PutVenueTemplateRes represents the response to storing a venue template
+type PutVenueTemplateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutVenueTemplateRes) Reset() {
+	*x = PutVenueTemplateRes{}
+	mi := &file_proto_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutVenueTemplateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutVenueTemplateRes) ProtoMessage() {}
+
+func (x *PutVenueTemplateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutVenueTemplateRes.ProtoReflect.Descriptor instead.
+func (*PutVenueTemplateRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PutVenueTemplateRes) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// GetVenueTemplateReq represents a request to get a venue template
+type GetVenueTemplateReq struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version to get; 0 for the latest
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueTemplateReq) Reset() {
+	*x = GetVenueTemplateReq{}
+	mi := &file_proto_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueTemplateReq) ProtoMessage() {}
+
+func (x *GetVenueTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueTemplateReq.ProtoReflect.Descriptor instead.
+func (*GetVenueTemplateReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetVenueTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *GetVenueTemplateReq) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// GetVenueTemplateRes represents one version of a venue template
+type GetVenueTemplateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueTemplateRes) Reset() {
+	*x = GetVenueTemplateRes{}
+	mi := &file_proto_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueTemplateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueTemplateRes) ProtoMessage() {}
+
+func (x *GetVenueTemplateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueTemplateRes.ProtoReflect.Descriptor instead.
+func (*GetVenueTemplateRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetVenueTemplateRes) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *GetVenueTemplateRes) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetVenueTemplateRes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetVenueTemplateRes) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *GetVenueTemplateRes) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InstantiateVenueTemplateReq represents a request to create an event's seats from a template
+type InstantiateVenueTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version to instantiate; 0 for the latest
+	TemplateVersion int32 `protobuf:"varint,4,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// Seat map version observed by the caller; the change fails if it has changed
+	ExpectedSeatMapVersion int32 `protobuf:"varint,5,opt,name=expected_seat_map_version,json=expectedSeatMapVersion,proto3" json:"expected_seat_map_version,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InstantiateVenueTemplateReq) Reset() {
+	*x = InstantiateVenueTemplateReq{}
+	mi := &file_proto_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateVenueTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateVenueTemplateReq) ProtoMessage() {}
+
+func (x *InstantiateVenueTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateVenueTemplateReq.ProtoReflect.Descriptor instead.
+func (*InstantiateVenueTemplateReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InstantiateVenueTemplateReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *InstantiateVenueTemplateReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *InstantiateVenueTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateVenueTemplateReq) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *InstantiateVenueTemplateReq) GetExpectedSeatMapVersion() int32 {
+	if x != nil {
+		return x.ExpectedSeatMapVersion
+	}
+	return 0
+}
+
+// InstantiateVenueTemplateRes represents the response to template instantiation
+type InstantiateVenueTemplateRes struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TemplateVersion int32                  `protobuf:"varint,1,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	SeatsCreated    int32                  `protobuf:"varint,2,opt,name=seats_created,json=seatsCreated,proto3" json:"seats_created,omitempty"`
+	// Seat map version after the change
+	SeatMapVersion int32 `protobuf:"varint,3,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstantiateVenueTemplateRes) Reset() {
+	*x = InstantiateVenueTemplateRes{}
+	mi := &file_proto_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateVenueTemplateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateVenueTemplateRes) ProtoMessage() {}
+
+func (x *InstantiateVenueTemplateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateVenueTemplateRes.ProtoReflect.Descriptor instead.
+func (*InstantiateVenueTemplateRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InstantiateVenueTemplateRes) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *InstantiateVenueTemplateRes) GetSeatsCreated() int32 {
+	if x != nil {
+		return x.SeatsCreated
+	}
+	return 0
+}
+
+func (x *InstantiateVenueTemplateRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// SetHoldPolicyReq represents an event's hold policy. Zero values use the global
+// defaults; all zero clears the event's policy.
+type SetHoldPolicyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Hold TTL, capped by the service's maximum hold TTL
+	TtlSeconds int32 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Times a reservation may re-hold seats it already holds
+	MaxExtensions int32 `protobuf:"varint,4,opt,name=max_extensions,json=maxExtensions,proto3" json:"max_extensions,omitempty"`
+	// Largest number of seats in one hold (at most 50)
+	MaxSeats      int32 `protobuf:"varint,5,opt,name=max_seats,json=maxSeats,proto3" json:"max_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldPolicyReq) Reset() {
+	*x = SetHoldPolicyReq{}
+	mi := &file_proto_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldPolicyReq) ProtoMessage() {}
+
+func (x *SetHoldPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetHoldPolicyReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetHoldPolicyReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetHoldPolicyReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SetHoldPolicyReq) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SetHoldPolicyReq) GetMaxExtensions() int32 {
+	if x != nil {
+		return x.MaxExtensions
+	}
+	return 0
+}
+
+func (x *SetHoldPolicyReq) GetMaxSeats() int32 {
+	if x != nil {
+		return x.MaxSeats
+	}
+	return 0
+}
+
+// SetHoldPolicyRes represents the response to setting a hold policy
+type SetHoldPolicyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "UPDATED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldPolicyRes) Reset() {
+	*x = SetHoldPolicyRes{}
+	mi := &file_proto_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldPolicyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldPolicyRes) ProtoMessage() {}
+
+func (x *SetHoldPolicyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldPolicyRes.ProtoReflect.Descriptor instead.
+func (*SetHoldPolicyRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetHoldPolicyRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// SetSeatsMigrationReq represents a request to move an event's seats migration state
+type SetSeatsMigrationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// "DUAL_WRITE", "CUTOVER" or empty for the original table only
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeatsMigrationReq) Reset() {
+	*x = SetSeatsMigrationReq{}
+	mi := &file_proto_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatsMigrationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatsMigrationReq) ProtoMessage() {}
+
+func (x *SetSeatsMigrationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatsMigrationReq.ProtoReflect.Descriptor instead.
+func (*SetSeatsMigrationReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetSeatsMigrationReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetSeatsMigrationReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SetSeatsMigrationReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// SetSeatsMigrationRes represents the response to moving a seats migration state
+type SetSeatsMigrationRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Instances may route the event by its previous state until then; keep the event
+	// frozen until this time after a cutover change
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeatsMigrationRes) Reset() {
+	*x = SetSeatsMigrationRes{}
+	mi := &file_proto_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeatsMigrationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatsMigrationRes) ProtoMessage() {}
+
+func (x *SetSeatsMigrationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatsMigrationRes.ProtoReflect.Descriptor instead.
+func (*SetSeatsMigrationRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetSeatsMigrationRes) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SetSeatsMigrationRes) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+// VerifySeatsMigrationReq represents a request to verify an event's seats migration
+type VerifySeatsMigrationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Copy divergent seats from the authoritative table again
+	Repair        bool `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySeatsMigrationReq) Reset() {
+	*x = VerifySeatsMigrationReq{}
+	mi := &file_proto_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySeatsMigrationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySeatsMigrationReq) ProtoMessage() {}
+
+func (x *VerifySeatsMigrationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySeatsMigrationReq.ProtoReflect.Descriptor instead.
+func (*VerifySeatsMigrationReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifySeatsMigrationReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VerifySeatsMigrationReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *VerifySeatsMigrationReq) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+// VerifySeatsMigrationRes represents the result of a seats migration verification pass
+type VerifySeatsMigrationRes struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	State       string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	SourceSeats int32                  `protobuf:"varint,2,opt,name=source_seats,json=sourceSeats,proto3" json:"source_seats,omitempty"`
+	TargetSeats int32                  `protobuf:"varint,3,opt,name=target_seats,json=targetSeats,proto3" json:"target_seats,omitempty"`
+	// Seats absent from, different in, or only present in the mirror table
+	Missing       int32 `protobuf:"varint,4,opt,name=missing,proto3" json:"missing,omitempty"`
+	Mismatched    int32 `protobuf:"varint,5,opt,name=mismatched,proto3" json:"mismatched,omitempty"`
+	Extra         int32 `protobuf:"varint,6,opt,name=extra,proto3" json:"extra,omitempty"`
+	Repaired      int32 `protobuf:"varint,7,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySeatsMigrationRes) Reset() {
+	*x = VerifySeatsMigrationRes{}
+	mi := &file_proto_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySeatsMigrationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySeatsMigrationRes) ProtoMessage() {}
+
+func (x *VerifySeatsMigrationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySeatsMigrationRes.ProtoReflect.Descriptor instead.
+func (*VerifySeatsMigrationRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VerifySeatsMigrationRes) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *VerifySeatsMigrationRes) GetSourceSeats() int32 {
+	if x != nil {
+		return x.SourceSeats
+	}
+	return 0
+}
+
+func (x *VerifySeatsMigrationRes) GetTargetSeats() int32 {
+	if x != nil {
+		return x.TargetSeats
+	}
+	return 0
+}
+
+func (x *VerifySeatsMigrationRes) GetMissing() int32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *VerifySeatsMigrationRes) GetMismatched() int32 {
+	if x != nil {
+		return x.Mismatched
+	}
+	return 0
+}
+
+func (x *VerifySeatsMigrationRes) GetExtra() int32 {
+	if x != nil {
+		return x.Extra
+	}
+	return 0
+}
+
+func (x *VerifySeatsMigrationRes) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+// GetCanaryReportReq represents a request for the storage canary's comparisons
+type GetCanaryReportReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanaryReportReq) Reset() {
+	*x = GetCanaryReportReq{}
+	mi := &file_proto_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanaryReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanaryReportReq) ProtoMessage() {}
+
+func (x *GetCanaryReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanaryReportReq.ProtoReflect.Descriptor instead.
+func (*GetCanaryReportReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{45}
+}
+
+// GetCanaryReportRes reports the storage canary's comparisons since the instance started
+type GetCanaryReportRes struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Comparisons int64                  `protobuf:"varint,1,opt,name=comparisons,proto3" json:"comparisons,omitempty"`
+	Divergences int64                  `protobuf:"varint,2,opt,name=divergences,proto3" json:"divergences,omitempty"`
+	// Start of the current window without divergence and the comparisons made in it
+	CleanSince       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=clean_since,json=cleanSince,proto3" json:"clean_since,omitempty"`
+	CleanComparisons int64                  `protobuf:"varint,4,opt,name=clean_comparisons,json=cleanComparisons,proto3" json:"clean_comparisons,omitempty"`
+	LastDivergence   string                 `protobuf:"bytes,5,opt,name=last_divergence,json=lastDivergence,proto3" json:"last_divergence,omitempty"`
+	// True once the clean window spans MIGRATION_CANARY_CLEAN_WINDOW and
+	// MIGRATION_CANARY_MIN_COMPARISONS
+	CutoverReady  bool `protobuf:"varint,6,opt,name=cutover_ready,json=cutoverReady,proto3" json:"cutover_ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanaryReportRes) Reset() {
+	*x = GetCanaryReportRes{}
+	mi := &file_proto_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanaryReportRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanaryReportRes) ProtoMessage() {}
+
+func (x *GetCanaryReportRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanaryReportRes.ProtoReflect.Descriptor instead.
+func (*GetCanaryReportRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCanaryReportRes) GetComparisons() int64 {
+	if x != nil {
+		return x.Comparisons
+	}
+	return 0
+}
+
+func (x *GetCanaryReportRes) GetDivergences() int64 {
+	if x != nil {
+		return x.Divergences
+	}
+	return 0
+}
+
+func (x *GetCanaryReportRes) GetCleanSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CleanSince
+	}
+	return nil
+}
+
+func (x *GetCanaryReportRes) GetCleanComparisons() int64 {
+	if x != nil {
+		return x.CleanComparisons
+	}
+	return 0
+}
+
+func (x *GetCanaryReportRes) GetLastDivergence() string {
+	if x != nil {
+		return x.LastDivergence
+	}
+	return ""
+}
+
+func (x *GetCanaryReportRes) GetCutoverReady() bool {
+	if x != nil {
+		return x.CutoverReady
+	}
+	return false
+}
+
+// StartOperationReq represents a request to run a bulk operation in the background
+type StartOperationReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*StartOperationReq_ReleaseEventHolds
+	//	*StartOperationReq_InstantiateVenueTemplate
+	//	*StartOperationReq_CloseEvent
+	Request       isStartOperationReq_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOperationReq) Reset() {
+	*x = StartOperationReq{}
+	mi := &file_proto_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOperationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOperationReq) ProtoMessage() {}
+
+func (x *StartOperationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOperationReq.ProtoReflect.Descriptor instead.
+func (*StartOperationReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StartOperationReq) GetRequest() isStartOperationReq_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *StartOperationReq) GetReleaseEventHolds() *ReleaseEventHoldsReq {
+	if x != nil {
+		if x, ok := x.Request.(*StartOperationReq_ReleaseEventHolds); ok {
+			return x.ReleaseEventHolds
+		}
+	}
+	return nil
+}
+
+func (x *StartOperationReq) GetInstantiateVenueTemplate() *InstantiateVenueTemplateReq {
+	if x != nil {
+		if x, ok := x.Request.(*StartOperationReq_InstantiateVenueTemplate); ok {
+			return x.InstantiateVenueTemplate
+		}
+	}
+	return nil
+}
+
+func (x *StartOperationReq) GetCloseEvent() *CloseEventReq {
+	if x != nil {
+		if x, ok := x.Request.(*StartOperationReq_CloseEvent); ok {
+			return x.CloseEvent
+		}
+	}
+	return nil
+}
+
+type isStartOperationReq_Request interface {
+	isStartOperationReq_Request()
+}
+
+type StartOperationReq_ReleaseEventHolds struct {
+	ReleaseEventHolds *ReleaseEventHoldsReq `protobuf:"bytes,1,opt,name=release_event_holds,json=releaseEventHolds,proto3,oneof"`
+}
+
+type StartOperationReq_InstantiateVenueTemplate struct {
+	InstantiateVenueTemplate *InstantiateVenueTemplateReq `protobuf:"bytes,2,opt,name=instantiate_venue_template,json=instantiateVenueTemplate,proto3,oneof"`
+}
+
+type StartOperationReq_CloseEvent struct {
+	CloseEvent *CloseEventReq `protobuf:"bytes,3,opt,name=close_event,json=closeEvent,proto3,oneof"`
+}
+
+func (*StartOperationReq_ReleaseEventHolds) isStartOperationReq_Request() {}
+
+func (*StartOperationReq_InstantiateVenueTemplate) isStartOperationReq_Request() {}
+
+func (*StartOperationReq_CloseEvent) isStartOperationReq_Request() {}
+
+// GetOperationReq represents a request for an operation's state
+type GetOperationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationReq) Reset() {
+	*x = GetOperationReq{}
+	mi := &file_proto_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationReq) ProtoMessage() {}
+
+func (x *GetOperationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationReq.ProtoReflect.Descriptor instead.
+func (*GetOperationReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetOperationReq) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// CancelOperationReq represents a request to cancel a running operation
+type CancelOperationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOperationReq) Reset() {
+	*x = CancelOperationReq{}
+	mi := &file_proto_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOperationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationReq) ProtoMessage() {}
+
+func (x *CancelOperationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationReq.ProtoReflect.Descriptor instead.
+func (*CancelOperationReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CancelOperationReq) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// Operation represents the state of a long-running admin operation
+type Operation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OperationId     string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "RELEASE_EVENT_HOLDS", "INSTANTIATE_VENUE_TEMPLATE", "CLOSE_EVENT"
+	EventId         string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	State           string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // "RUNNING", "SUCCEEDED", "FAILED", "CANCELLED"
+	CancelRequested bool                   `protobuf:"varint,5,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	// Items to process, 0 while unknown
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,7,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed        int32                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_proto_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Operation) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *Operation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Operation) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Operation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Operation) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *Operation) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Operation) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *Operation) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *Operation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// UpsertSeatsReq represents one page of a bulk seat upsert
+type UpsertSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Client-chosen ID shared by all pages of one upload
+	UploadId string `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// next_cursor of the previous page; empty for the first page
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// At most 1000 seats
+	Seats []*SeatUpsert `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	// Seat map version observed by the caller; the change fails if it has changed
+	ExpectedSeatMapVersion int32 `protobuf:"varint,6,opt,name=expected_seat_map_version,json=expectedSeatMapVersion,proto3" json:"expected_seat_map_version,omitempty"`
+	// Required on the first page; later pages may repeat it unchanged
+	Manifest *SeatManifest `protobuf:"bytes,7,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Hex SHA-256 of this page's rows; a mismatching page is refused unapplied
+	PageSha256 string `protobuf:"bytes,8,opt,name=page_sha256,json=pageSha256,proto3" json:"page_sha256,omitempty"`
+	// Lease of a locked section the change is made under
+	LeaseId       string `protobuf:"bytes,9,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertSeatsReq) Reset() {
+	*x = UpsertSeatsReq{}
+	mi := &file_proto_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertSeatsReq) ProtoMessage() {}
+
+func (x *UpsertSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertSeatsReq.ProtoReflect.Descriptor instead.
+func (*UpsertSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpsertSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpsertSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *UpsertSeatsReq) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UpsertSeatsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *UpsertSeatsReq) GetSeats() []*SeatUpsert {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *UpsertSeatsReq) GetExpectedSeatMapVersion() int32 {
+	if x != nil {
+		return x.ExpectedSeatMapVersion
+	}
+	return 0
+}
+
+func (x *UpsertSeatsReq) GetManifest() *SeatManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *UpsertSeatsReq) GetPageSha256() string {
+	if x != nil {
+		return x.PageSha256
+	}
+	return ""
+}
+
+func (x *UpsertSeatsReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// SeatManifest describes a whole upload. Rows are hashed as "<seat_id>,<status>\n"
+// with the status defaulted to AVAILABLE, in upload order across all pages.
+type SeatManifest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows  int64                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TotalPages int32                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Hex SHA-256 of all rows of the upload
+	Sha256        string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatManifest) Reset() {
+	*x = SeatManifest{}
+	mi := &file_proto_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatManifest) ProtoMessage() {}
+
+func (x *SeatManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatManifest.ProtoReflect.Descriptor instead.
+func (*SeatManifest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SeatManifest) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *SeatManifest) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *SeatManifest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// SeatUpsert represents the desired state of a seat
+type SeatUpsert struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SeatId string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// "AVAILABLE" (default) or "BLOCKED"
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatUpsert) Reset() {
+	*x = SeatUpsert{}
+	mi := &file_proto_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatUpsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatUpsert) ProtoMessage() {}
+
+func (x *SeatUpsert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatUpsert.ProtoReflect.Descriptor instead.
+func (*SeatUpsert) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SeatUpsert) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatUpsert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// UpsertSeatsRes acknowledges a page of a bulk seat upsert
+type UpsertSeatsRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cursor to send with the next page
+	NextCursor string `protobuf:"bytes,1,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Upserted   int32  `protobuf:"varint,2,opt,name=upserted,proto3" json:"upserted,omitempty"`
+	// Seats left unchanged because they are held, sold or reserved
+	SkippedSeatIds []string `protobuf:"bytes,3,rep,name=skipped_seat_ids,json=skippedSeatIds,proto3" json:"skipped_seat_ids,omitempty"`
+	// The page had already been applied; counts refer to the upload so far
+	Duplicate     bool  `protobuf:"varint,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	TotalUpserted int64 `protobuf:"varint,5,opt,name=total_upserted,json=totalUpserted,proto3" json:"total_upserted,omitempty"`
+	TotalSkipped  int64 `protobuf:"varint,6,opt,name=total_skipped,json=totalSkipped,proto3" json:"total_skipped,omitempty"`
+	// Seat map version after the change
+	SeatMapVersion int32 `protobuf:"varint,7,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	// Outcome of every seat of the page, in request order; empty for duplicate pages
+	Results []*BatchResult `protobuf:"bytes,8,rep,name=results,proto3" json:"results,omitempty"`
+	// The page was the last one and the upload matched its manifest
+	Verified      bool `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertSeatsRes) Reset() {
+	*x = UpsertSeatsRes{}
+	mi := &file_proto_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertSeatsRes) ProtoMessage() {}
+
+func (x *UpsertSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertSeatsRes.ProtoReflect.Descriptor instead.
+func (*UpsertSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpsertSeatsRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *UpsertSeatsRes) GetUpserted() int32 {
+	if x != nil {
+		return x.Upserted
+	}
+	return 0
+}
+
+func (x *UpsertSeatsRes) GetSkippedSeatIds() []string {
+	if x != nil {
+		return x.SkippedSeatIds
+	}
+	return nil
+}
+
+func (x *UpsertSeatsRes) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *UpsertSeatsRes) GetTotalUpserted() int64 {
+	if x != nil {
+		return x.TotalUpserted
+	}
+	return 0
+}
+
+func (x *UpsertSeatsRes) GetTotalSkipped() int64 {
+	if x != nil {
+		return x.TotalSkipped
+	}
+	return 0
+}
+
+func (x *UpsertSeatsRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+func (x *UpsertSeatsRes) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UpsertSeatsRes) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+// GetSeatUploadReq represents a request for the cursor of an upload
+type GetSeatUploadReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatUploadReq) Reset() {
+	*x = GetSeatUploadReq{}
+	mi := &file_proto_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatUploadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatUploadReq) ProtoMessage() {}
+
+func (x *GetSeatUploadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatUploadReq.ProtoReflect.Descriptor instead.
+func (*GetSeatUploadReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetSeatUploadReq) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// GetSeatUploadRes represents the cursor of an upload
+type GetSeatUploadRes struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Cursor to send with the next page
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	TotalUpserted int64                  `protobuf:"varint,3,opt,name=total_upserted,json=totalUpserted,proto3" json:"total_upserted,omitempty"`
+	TotalSkipped  int64                  `protobuf:"varint,4,opt,name=total_skipped,json=totalSkipped,proto3" json:"total_skipped,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,6,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Manifest      *SeatManifest          `protobuf:"bytes,7,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Set once the last page matched the manifest
+	VerifiedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatUploadRes) Reset() {
+	*x = GetSeatUploadRes{}
+	mi := &file_proto_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatUploadRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatUploadRes) ProtoMessage() {}
+
+func (x *GetSeatUploadRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatUploadRes.ProtoReflect.Descriptor instead.
+func (*GetSeatUploadRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetSeatUploadRes) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetSeatUploadRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetSeatUploadRes) GetTotalUpserted() int64 {
+	if x != nil {
+		return x.TotalUpserted
+	}
+	return 0
+}
+
+func (x *GetSeatUploadRes) GetTotalSkipped() int64 {
+	if x != nil {
+		return x.TotalSkipped
+	}
+	return 0
+}
+
+func (x *GetSeatUploadRes) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetSeatUploadRes) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *GetSeatUploadRes) GetManifest() *SeatManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *GetSeatUploadRes) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+// ErasureReference is a reservation of a data subject, as known to the reservation service
+type ErasureReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Event the reservation was made for; its seats are tokenized when set
+	EventId       string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Order the reservation was committed as; its commit status is tokenized when set
+	OrderId       string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasureReference) Reset() {
+	*x = ErasureReference{}
+	mi := &file_proto_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureReference) ProtoMessage() {}
+
+func (x *ErasureReference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureReference.ProtoReflect.Descriptor instead.
+func (*ErasureReference) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ErasureReference) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ErasureReference) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ErasureReference) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *ErasureReference) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// EraseSubjectReq represents a request to erase the reservation references of a data subject
+type EraseSubjectReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the erasure request (e.g. the privacy ticket), recorded in the audit log
+	ErasureId     string              `protobuf:"bytes,1,opt,name=erasure_id,json=erasureId,proto3" json:"erasure_id,omitempty"`
+	References    []*ErasureReference `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseSubjectReq) Reset() {
+	*x = EraseSubjectReq{}
+	mi := &file_proto_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseSubjectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseSubjectReq) ProtoMessage() {}
+
+func (x *EraseSubjectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseSubjectReq.ProtoReflect.Descriptor instead.
+func (*EraseSubjectReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EraseSubjectReq) GetErasureId() string {
+	if x != nil {
+		return x.ErasureId
+	}
+	return ""
+}
+
+func (x *EraseSubjectReq) GetReferences() []*ErasureReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// EraseSubjectRes reports what an erasure changed
+type EraseSubjectRes struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	ErasureId                 string                 `protobuf:"bytes,1,opt,name=erasure_id,json=erasureId,proto3" json:"erasure_id,omitempty"`
+	SeatsTokenized            int32                  `protobuf:"varint,2,opt,name=seats_tokenized,json=seatsTokenized,proto3" json:"seats_tokenized,omitempty"`
+	CommitStatusesTokenized   int32                  `protobuf:"varint,3,opt,name=commit_statuses_tokenized,json=commitStatusesTokenized,proto3" json:"commit_statuses_tokenized,omitempty"`
+	IdempotencyRecordsDeleted int32                  `protobuf:"varint,4,opt,name=idempotency_records_deleted,json=idempotencyRecordsDeleted,proto3" json:"idempotency_records_deleted,omitempty"`
+	// Seats still held by one of the reservations; they are left unchanged
+	SeatsHeld int32 `protobuf:"varint,5,opt,name=seats_held,json=seatsHeld,proto3" json:"seats_held,omitempty"`
+	// True when no record referencing the reservations remains
+	Complete      bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseSubjectRes) Reset() {
+	*x = EraseSubjectRes{}
+	mi := &file_proto_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseSubjectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseSubjectRes) ProtoMessage() {}
+
+func (x *EraseSubjectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseSubjectRes.ProtoReflect.Descriptor instead.
+func (*EraseSubjectRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EraseSubjectRes) GetErasureId() string {
+	if x != nil {
+		return x.ErasureId
+	}
+	return ""
+}
+
+func (x *EraseSubjectRes) GetSeatsTokenized() int32 {
+	if x != nil {
+		return x.SeatsTokenized
+	}
+	return 0
+}
+
+func (x *EraseSubjectRes) GetCommitStatusesTokenized() int32 {
+	if x != nil {
+		return x.CommitStatusesTokenized
+	}
+	return 0
+}
+
+func (x *EraseSubjectRes) GetIdempotencyRecordsDeleted() int32 {
+	if x != nil {
+		return x.IdempotencyRecordsDeleted
+	}
+	return 0
+}
+
+func (x *EraseSubjectRes) GetSeatsHeld() int32 {
+	if x != nil {
+		return x.SeatsHeld
+	}
+	return 0
+}
+
+func (x *EraseSubjectRes) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *EraseSubjectRes) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// WrapFieldEncryptionKeyReq represents a request to wrap the field encryption data key
+type WrapFieldEncryptionKeyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// KMS key ID, ARN or alias to wrap the data key under
+	KmsKeyId      string `protobuf:"bytes,1,opt,name=kms_key_id,json=kmsKeyId,proto3" json:"kms_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WrapFieldEncryptionKeyReq) Reset() {
+	*x = WrapFieldEncryptionKeyReq{}
+	mi := &file_proto_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WrapFieldEncryptionKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapFieldEncryptionKeyReq) ProtoMessage() {}
+
+func (x *WrapFieldEncryptionKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapFieldEncryptionKeyReq.ProtoReflect.Descriptor instead.
+func (*WrapFieldEncryptionKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WrapFieldEncryptionKeyReq) GetKmsKeyId() string {
+	if x != nil {
+		return x.KmsKeyId
+	}
+	return ""
+}
+
+// WrapFieldEncryptionKeyRes carries the wrapped data key
+type WrapFieldEncryptionKeyRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64 wrapped data key to deploy as DDB_FIELD_ENCRYPTION_DATA_KEY
+	WrappedDataKey string `protobuf:"bytes,1,opt,name=wrapped_data_key,json=wrappedDataKey,proto3" json:"wrapped_data_key,omitempty"`
+	// True when a new data key was generated rather than the configured one re-wrapped
+	Generated     bool `protobuf:"varint,2,opt,name=generated,proto3" json:"generated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WrapFieldEncryptionKeyRes) Reset() {
+	*x = WrapFieldEncryptionKeyRes{}
+	mi := &file_proto_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WrapFieldEncryptionKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapFieldEncryptionKeyRes) ProtoMessage() {}
+
+func (x *WrapFieldEncryptionKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapFieldEncryptionKeyRes.ProtoReflect.Descriptor instead.
+func (*WrapFieldEncryptionKeyRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WrapFieldEncryptionKeyRes) GetWrappedDataKey() string {
+	if x != nil {
+		return x.WrappedDataKey
+	}
+	return ""
+}
+
+func (x *WrapFieldEncryptionKeyRes) GetGenerated() bool {
+	if x != nil {
+		return x.Generated
+	}
+	return false
+}
+
+// SetVisibilityRuleReq represents a request to hide a segment of an event's seats
+type SetVisibilityRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat ID prefix of the segment, e.g. "VIP-"
+	Segment string `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	// When the segment is revealed to everyone; unset hides it until RevealSegment
+	RevealAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reveal_at,json=revealAt,proto3" json:"reveal_at,omitempty"`
+	// Presale code that reveals the segment early to callers presenting it; empty for none
+	AccessCode    string `protobuf:"bytes,5,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVisibilityRuleReq) Reset() {
+	*x = SetVisibilityRuleReq{}
+	mi := &file_proto_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVisibilityRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityRuleReq) ProtoMessage() {}
+
+func (x *SetVisibilityRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityRuleReq.ProtoReflect.Descriptor instead.
+func (*SetVisibilityRuleReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetVisibilityRuleReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetVisibilityRuleReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SetVisibilityRuleReq) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *SetVisibilityRuleReq) GetRevealAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealAt
+	}
+	return nil
+}
+
+func (x *SetVisibilityRuleReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+// SetVisibilityRuleRes represents the response to setting a visibility rule
+type SetVisibilityRuleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "UPDATED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVisibilityRuleRes) Reset() {
+	*x = SetVisibilityRuleRes{}
+	mi := &file_proto_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVisibilityRuleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityRuleRes) ProtoMessage() {}
+
+func (x *SetVisibilityRuleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityRuleRes.ProtoReflect.Descriptor instead.
+func (*SetVisibilityRuleRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetVisibilityRuleRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// RevealSegmentReq represents a request to reveal a hidden segment
+type RevealSegmentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Segment       string                 `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealSegmentReq) Reset() {
+	*x = RevealSegmentReq{}
+	mi := &file_proto_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealSegmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealSegmentReq) ProtoMessage() {}
+
+func (x *RevealSegmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealSegmentReq.ProtoReflect.Descriptor instead.
+func (*RevealSegmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RevealSegmentReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RevealSegmentReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *RevealSegmentReq) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+// RevealSegmentRes represents the response to revealing a segment
+type RevealSegmentRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "REVEALED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealSegmentRes) Reset() {
+	*x = RevealSegmentRes{}
+	mi := &file_proto_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealSegmentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealSegmentRes) ProtoMessage() {}
+
+func (x *RevealSegmentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealSegmentRes.ProtoReflect.Descriptor instead.
+func (*RevealSegmentRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RevealSegmentRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// CreateEventReq represents a request to create an event from a seat layout
+type CreateEventReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Sections      []*SeatLayoutSection   `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventReq) Reset() {
+	*x = CreateEventReq{}
+	mi := &file_proto_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventReq) ProtoMessage() {}
+
+func (x *CreateEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventReq.ProtoReflect.Descriptor instead.
+func (*CreateEventReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateEventReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateEventReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *CreateEventReq) GetSections() []*SeatLayoutSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// SeatLayoutSection describes a section of a seat layout. Its seats are named
+// "<section>-<row>-<number>", numbered from 1 in each row.
+type SeatLayoutSection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Section     string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"` // must not contain "-"
+	Rows        []string               `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	SeatsPerRow int32                  `protobuf:"varint,3,opt,name=seats_per_row,json=seatsPerRow,proto3" json:"seats_per_row,omitempty"`
+	// Seat numbers followed by an aisle; the seats either side of an aisle aren't adjacent
+	AislesAfter   []int32 `protobuf:"varint,4,rep,packed,name=aisles_after,json=aislesAfter,proto3" json:"aisles_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatLayoutSection) Reset() {
+	*x = SeatLayoutSection{}
+	mi := &file_proto_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatLayoutSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatLayoutSection) ProtoMessage() {}
+
+func (x *SeatLayoutSection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatLayoutSection.ProtoReflect.Descriptor instead.
+func (*SeatLayoutSection) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SeatLayoutSection) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatLayoutSection) GetRows() []string {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *SeatLayoutSection) GetSeatsPerRow() int32 {
+	if x != nil {
+		return x.SeatsPerRow
+	}
+	return 0
+}
+
+func (x *SeatLayoutSection) GetAislesAfter() []int32 {
+	if x != nil {
+		return x.AislesAfter
+	}
+	return nil
+}
+
+// CreateEventProgress reports cumulative progress of provisioning an event's seats
+type CreateEventProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalSeats    int32                  `protobuf:"varint,1,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	Provisioned   int32                  `protobuf:"varint,2,opt,name=provisioned,proto3" json:"provisioned,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventProgress) Reset() {
+	*x = CreateEventProgress{}
+	mi := &file_proto_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventProgress) ProtoMessage() {}
+
+func (x *CreateEventProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventProgress.ProtoReflect.Descriptor instead.
+func (*CreateEventProgress) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateEventProgress) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *CreateEventProgress) GetProvisioned() int32 {
+	if x != nil {
+		return x.Provisioned
+	}
+	return 0
+}
+
+func (x *CreateEventProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// AcquireSectionLockReq represents a request to lease a section for editing
+type AcquireSectionLockReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat ID prefix before the first "-", e.g. "A" for seat "A-12"
+	Section string `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	// Editor holding the lease, reported to conflicting callers
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Lease duration (default 60, max 600)
+	TtlSeconds    int32 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireSectionLockReq) Reset() {
+	*x = AcquireSectionLockReq{}
+	mi := &file_proto_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireSectionLockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireSectionLockReq) ProtoMessage() {}
+
+func (x *AcquireSectionLockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireSectionLockReq.ProtoReflect.Descriptor instead.
+func (*AcquireSectionLockReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AcquireSectionLockReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AcquireSectionLockReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *AcquireSectionLockReq) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *AcquireSectionLockReq) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *AcquireSectionLockReq) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// RenewSectionLockReq represents a request to extend a section lease
+type RenewSectionLockReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Section       string                 `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	LeaseId       string                 `protobuf:"bytes,4,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// New lease duration from now (default 60, max 600)
+	TtlSeconds    int32 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSectionLockReq) Reset() {
+	*x = RenewSectionLockReq{}
+	mi := &file_proto_admin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSectionLockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSectionLockReq) ProtoMessage() {}
+
+func (x *RenewSectionLockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSectionLockReq.ProtoReflect.Descriptor instead.
+func (*RenewSectionLockReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RenewSectionLockReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RenewSectionLockReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *RenewSectionLockReq) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *RenewSectionLockReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *RenewSectionLockReq) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// SectionLease describes a live section lease
+type SectionLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Section       string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Holder        string                 `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionLease) Reset() {
+	*x = SectionLease{}
+	mi := &file_proto_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionLease) ProtoMessage() {}
+
+func (x *SectionLease) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionLease.ProtoReflect.Descriptor instead.
+func (*SectionLease) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SectionLease) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *SectionLease) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SectionLease) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *SectionLease) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// ReleaseSectionLockReq represents a request to end a section lease
+type ReleaseSectionLockReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Section       string                 `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	LeaseId       string                 `protobuf:"bytes,4,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSectionLockReq) Reset() {
+	*x = ReleaseSectionLockReq{}
+	mi := &file_proto_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSectionLockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSectionLockReq) ProtoMessage() {}
+
+func (x *ReleaseSectionLockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSectionLockReq.ProtoReflect.Descriptor instead.
+func (*ReleaseSectionLockReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReleaseSectionLockReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReleaseSectionLockReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *ReleaseSectionLockReq) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ReleaseSectionLockReq) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// ReleaseSectionLockRes represents the response to ending a section lease
+type ReleaseSectionLockRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "RELEASED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSectionLockRes) Reset() {
+	*x = ReleaseSectionLockRes{}
+	mi := &file_proto_admin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSectionLockRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSectionLockRes) ProtoMessage() {}
+
+func (x *ReleaseSectionLockRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSectionLockRes.ProtoReflect.Descriptor instead.
+func (*ReleaseSectionLockRes) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReleaseSectionLockRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// CloseEventReq represents a request to close an event's remaining seats
+type CloseEventReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// SEAT_STATUS_SOLD or SEAT_STATUS_CLOSED
+	Status SeatStatus `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	Reason string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Seats changed per transaction (default 25, max 99)
+	ChunkSize int32 `protobuf:"varint,5,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// Rate limit of seat changes (default 200)
+	SeatsPerSecond int32 `protobuf:"varint,6,opt,name=seats_per_second,json=seatsPerSecond,proto3" json:"seats_per_second,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloseEventReq) Reset() {
+	*x = CloseEventReq{}
+	mi := &file_proto_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEventReq) ProtoMessage() {}
+
+func (x *CloseEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseEventReq.ProtoReflect.Descriptor instead.
+func (*CloseEventReq) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CloseEventReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CloseEventReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *CloseEventReq) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *CloseEventReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CloseEventReq) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *CloseEventReq) GetSeatsPerSecond() int32 {
+	if x != nil {
+		return x.SeatsPerSecond
+	}
+	return 0
+}
+
+// CloseEventProgress reports cumulative progress of closing an event
+type CloseEventProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Groups the ledger entries of this run
+	CloseId string `protobuf:"bytes,1,opt,name=close_id,json=closeId,proto3" json:"close_id,omitempty"`
+	Scanned int32  `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Closed  int32  `protobuf:"varint,3,opt,name=closed,proto3" json:"closed,omitempty"`
+	// Seats skipped because they changed meanwhile; rerun to retry
+	Failed        int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	LedgerEntries int32 `protobuf:"varint,5,opt,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	Done          bool  `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseEventProgress) Reset() {
+	*x = CloseEventProgress{}
+	mi := &file_proto_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseEventProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEventProgress) ProtoMessage() {}
+
+func (x *CloseEventProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseEventProgress.ProtoReflect.Descriptor instead.
+func (*CloseEventProgress) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CloseEventProgress) GetCloseId() string {
+	if x != nil {
+		return x.CloseId
+	}
+	return ""
+}
+
+func (x *CloseEventProgress) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *CloseEventProgress) GetClosed() int32 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *CloseEventProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CloseEventProgress) GetLedgerEntries() int32 {
+	if x != nil {
+		return x.LedgerEntries
+	}
+	return 0
+}
+
+func (x *CloseEventProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15proto/inventory.proto\"\x96\x01\n" +
+	"\x11AdjustCapacityReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x05R\x0fexpectedVersion\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\"K\n" +
+	"\x11AdjustCapacityRes\x12\x1c\n" +
+	"\tremaining\x18\x01 \x01(\x05R\tremaining\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xf1\x01\n" +
+	"\rBlockSeatsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\bseat_ids\x18\x02 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\x129\n" +
+	"\x19expected_seat_map_version\x18\x05 \x01(\x05R\x16expectedSeatMapVersion\x12\x19\n" +
+	"\blease_id\x18\x06 \x01(\tR\aleaseId\"Q\n" +
+	"\rBlockSeatsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12(\n" +
+	"\x10seat_map_version\x18\x02 \x01(\x05R\x0eseatMapVersion\"\xdb\x01\n" +
+	"\x0fUnblockSeatsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\bseat_ids\x18\x02 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\x129\n" +
+	"\x19expected_seat_map_version\x18\x04 \x01(\x05R\x16expectedSeatMapVersion\x12\x19\n" +
+	"\blease_id\x18\x05 \x01(\tR\aleaseId\"S\n" +
+	"\x0fUnblockSeatsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12(\n" +
+	"\x10seat_map_version\x18\x02 \x01(\x05R\x0eseatMapVersion\"\xa6\x02\n" +
+	"\x10SetSeatStatusReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\bseat_ids\x18\x02 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12%\n" +
+	"\x0eperformance_id\x18\x05 \x01(\tR\rperformanceId\x129\n" +
+	"\x19expected_seat_map_version\x18\x06 \x01(\x05R\x16expectedSeatMapVersion\x12\x19\n" +
+	"\blease_id\x18\a \x01(\tR\aleaseId\"n\n" +
+	"\x10SetSeatStatusRes\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x12(\n" +
+	"\x10seat_map_version\x18\x02 \x01(\x05R\x0eseatMapVersion\"\xc0\x02\n" +
+	"\x12SetSeatMetadataReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\bseat_ids\x18\x02 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12J\n" +
+	"\bmetadata\x18\x03 \x03(\v2..inventory.v1.SetSeatMetadataReq.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12%\n" +
+	"\x0eperformance_id\x18\x05 \x01(\tR\rperformanceId\x12\x19\n" +
+	"\blease_id\x18\x06 \x01(\tR\aleaseId\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x12SetSeatMetadataRes\x12(\n" +
+	"\x05seats\x18\x01 \x03(\v2\x12.inventory.v1.SeatR\x05seats\"\x81\x01\n" +
+	"\vGetSeatsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\bseat_ids\x18\x02 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\"7\n" +
+	"\vGetSeatsRes\x12(\n" +
+	"\x05seats\x18\x01 \x03(\v2\x12.inventory.v1.SeatR\x05seats\"I\n" +
+	"\x15SetMaintenanceModeReq\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"1\n" +
+	"\x15SetMaintenanceModeRes\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"j\n" +
+	"\x0eFreezeEventReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\"(\n" +
+	"\x0eFreezeEventRes\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\"T\n" +
+	"\x10UnfreezeEventReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"*\n" +
+	"\x10UnfreezeEventRes\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\"\xa5\x01\n" +
+	"\x14ReleaseEventHoldsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12,\n" +
+	"\x12older_than_seconds\x18\x02 \x01(\x05R\x10olderThanSeconds\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\x05R\tchunkSize\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\"\xbb\x01\n" +
+	"\x19ReleaseEventHoldsProgress\x12\x18\n" +
+	"\ascanned\x18\x01 \x01(\x05R\ascanned\x12\x1a\n" +
+	"\breleased\x18\x02 \x01(\x05R\breleased\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12<\n" +
+	"\ffailed_seats\x18\x05 \x03(\v2\x19.inventory.v1.BatchResultR\vfailedSeats\"U\n" +
+	"\x11ListStuckHoldsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"\xc8\x01\n" +
+	"\tStuckHold\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aseat_id\x18\x02 \x01(\tR\x06seatId\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\x129\n" +
+	"\n" +
+	"held_since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\theldSince\x12%\n" +
+	"\x0eperformance_id\x18\x05 \x01(\tR\rperformanceId\"B\n" +
+	"\x11ListStuckHoldsRes\x12-\n" +
+	"\x05holds\x18\x01 \x03(\v2\x17.inventory.v1.StuckHoldR\x05holds\"\xef\x01\n" +
+	"\x0fPlanCapacityReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12(\n" +
+	"\x10sales_per_second\x18\x02 \x01(\x01R\x0esalesPerSecond\x12)\n" +
+	"\x10lookback_seconds\x18\x03 \x01(\x05R\x0flookbackSeconds\x12\x1f\n" +
+	"\vpeak_factor\x18\x04 \x01(\x01R\n" +
+	"peakFactor\x12$\n" +
+	"\x0eavg_order_size\x18\x05 \x01(\x01R\favgOrderSize\x12%\n" +
+	"\x0eperformance_id\x18\x06 \x01(\tR\rperformanceId\"\x8d\x03\n" +
+	"\x0fPlanCapacityRes\x12\x1c\n" +
+	"\tremaining\x18\x01 \x01(\x05R\tremaining\x12%\n" +
+	"\x0einventory_type\x18\x02 \x01(\tR\rinventoryType\x12(\n" +
+	"\x10sales_per_second\x18\x03 \x01(\x01R\x0esalesPerSecond\x12'\n" +
+	"\x0fvelocity_source\x18\x04 \x01(\tR\x0evelocitySource\x12G\n" +
+	"\x11projected_sellout\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10projectedSellout\x12,\n" +
+	"\x12seconds_to_sellout\x18\x06 \x01(\x03R\x10secondsToSellout\x12<\n" +
+	"\x1bpeak_write_units_per_second\x18\a \x01(\x01R\x17peakWriteUnitsPerSecond\x12-\n" +
+	"\x12recommended_shards\x18\b \x01(\x05R\x11recommendedShards\"\xdd\x01\n" +
+	"\x14PutDemandForecastReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x128\n" +
+	"\n" +
+	"on_sale_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bonSaleAt\x121\n" +
+	"\x06points\x18\x04 \x03(\v2\x19.inventory.v1.DemandPointR\x06points\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"^\n" +
+	"\vDemandPoint\x12%\n" +
+	"\x0eoffset_seconds\x18\x01 \x01(\x05R\roffsetSeconds\x12(\n" +
+	"\x10sales_per_second\x18\x02 \x01(\x01R\x0esalesPerSecond\"a\n" +
+	"\x14PutDemandForecastRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x121\n" +
+	"\x15peak_sales_per_second\x18\x02 \x01(\x01R\x12peakSalesPerSecond\"\x9f\x01\n" +
+	"\x13StreamEventStatsReq\x12\x1b\n" +
+	"\tevent_ids\x18\x01 \x03(\tR\beventIds\x12)\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x05R\x0fintervalSeconds\x12@\n" +
+	"\fperformances\x18\x03 \x03(\v2\x1c.inventory.v1.PerformanceRefR\fperformances\"R\n" +
+	"\x0ePerformanceRef\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"\xb8\x03\n" +
+	"\n" +
+	"EventStats\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0einventory_type\x18\x02 \x01(\tR\rinventoryType\x12,\n" +
+	"\x12commits_per_second\x18\x03 \x01(\x01R\x10commitsPerSecond\x120\n" +
+	"\x14conflicts_per_second\x18\x04 \x01(\x01R\x12conflictsPerSecond\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x05R\tremaining\x12\x14\n" +
+	"\x05holds\x18\x06 \x01(\x05R\x05holds\x12%\n" +
+	"\x0eperformance_id\x18\a \x01(\tR\rperformanceId\x12#\n" +
+	"\rholds_created\x18\b \x01(\x04R\fholdsCreated\x12#\n" +
+	"\rholds_expired\x18\t \x01(\x04R\fholdsExpired\x12'\n" +
+	"\x0fholds_committed\x18\n" +
+	" \x01(\x04R\x0eholdsCommitted\x12:\n" +
+	"\x1aavg_hold_to_commit_seconds\x18\v \x01(\x01R\x16avgHoldToCommitSeconds\"T\n" +
+	"\x10GetEventStatsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"p\n" +
+	"\x10EventStatsUpdate\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.inventory.v1.EventStatsR\x06events\"e\n" +
+	"\x13PutVenueTemplateReq\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\"/\n" +
+	"\x13PutVenueTemplateRes\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\"P\n" +
+	"\x13GetVenueTemplateReq\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xba\x01\n" +
+	"\x13GetVenueTemplateRes\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe6\x01\n" +
+	"\x1bInstantiateVenueTemplateReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x12)\n" +
+	"\x10template_version\x18\x04 \x01(\x05R\x0ftemplateVersion\x129\n" +
+	"\x19expected_seat_map_version\x18\x05 \x01(\x05R\x16expectedSeatMapVersion\"\x97\x01\n" +
+	"\x1bInstantiateVenueTemplateRes\x12)\n" +
+	"\x10template_version\x18\x01 \x01(\x05R\x0ftemplateVersion\x12#\n" +
+	"\rseats_created\x18\x02 \x01(\x05R\fseatsCreated\x12(\n" +
+	"\x10seat_map_version\x18\x03 \x01(\x05R\x0eseatMapVersion\"\xb9\x01\n" +
+	"\x10SetHoldPolicyReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\x12%\n" +
+	"\x0emax_extensions\x18\x04 \x01(\x05R\rmaxExtensions\x12\x1b\n" +
+	"\tmax_seats\x18\x05 \x01(\x05R\bmaxSeats\"*\n" +
+	"\x10SetHoldPolicyRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"n\n" +
+	"\x14SetSeatsMigrationReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"g\n" +
+	"\x14SetSeatsMigrationRes\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"settled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\"s\n" +
+	"\x17VerifySeatsMigrationReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x16\n" +
+	"\x06repair\x18\x03 \x01(\bR\x06repair\"\xe1\x01\n" +
+	"\x17VerifySeatsMigrationRes\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12!\n" +
+	"\fsource_seats\x18\x02 \x01(\x05R\vsourceSeats\x12!\n" +
+	"\ftarget_seats\x18\x03 \x01(\x05R\vtargetSeats\x12\x18\n" +
+	"\amissing\x18\x04 \x01(\x05R\amissing\x12\x1e\n" +
+	"\n" +
+	"mismatched\x18\x05 \x01(\x05R\n" +
+	"mismatched\x12\x14\n" +
+	"\x05extra\x18\x06 \x01(\x05R\x05extra\x12\x1a\n" +
+	"\brepaired\x18\a \x01(\x05R\brepaired\"\x14\n" +
+	"\x12GetCanaryReportReq\"\x90\x02\n" +
+	"\x12GetCanaryReportRes\x12 \n" +
+	"\vcomparisons\x18\x01 \x01(\x03R\vcomparisons\x12 \n" +
+	"\vdivergences\x18\x02 \x01(\x03R\vdivergences\x12;\n" +
+	"\vclean_since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"cleanSince\x12+\n" +
+	"\x11clean_comparisons\x18\x04 \x01(\x03R\x10cleanComparisons\x12'\n" +
+	"\x0flast_divergence\x18\x05 \x01(\tR\x0elastDivergence\x12#\n" +
+	"\rcutover_ready\x18\x06 \x01(\bR\fcutoverReady\"\x9f\x02\n" +
+	"\x11StartOperationReq\x12T\n" +
+	"\x13release_event_holds\x18\x01 \x01(\v2\".inventory.v1.ReleaseEventHoldsReqH\x00R\x11releaseEventHolds\x12i\n" +
+	"\x1ainstantiate_venue_template\x18\x02 \x01(\v2).inventory.v1.InstantiateVenueTemplateReqH\x00R\x18instantiateVenueTemplate\x12>\n" +
+	"\vclose_event\x18\x03 \x01(\v2\x1b.inventory.v1.CloseEventReqH\x00R\n" +
+	"closeEventB\t\n" +
+	"\arequest\"4\n" +
+	"\x0fGetOperationReq\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"7\n" +
+	"\x12CancelOperationReq\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"\xf6\x02\n" +
+	"\tOperation\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12)\n" +
+	"\x10cancel_requested\x18\x05 \x01(\bR\x0fcancelRequested\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\a \x01(\x05R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x05R\x06failed\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe6\x02\n" +
+	"\x0eUpsertSeatsReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12.\n" +
+	"\x05seats\x18\x05 \x03(\v2\x18.inventory.v1.SeatUpsertR\x05seats\x129\n" +
+	"\x19expected_seat_map_version\x18\x06 \x01(\x05R\x16expectedSeatMapVersion\x126\n" +
+	"\bmanifest\x18\a \x01(\v2\x1a.inventory.v1.SeatManifestR\bmanifest\x12\x1f\n" +
+	"\vpage_sha256\x18\b \x01(\tR\n" +
+	"pageSha256\x12\x19\n" +
+	"\blease_id\x18\t \x01(\tR\aleaseId\"f\n" +
+	"\fSeatManifest\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x03R\ttotalRows\x12\x1f\n" +
+	"\vtotal_pages\x18\x02 \x01(\x05R\n" +
+	"totalPages\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"=\n" +
+	"\n" +
+	"SeatUpsert\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xdc\x02\n" +
+	"\x0eUpsertSeatsRes\x12\x1f\n" +
+	"\vnext_cursor\x18\x01 \x01(\tR\n" +
+	"nextCursor\x12\x1a\n" +
+	"\bupserted\x18\x02 \x01(\x05R\bupserted\x12(\n" +
+	"\x10skipped_seat_ids\x18\x03 \x03(\tR\x0eskippedSeatIds\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\bR\tduplicate\x12%\n" +
+	"\x0etotal_upserted\x18\x05 \x01(\x03R\rtotalUpserted\x12#\n" +
+	"\rtotal_skipped\x18\x06 \x01(\x03R\ftotalSkipped\x12(\n" +
+	"\x10seat_map_version\x18\a \x01(\x05R\x0eseatMapVersion\x123\n" +
+	"\aresults\x18\b \x03(\v2\x19.inventory.v1.BatchResultR\aresults\x12\x1a\n" +
+	"\bverified\x18\t \x01(\bR\bverified\"/\n" +
+	"\x10GetSeatUploadReq\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"\xf1\x02\n" +
+	"\x10GetSeatUploadRes\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12%\n" +
+	"\x0etotal_upserted\x18\x03 \x01(\x03R\rtotalUpserted\x12#\n" +
+	"\rtotal_skipped\x18\x04 \x01(\x03R\ftotalSkipped\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0eperformance_id\x18\x06 \x01(\tR\rperformanceId\x126\n" +
+	"\bmanifest\x18\a \x01(\v2\x1a.inventory.v1.SeatManifestR\bmanifest\x12;\n" +
+	"\vverified_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\x96\x01\n" +
+	"\x10ErasureReference\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\"p\n" +
+	"\x0fEraseSubjectReq\x12\x1d\n" +
+	"\n" +
+	"erasure_id\x18\x01 \x01(\tR\terasureId\x12>\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2\x1e.inventory.v1.ErasureReferenceR\n" +
+	"references\"\xcf\x02\n" +
+	"\x0fEraseSubjectRes\x12\x1d\n" +
+	"\n" +
+	"erasure_id\x18\x01 \x01(\tR\terasureId\x12'\n" +
+	"\x0fseats_tokenized\x18\x02 \x01(\x05R\x0eseatsTokenized\x12:\n" +
+	"\x19commit_statuses_tokenized\x18\x03 \x01(\x05R\x17commitStatusesTokenized\x12>\n" +
+	"\x1bidempotency_records_deleted\x18\x04 \x01(\x05R\x19idempotencyRecordsDeleted\x12\x1d\n" +
+	"\n" +
+	"seats_held\x18\x05 \x01(\x05R\tseatsHeld\x12\x1a\n" +
+	"\bcomplete\x18\x06 \x01(\bR\bcomplete\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"9\n" +
+	"\x19WrapFieldEncryptionKeyReq\x12\x1c\n" +
+	"\n" +
+	"kms_key_id\x18\x01 \x01(\tR\bkmsKeyId\"c\n" +
+	"\x19WrapFieldEncryptionKeyRes\x12(\n" +
+	"\x10wrapped_data_key\x18\x01 \x01(\tR\x0ewrappedDataKey\x12\x1c\n" +
+	"\tgenerated\x18\x02 \x01(\bR\tgenerated\"\xcc\x01\n" +
+	"\x14SetVisibilityRuleReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\asegment\x18\x03 \x01(\tR\asegment\x127\n" +
+	"\treveal_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\brevealAt\x12\x1f\n" +
+	"\vaccess_code\x18\x05 \x01(\tR\n" +
+	"accessCode\".\n" +
+	"\x14SetVisibilityRuleRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"n\n" +
+	"\x10RevealSegmentReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\asegment\x18\x03 \x01(\tR\asegment\"*\n" +
+	"\x10RevealSegmentRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x8f\x01\n" +
+	"\x0eCreateEventReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12;\n" +
+	"\bsections\x18\x03 \x03(\v2\x1f.inventory.v1.SeatLayoutSectionR\bsections\"\x88\x01\n" +
+	"\x11SeatLayoutSection\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x12\n" +
+	"\x04rows\x18\x02 \x03(\tR\x04rows\x12\"\n" +
+	"\rseats_per_row\x18\x03 \x01(\x05R\vseatsPerRow\x12!\n" +
+	"\faisles_after\x18\x04 \x03(\x05R\vaislesAfter\"l\n" +
+	"\x13CreateEventProgress\x12\x1f\n" +
+	"\vtotal_seats\x18\x01 \x01(\x05R\n" +
+	"totalSeats\x12 \n" +
+	"\vprovisioned\x18\x02 \x01(\x05R\vprovisioned\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"\xac\x01\n" +
+	"\x15AcquireSectionLockReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\asection\x18\x03 \x01(\tR\asection\x12\x16\n" +
+	"\x06holder\x18\x04 \x01(\tR\x06holder\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x05R\n" +
+	"ttlSeconds\"\xad\x01\n" +
+	"\x13RenewSectionLockReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\asection\x18\x03 \x01(\tR\asection\x12\x19\n" +
+	"\blease_id\x18\x04 \x01(\tR\aleaseId\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x05R\n" +
+	"ttlSeconds\"\x96\x01\n" +
+	"\fSectionLease\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x18\n" +
+	"\asection\x18\x02 \x01(\tR\asection\x12\x16\n" +
+	"\x06holder\x18\x03 \x01(\tR\x06holder\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x8e\x01\n" +
+	"\x15ReleaseSectionLockReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\asection\x18\x03 \x01(\tR\asection\x12\x19\n" +
+	"\blease_id\x18\x04 \x01(\tR\aleaseId\"/\n" +
+	"\x15ReleaseSectionLockRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xe4\x01\n" +
+	"\rCloseEventReq\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x05 \x01(\x05R\tchunkSize\x12(\n" +
+	"\x10seats_per_second\x18\x06 \x01(\x05R\x0eseatsPerSecond\"\xb4\x01\n" +
+	"\x12CloseEventProgress\x12\x19\n" +
+	"\bclose_id\x18\x01 \x01(\tR\acloseId\x12\x18\n" +
+	"\ascanned\x18\x02 \x01(\x05R\ascanned\x12\x16\n" +
+	"\x06closed\x18\x03 \x01(\x05R\x06closed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12%\n" +
+	"\x0eledger_entries\x18\x05 \x01(\x05R\rledgerEntries\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done2\x89\x18\n" +
+	"\x0eInventoryAdmin\x12R\n" +
+	"\x0eAdjustCapacity\x12\x1f.inventory.v1.AdjustCapacityReq\x1a\x1f.inventory.v1.AdjustCapacityRes\x12F\n" +
+	"\n" +
+	"BlockSeats\x12\x1b.inventory.v1.BlockSeatsReq\x1a\x1b.inventory.v1.BlockSeatsRes\x12L\n" +
+	"\fUnblockSeats\x12\x1d.inventory.v1.UnblockSeatsReq\x1a\x1d.inventory.v1.UnblockSeatsRes\x12O\n" +
+	"\rSetSeatStatus\x12\x1e.inventory.v1.SetSeatStatusReq\x1a\x1e.inventory.v1.SetSeatStatusRes\x12U\n" +
+	"\x0fSetSeatMetadata\x12 .inventory.v1.SetSeatMetadataReq\x1a .inventory.v1.SetSeatMetadataRes\x12@\n" +
+	"\bGetSeats\x12\x19.inventory.v1.GetSeatsReq\x1a\x19.inventory.v1.GetSeatsRes\x12L\n" +
+	"\fEraseSubject\x12\x1d.inventory.v1.EraseSubjectReq\x1a\x1d.inventory.v1.EraseSubjectRes\x12j\n" +
+	"\x16WrapFieldEncryptionKey\x12'.inventory.v1.WrapFieldEncryptionKeyReq\x1a'.inventory.v1.WrapFieldEncryptionKeyRes\x12^\n" +
+	"\x12SetMaintenanceMode\x12#.inventory.v1.SetMaintenanceModeReq\x1a#.inventory.v1.SetMaintenanceModeRes\x12I\n" +
+	"\vFreezeEvent\x12\x1c.inventory.v1.FreezeEventReq\x1a\x1c.inventory.v1.FreezeEventRes\x12O\n" +
+	"\rUnfreezeEvent\x12\x1e.inventory.v1.UnfreezeEventReq\x1a\x1e.inventory.v1.UnfreezeEventRes\x12b\n" +
+	"\x11ReleaseEventHolds\x12\".inventory.v1.ReleaseEventHoldsReq\x1a'.inventory.v1.ReleaseEventHoldsProgress0\x01\x12R\n" +
+	"\x0eListStuckHolds\x12\x1f.inventory.v1.ListStuckHoldsReq\x1a\x1f.inventory.v1.ListStuckHoldsRes\x12L\n" +
+	"\fPlanCapacity\x12\x1d.inventory.v1.PlanCapacityReq\x1a\x1d.inventory.v1.PlanCapacityRes\x12[\n" +
+	"\x11PutDemandForecast\x12\".inventory.v1.PutDemandForecastReq\x1a\".inventory.v1.PutDemandForecastRes\x12W\n" +
+	"\x10StreamEventStats\x12!.inventory.v1.StreamEventStatsReq\x1a\x1e.inventory.v1.EventStatsUpdate0\x01\x12I\n" +
+	"\rGetEventStats\x12\x1e.inventory.v1.GetEventStatsReq\x1a\x18.inventory.v1.EventStats\x12X\n" +
+	"\x10PutVenueTemplate\x12!.inventory.v1.PutVenueTemplateReq\x1a!.inventory.v1.PutVenueTemplateRes\x12X\n" +
+	"\x10GetVenueTemplate\x12!.inventory.v1.GetVenueTemplateReq\x1a!.inventory.v1.GetVenueTemplateRes\x12p\n" +
+	"\x18InstantiateVenueTemplate\x12).inventory.v1.InstantiateVenueTemplateReq\x1a).inventory.v1.InstantiateVenueTemplateRes\x12O\n" +
+	"\rSetHoldPolicy\x12\x1e.inventory.v1.SetHoldPolicyReq\x1a\x1e.inventory.v1.SetHoldPolicyRes\x12[\n" +
+	"\x11SetSeatsMigration\x12\".inventory.v1.SetSeatsMigrationReq\x1a\".inventory.v1.SetSeatsMigrationRes\x12d\n" +
+	"\x14VerifySeatsMigration\x12%.inventory.v1.VerifySeatsMigrationReq\x1a%.inventory.v1.VerifySeatsMigrationRes\x12U\n" +
+	"\x0fGetCanaryReport\x12 .inventory.v1.GetCanaryReportReq\x1a .inventory.v1.GetCanaryReportRes\x12J\n" +
+	"\x0eStartOperation\x12\x1f.inventory.v1.StartOperationReq\x1a\x17.inventory.v1.Operation\x12F\n" +
+	"\fGetOperation\x12\x1d.inventory.v1.GetOperationReq\x1a\x17.inventory.v1.Operation\x12L\n" +
+	"\x0fCancelOperation\x12 .inventory.v1.CancelOperationReq\x1a\x17.inventory.v1.Operation\x12I\n" +
+	"\vUpsertSeats\x12\x1c.inventory.v1.UpsertSeatsReq\x1a\x1c.inventory.v1.UpsertSeatsRes\x12O\n" +
+	"\rGetSeatUpload\x12\x1e.inventory.v1.GetSeatUploadReq\x1a\x1e.inventory.v1.GetSeatUploadRes\x12M\n" +
+	"\n" +
+	"CloseEvent\x12\x1b.inventory.v1.CloseEventReq\x1a .inventory.v1.CloseEventProgress0\x01\x12U\n" +
+	"\x12AcquireSectionLock\x12#.inventory.v1.AcquireSectionLockReq\x1a\x1a.inventory.v1.SectionLease\x12Q\n" +
+	"\x10RenewSectionLock\x12!.inventory.v1.RenewSectionLockReq\x1a\x1a.inventory.v1.SectionLease\x12^\n" +
+	"\x12ReleaseSectionLock\x12#.inventory.v1.ReleaseSectionLockReq\x1a#.inventory.v1.ReleaseSectionLockRes\x12P\n" +
+	"\vCreateEvent\x12\x1c.inventory.v1.CreateEventReq\x1a!.inventory.v1.CreateEventProgress0\x01\x12[\n" +
+	"\x11SetVisibilityRule\x12\".inventory.v1.SetVisibilityRuleReq\x1a\".inventory.v1.SetVisibilityRuleRes\x12O\n" +
+	"\rRevealSegment\x12\x1e.inventory.v1.RevealSegmentReq\x1a\x1e.inventory.v1.RevealSegmentResB-Z+github.com/traffictacos/inventory-api/protob\x06proto3"
+
+var (
+	file_proto_admin_proto_rawDescOnce sync.Once
+	file_proto_admin_proto_rawDescData []byte
+)
+
+func file_proto_admin_proto_rawDescGZIP() []byte {
+	file_proto_admin_proto_rawDescOnce.Do(func() {
+		file_proto_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)))
+	})
+	return file_proto_admin_proto_rawDescData
+}
+
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_proto_admin_proto_goTypes = []any{
+	(*AdjustCapacityReq)(nil),           // 0: inventory.v1.AdjustCapacityReq
+	(*AdjustCapacityRes)(nil),           // 1: inventory.v1.AdjustCapacityRes
+	(*BlockSeatsReq)(nil),               // 2: inventory.v1.BlockSeatsReq
+	(*BlockSeatsRes)(nil),               // 3: inventory.v1.BlockSeatsRes
+	(*UnblockSeatsReq)(nil),             // 4: inventory.v1.UnblockSeatsReq
+	(*UnblockSeatsRes)(nil),             // 5: inventory.v1.UnblockSeatsRes
+	(*SetSeatStatusReq)(nil),            // 6: inventory.v1.SetSeatStatusReq
+	(*SetSeatStatusRes)(nil),            // 7: inventory.v1.SetSeatStatusRes
+	(*SetSeatMetadataReq)(nil),          // 8: inventory.v1.SetSeatMetadataReq
+	(*SetSeatMetadataRes)(nil),          // 9: inventory.v1.SetSeatMetadataRes
+	(*GetSeatsReq)(nil),                 // 10: inventory.v1.GetSeatsReq
+	(*GetSeatsRes)(nil),                 // 11: inventory.v1.GetSeatsRes
+	(*SetMaintenanceModeReq)(nil),       // 12: inventory.v1.SetMaintenanceModeReq
+	(*SetMaintenanceModeRes)(nil),       // 13: inventory.v1.SetMaintenanceModeRes
+	(*FreezeEventReq)(nil),              // 14: inventory.v1.FreezeEventReq
+	(*FreezeEventRes)(nil),              // 15: inventory.v1.FreezeEventRes
+	(*UnfreezeEventReq)(nil),            // 16: inventory.v1.UnfreezeEventReq
+	(*UnfreezeEventRes)(nil),            // 17: inventory.v1.UnfreezeEventRes
+	(*ReleaseEventHoldsReq)(nil),        // 18: inventory.v1.ReleaseEventHoldsReq
+	(*ReleaseEventHoldsProgress)(nil),   // 19: inventory.v1.ReleaseEventHoldsProgress
+	(*ListStuckHoldsReq)(nil),           // 20: inventory.v1.ListStuckHoldsReq
+	(*StuckHold)(nil),                   // 21: inventory.v1.StuckHold
+	(*ListStuckHoldsRes)(nil),           // 22: inventory.v1.ListStuckHoldsRes
+	(*PlanCapacityReq)(nil),             // 23: inventory.v1.PlanCapacityReq
+	(*PlanCapacityRes)(nil),             // 24: inventory.v1.PlanCapacityRes
+	(*PutDemandForecastReq)(nil),        // 25: inventory.v1.PutDemandForecastReq
+	(*DemandPoint)(nil),                 // 26: inventory.v1.DemandPoint
+	(*PutDemandForecastRes)(nil),        // 27: inventory.v1.PutDemandForecastRes
+	(*StreamEventStatsReq)(nil),         // 28: inventory.v1.StreamEventStatsReq
+	(*PerformanceRef)(nil),              // 29: inventory.v1.PerformanceRef
+	(*EventStats)(nil),                  // 30: inventory.v1.EventStats
+	(*GetEventStatsReq)(nil),            // 31: inventory.v1.GetEventStatsReq
+	(*EventStatsUpdate)(nil),            // 32: inventory.v1.EventStatsUpdate
+	(*PutVenueTemplateReq)(nil),         // 33: inventory.v1.PutVenueTemplateReq
+	(*PutVenueTemplateRes)(nil),         // 34: inventory.v1.PutVenueTemplateRes
+	(*GetVenueTemplateReq)(nil),         // 35: inventory.v1.GetVenueTemplateReq
+	(*GetVenueTemplateRes)(nil),         // 36: inventory.v1.GetVenueTemplateRes
+	(*InstantiateVenueTemplateReq)(nil), // 37: inventory.v1.InstantiateVenueTemplateReq
+	(*InstantiateVenueTemplateRes)(nil), // 38: inventory.v1.InstantiateVenueTemplateRes
+	(*SetHoldPolicyReq)(nil),            // 39: inventory.v1.SetHoldPolicyReq
+	(*SetHoldPolicyRes)(nil),            // 40: inventory.v1.SetHoldPolicyRes
+	(*SetSeatsMigrationReq)(nil),        // 41: inventory.v1.SetSeatsMigrationReq
+	(*SetSeatsMigrationRes)(nil),        // 42: inventory.v1.SetSeatsMigrationRes
+	(*VerifySeatsMigrationReq)(nil),     // 43: inventory.v1.VerifySeatsMigrationReq
+	(*VerifySeatsMigrationRes)(nil),     // 44: inventory.v1.VerifySeatsMigrationRes
+	(*GetCanaryReportReq)(nil),          // 45: inventory.v1.GetCanaryReportReq
+	(*GetCanaryReportRes)(nil),          // 46: inventory.v1.GetCanaryReportRes
+	(*StartOperationReq)(nil),           // 47: inventory.v1.StartOperationReq
+	(*GetOperationReq)(nil),             // 48: inventory.v1.GetOperationReq
+	(*CancelOperationReq)(nil),          // 49: inventory.v1.CancelOperationReq
+	(*Operation)(nil),                   // 50: inventory.v1.Operation
+	(*UpsertSeatsReq)(nil),              // 51: inventory.v1.UpsertSeatsReq
+	(*SeatManifest)(nil),                // 52: inventory.v1.SeatManifest
+	(*SeatUpsert)(nil),                  // 53: inventory.v1.SeatUpsert
+	(*UpsertSeatsRes)(nil),              // 54: inventory.v1.UpsertSeatsRes
+	(*GetSeatUploadReq)(nil),            // 55: inventory.v1.GetSeatUploadReq
+	(*GetSeatUploadRes)(nil),            // 56: inventory.v1.GetSeatUploadRes
+	(*ErasureReference)(nil),            // 57: inventory.v1.ErasureReference
+	(*EraseSubjectReq)(nil),             // 58: inventory.v1.EraseSubjectReq
+	(*EraseSubjectRes)(nil),             // 59: inventory.v1.EraseSubjectRes
+	(*WrapFieldEncryptionKeyReq)(nil),   // 60: inventory.v1.WrapFieldEncryptionKeyReq
+	(*WrapFieldEncryptionKeyRes)(nil),   // 61: inventory.v1.WrapFieldEncryptionKeyRes
+	(*SetVisibilityRuleReq)(nil),        // 62: inventory.v1.SetVisibilityRuleReq
+	(*SetVisibilityRuleRes)(nil),        // 63: inventory.v1.SetVisibilityRuleRes
+	(*RevealSegmentReq)(nil),            // 64: inventory.v1.RevealSegmentReq
+	(*RevealSegmentRes)(nil),            // 65: inventory.v1.RevealSegmentRes
+	(*CreateEventReq)(nil),              // 66: inventory.v1.CreateEventReq
+	(*SeatLayoutSection)(nil),           // 67: inventory.v1.SeatLayoutSection
+	(*CreateEventProgress)(nil),         // 68: inventory.v1.CreateEventProgress
+	(*AcquireSectionLockReq)(nil),       // 69: inventory.v1.AcquireSectionLockReq
+	(*RenewSectionLockReq)(nil),         // 70: inventory.v1.RenewSectionLockReq
+	(*SectionLease)(nil),                // 71: inventory.v1.SectionLease
+	(*ReleaseSectionLockReq)(nil),       // 72: inventory.v1.ReleaseSectionLockReq
+	(*ReleaseSectionLockRes)(nil),       // 73: inventory.v1.ReleaseSectionLockRes
+	(*CloseEventReq)(nil),               // 74: inventory.v1.CloseEventReq
+	(*CloseEventProgress)(nil),          // 75: inventory.v1.CloseEventProgress
+	nil,                                 // 76: inventory.v1.SetSeatMetadataReq.MetadataEntry
+	(*SeatRef)(nil),                     // 77: inventory.v1.SeatRef
+	(SeatStatus)(0),                     // 78: inventory.v1.SeatStatus
+	(*Seat)(nil),                        // 79: inventory.v1.Seat
+	(*BatchResult)(nil),                 // 80: inventory.v1.BatchResult
+	(*timestamppb.Timestamp)(nil),       // 81: google.protobuf.Timestamp
+}
+var file_proto_admin_proto_depIdxs = []int32{
+	77, // 0: inventory.v1.BlockSeatsReq.seat_ids:type_name -> inventory.v1.SeatRef
+	77, // 1: inventory.v1.UnblockSeatsReq.seat_ids:type_name -> inventory.v1.SeatRef
+	77, // 2: inventory.v1.SetSeatStatusReq.seat_ids:type_name -> inventory.v1.SeatRef
+	78, // 3: inventory.v1.SetSeatStatusReq.status:type_name -> inventory.v1.SeatStatus
+	78, // 4: inventory.v1.SetSeatStatusRes.status:type_name -> inventory.v1.SeatStatus
+	77, // 5: inventory.v1.SetSeatMetadataReq.seat_ids:type_name -> inventory.v1.SeatRef
+	76, // 6: inventory.v1.SetSeatMetadataReq.metadata:type_name -> inventory.v1.SetSeatMetadataReq.MetadataEntry
+	79, // 7: inventory.v1.SetSeatMetadataRes.seats:type_name -> inventory.v1.Seat
+	77, // 8: inventory.v1.GetSeatsReq.seat_ids:type_name -> inventory.v1.SeatRef
+	79, // 9: inventory.v1.GetSeatsRes.seats:type_name -> inventory.v1.Seat
+	80, // 10: inventory.v1.ReleaseEventHoldsProgress.failed_seats:type_name -> inventory.v1.BatchResult
+	81, // 11: inventory.v1.StuckHold.held_since:type_name -> google.protobuf.Timestamp
+	21, // 12: inventory.v1.ListStuckHoldsRes.holds:type_name -> inventory.v1.StuckHold
+	81, // 13: inventory.v1.PlanCapacityRes.projected_sellout:type_name -> google.protobuf.Timestamp
+	81, // 14: inventory.v1.PutDemandForecastReq.on_sale_at:type_name -> google.protobuf.Timestamp
+	26, // 15: inventory.v1.PutDemandForecastReq.points:type_name -> inventory.v1.DemandPoint
+	29, // 16: inventory.v1.StreamEventStatsReq.performances:type_name -> inventory.v1.PerformanceRef
+	81, // 17: inventory.v1.EventStatsUpdate.at:type_name -> google.protobuf.Timestamp
+	30, // 18: inventory.v1.EventStatsUpdate.events:type_name -> inventory.v1.EventStats
+	81, // 19: inventory.v1.GetVenueTemplateRes.created_at:type_name -> google.protobuf.Timestamp
+	81, // 20: inventory.v1.SetSeatsMigrationRes.settled_at:type_name -> google.protobuf.Timestamp
+	81, // 21: inventory.v1.GetCanaryReportRes.clean_since:type_name -> google.protobuf.Timestamp
+	18, // 22: inventory.v1.StartOperationReq.release_event_holds:type_name -> inventory.v1.ReleaseEventHoldsReq
+	37, // 23: inventory.v1.StartOperationReq.instantiate_venue_template:type_name -> inventory.v1.InstantiateVenueTemplateReq
+	74, // 24: inventory.v1.StartOperationReq.close_event:type_name -> inventory.v1.CloseEventReq
+	81, // 25: inventory.v1.Operation.created_at:type_name -> google.protobuf.Timestamp
+	81, // 26: inventory.v1.Operation.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 27: inventory.v1.UpsertSeatsReq.seats:type_name -> inventory.v1.SeatUpsert
+	52, // 28: inventory.v1.UpsertSeatsReq.manifest:type_name -> inventory.v1.SeatManifest
+	80, // 29: inventory.v1.UpsertSeatsRes.results:type_name -> inventory.v1.BatchResult
+	81, // 30: inventory.v1.GetSeatUploadRes.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 31: inventory.v1.GetSeatUploadRes.manifest:type_name -> inventory.v1.SeatManifest
+	81, // 32: inventory.v1.GetSeatUploadRes.verified_at:type_name -> google.protobuf.Timestamp
+	57, // 33: inventory.v1.EraseSubjectReq.references:type_name -> inventory.v1.ErasureReference
+	81, // 34: inventory.v1.EraseSubjectRes.completed_at:type_name -> google.protobuf.Timestamp
+	81, // 35: inventory.v1.SetVisibilityRuleReq.reveal_at:type_name -> google.protobuf.Timestamp
+	67, // 36: inventory.v1.CreateEventReq.sections:type_name -> inventory.v1.SeatLayoutSection
+	81, // 37: inventory.v1.SectionLease.expires_at:type_name -> google.protobuf.Timestamp
+	78, // 38: inventory.v1.CloseEventReq.status:type_name -> inventory.v1.SeatStatus
+	0,  // 39: inventory.v1.InventoryAdmin.AdjustCapacity:input_type -> inventory.v1.AdjustCapacityReq
+	2,  // 40: inventory.v1.InventoryAdmin.BlockSeats:input_type -> inventory.v1.BlockSeatsReq
+	4,  // 41: inventory.v1.InventoryAdmin.UnblockSeats:input_type -> inventory.v1.UnblockSeatsReq
+	6,  // 42: inventory.v1.InventoryAdmin.SetSeatStatus:input_type -> inventory.v1.SetSeatStatusReq
+	8,  // 43: inventory.v1.InventoryAdmin.SetSeatMetadata:input_type -> inventory.v1.SetSeatMetadataReq
+	10, // 44: inventory.v1.InventoryAdmin.GetSeats:input_type -> inventory.v1.GetSeatsReq
+	58, // 45: inventory.v1.InventoryAdmin.EraseSubject:input_type -> inventory.v1.EraseSubjectReq
+	60, // 46: inventory.v1.InventoryAdmin.WrapFieldEncryptionKey:input_type -> inventory.v1.WrapFieldEncryptionKeyReq
+	12, // 47: inventory.v1.InventoryAdmin.SetMaintenanceMode:input_type -> inventory.v1.SetMaintenanceModeReq
+	14, // 48: inventory.v1.InventoryAdmin.FreezeEvent:input_type -> inventory.v1.FreezeEventReq
+	16, // 49: inventory.v1.InventoryAdmin.UnfreezeEvent:input_type -> inventory.v1.UnfreezeEventReq
+	18, // 50: inventory.v1.InventoryAdmin.ReleaseEventHolds:input_type -> inventory.v1.ReleaseEventHoldsReq
+	20, // 51: inventory.v1.InventoryAdmin.ListStuckHolds:input_type -> inventory.v1.ListStuckHoldsReq
+	23, // 52: inventory.v1.InventoryAdmin.PlanCapacity:input_type -> inventory.v1.PlanCapacityReq
+	25, // 53: inventory.v1.InventoryAdmin.PutDemandForecast:input_type -> inventory.v1.PutDemandForecastReq
+	28, // 54: inventory.v1.InventoryAdmin.StreamEventStats:input_type -> inventory.v1.StreamEventStatsReq
+	31, // 55: inventory.v1.InventoryAdmin.GetEventStats:input_type -> inventory.v1.GetEventStatsReq
+	33, // 56: inventory.v1.InventoryAdmin.PutVenueTemplate:input_type -> inventory.v1.PutVenueTemplateReq
+	35, // 57: inventory.v1.InventoryAdmin.GetVenueTemplate:input_type -> inventory.v1.GetVenueTemplateReq
+	37, // 58: inventory.v1.InventoryAdmin.InstantiateVenueTemplate:input_type -> inventory.v1.InstantiateVenueTemplateReq
+	39, // 59: inventory.v1.InventoryAdmin.SetHoldPolicy:input_type -> inventory.v1.SetHoldPolicyReq
+	41, // 60: inventory.v1.InventoryAdmin.SetSeatsMigration:input_type -> inventory.v1.SetSeatsMigrationReq
+	43, // 61: inventory.v1.InventoryAdmin.VerifySeatsMigration:input_type -> inventory.v1.VerifySeatsMigrationReq
+	45, // 62: inventory.v1.InventoryAdmin.GetCanaryReport:input_type -> inventory.v1.GetCanaryReportReq
+	47, // 63: inventory.v1.InventoryAdmin.StartOperation:input_type -> inventory.v1.StartOperationReq
+	48, // 64: inventory.v1.InventoryAdmin.GetOperation:input_type -> inventory.v1.GetOperationReq
+	49, // 65: inventory.v1.InventoryAdmin.CancelOperation:input_type -> inventory.v1.CancelOperationReq
+	51, // 66: inventory.v1.InventoryAdmin.UpsertSeats:input_type -> inventory.v1.UpsertSeatsReq
+	55, // 67: inventory.v1.InventoryAdmin.GetSeatUpload:input_type -> inventory.v1.GetSeatUploadReq
+	74, // 68: inventory.v1.InventoryAdmin.CloseEvent:input_type -> inventory.v1.CloseEventReq
+	69, // 69: inventory.v1.InventoryAdmin.AcquireSectionLock:input_type -> inventory.v1.AcquireSectionLockReq
+	70, // 70: inventory.v1.InventoryAdmin.RenewSectionLock:input_type -> inventory.v1.RenewSectionLockReq
+	72, // 71: inventory.v1.InventoryAdmin.ReleaseSectionLock:input_type -> inventory.v1.ReleaseSectionLockReq
+	66, // 72: inventory.v1.InventoryAdmin.CreateEvent:input_type -> inventory.v1.CreateEventReq
+	62, // 73: inventory.v1.InventoryAdmin.SetVisibilityRule:input_type -> inventory.v1.SetVisibilityRuleReq
+	64, // 74: inventory.v1.InventoryAdmin.RevealSegment:input_type -> inventory.v1.RevealSegmentReq
+	1,  // 75: inventory.v1.InventoryAdmin.AdjustCapacity:output_type -> inventory.v1.AdjustCapacityRes
+	3,  // 76: inventory.v1.InventoryAdmin.BlockSeats:output_type -> inventory.v1.BlockSeatsRes
+	5,  // 77: inventory.v1.InventoryAdmin.UnblockSeats:output_type -> inventory.v1.UnblockSeatsRes
+	7,  // 78: inventory.v1.InventoryAdmin.SetSeatStatus:output_type -> inventory.v1.SetSeatStatusRes
+	9,  // 79: inventory.v1.InventoryAdmin.SetSeatMetadata:output_type -> inventory.v1.SetSeatMetadataRes
+	11, // 80: inventory.v1.InventoryAdmin.GetSeats:output_type -> inventory.v1.GetSeatsRes
+	59, // 81: inventory.v1.InventoryAdmin.EraseSubject:output_type -> inventory.v1.EraseSubjectRes
+	61, // 82: inventory.v1.InventoryAdmin.WrapFieldEncryptionKey:output_type -> inventory.v1.WrapFieldEncryptionKeyRes
+	13, // 83: inventory.v1.InventoryAdmin.SetMaintenanceMode:output_type -> inventory.v1.SetMaintenanceModeRes
+	15, // 84: inventory.v1.InventoryAdmin.FreezeEvent:output_type -> inventory.v1.FreezeEventRes
+	17, // 85: inventory.v1.InventoryAdmin.UnfreezeEvent:output_type -> inventory.v1.UnfreezeEventRes
+	19, // 86: inventory.v1.InventoryAdmin.ReleaseEventHolds:output_type -> inventory.v1.ReleaseEventHoldsProgress
+	22, // 87: inventory.v1.InventoryAdmin.ListStuckHolds:output_type -> inventory.v1.ListStuckHoldsRes
+	24, // 88: inventory.v1.InventoryAdmin.PlanCapacity:output_type -> inventory.v1.PlanCapacityRes
+	27, // 89: inventory.v1.InventoryAdmin.PutDemandForecast:output_type -> inventory.v1.PutDemandForecastRes
+	32, // 90: inventory.v1.InventoryAdmin.StreamEventStats:output_type -> inventory.v1.EventStatsUpdate
+	30, // 91: inventory.v1.InventoryAdmin.GetEventStats:output_type -> inventory.v1.EventStats
+	34, // 92: inventory.v1.InventoryAdmin.PutVenueTemplate:output_type -> inventory.v1.PutVenueTemplateRes
+	36, // 93: inventory.v1.InventoryAdmin.GetVenueTemplate:output_type -> inventory.v1.GetVenueTemplateRes
+	38, // 94: inventory.v1.InventoryAdmin.InstantiateVenueTemplate:output_type -> inventory.v1.InstantiateVenueTemplateRes
+	40, // 95: inventory.v1.InventoryAdmin.SetHoldPolicy:output_type -> inventory.v1.SetHoldPolicyRes
+	42, // 96: inventory.v1.InventoryAdmin.SetSeatsMigration:output_type -> inventory.v1.SetSeatsMigrationRes
+	44, // 97: inventory.v1.InventoryAdmin.VerifySeatsMigration:output_type -> inventory.v1.VerifySeatsMigrationRes
+	46, // 98: inventory.v1.InventoryAdmin.GetCanaryReport:output_type -> inventory.v1.GetCanaryReportRes
+	50, // 99: inventory.v1.InventoryAdmin.StartOperation:output_type -> inventory.v1.Operation
+	50, // 100: inventory.v1.InventoryAdmin.GetOperation:output_type -> inventory.v1.Operation
+	50, // 101: inventory.v1.InventoryAdmin.CancelOperation:output_type -> inventory.v1.Operation
+	54, // 102: inventory.v1.InventoryAdmin.UpsertSeats:output_type -> inventory.v1.UpsertSeatsRes
+	56, // 103: inventory.v1.InventoryAdmin.GetSeatUpload:output_type -> inventory.v1.GetSeatUploadRes
+	75, // 104: inventory.v1.InventoryAdmin.CloseEvent:output_type -> inventory.v1.CloseEventProgress
+	71, // 105: inventory.v1.InventoryAdmin.AcquireSectionLock:output_type -> inventory.v1.SectionLease
+	71, // 106: inventory.v1.InventoryAdmin.RenewSectionLock:output_type -> inventory.v1.SectionLease
+	73, // 107: inventory.v1.InventoryAdmin.ReleaseSectionLock:output_type -> inventory.v1.ReleaseSectionLockRes
+	68, // 108: inventory.v1.InventoryAdmin.CreateEvent:output_type -> inventory.v1.CreateEventProgress
+	63, // 109: inventory.v1.InventoryAdmin.SetVisibilityRule:output_type -> inventory.v1.SetVisibilityRuleRes
+	65, // 110: inventory.v1.InventoryAdmin.RevealSegment:output_type -> inventory.v1.RevealSegmentRes
+	75, // [75:111] is the sub-list for method output_type
+	39, // [39:75] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_proto_admin_proto_init() }
+func file_proto_admin_proto_init() {
+	if File_proto_admin_proto != nil {
+		return
+	}
+	file_proto_inventory_proto_init()
+	file_proto_admin_proto_msgTypes[47].OneofWrappers = []any{
+		(*StartOperationReq_ReleaseEventHolds)(nil),
+		(*StartOperationReq_InstantiateVenueTemplate)(nil),
+		(*StartOperationReq_CloseEvent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   77,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_admin_proto_goTypes,
+		DependencyIndexes: file_proto_admin_proto_depIdxs,
+		MessageInfos:      file_proto_admin_proto_msgTypes,
+	}.Build()
+	File_proto_admin_proto = out.File
+	file_proto_admin_proto_goTypes = nil
+	file_proto_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,1598 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: proto/admin.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InventoryAdmin_AdjustCapacity_FullMethodName           = "/inventory.v1.InventoryAdmin/AdjustCapacity"
+	InventoryAdmin_BlockSeats_FullMethodName               = "/inventory.v1.InventoryAdmin/BlockSeats"
+	InventoryAdmin_UnblockSeats_FullMethodName             = "/inventory.v1.InventoryAdmin/UnblockSeats"
+	InventoryAdmin_SetSeatStatus_FullMethodName            = "/inventory.v1.InventoryAdmin/SetSeatStatus"
+	InventoryAdmin_SetSeatMetadata_FullMethodName          = "/inventory.v1.InventoryAdmin/SetSeatMetadata"
+	InventoryAdmin_GetSeats_FullMethodName                 = "/inventory.v1.InventoryAdmin/GetSeats"
+	InventoryAdmin_EraseSubject_FullMethodName             = "/inventory.v1.InventoryAdmin/EraseSubject"
+	InventoryAdmin_WrapFieldEncryptionKey_FullMethodName   = "/inventory.v1.InventoryAdmin/WrapFieldEncryptionKey"
+	InventoryAdmin_SetMaintenanceMode_FullMethodName       = "/inventory.v1.InventoryAdmin/SetMaintenanceMode"
+	InventoryAdmin_FreezeEvent_FullMethodName              = "/inventory.v1.InventoryAdmin/FreezeEvent"
+	InventoryAdmin_UnfreezeEvent_FullMethodName            = "/inventory.v1.InventoryAdmin/UnfreezeEvent"
+	InventoryAdmin_ReleaseEventHolds_FullMethodName        = "/inventory.v1.InventoryAdmin/ReleaseEventHolds"
+	InventoryAdmin_ListStuckHolds_FullMethodName           = "/inventory.v1.InventoryAdmin/ListStuckHolds"
+	InventoryAdmin_PlanCapacity_FullMethodName             = "/inventory.v1.InventoryAdmin/PlanCapacity"
+	InventoryAdmin_PutDemandForecast_FullMethodName        = "/inventory.v1.InventoryAdmin/PutDemandForecast"
+	InventoryAdmin_StreamEventStats_FullMethodName         = "/inventory.v1.InventoryAdmin/StreamEventStats"
+	InventoryAdmin_GetEventStats_FullMethodName            = "/inventory.v1.InventoryAdmin/GetEventStats"
+	InventoryAdmin_PutVenueTemplate_FullMethodName         = "/inventory.v1.InventoryAdmin/PutVenueTemplate"
+	InventoryAdmin_GetVenueTemplate_FullMethodName         = "/inventory.v1.InventoryAdmin/GetVenueTemplate"
+	InventoryAdmin_InstantiateVenueTemplate_FullMethodName = "/inventory.v1.InventoryAdmin/InstantiateVenueTemplate"
+	InventoryAdmin_SetHoldPolicy_FullMethodName            = "/inventory.v1.InventoryAdmin/SetHoldPolicy"
+	InventoryAdmin_SetSeatsMigration_FullMethodName        = "/inventory.v1.InventoryAdmin/SetSeatsMigration"
+	InventoryAdmin_VerifySeatsMigration_FullMethodName     = "/inventory.v1.InventoryAdmin/VerifySeatsMigration"
+	InventoryAdmin_GetCanaryReport_FullMethodName          = "/inventory.v1.InventoryAdmin/GetCanaryReport"
+	InventoryAdmin_StartOperation_FullMethodName           = "/inventory.v1.InventoryAdmin/StartOperation"
+	InventoryAdmin_GetOperation_FullMethodName             = "/inventory.v1.InventoryAdmin/GetOperation"
+	InventoryAdmin_CancelOperation_FullMethodName          = "/inventory.v1.InventoryAdmin/CancelOperation"
+	InventoryAdmin_UpsertSeats_FullMethodName              = "/inventory.v1.InventoryAdmin/UpsertSeats"
+	InventoryAdmin_GetSeatUpload_FullMethodName            = "/inventory.v1.InventoryAdmin/GetSeatUpload"
+	InventoryAdmin_CloseEvent_FullMethodName               = "/inventory.v1.InventoryAdmin/CloseEvent"
+	InventoryAdmin_AcquireSectionLock_FullMethodName       = "/inventory.v1.InventoryAdmin/AcquireSectionLock"
+	InventoryAdmin_RenewSectionLock_FullMethodName         = "/inventory.v1.InventoryAdmin/RenewSectionLock"
+	InventoryAdmin_ReleaseSectionLock_FullMethodName       = "/inventory.v1.InventoryAdmin/ReleaseSectionLock"
+	InventoryAdmin_CreateEvent_FullMethodName              = "/inventory.v1.InventoryAdmin/CreateEvent"
+	InventoryAdmin_SetVisibilityRule_FullMethodName        = "/inventory.v1.InventoryAdmin/SetVisibilityRule"
+	InventoryAdmin_RevealSegment_FullMethodName            = "/inventory.v1.InventoryAdmin/RevealSegment"
+)
+
+// InventoryAdminClient is the client API for InventoryAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InventoryAdmin exposes operational RPCs that must never be reachable from the public listener.
+// It is served on a separate admin port with its own authentication.
+type InventoryAdminClient interface {
+	// AdjustCapacity changes the remaining quantity of an event (optimistic locking on version)
+	AdjustCapacity(ctx context.Context, in *AdjustCapacityReq, opts ...grpc.CallOption) (*AdjustCapacityRes, error)
+	// BlockSeats takes available seats out of sale (e.g. broken seats, production holds)
+	BlockSeats(ctx context.Context, in *BlockSeatsReq, opts ...grpc.CallOption) (*BlockSeatsRes, error)
+	// UnblockSeats returns blocked seats to sale
+	UnblockSeats(ctx context.Context, in *UnblockSeatsReq, opts ...grpc.CallOption) (*UnblockSeatsRes, error)
+	// SetSeatStatus moves seats to an operator-managed status (AVAILABLE, BLOCKED, KILLED or
+	// RESERVED_INTERNAL); every seat must be allowed to make the transition
+	SetSeatStatus(ctx context.Context, in *SetSeatStatusReq, opts ...grpc.CallOption) (*SetSeatStatusRes, error)
+	// SetSeatMetadata replaces the metadata and operator note of seats without changing their status
+	SetSeatMetadata(ctx context.Context, in *SetSeatMetadataReq, opts ...grpc.CallOption) (*SetSeatMetadataRes, error)
+	// GetSeats returns seats with their status, metadata and note
+	GetSeats(ctx context.Context, in *GetSeatsReq, opts ...grpc.CallOption) (*GetSeatsRes, error)
+	// EraseSubject replaces the reservation identifiers of a data subject with opaque tokens
+	// in seat and commit status records and deletes the idempotency records keyed by them
+	EraseSubject(ctx context.Context, in *EraseSubjectReq, opts ...grpc.CallOption) (*EraseSubjectRes, error)
+	// WrapFieldEncryptionKey returns the field encryption data key wrapped under a KMS key, for
+	// DDB_FIELD_ENCRYPTION_DATA_KEY. A configured key is re-wrapped (rotation); otherwise a new
+	// data key is generated.
+	WrapFieldEncryptionKey(ctx context.Context, in *WrapFieldEncryptionKeyReq, opts ...grpc.CallOption) (*WrapFieldEncryptionKeyRes, error)
+	// SetMaintenanceMode rejects all inventory writes of the instance serving the call while
+	// enabled. The flag isn't shared or persisted: call every instance to cover the service,
+	// or freeze events for a change all instances see.
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeReq, opts ...grpc.CallOption) (*SetMaintenanceModeRes, error)
+	// FreezeEvent rejects writes for a single event while reads keep working
+	FreezeEvent(ctx context.Context, in *FreezeEventReq, opts ...grpc.CallOption) (*FreezeEventRes, error)
+	// UnfreezeEvent lifts a per-event freeze
+	UnfreezeEvent(ctx context.Context, in *UnfreezeEventReq, opts ...grpc.CallOption) (*UnfreezeEventRes, error)
+	// ReleaseEventHolds releases all HOLD seats of an event in transactional chunks,
+	// streaming progress until done. Used to recover holds stranded by an upstream outage.
+	ReleaseEventHolds(ctx context.Context, in *ReleaseEventHoldsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReleaseEventHoldsProgress], error)
+	// ListStuckHolds lists HOLD seats older than hold TTL plus grace.
+	// Without event_id it returns the result of the last background scan.
+	ListStuckHolds(ctx context.Context, in *ListStuckHoldsReq, opts ...grpc.CallOption) (*ListStuckHoldsRes, error)
+	// PlanCapacity projects sell-out time and peak write throughput for an event
+	// from its current counters and sales velocity, to size tables before an on-sale
+	PlanCapacity(ctx context.Context, in *PlanCapacityReq, opts ...grpc.CallOption) (*PlanCapacityRes, error)
+	// PutDemandForecast stores the demand curve a forecasting system expects for an event's
+	// on-sale. PlanCapacity projects from it when no sales velocity is requested.
+	PutDemandForecast(ctx context.Context, in *PutDemandForecastReq, opts ...grpc.CallOption) (*PutDemandForecastRes, error)
+	// StreamEventStats pushes rolled-up stats for the subscribed events every interval
+	// until the client disconnects. Rates are for the instance serving the stream.
+	StreamEventStats(ctx context.Context, in *StreamEventStatsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventStatsUpdate], error)
+	// GetEventStats returns an event's current stats, including this instance's hold funnel
+	GetEventStats(ctx context.Context, in *GetEventStatsReq, opts ...grpc.CallOption) (*EventStats, error)
+	// PutVenueTemplate stores a venue seat layout as the template's next version.
+	// Existing versions are never modified, so events keep the layout they were created from.
+	PutVenueTemplate(ctx context.Context, in *PutVenueTemplateReq, opts ...grpc.CallOption) (*PutVenueTemplateRes, error)
+	// GetVenueTemplate returns one version of a venue template (latest by default)
+	GetVenueTemplate(ctx context.Context, in *GetVenueTemplateReq, opts ...grpc.CallOption) (*GetVenueTemplateRes, error)
+	// InstantiateVenueTemplate creates an event's seats from a venue template version
+	// and records the template on the event. Retrying with the same version is safe.
+	InstantiateVenueTemplate(ctx context.Context, in *InstantiateVenueTemplateReq, opts ...grpc.CallOption) (*InstantiateVenueTemplateRes, error)
+	// SetHoldPolicy overrides the hold TTL, extension and size limits of one event
+	SetHoldPolicy(ctx context.Context, in *SetHoldPolicyReq, opts ...grpc.CallOption) (*SetHoldPolicyRes, error)
+	// SetSeatsMigration moves an event's seats between the original and new seats tables:
+	// DUAL_WRITE mirrors writes to the new table, CUTOVER makes it authoritative and an
+	// empty state returns to the original table only. Cutover changes require a frozen event.
+	SetSeatsMigration(ctx context.Context, in *SetSeatsMigrationReq, opts ...grpc.CallOption) (*SetSeatsMigrationRes, error)
+	// VerifySeatsMigration compares an event's seats in both seats tables, optionally
+	// repairing divergent seats. A clean pass marks a DUAL_WRITE event VERIFIED.
+	VerifySeatsMigration(ctx context.Context, in *VerifySeatsMigrationReq, opts ...grpc.CallOption) (*VerifySeatsMigrationRes, error)
+	// GetCanaryReport reports this instance's comparisons of sampled reads with the
+	// candidate storage implementation; deploy pipelines gate cutover on cutover_ready
+	GetCanaryReport(ctx context.Context, in *GetCanaryReportReq, opts ...grpc.CallOption) (*GetCanaryReportRes, error)
+	// StartOperation runs a bulk operation in the background and returns it as RUNNING.
+	// Its state is persisted, so any instance can report or cancel it.
+	StartOperation(ctx context.Context, in *StartOperationReq, opts ...grpc.CallOption) (*Operation, error)
+	// GetOperation reports the progress and outcome of an operation
+	GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*Operation, error)
+	// CancelOperation asks a running operation to stop; it stops after its current chunk
+	// and ends CANCELLED. Work already done is not undone.
+	CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*Operation, error)
+	// UpsertSeats applies one page of a bulk seat upsert from an external venue system.
+	// Pages of an upload are applied in cursor order; resending an acknowledged page is
+	// acknowledged again without reapplying it, so uploads resume safely after a failure.
+	UpsertSeats(ctx context.Context, in *UpsertSeatsReq, opts ...grpc.CallOption) (*UpsertSeatsRes, error)
+	// GetSeatUpload returns the cursor of an upload, to resume it from another client
+	GetSeatUpload(ctx context.Context, in *GetSeatUploadReq, opts ...grpc.CallOption) (*GetSeatUploadRes, error)
+	// CloseEvent moves all of an event's available seats to SOLD (comp allocations) or
+	// CLOSED (sales closed early) in rate-limited chunks, recording a ledger entry per
+	// chunk and streaming cumulative progress. Held seats are left to their reservations.
+	CloseEvent(ctx context.Context, in *CloseEventReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CloseEventProgress], error)
+	// AcquireSectionLock leases a section of an event's seats to an editor. While the lease
+	// is live, admin seat changes in the section fail unless they carry its lease_id;
+	// customer holds and commits are not affected.
+	AcquireSectionLock(ctx context.Context, in *AcquireSectionLockReq, opts ...grpc.CallOption) (*SectionLease, error)
+	// RenewSectionLock extends a live lease; an expired lease must be acquired again
+	RenewSectionLock(ctx context.Context, in *RenewSectionLockReq, opts ...grpc.CallOption) (*SectionLease, error)
+	// ReleaseSectionLock ends a lease before it expires
+	ReleaseSectionLock(ctx context.Context, in *ReleaseSectionLockReq, opts ...grpc.CallOption) (*ReleaseSectionLockRes, error)
+	// CreateEvent creates an event's inventory item and provisions its seats from a seat
+	// layout, streaming cumulative progress. The event stays frozen until every seat is
+	// written; rerunning with the same layout completes an interrupted run.
+	CreateEvent(ctx context.Context, in *CreateEventReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateEventProgress], error)
+	// SetVisibilityRule hides a segment of an event's seats until a date or from callers
+	// without a presale access code
+	SetVisibilityRule(ctx context.Context, in *SetVisibilityRuleReq, opts ...grpc.CallOption) (*SetVisibilityRuleRes, error)
+	// RevealSegment removes a segment's visibility rule, releasing its seats to everyone
+	RevealSegment(ctx context.Context, in *RevealSegmentReq, opts ...grpc.CallOption) (*RevealSegmentRes, error)
+}
+
+type inventoryAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryAdminClient(cc grpc.ClientConnInterface) InventoryAdminClient {
+	return &inventoryAdminClient{cc}
+}
+
+func (c *inventoryAdminClient) AdjustCapacity(ctx context.Context, in *AdjustCapacityReq, opts ...grpc.CallOption) (*AdjustCapacityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustCapacityRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_AdjustCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) BlockSeats(ctx context.Context, in *BlockSeatsReq, opts ...grpc.CallOption) (*BlockSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockSeatsRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_BlockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) UnblockSeats(ctx context.Context, in *UnblockSeatsReq, opts ...grpc.CallOption) (*UnblockSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockSeatsRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_UnblockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) SetSeatStatus(ctx context.Context, in *SetSeatStatusReq, opts ...grpc.CallOption) (*SetSeatStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSeatStatusRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetSeatStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) SetSeatMetadata(ctx context.Context, in *SetSeatMetadataReq, opts ...grpc.CallOption) (*SetSeatMetadataRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSeatMetadataRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetSeatMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) GetSeats(ctx context.Context, in *GetSeatsReq, opts ...grpc.CallOption) (*GetSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatsRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) EraseSubject(ctx context.Context, in *EraseSubjectReq, opts ...grpc.CallOption) (*EraseSubjectRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseSubjectRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_EraseSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) WrapFieldEncryptionKey(ctx context.Context, in *WrapFieldEncryptionKeyReq, opts ...grpc.CallOption) (*WrapFieldEncryptionKeyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WrapFieldEncryptionKeyRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_WrapFieldEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeReq, opts ...grpc.CallOption) (*SetMaintenanceModeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaintenanceModeRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) FreezeEvent(ctx context.Context, in *FreezeEventReq, opts ...grpc.CallOption) (*FreezeEventRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeEventRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_FreezeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) UnfreezeEvent(ctx context.Context, in *UnfreezeEventReq, opts ...grpc.CallOption) (*UnfreezeEventRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeEventRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_UnfreezeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) ReleaseEventHolds(ctx context.Context, in *ReleaseEventHoldsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReleaseEventHoldsProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryAdmin_ServiceDesc.Streams[0], InventoryAdmin_ReleaseEventHolds_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReleaseEventHoldsReq, ReleaseEventHoldsProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_ReleaseEventHoldsClient = grpc.ServerStreamingClient[ReleaseEventHoldsProgress]
+
+func (c *inventoryAdminClient) ListStuckHolds(ctx context.Context, in *ListStuckHoldsReq, opts ...grpc.CallOption) (*ListStuckHoldsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStuckHoldsRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_ListStuckHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) PlanCapacity(ctx context.Context, in *PlanCapacityReq, opts ...grpc.CallOption) (*PlanCapacityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanCapacityRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_PlanCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) PutDemandForecast(ctx context.Context, in *PutDemandForecastReq, opts ...grpc.CallOption) (*PutDemandForecastRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutDemandForecastRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_PutDemandForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) StreamEventStats(ctx context.Context, in *StreamEventStatsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventStatsUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryAdmin_ServiceDesc.Streams[1], InventoryAdmin_StreamEventStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventStatsReq, EventStatsUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_StreamEventStatsClient = grpc.ServerStreamingClient[EventStatsUpdate]
+
+func (c *inventoryAdminClient) GetEventStats(ctx context.Context, in *GetEventStatsReq, opts ...grpc.CallOption) (*EventStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventStats)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetEventStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) PutVenueTemplate(ctx context.Context, in *PutVenueTemplateReq, opts ...grpc.CallOption) (*PutVenueTemplateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutVenueTemplateRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_PutVenueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) GetVenueTemplate(ctx context.Context, in *GetVenueTemplateReq, opts ...grpc.CallOption) (*GetVenueTemplateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVenueTemplateRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetVenueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) InstantiateVenueTemplate(ctx context.Context, in *InstantiateVenueTemplateReq, opts ...grpc.CallOption) (*InstantiateVenueTemplateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstantiateVenueTemplateRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_InstantiateVenueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) SetHoldPolicy(ctx context.Context, in *SetHoldPolicyReq, opts ...grpc.CallOption) (*SetHoldPolicyRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHoldPolicyRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetHoldPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) SetSeatsMigration(ctx context.Context, in *SetSeatsMigrationReq, opts ...grpc.CallOption) (*SetSeatsMigrationRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSeatsMigrationRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetSeatsMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) VerifySeatsMigration(ctx context.Context, in *VerifySeatsMigrationReq, opts ...grpc.CallOption) (*VerifySeatsMigrationRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySeatsMigrationRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_VerifySeatsMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) GetCanaryReport(ctx context.Context, in *GetCanaryReportReq, opts ...grpc.CallOption) (*GetCanaryReportRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanaryReportRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetCanaryReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) StartOperation(ctx context.Context, in *StartOperationReq, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, InventoryAdmin_StartOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, InventoryAdmin_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) UpsertSeats(ctx context.Context, in *UpsertSeatsReq, opts ...grpc.CallOption) (*UpsertSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertSeatsRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_UpsertSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) GetSeatUpload(ctx context.Context, in *GetSeatUploadReq, opts ...grpc.CallOption) (*GetSeatUploadRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatUploadRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_GetSeatUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) CloseEvent(ctx context.Context, in *CloseEventReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CloseEventProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryAdmin_ServiceDesc.Streams[2], InventoryAdmin_CloseEvent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CloseEventReq, CloseEventProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_CloseEventClient = grpc.ServerStreamingClient[CloseEventProgress]
+
+func (c *inventoryAdminClient) AcquireSectionLock(ctx context.Context, in *AcquireSectionLockReq, opts ...grpc.CallOption) (*SectionLease, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionLease)
+	err := c.cc.Invoke(ctx, InventoryAdmin_AcquireSectionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) RenewSectionLock(ctx context.Context, in *RenewSectionLockReq, opts ...grpc.CallOption) (*SectionLease, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionLease)
+	err := c.cc.Invoke(ctx, InventoryAdmin_RenewSectionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) ReleaseSectionLock(ctx context.Context, in *ReleaseSectionLockReq, opts ...grpc.CallOption) (*ReleaseSectionLockRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSectionLockRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_ReleaseSectionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) CreateEvent(ctx context.Context, in *CreateEventReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateEventProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryAdmin_ServiceDesc.Streams[3], InventoryAdmin_CreateEvent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateEventReq, CreateEventProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_CreateEventClient = grpc.ServerStreamingClient[CreateEventProgress]
+
+func (c *inventoryAdminClient) SetVisibilityRule(ctx context.Context, in *SetVisibilityRuleReq, opts ...grpc.CallOption) (*SetVisibilityRuleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVisibilityRuleRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_SetVisibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryAdminClient) RevealSegment(ctx context.Context, in *RevealSegmentReq, opts ...grpc.CallOption) (*RevealSegmentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealSegmentRes)
+	err := c.cc.Invoke(ctx, InventoryAdmin_RevealSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryAdminServer is the server API for InventoryAdmin service.
+// All implementations must embed UnimplementedInventoryAdminServer
+// for forward compatibility.
+//
+// InventoryAdmin exposes operational RPCs that must never be reachable from the public listener.
+// It is served on a separate admin port with its own authentication.
+type InventoryAdminServer interface {
+	// AdjustCapacity changes the remaining quantity of an event (optimistic locking on version)
+	AdjustCapacity(context.Context, *AdjustCapacityReq) (*AdjustCapacityRes, error)
+	// BlockSeats takes available seats out of sale (e.g. broken seats, production holds)
+	BlockSeats(context.Context, *BlockSeatsReq) (*BlockSeatsRes, error)
+	// UnblockSeats returns blocked seats to sale
+	UnblockSeats(context.Context, *UnblockSeatsReq) (*UnblockSeatsRes, error)
+	// SetSeatStatus moves seats to an operator-managed status (AVAILABLE, BLOCKED, KILLED or
+	// RESERVED_INTERNAL); every seat must be allowed to make the transition
+	SetSeatStatus(context.Context, *SetSeatStatusReq) (*SetSeatStatusRes, error)
+	// SetSeatMetadata replaces the metadata and operator note of seats without changing their status
+	SetSeatMetadata(context.Context, *SetSeatMetadataReq) (*SetSeatMetadataRes, error)
+	// GetSeats returns seats with their status, metadata and note
+	GetSeats(context.Context, *GetSeatsReq) (*GetSeatsRes, error)
+	// EraseSubject replaces the reservation identifiers of a data subject with opaque tokens
+	// in seat and commit status records and deletes the idempotency records keyed by them
+	EraseSubject(context.Context, *EraseSubjectReq) (*EraseSubjectRes, error)
+	// WrapFieldEncryptionKey returns the field encryption data key wrapped under a KMS key, for
+	// DDB_FIELD_ENCRYPTION_DATA_KEY. A configured key is re-wrapped (rotation); otherwise a new
+	// data key is generated.
+	WrapFieldEncryptionKey(context.Context, *WrapFieldEncryptionKeyReq) (*WrapFieldEncryptionKeyRes, error)
+	// SetMaintenanceMode rejects all inventory writes of the instance serving the call while
+	// enabled. The flag isn't shared or persisted: call every instance to cover the service,
+	// or freeze events for a change all instances see.
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeReq) (*SetMaintenanceModeRes, error)
+	// FreezeEvent rejects writes for a single event while reads keep working
+	FreezeEvent(context.Context, *FreezeEventReq) (*FreezeEventRes, error)
+	// UnfreezeEvent lifts a per-event freeze
+	UnfreezeEvent(context.Context, *UnfreezeEventReq) (*UnfreezeEventRes, error)
+	// ReleaseEventHolds releases all HOLD seats of an event in transactional chunks,
+	// streaming progress until done. Used to recover holds stranded by an upstream outage.
+	ReleaseEventHolds(*ReleaseEventHoldsReq, grpc.ServerStreamingServer[ReleaseEventHoldsProgress]) error
+	// ListStuckHolds lists HOLD seats older than hold TTL plus grace.
+	// Without event_id it returns the result of the last background scan.
+	ListStuckHolds(context.Context, *ListStuckHoldsReq) (*ListStuckHoldsRes, error)
+	// PlanCapacity projects sell-out time and peak write throughput for an event
+	// from its current counters and sales velocity, to size tables before an on-sale
+	PlanCapacity(context.Context, *PlanCapacityReq) (*PlanCapacityRes, error)
+	// PutDemandForecast stores the demand curve a forecasting system expects for an event's
+	// on-sale. PlanCapacity projects from it when no sales velocity is requested.
+	PutDemandForecast(context.Context, *PutDemandForecastReq) (*PutDemandForecastRes, error)
+	// StreamEventStats pushes rolled-up stats for the subscribed events every interval
+	// until the client disconnects. Rates are for the instance serving the stream.
+	StreamEventStats(*StreamEventStatsReq, grpc.ServerStreamingServer[EventStatsUpdate]) error
+	// GetEventStats returns an event's current stats, including this instance's hold funnel
+	GetEventStats(context.Context, *GetEventStatsReq) (*EventStats, error)
+	// PutVenueTemplate stores a venue seat layout as the template's next version.
+	// Existing versions are never modified, so events keep the layout they were created from.
+	PutVenueTemplate(context.Context, *PutVenueTemplateReq) (*PutVenueTemplateRes, error)
+	// GetVenueTemplate returns one version of a venue template (latest by default)
+	GetVenueTemplate(context.Context, *GetVenueTemplateReq) (*GetVenueTemplateRes, error)
+	// InstantiateVenueTemplate creates an event's seats from a venue template version
+	// and records the template on the event. Retrying with the same version is safe.
+	InstantiateVenueTemplate(context.Context, *InstantiateVenueTemplateReq) (*InstantiateVenueTemplateRes, error)
+	// SetHoldPolicy overrides the hold TTL, extension and size limits of one event
+	SetHoldPolicy(context.Context, *SetHoldPolicyReq) (*SetHoldPolicyRes, error)
+	// SetSeatsMigration moves an event's seats between the original and new seats tables:
+	// DUAL_WRITE mirrors writes to the new table, CUTOVER makes it authoritative and an
+	// empty state returns to the original table only. Cutover changes require a frozen event.
+	SetSeatsMigration(context.Context, *SetSeatsMigrationReq) (*SetSeatsMigrationRes, error)
+	// VerifySeatsMigration compares an event's seats in both seats tables, optionally
+	// repairing divergent seats. A clean pass marks a DUAL_WRITE event VERIFIED.
+	VerifySeatsMigration(context.Context, *VerifySeatsMigrationReq) (*VerifySeatsMigrationRes, error)
+	// GetCanaryReport reports this instance's comparisons of sampled reads with the
+	// candidate storage implementation; deploy pipelines gate cutover on cutover_ready
+	GetCanaryReport(context.Context, *GetCanaryReportReq) (*GetCanaryReportRes, error)
+	// StartOperation runs a bulk operation in the background and returns it as RUNNING.
+	// Its state is persisted, so any instance can report or cancel it.
+	StartOperation(context.Context, *StartOperationReq) (*Operation, error)
+	// GetOperation reports the progress and outcome of an operation
+	GetOperation(context.Context, *GetOperationReq) (*Operation, error)
+	// CancelOperation asks a running operation to stop; it stops after its current chunk
+	// and ends CANCELLED. Work already done is not undone.
+	CancelOperation(context.Context, *CancelOperationReq) (*Operation, error)
+	// UpsertSeats applies one page of a bulk seat upsert from an external venue system.
+	// Pages of an upload are applied in cursor order; resending an acknowledged page is
+	// acknowledged again without reapplying it, so uploads resume safely after a failure.
+	UpsertSeats(context.Context, *UpsertSeatsReq) (*UpsertSeatsRes, error)
+	// GetSeatUpload returns the cursor of an upload, to resume it from another client
+	GetSeatUpload(context.Context, *GetSeatUploadReq) (*GetSeatUploadRes, error)
+	// CloseEvent moves all of an event's available seats to SOLD (comp allocations) or
+	// CLOSED (sales closed early) in rate-limited chunks, recording a ledger entry per
+	// chunk and streaming cumulative progress. Held seats are left to their reservations.
+	CloseEvent(*CloseEventReq, grpc.ServerStreamingServer[CloseEventProgress]) error
+	// AcquireSectionLock leases a section of an event's seats to an editor. While the lease
+	// is live, admin seat changes in the section fail unless they carry its lease_id;
+	// customer holds and commits are not affected.
+	AcquireSectionLock(context.Context, *AcquireSectionLockReq) (*SectionLease, error)
+	// RenewSectionLock extends a live lease; an expired lease must be acquired again
+	RenewSectionLock(context.Context, *RenewSectionLockReq) (*SectionLease, error)
+	// ReleaseSectionLock ends a lease before it expires
+	ReleaseSectionLock(context.Context, *ReleaseSectionLockReq) (*ReleaseSectionLockRes, error)
+	// CreateEvent creates an event's inventory item and provisions its seats from a seat
+	// layout, streaming cumulative progress. The event stays frozen until every seat is
+	// written; rerunning with the same layout completes an interrupted run.
+	CreateEvent(*CreateEventReq, grpc.ServerStreamingServer[CreateEventProgress]) error
+	// SetVisibilityRule hides a segment of an event's seats until a date or from callers
+	// without a presale access code
+	SetVisibilityRule(context.Context, *SetVisibilityRuleReq) (*SetVisibilityRuleRes, error)
+	// RevealSegment removes a segment's visibility rule, releasing its seats to everyone
+	RevealSegment(context.Context, *RevealSegmentReq) (*RevealSegmentRes, error)
+	mustEmbedUnimplementedInventoryAdminServer()
+}
+
+// UnimplementedInventoryAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryAdminServer struct{}
+
+func (UnimplementedInventoryAdminServer) AdjustCapacity(context.Context, *AdjustCapacityReq) (*AdjustCapacityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustCapacity not implemented")
+}
+func (UnimplementedInventoryAdminServer) BlockSeats(context.Context, *BlockSeatsReq) (*BlockSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeats not implemented")
+}
+func (UnimplementedInventoryAdminServer) UnblockSeats(context.Context, *UnblockSeatsReq) (*UnblockSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeats not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetSeatStatus(context.Context, *SetSeatStatusReq) (*SetSeatStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeatStatus not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetSeatMetadata(context.Context, *SetSeatMetadataReq) (*SetSeatMetadataRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeatMetadata not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetSeats(context.Context, *GetSeatsReq) (*GetSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeats not implemented")
+}
+func (UnimplementedInventoryAdminServer) EraseSubject(context.Context, *EraseSubjectReq) (*EraseSubjectRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseSubject not implemented")
+}
+func (UnimplementedInventoryAdminServer) WrapFieldEncryptionKey(context.Context, *WrapFieldEncryptionKeyReq) (*WrapFieldEncryptionKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapFieldEncryptionKey not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeReq) (*SetMaintenanceModeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedInventoryAdminServer) FreezeEvent(context.Context, *FreezeEventReq) (*FreezeEventRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeEvent not implemented")
+}
+func (UnimplementedInventoryAdminServer) UnfreezeEvent(context.Context, *UnfreezeEventReq) (*UnfreezeEventRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeEvent not implemented")
+}
+func (UnimplementedInventoryAdminServer) ReleaseEventHolds(*ReleaseEventHoldsReq, grpc.ServerStreamingServer[ReleaseEventHoldsProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ReleaseEventHolds not implemented")
+}
+func (UnimplementedInventoryAdminServer) ListStuckHolds(context.Context, *ListStuckHoldsReq) (*ListStuckHoldsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStuckHolds not implemented")
+}
+func (UnimplementedInventoryAdminServer) PlanCapacity(context.Context, *PlanCapacityReq) (*PlanCapacityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanCapacity not implemented")
+}
+func (UnimplementedInventoryAdminServer) PutDemandForecast(context.Context, *PutDemandForecastReq) (*PutDemandForecastRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutDemandForecast not implemented")
+}
+func (UnimplementedInventoryAdminServer) StreamEventStats(*StreamEventStatsReq, grpc.ServerStreamingServer[EventStatsUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEventStats not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetEventStats(context.Context, *GetEventStatsReq) (*EventStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventStats not implemented")
+}
+func (UnimplementedInventoryAdminServer) PutVenueTemplate(context.Context, *PutVenueTemplateReq) (*PutVenueTemplateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutVenueTemplate not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetVenueTemplate(context.Context, *GetVenueTemplateReq) (*GetVenueTemplateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenueTemplate not implemented")
+}
+func (UnimplementedInventoryAdminServer) InstantiateVenueTemplate(context.Context, *InstantiateVenueTemplateReq) (*InstantiateVenueTemplateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateVenueTemplate not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetHoldPolicy(context.Context, *SetHoldPolicyReq) (*SetHoldPolicyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHoldPolicy not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetSeatsMigration(context.Context, *SetSeatsMigrationReq) (*SetSeatsMigrationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeatsMigration not implemented")
+}
+func (UnimplementedInventoryAdminServer) VerifySeatsMigration(context.Context, *VerifySeatsMigrationReq) (*VerifySeatsMigrationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySeatsMigration not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetCanaryReport(context.Context, *GetCanaryReportReq) (*GetCanaryReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCanaryReport not implemented")
+}
+func (UnimplementedInventoryAdminServer) StartOperation(context.Context, *StartOperationReq) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOperation not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetOperation(context.Context, *GetOperationReq) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedInventoryAdminServer) CancelOperation(context.Context, *CancelOperationReq) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedInventoryAdminServer) UpsertSeats(context.Context, *UpsertSeatsReq) (*UpsertSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertSeats not implemented")
+}
+func (UnimplementedInventoryAdminServer) GetSeatUpload(context.Context, *GetSeatUploadReq) (*GetSeatUploadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatUpload not implemented")
+}
+func (UnimplementedInventoryAdminServer) CloseEvent(*CloseEventReq, grpc.ServerStreamingServer[CloseEventProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method CloseEvent not implemented")
+}
+func (UnimplementedInventoryAdminServer) AcquireSectionLock(context.Context, *AcquireSectionLockReq) (*SectionLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireSectionLock not implemented")
+}
+func (UnimplementedInventoryAdminServer) RenewSectionLock(context.Context, *RenewSectionLockReq) (*SectionLease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewSectionLock not implemented")
+}
+func (UnimplementedInventoryAdminServer) ReleaseSectionLock(context.Context, *ReleaseSectionLockReq) (*ReleaseSectionLockRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSectionLock not implemented")
+}
+func (UnimplementedInventoryAdminServer) CreateEvent(*CreateEventReq, grpc.ServerStreamingServer[CreateEventProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedInventoryAdminServer) SetVisibilityRule(context.Context, *SetVisibilityRuleReq) (*SetVisibilityRuleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVisibilityRule not implemented")
+}
+func (UnimplementedInventoryAdminServer) RevealSegment(context.Context, *RevealSegmentReq) (*RevealSegmentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSegment not implemented")
+}
+func (UnimplementedInventoryAdminServer) mustEmbedUnimplementedInventoryAdminServer() {}
+func (UnimplementedInventoryAdminServer) testEmbeddedByValue()                        {}
+
+// UnsafeInventoryAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryAdminServer will
+// result in compilation errors.
+type UnsafeInventoryAdminServer interface {
+	mustEmbedUnimplementedInventoryAdminServer()
+}
+
+func RegisterInventoryAdminServer(s grpc.ServiceRegistrar, srv InventoryAdminServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryAdmin_ServiceDesc, srv)
+}
+
+func _InventoryAdmin_AdjustCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustCapacityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).AdjustCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_AdjustCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).AdjustCapacity(ctx, req.(*AdjustCapacityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_BlockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).BlockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_BlockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).BlockSeats(ctx, req.(*BlockSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_UnblockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).UnblockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_UnblockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).UnblockSeats(ctx, req.(*UnblockSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_SetSeatStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeatStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetSeatStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetSeatStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetSeatStatus(ctx, req.(*SetSeatStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_SetSeatMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeatMetadataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetSeatMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetSeatMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetSeatMetadata(ctx, req.(*SetSeatMetadataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_GetSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetSeats(ctx, req.(*GetSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_EraseSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).EraseSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_EraseSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).EraseSubject(ctx, req.(*EraseSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_WrapFieldEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WrapFieldEncryptionKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).WrapFieldEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_WrapFieldEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).WrapFieldEncryptionKey(ctx, req.(*WrapFieldEncryptionKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_FreezeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeEventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).FreezeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_FreezeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).FreezeEvent(ctx, req.(*FreezeEventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_UnfreezeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeEventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).UnfreezeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_UnfreezeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).UnfreezeEvent(ctx, req.(*UnfreezeEventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_ReleaseEventHolds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReleaseEventHoldsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryAdminServer).ReleaseEventHolds(m, &grpc.GenericServerStream[ReleaseEventHoldsReq, ReleaseEventHoldsProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_ReleaseEventHoldsServer = grpc.ServerStreamingServer[ReleaseEventHoldsProgress]
+
+func _InventoryAdmin_ListStuckHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStuckHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).ListStuckHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_ListStuckHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).ListStuckHolds(ctx, req.(*ListStuckHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_PlanCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanCapacityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).PlanCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_PlanCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).PlanCapacity(ctx, req.(*PlanCapacityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_PutDemandForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutDemandForecastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).PutDemandForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_PutDemandForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).PutDemandForecast(ctx, req.(*PutDemandForecastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_StreamEventStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventStatsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryAdminServer).StreamEventStats(m, &grpc.GenericServerStream[StreamEventStatsReq, EventStatsUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_StreamEventStatsServer = grpc.ServerStreamingServer[EventStatsUpdate]
+
+func _InventoryAdmin_GetEventStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetEventStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetEventStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetEventStats(ctx, req.(*GetEventStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_PutVenueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutVenueTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).PutVenueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_PutVenueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).PutVenueTemplate(ctx, req.(*PutVenueTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_GetVenueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenueTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetVenueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetVenueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetVenueTemplate(ctx, req.(*GetVenueTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_InstantiateVenueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateVenueTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).InstantiateVenueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_InstantiateVenueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).InstantiateVenueTemplate(ctx, req.(*InstantiateVenueTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_SetHoldPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHoldPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetHoldPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetHoldPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetHoldPolicy(ctx, req.(*SetHoldPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_SetSeatsMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeatsMigrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetSeatsMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetSeatsMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetSeatsMigration(ctx, req.(*SetSeatsMigrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_VerifySeatsMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySeatsMigrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).VerifySeatsMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_VerifySeatsMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).VerifySeatsMigration(ctx, req.(*VerifySeatsMigrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_GetCanaryReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanaryReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetCanaryReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetCanaryReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetCanaryReport(ctx, req.(*GetCanaryReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_StartOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).StartOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_StartOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).StartOperation(ctx, req.(*StartOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetOperation(ctx, req.(*GetOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).CancelOperation(ctx, req.(*CancelOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_UpsertSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).UpsertSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_UpsertSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).UpsertSeats(ctx, req.(*UpsertSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_GetSeatUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatUploadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).GetSeatUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_GetSeatUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).GetSeatUpload(ctx, req.(*GetSeatUploadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_CloseEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseEventReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryAdminServer).CloseEvent(m, &grpc.GenericServerStream[CloseEventReq, CloseEventProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_CloseEventServer = grpc.ServerStreamingServer[CloseEventProgress]
+
+func _InventoryAdmin_AcquireSectionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireSectionLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).AcquireSectionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_AcquireSectionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).AcquireSectionLock(ctx, req.(*AcquireSectionLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_RenewSectionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSectionLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).RenewSectionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_RenewSectionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).RenewSectionLock(ctx, req.(*RenewSectionLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_ReleaseSectionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSectionLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).ReleaseSectionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_ReleaseSectionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).ReleaseSectionLock(ctx, req.(*ReleaseSectionLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_CreateEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateEventReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryAdminServer).CreateEvent(m, &grpc.GenericServerStream[CreateEventReq, CreateEventProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryAdmin_CreateEventServer = grpc.ServerStreamingServer[CreateEventProgress]
+
+func _InventoryAdmin_SetVisibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVisibilityRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).SetVisibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_SetVisibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).SetVisibilityRule(ctx, req.(*SetVisibilityRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryAdmin_RevealSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealSegmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryAdminServer).RevealSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryAdmin_RevealSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryAdminServer).RevealSegment(ctx, req.(*RevealSegmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryAdmin_ServiceDesc is the grpc.ServiceDesc for InventoryAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "inventory.v1.InventoryAdmin",
+	HandlerType: (*InventoryAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AdjustCapacity",
+			Handler:    _InventoryAdmin_AdjustCapacity_Handler,
+		},
+		{
+			MethodName: "BlockSeats",
+			Handler:    _InventoryAdmin_BlockSeats_Handler,
+		},
+		{
+			MethodName: "UnblockSeats",
+			Handler:    _InventoryAdmin_UnblockSeats_Handler,
+		},
+		{
+			MethodName: "SetSeatStatus",
+			Handler:    _InventoryAdmin_SetSeatStatus_Handler,
+		},
+		{
+			MethodName: "SetSeatMetadata",
+			Handler:    _InventoryAdmin_SetSeatMetadata_Handler,
+		},
+		{
+			MethodName: "GetSeats",
+			Handler:    _InventoryAdmin_GetSeats_Handler,
+		},
+		{
+			MethodName: "EraseSubject",
+			Handler:    _InventoryAdmin_EraseSubject_Handler,
+		},
+		{
+			MethodName: "WrapFieldEncryptionKey",
+			Handler:    _InventoryAdmin_WrapFieldEncryptionKey_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _InventoryAdmin_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "FreezeEvent",
+			Handler:    _InventoryAdmin_FreezeEvent_Handler,
+		},
+		{
+			MethodName: "UnfreezeEvent",
+			Handler:    _InventoryAdmin_UnfreezeEvent_Handler,
+		},
+		{
+			MethodName: "ListStuckHolds",
+			Handler:    _InventoryAdmin_ListStuckHolds_Handler,
+		},
+		{
+			MethodName: "PlanCapacity",
+			Handler:    _InventoryAdmin_PlanCapacity_Handler,
+		},
+		{
+			MethodName: "PutDemandForecast",
+			Handler:    _InventoryAdmin_PutDemandForecast_Handler,
+		},
+		{
+			MethodName: "GetEventStats",
+			Handler:    _InventoryAdmin_GetEventStats_Handler,
+		},
+		{
+			MethodName: "PutVenueTemplate",
+			Handler:    _InventoryAdmin_PutVenueTemplate_Handler,
+		},
+		{
+			MethodName: "GetVenueTemplate",
+			Handler:    _InventoryAdmin_GetVenueTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateVenueTemplate",
+			Handler:    _InventoryAdmin_InstantiateVenueTemplate_Handler,
+		},
+		{
+			MethodName: "SetHoldPolicy",
+			Handler:    _InventoryAdmin_SetHoldPolicy_Handler,
+		},
+		{
+			MethodName: "SetSeatsMigration",
+			Handler:    _InventoryAdmin_SetSeatsMigration_Handler,
+		},
+		{
+			MethodName: "VerifySeatsMigration",
+			Handler:    _InventoryAdmin_VerifySeatsMigration_Handler,
+		},
+		{
+			MethodName: "GetCanaryReport",
+			Handler:    _InventoryAdmin_GetCanaryReport_Handler,
+		},
+		{
+			MethodName: "StartOperation",
+			Handler:    _InventoryAdmin_StartOperation_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _InventoryAdmin_GetOperation_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _InventoryAdmin_CancelOperation_Handler,
+		},
+		{
+			MethodName: "UpsertSeats",
+			Handler:    _InventoryAdmin_UpsertSeats_Handler,
+		},
+		{
+			MethodName: "GetSeatUpload",
+			Handler:    _InventoryAdmin_GetSeatUpload_Handler,
+		},
+		{
+			MethodName: "AcquireSectionLock",
+			Handler:    _InventoryAdmin_AcquireSectionLock_Handler,
+		},
+		{
+			MethodName: "RenewSectionLock",
+			Handler:    _InventoryAdmin_RenewSectionLock_Handler,
+		},
+		{
+			MethodName: "ReleaseSectionLock",
+			Handler:    _InventoryAdmin_ReleaseSectionLock_Handler,
+		},
+		{
+			MethodName: "SetVisibilityRule",
+			Handler:    _InventoryAdmin_SetVisibilityRule_Handler,
+		},
+		{
+			MethodName: "RevealSegment",
+			Handler:    _InventoryAdmin_RevealSegment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReleaseEventHolds",
+			Handler:       _InventoryAdmin_ReleaseEventHolds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEventStats",
+			Handler:       _InventoryAdmin_StreamEventStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CloseEvent",
+			Handler:       _InventoryAdmin_CloseEvent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateEvent",
+			Handler:       _InventoryAdmin_CreateEvent_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/admin.proto",
+}
@@ -7,9 +7,10 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,28 +23,295 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// SeatRef represents a reference to a specific seat
-type SeatRef struct {
+// LoadState is a coarse reading of the saturation of an instance
+type LoadState int32
+
+const (
+	LoadState_LOAD_STATE_UNSPECIFIED LoadState = 0
+	// Admit traffic normally
+	LoadState_LOAD_STATE_NORMAL LoadState = 1
+	// Approaching capacity; slow down admissions
+	LoadState_LOAD_STATE_ELEVATED LoadState = 2
+	// At capacity or shedding load; hold admissions back
+	LoadState_LOAD_STATE_OVERLOADED LoadState = 3
+)
+
+// Enum value maps for LoadState.
+var (
+	LoadState_name = map[int32]string{
+		0: "LOAD_STATE_UNSPECIFIED",
+		1: "LOAD_STATE_NORMAL",
+		2: "LOAD_STATE_ELEVATED",
+		3: "LOAD_STATE_OVERLOADED",
+	}
+	LoadState_value = map[string]int32{
+		"LOAD_STATE_UNSPECIFIED": 0,
+		"LOAD_STATE_NORMAL":      1,
+		"LOAD_STATE_ELEVATED":    2,
+		"LOAD_STATE_OVERLOADED":  3,
+	}
+)
+
+func (x LoadState) Enum() *LoadState {
+	p := new(LoadState)
+	*p = x
+	return p
+}
+
+func (x LoadState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoadState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (LoadState) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[0]
+}
+
+func (x LoadState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoadState.Descriptor instead.
+func (LoadState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// SeatStatus is the sale status of a seat. Seat items store and report the
+// status name without the SEAT_STATUS_ prefix, e.g. "AVAILABLE".
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_UNSPECIFIED SeatStatus = 0
+	// Open for sale
+	SeatStatus_SEAT_STATUS_AVAILABLE SeatStatus = 1
+	// Held by a reservation until it is committed, released or expires
+	SeatStatus_SEAT_STATUS_HOLD SeatStatus = 2
+	// Committed to an order
+	SeatStatus_SEAT_STATUS_SOLD SeatStatus = 3
+	// Temporarily withheld from sale by an operator
+	SeatStatus_SEAT_STATUS_BLOCKED SeatStatus = 4
+	// Removed from the seat map for the event, e.g. for camera positions or sightline loss
+	SeatStatus_SEAT_STATUS_KILLED SeatStatus = 5
+	// Set aside for internal use (house seats, production, artist allocations)
+	SeatStatus_SEAT_STATUS_RESERVED_INTERNAL SeatStatus = 6
+	// Allocated to a season ticket until the performance is materialized or released
+	SeatStatus_SEAT_STATUS_ALLOCATED SeatStatus = 7
+	// Withdrawn from sale when an event's sales were closed early
+	SeatStatus_SEAT_STATUS_CLOSED SeatStatus = 8
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_UNSPECIFIED",
+		1: "SEAT_STATUS_AVAILABLE",
+		2: "SEAT_STATUS_HOLD",
+		3: "SEAT_STATUS_SOLD",
+		4: "SEAT_STATUS_BLOCKED",
+		5: "SEAT_STATUS_KILLED",
+		6: "SEAT_STATUS_RESERVED_INTERNAL",
+		7: "SEAT_STATUS_ALLOCATED",
+		8: "SEAT_STATUS_CLOSED",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_UNSPECIFIED":       0,
+		"SEAT_STATUS_AVAILABLE":         1,
+		"SEAT_STATUS_HOLD":              2,
+		"SEAT_STATUS_SOLD":              3,
+		"SEAT_STATUS_BLOCKED":           4,
+		"SEAT_STATUS_KILLED":            5,
+		"SEAT_STATUS_RESERVED_INTERNAL": 6,
+		"SEAT_STATUS_ALLOCATED":         7,
+		"SEAT_STATUS_CLOSED":            8,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[1]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// SeatHolder is who keeps a seat that isn't available
+type SeatHolder int32
+
+const (
+	// Nobody: the seat is available, unknown or hidden
+	SeatHolder_SEAT_HOLDER_UNSPECIFIED SeatHolder = 0
+	// A reservation's hold
+	SeatHolder_SEAT_HOLDER_HOLD SeatHolder = 1
+	// An order
+	SeatHolder_SEAT_HOLDER_SOLD SeatHolder = 2
+	// A season ticket allocation
+	SeatHolder_SEAT_HOLDER_SEASON SeatHolder = 3
+	// An operator (blocked, killed or reserved internally)
+	SeatHolder_SEAT_HOLDER_OPERATOR SeatHolder = 4
+)
+
+// Enum value maps for SeatHolder.
+var (
+	SeatHolder_name = map[int32]string{
+		0: "SEAT_HOLDER_UNSPECIFIED",
+		1: "SEAT_HOLDER_HOLD",
+		2: "SEAT_HOLDER_SOLD",
+		3: "SEAT_HOLDER_SEASON",
+		4: "SEAT_HOLDER_OPERATOR",
+	}
+	SeatHolder_value = map[string]int32{
+		"SEAT_HOLDER_UNSPECIFIED": 0,
+		"SEAT_HOLDER_HOLD":        1,
+		"SEAT_HOLDER_SOLD":        2,
+		"SEAT_HOLDER_SEASON":      3,
+		"SEAT_HOLDER_OPERATOR":    4,
+	}
+)
+
+func (x SeatHolder) Enum() *SeatHolder {
+	p := new(SeatHolder)
+	*p = x
+	return p
+}
+
+func (x SeatHolder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatHolder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (SeatHolder) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[2]
+}
+
+func (x SeatHolder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatHolder.Descriptor instead.
+func (SeatHolder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// ErrorCode classifies why a commit, release or hold failed, so clients and dashboards
+// classify failures the same way. Failed calls carry it in an ErrorDetail status detail
+// next to the google.rpc.ErrorInfo; other failures have no code.
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	// The event has fewer tickets left than requested
+	ErrorCode_ERROR_CODE_INSUFFICIENT_INVENTORY ErrorCode = 1
+	// Seats are held or bought by another reservation, or otherwise not for sale
+	ErrorCode_ERROR_CODE_SEAT_CONFLICT ErrorCode = 2
+	// The reservation's hold expired before it was committed
+	ErrorCode_ERROR_CODE_HOLD_EXPIRED ErrorCode = 3
+	// The event is frozen or closed
+	ErrorCode_ERROR_CODE_EVENT_NOT_ON_SALE ErrorCode = 4
+	// A per-hold, extension or partner quota limit was reached
+	ErrorCode_ERROR_CODE_LIMIT_EXCEEDED ErrorCode = 5
+	// The buyer fingerprint placed more orders of the event than the velocity rules allow
+	ErrorCode_ERROR_CODE_VELOCITY_LIMIT_EXCEEDED ErrorCode = 6
+	// The order has more tickets of an event, or in total, than the event allows
+	ErrorCode_ERROR_CODE_ORDER_LIMIT_EXCEEDED ErrorCode = 7
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNSPECIFIED",
+		1: "ERROR_CODE_INSUFFICIENT_INVENTORY",
+		2: "ERROR_CODE_SEAT_CONFLICT",
+		3: "ERROR_CODE_HOLD_EXPIRED",
+		4: "ERROR_CODE_EVENT_NOT_ON_SALE",
+		5: "ERROR_CODE_LIMIT_EXCEEDED",
+		6: "ERROR_CODE_VELOCITY_LIMIT_EXCEEDED",
+		7: "ERROR_CODE_ORDER_LIMIT_EXCEEDED",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":             0,
+		"ERROR_CODE_INSUFFICIENT_INVENTORY":  1,
+		"ERROR_CODE_SEAT_CONFLICT":           2,
+		"ERROR_CODE_HOLD_EXPIRED":            3,
+		"ERROR_CODE_EVENT_NOT_ON_SALE":       4,
+		"ERROR_CODE_LIMIT_EXCEEDED":          5,
+		"ERROR_CODE_VELOCITY_LIMIT_EXCEEDED": 6,
+		"ERROR_CODE_ORDER_LIMIT_EXCEEDED":    7,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[3]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// GetLoadStatusReq represents a request for the load of the instance
+type GetLoadStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeatRef) Reset() {
-	*x = SeatRef{}
+func (x *GetLoadStatusReq) Reset() {
+	*x = GetLoadStatusReq{}
 	mi := &file_proto_inventory_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeatRef) String() string {
+func (x *GetLoadStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatRef) ProtoMessage() {}
+func (*GetLoadStatusReq) ProtoMessage() {}
 
-func (x *SeatRef) ProtoReflect() protoreflect.Message {
+func (x *GetLoadStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_inventory_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -55,45 +323,2920 @@ func (x *SeatRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatRef.ProtoReflect.Descriptor instead.
-func (*SeatRef) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoadStatusReq.ProtoReflect.Descriptor instead.
+func (*GetLoadStatusReq) Descriptor() ([]byte, []int) {
 	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *SeatRef) GetSeatId() string {
+// LoadStatus reports the saturation of one instance
+type LoadStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Highest of the saturation signals below, from 0 (idle) to 1 (saturated)
+	Saturation float64   `protobuf:"fixed64,1,opt,name=saturation,proto3" json:"saturation,omitempty"`
+	State      LoadState `protobuf:"varint,2,opt,name=state,proto3,enum=inventory.v1.LoadState" json:"state,omitempty"`
+	// Public RPCs in flight and the configured concurrency limit
+	InFlight    int32 `protobuf:"varint,3,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	MaxInFlight int32 `protobuf:"varint,4,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	// Brownout level (0-3); load is shed from level 1
+	BrownoutLevel int32 `protobuf:"varint,5,opt,name=brownout_level,json=brownoutLevel,proto3" json:"brownout_level,omitempty"`
+	// Share of the commit queue in use
+	CommitQueueFill float64 `protobuf:"fixed64,6,opt,name=commit_queue_fill,json=commitQueueFill,proto3" json:"commit_queue_fill,omitempty"`
+	// Public RPCs throttled by DynamoDB in the last second, and their share of all RPCs
+	ThrottledPerSecond float64 `protobuf:"fixed64,7,opt,name=throttled_per_second,json=throttledPerSecond,proto3" json:"throttled_per_second,omitempty"`
+	ThrottleRatio      float64 `protobuf:"fixed64,8,opt,name=throttle_ratio,json=throttleRatio,proto3" json:"throttle_ratio,omitempty"`
+	// Writes fail fast while DynamoDB is down or maintenance mode is on; saturation is then 1
+	Degraded      bool `protobuf:"varint,9,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	Maintenance   bool `protobuf:"varint,10,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadStatus) Reset() {
+	*x = LoadStatus{}
+	mi := &file_proto_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadStatus) ProtoMessage() {}
+
+func (x *LoadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadStatus.ProtoReflect.Descriptor instead.
+func (*LoadStatus) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoadStatus) GetSaturation() float64 {
+	if x != nil {
+		return x.Saturation
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetState() LoadState {
+	if x != nil {
+		return x.State
+	}
+	return LoadState_LOAD_STATE_UNSPECIFIED
+}
+
+func (x *LoadStatus) GetInFlight() int32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetBrownoutLevel() int32 {
+	if x != nil {
+		return x.BrownoutLevel
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetCommitQueueFill() float64 {
+	if x != nil {
+		return x.CommitQueueFill
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetThrottledPerSecond() float64 {
+	if x != nil {
+		return x.ThrottledPerSecond
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetThrottleRatio() float64 {
+	if x != nil {
+		return x.ThrottleRatio
+	}
+	return 0
+}
+
+func (x *LoadStatus) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *LoadStatus) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+// SectionQty is a quantity in a general-admission section of a hybrid event
+type SectionQty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Qty           int32                  `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionQty) Reset() {
+	*x = SectionQty{}
+	mi := &file_proto_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionQty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionQty) ProtoMessage() {}
+
+func (x *SectionQty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionQty.ProtoReflect.Descriptor instead.
+func (*SectionQty) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SectionQty) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SectionQty) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+// SeatAvailability is the state of one requested seat of a seat check
+type SeatAvailability struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SeatId string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// UNSPECIFIED for seats that don't exist or are hidden
+	Status SeatStatus `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	Holder SeatHolder `protobuf:"varint,3,opt,name=holder,proto3,enum=inventory.v1.SeatHolder" json:"holder,omitempty"`
+	// Hidden by a visibility rule; the seat reports unavailable without its status
+	Hidden        bool `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatAvailability) Reset() {
+	*x = SeatAvailability{}
+	mi := &file_proto_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatAvailability) ProtoMessage() {}
+
+func (x *SeatAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatAvailability.ProtoReflect.Descriptor instead.
+func (*SeatAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SeatAvailability) GetSeatId() string {
 	if x != nil {
 		return x.SeatId
 	}
-	return ""
+	return ""
+}
+
+func (x *SeatAvailability) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *SeatAvailability) GetHolder() SeatHolder {
+	if x != nil {
+		return x.Holder
+	}
+	return SeatHolder_SEAT_HOLDER_UNSPECIFIED
+}
+
+func (x *SeatAvailability) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// SeatRef represents a reference to a specific seat
+type SeatRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatRef) Reset() {
+	*x = SeatRef{}
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatRef) ProtoMessage() {}
+
+func (x *SeatRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatRef.ProtoReflect.Descriptor instead.
+func (*SeatRef) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SeatRef) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+// Seat describes a seat of an event
+type Seat struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SeatId string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Status SeatStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	// Operator annotations (e.g. "view": "obstructed", "ada_companion": "A-2");
+	// they never affect availability
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Seat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *Seat) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *Seat) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Seat) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Seat) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CheckReq represents a request to check availability
+type CheckReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// If qty > 0, check quantity-based inventory
+	// If seat_ids is not empty, check seat-based inventory (takes precedence)
+	Qty     int32      `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
+	SeatIds []*SeatRef `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Performance (showtime) of a multi-performance event; empty for single-performance events
+	PerformanceId string `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Presale access code revealing hidden seat segments; hidden seats report unavailable without it
+	AccessCode    string `protobuf:"bytes,5,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckReq) Reset() {
+	*x = CheckReq{}
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckReq) ProtoMessage() {}
+
+func (x *CheckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckReq.ProtoReflect.Descriptor instead.
+func (*CheckReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckReq) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *CheckReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CheckReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *CheckReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+// CheckRes represents the response to availability check
+type CheckRes struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Available        bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	UnavailableSeats []string               `protobuf:"bytes,2,rep,name=unavailable_seats,json=unavailableSeats,proto3" json:"unavailable_seats,omitempty"`
+	// Seat map version of the event for seat checks, to validate cached seat maps;
+	// 0 when unknown in degraded mode
+	SeatMapVersion int32 `protobuf:"varint,3,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	// Served from a last-known snapshot while the database is unavailable
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Time the stale answer reflects at the latest; set only when stale
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// Remaining quantity of quantity checks; 0 for seat checks. Above the configured
+	// threshold it is rounded down, so exact stock isn't exposed.
+	Remaining int32 `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// Set when remaining was rounded down and means "at least remaining"
+	RemainingApproximate bool `protobuf:"varint,7,opt,name=remaining_approximate,json=remainingApproximate,proto3" json:"remaining_approximate,omitempty"`
+	// State of every requested seat of seat checks, in request order
+	Seats         []*SeatAvailability `protobuf:"bytes,8,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRes) Reset() {
+	*x = CheckRes{}
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRes) ProtoMessage() {}
+
+func (x *CheckRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRes.ProtoReflect.Descriptor instead.
+func (*CheckRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckRes) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckRes) GetUnavailableSeats() []string {
+	if x != nil {
+		return x.UnavailableSeats
+	}
+	return nil
+}
+
+func (x *CheckRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+func (x *CheckRes) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *CheckRes) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *CheckRes) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *CheckRes) GetRemainingApproximate() bool {
+	if x != nil {
+		return x.RemainingApproximate
+	}
+	return false
+}
+
+func (x *CheckRes) GetSeats() []*SeatAvailability {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+// EventCheck is the availability check of one event of a batch check
+type EventCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Quantity the event must have left to be available; 0 checks for any
+	Qty           int32 `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventCheck) Reset() {
+	*x = EventCheck{}
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCheck) ProtoMessage() {}
+
+func (x *EventCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCheck.ProtoReflect.Descriptor instead.
+func (*EventCheck) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventCheck) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventCheck) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *EventCheck) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+// BatchCheckAvailabilityReq represents a request to check several events at once
+type BatchCheckAvailabilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*EventCheck          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCheckAvailabilityReq) Reset() {
+	*x = BatchCheckAvailabilityReq{}
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCheckAvailabilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckAvailabilityReq) ProtoMessage() {}
+
+func (x *BatchCheckAvailabilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckAvailabilityReq.ProtoReflect.Descriptor instead.
+func (*BatchCheckAvailabilityReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchCheckAvailabilityReq) GetEvents() []*EventCheck {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// EventCheckResult is the availability of one event of a batch check
+type EventCheckResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Unset when the check failed
+	Availability  *CheckRes    `protobuf:"bytes,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	Result        *BatchResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventCheckResult) Reset() {
+	*x = EventCheckResult{}
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCheckResult) ProtoMessage() {}
+
+func (x *EventCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCheckResult.ProtoReflect.Descriptor instead.
+func (*EventCheckResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventCheckResult) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventCheckResult) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *EventCheckResult) GetAvailability() *CheckRes {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *EventCheckResult) GetResult() *BatchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// BatchCheckAvailabilityRes represents the response to a batch availability check
+type BatchCheckAvailabilityRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per requested event, in request order
+	Results       []*EventCheckResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCheckAvailabilityRes) Reset() {
+	*x = BatchCheckAvailabilityRes{}
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCheckAvailabilityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckAvailabilityRes) ProtoMessage() {}
+
+func (x *BatchCheckAvailabilityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckAvailabilityRes.ProtoReflect.Descriptor instead.
+func (*BatchCheckAvailabilityRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchCheckAvailabilityRes) GetResults() []*EventCheckResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CommitReq represents a request to commit a reservation
+type CommitReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId   string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId         string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Qty             int32                  `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	SeatIds         []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PaymentIntentId string                 `protobuf:"bytes,5,opt,name=payment_intent_id,json=paymentIntentId,proto3" json:"payment_intent_id,omitempty"`
+	// General-admission quantities of a hybrid event, committed atomically with seat_ids
+	SectionQtys   []*SectionQty `protobuf:"bytes,6,rep,name=section_qtys,json=sectionQtys,proto3" json:"section_qtys,omitempty"`
+	PerformanceId string        `protobuf:"bytes,7,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Line items of a bundle (e.g. Saturday + Sunday passes), committed all or none under
+	// one order. A bundle leaves event_id, performance_id, qty, seat_ids and section_qtys empty.
+	LineItems []*CommitLineItem `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	// Token from PreauthorizeCommit for this commit; required when the service requires
+	// pre-authorization
+	PreauthToken string `protobuf:"bytes,9,opt,name=preauth_token,json=preauthToken,proto3" json:"preauth_token,omitempty"`
+	// Opaque fingerprint of the buyer, e.g. a hash of their payment method or account, from
+	// reservation-api. Orders per fingerprint and event are limited by the velocity rules;
+	// commits without one aren't limited.
+	BuyerFingerprint string `protobuf:"bytes,10,opt,name=buyer_fingerprint,json=buyerFingerprint,proto3" json:"buyer_fingerprint,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommitReq) Reset() {
+	*x = CommitReq{}
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReq) ProtoMessage() {}
+
+func (x *CommitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReq.ProtoReflect.Descriptor instead.
+func (*CommitReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommitReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *CommitReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CommitReq) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *CommitReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CommitReq) GetPaymentIntentId() string {
+	if x != nil {
+		return x.PaymentIntentId
+	}
+	return ""
+}
+
+func (x *CommitReq) GetSectionQtys() []*SectionQty {
+	if x != nil {
+		return x.SectionQtys
+	}
+	return nil
+}
+
+func (x *CommitReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *CommitReq) GetLineItems() []*CommitLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *CommitReq) GetPreauthToken() string {
+	if x != nil {
+		return x.PreauthToken
+	}
+	return ""
+}
+
+func (x *CommitReq) GetBuyerFingerprint() string {
+	if x != nil {
+		return x.BuyerFingerprint
+	}
+	return ""
+}
+
+// PreauthorizeCommitRes represents a pre-authorized commit
+type PreauthorizeCommitRes struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PreauthToken string                 `protobuf:"bytes,1,opt,name=preauth_token,json=preauthToken,proto3" json:"preauth_token,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Lines the commit will confirm, with the locked seat prices
+	Lines         []*OrderLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreauthorizeCommitRes) Reset() {
+	*x = PreauthorizeCommitRes{}
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreauthorizeCommitRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreauthorizeCommitRes) ProtoMessage() {}
+
+func (x *PreauthorizeCommitRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreauthorizeCommitRes.ProtoReflect.Descriptor instead.
+func (*PreauthorizeCommitRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreauthorizeCommitRes) GetPreauthToken() string {
+	if x != nil {
+		return x.PreauthToken
+	}
+	return ""
+}
+
+func (x *PreauthorizeCommitRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PreauthorizeCommitRes) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// CommitLineItem is the part of a bundle commit for one event or performance, held by
+// the bundle's reservation
+type CommitLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Qty           int32                  `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	SectionQtys   []*SectionQty          `protobuf:"bytes,5,rep,name=section_qtys,json=sectionQtys,proto3" json:"section_qtys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitLineItem) Reset() {
+	*x = CommitLineItem{}
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitLineItem) ProtoMessage() {}
+
+func (x *CommitLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitLineItem.ProtoReflect.Descriptor instead.
+func (*CommitLineItem) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CommitLineItem) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CommitLineItem) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *CommitLineItem) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *CommitLineItem) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CommitLineItem) GetSectionQtys() []*SectionQty {
+	if x != nil {
+		return x.SectionQtys
+	}
+	return nil
+}
+
+// CommitRes represents the response to commit reservation
+type CommitRes struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "CONFIRMED", or "PENDING" for asynchronous commits
+	// Seats plus quantity committed; set with lines
+	CommittedQty int32 `protobuf:"varint,3,opt,name=committed_qty,json=committedQty,proto3" json:"committed_qty,omitempty"`
+	// Confirmed lines for receipts; retries of a confirmed reservation get the lines of the
+	// call that confirmed it, and asynchronous commits have none
+	Lines         []*OrderLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitRes) Reset() {
+	*x = CommitRes{}
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitRes) ProtoMessage() {}
+
+func (x *CommitRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitRes.ProtoReflect.Descriptor instead.
+func (*CommitRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CommitRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CommitRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CommitRes) GetCommittedQty() int32 {
+	if x != nil {
+		return x.CommittedQty
+	}
+	return 0
+}
+
+func (x *CommitRes) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// OrderLine is one confirmed line of a commit
+type OrderLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat of a reserved-seat line; empty for quantity and general-admission lines
+	SeatId string `protobuf:"bytes,3,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// General-admission section, or the seat's "section" metadata
+	Section string `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	// The seat's "tier" and "price" metadata, when present
+	Tier  string `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// 1 for seats
+	Qty           int32 `protobuf:"varint,7,opt,name=qty,proto3" json:"qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderLine) Reset() {
+	*x = OrderLine{}
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLine) ProtoMessage() {}
+
+func (x *OrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLine.ProtoReflect.Descriptor instead.
+func (*OrderLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderLine) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderLine) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *OrderLine) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *OrderLine) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *OrderLine) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *OrderLine) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderLine) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+// ReleaseReq represents a request to release a hold
+type ReleaseReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Qty           int32                  `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// General-admission quantities of a hybrid event, released atomically with seat_ids
+	SectionQtys   []*SectionQty `protobuf:"bytes,5,rep,name=section_qtys,json=sectionQtys,proto3" json:"section_qtys,omitempty"`
+	PerformanceId string        `protobuf:"bytes,6,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReq) Reset() {
+	*x = ReleaseReq{}
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReq) ProtoMessage() {}
+
+func (x *ReleaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReq.ProtoReflect.Descriptor instead.
+func (*ReleaseReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReleaseReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReleaseReq) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *ReleaseReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReleaseReq) GetSectionQtys() []*SectionQty {
+	if x != nil {
+		return x.SectionQtys
+	}
+	return nil
+}
+
+func (x *ReleaseReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// ReleaseRes represents the response to release hold
+type ReleaseRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "RELEASED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRes) Reset() {
+	*x = ReleaseRes{}
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRes) ProtoMessage() {}
+
+func (x *ReleaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRes.ProtoReflect.Descriptor instead.
+func (*ReleaseRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// HoldReq represents a request to hold seats for a reservation.
+// Holding seats the reservation already holds extends the hold, up to the event's extension limit.
+type HoldReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Presale access code revealing hidden seat segments
+	AccessCode    string `protobuf:"bytes,5,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldReq) Reset() {
+	*x = HoldReq{}
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldReq) ProtoMessage() {}
+
+func (x *HoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldReq.ProtoReflect.Descriptor instead.
+func (*HoldReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HoldReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *HoldReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *HoldReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *HoldReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *HoldReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+// ExtendHoldReq represents a request to extend a reservation's hold on seats
+type ExtendHoldReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []*SeatRef             `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,4,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendHoldReq) Reset() {
+	*x = ExtendHoldReq{}
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldReq) ProtoMessage() {}
+
+func (x *ExtendHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldReq.ProtoReflect.Descriptor instead.
+func (*ExtendHoldReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExtendHoldReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ExtendHoldReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ExtendHoldReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ExtendHoldReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// HoldRes represents the response to a seat hold
+type HoldRes struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Status    string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "HOLD"
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Times the hold can still be extended by ExtendHold or by holding the seats again
+	ExtensionsRemaining int32 `protobuf:"varint,3,opt,name=extensions_remaining,json=extensionsRemaining,proto3" json:"extensions_remaining,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HoldRes) Reset() {
+	*x = HoldRes{}
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRes) ProtoMessage() {}
+
+func (x *HoldRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRes.ProtoReflect.Descriptor instead.
+func (*HoldRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HoldRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HoldRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *HoldRes) GetExtensionsRemaining() int32 {
+	if x != nil {
+		return x.ExtensionsRemaining
+	}
+	return 0
+}
+
+// SwapSeatsReq represents a request to exchange a reservation's seats for other seats
+type SwapSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seats the reservation holds or bought, all in the same status
+	ReleaseSeatIds []*SeatRef `protobuf:"bytes,4,rep,name=release_seat_ids,json=releaseSeatIds,proto3" json:"release_seat_ids,omitempty"`
+	// Available seats taking their place
+	AcquireSeatIds []*SeatRef `protobuf:"bytes,5,rep,name=acquire_seat_ids,json=acquireSeatIds,proto3" json:"acquire_seat_ids,omitempty"`
+	// Presale access code revealing hidden seat segments
+	AccessCode string `protobuf:"bytes,6,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	// Why the seats are exchanged, e.g. a support ticket, for the logs
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapSeatsReq) Reset() {
+	*x = SwapSeatsReq{}
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsReq) ProtoMessage() {}
+
+func (x *SwapSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsReq.ProtoReflect.Descriptor instead.
+func (*SwapSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SwapSeatsReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *SwapSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SwapSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *SwapSeatsReq) GetReleaseSeatIds() []*SeatRef {
+	if x != nil {
+		return x.ReleaseSeatIds
+	}
+	return nil
+}
+
+func (x *SwapSeatsReq) GetAcquireSeatIds() []*SeatRef {
+	if x != nil {
+		return x.AcquireSeatIds
+	}
+	return nil
+}
+
+func (x *SwapSeatsReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+func (x *SwapSeatsReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SwapSeatsRes represents the response to a seat swap
+type SwapSeatsRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "HOLD" or "SOLD": the status of the acquired seats
+	// Lines of the acquired seats, with their prices
+	Lines []*OrderLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Expiry of the hold on the acquired seats, when held
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapSeatsRes) Reset() {
+	*x = SwapSeatsRes{}
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsRes) ProtoMessage() {}
+
+func (x *SwapSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsRes.ProtoReflect.Descriptor instead.
+func (*SwapSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SwapSeatsRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SwapSeatsRes) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *SwapSeatsRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// GetCommitStatusReq represents a request for the status of an asynchronous commit
+type GetCommitStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommitStatusReq) Reset() {
+	*x = GetCommitStatusReq{}
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommitStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitStatusReq) ProtoMessage() {}
+
+func (x *GetCommitStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitStatusReq.ProtoReflect.Descriptor instead.
+func (*GetCommitStatusReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCommitStatusReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// GetCommitStatusRes represents the status of an asynchronous commit
+type GetCommitStatusRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Order the reservation was committed as; differs from the requested order_id
+	// when the reservation had already been committed by another request
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "PENDING", "CONFIRMED", "FAILED"
+	// Failure reason when status is "FAILED"
+	Error     string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Classified failure reason when status is "FAILED", if it has a code
+	ErrorCode     ErrorCode `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3,enum=inventory.v1.ErrorCode" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommitStatusRes) Reset() {
+	*x = GetCommitStatusRes{}
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommitStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitStatusRes) ProtoMessage() {}
+
+func (x *GetCommitStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitStatusRes.ProtoReflect.Descriptor instead.
+func (*GetCommitStatusRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCommitStatusRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetCommitStatusRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCommitStatusRes) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCommitStatusRes) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetCommitStatusRes) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// ErrorDetail is the status detail of a failed call with an error code
+type ErrorDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorCode     ErrorCode              `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=inventory.v1.ErrorCode" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ErrorDetail) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// AllocateSeasonReq represents a request to allocate seats across the performances of a series
+type AllocateSeasonReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Caller-chosen ID of the allocation, e.g. the season ticket ID
+	AllocationId   string     `protobuf:"bytes,1,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	EventId        string     `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceIds []string   `protobuf:"bytes,3,rep,name=performance_ids,json=performanceIds,proto3" json:"performance_ids,omitempty"`
+	SeatIds        []*SeatRef `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AllocateSeasonReq) Reset() {
+	*x = AllocateSeasonReq{}
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateSeasonReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateSeasonReq) ProtoMessage() {}
+
+func (x *AllocateSeasonReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateSeasonReq.ProtoReflect.Descriptor instead.
+func (*AllocateSeasonReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AllocateSeasonReq) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *AllocateSeasonReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AllocateSeasonReq) GetPerformanceIds() []string {
+	if x != nil {
+		return x.PerformanceIds
+	}
+	return nil
+}
+
+func (x *AllocateSeasonReq) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+// MaterializeSeasonReq represents a request to sell one performance of an allocation
+type MaterializeSeasonReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllocationId  string                 `protobuf:"bytes,1,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterializeSeasonReq) Reset() {
+	*x = MaterializeSeasonReq{}
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterializeSeasonReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeSeasonReq) ProtoMessage() {}
+
+func (x *MaterializeSeasonReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeSeasonReq.ProtoReflect.Descriptor instead.
+func (*MaterializeSeasonReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MaterializeSeasonReq) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *MaterializeSeasonReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// MaterializeSeasonRes represents the response to materializing a performance
+type MaterializeSeasonRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Order the performance was sold as; the same order when it was already materialized
+	OrderId       string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "CONFIRMED"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterializeSeasonRes) Reset() {
+	*x = MaterializeSeasonRes{}
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterializeSeasonRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeSeasonRes) ProtoMessage() {}
+
+func (x *MaterializeSeasonRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeSeasonRes.ProtoReflect.Descriptor instead.
+func (*MaterializeSeasonRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MaterializeSeasonRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *MaterializeSeasonRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ReleaseSeasonReq represents a request to release an allocation
+type ReleaseSeasonReq struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AllocationId string                 `protobuf:"bytes,1,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	// Performance to release; empty releases every performance not materialized yet
+	PerformanceId string `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeasonReq) Reset() {
+	*x = ReleaseSeasonReq{}
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeasonReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeasonReq) ProtoMessage() {}
+
+func (x *ReleaseSeasonReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeasonReq.ProtoReflect.Descriptor instead.
+func (*ReleaseSeasonReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReleaseSeasonReq) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *ReleaseSeasonReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+// SeasonAllocation is a season ticket's long-lived allocation of seats across a series
+type SeasonAllocation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AllocationId   string                 `protobuf:"bytes,1,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceIds []string               `protobuf:"bytes,3,rep,name=performance_ids,json=performanceIds,proto3" json:"performance_ids,omitempty"`
+	SeatIds        []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Order of each materialized performance, by performance ID
+	Materialized  map[string]string      `protobuf:"bytes,5,rep,name=materialized,proto3" json:"materialized,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Released      []string               `protobuf:"bytes,6,rep,name=released,proto3" json:"released,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"` // "ACTIVE", "RELEASED"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonAllocation) Reset() {
+	*x = SeasonAllocation{}
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonAllocation) ProtoMessage() {}
+
+func (x *SeasonAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonAllocation.ProtoReflect.Descriptor instead.
+func (*SeasonAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SeasonAllocation) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+func (x *SeasonAllocation) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SeasonAllocation) GetPerformanceIds() []string {
+	if x != nil {
+		return x.PerformanceIds
+	}
+	return nil
+}
+
+func (x *SeasonAllocation) GetSeatIds() []*SeatRef {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *SeasonAllocation) GetMaterialized() map[string]string {
+	if x != nil {
+		return x.Materialized
+	}
+	return nil
+}
+
+func (x *SeasonAllocation) GetReleased() []string {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+func (x *SeasonAllocation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SeasonAllocation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetReservationStatusReq represents a request for what a reservation ended up with
+type GetReservationStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusReq) Reset() {
+	*x = GetReservationStatusReq{}
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusReq) ProtoMessage() {}
+
+func (x *GetReservationStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusReq.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetReservationStatusReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+// ReservationSeat is a seat held or sold under a reservation
+type ReservationSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	SeatId        string                 `protobuf:"bytes,3,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationSeat) Reset() {
+	*x = ReservationSeat{}
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationSeat) ProtoMessage() {}
+
+func (x *ReservationSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationSeat.ProtoReflect.Descriptor instead.
+func (*ReservationSeat) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReservationSeat) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReservationSeat) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *ReservationSeat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+// GetReservationStatusRes represents what a reservation ended up with. Seats are read
+// from an index that lags writes by up to about a second.
+type GetReservationStatusRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "HELD", "COMMITTED", "RELEASED"
+	// Order of a committed reservation; empty if its commit record has already expired
+	OrderId       string             `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	HeldSeats     []*ReservationSeat `protobuf:"bytes,4,rep,name=held_seats,json=heldSeats,proto3" json:"held_seats,omitempty"`
+	SoldSeats     []*ReservationSeat `protobuf:"bytes,5,rep,name=sold_seats,json=soldSeats,proto3" json:"sold_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusRes) Reset() {
+	*x = GetReservationStatusRes{}
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusRes) ProtoMessage() {}
+
+func (x *GetReservationStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusRes.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetReservationStatusRes) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRes) GetHeldSeats() []*ReservationSeat {
+	if x != nil {
+		return x.HeldSeats
+	}
+	return nil
+}
+
+func (x *GetReservationStatusRes) GetSoldSeats() []*ReservationSeat {
+	if x != nil {
+		return x.SoldSeats
+	}
+	return nil
+}
+
+// ListSeatsReq represents a request for a page of an event's seats
+type ListSeatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Only seats with one of these statuses; empty lists every status
+	StatusFilter []SeatStatus `protobuf:"varint,3,rep,packed,name=status_filter,json=statusFilter,proto3,enum=inventory.v1.SeatStatus" json:"status_filter,omitempty"`
+	// Only seats of this section, i.e. seat IDs "<section>-..."
+	Section string `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	// next_page_token of the previous page; empty for the first page
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Seats per page; defaults to 100, at most 1000
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Presale access code revealing hidden seat segments; hidden seats are left out without it
+	AccessCode    string `protobuf:"bytes,7,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeatsReq) Reset() {
+	*x = ListSeatsReq{}
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeatsReq) ProtoMessage() {}
+
+func (x *ListSeatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeatsReq.ProtoReflect.Descriptor instead.
+func (*ListSeatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSeatsReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListSeatsReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *ListSeatsReq) GetStatusFilter() []SeatStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListSeatsReq) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ListSeatsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSeatsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSeatsReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+// ListSeatsRes represents a page of an event's seats
+type ListSeatsRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seats without their operator note
+	Seats []*Seat `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	// Token of the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Seat map version of the event, to validate cached seat maps
+	SeatMapVersion int32 `protobuf:"varint,3,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSeatsRes) Reset() {
+	*x = ListSeatsRes{}
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeatsRes) ProtoMessage() {}
+
+func (x *ListSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeatsRes.ProtoReflect.Descriptor instead.
+func (*ListSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSeatsRes) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *ListSeatsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSeatsRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// GetAdjacentAvailableReq represents a request for available seats next to each other
+type GetAdjacentAvailableReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	// Seat to search near; its row is searched
+	SeatId string `protobuf:"bytes,3,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// Seats wanted together, at most 20
+	N int32 `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
+	// Presale access code revealing hidden seat segments; hidden seats are skipped without it
+	AccessCode    string `protobuf:"bytes,5,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdjacentAvailableReq) Reset() {
+	*x = GetAdjacentAvailableReq{}
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdjacentAvailableReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdjacentAvailableReq) ProtoMessage() {}
+
+func (x *GetAdjacentAvailableReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdjacentAvailableReq.ProtoReflect.Descriptor instead.
+func (*GetAdjacentAvailableReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAdjacentAvailableReq) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetAdjacentAvailableReq) GetPerformanceId() string {
+	if x != nil {
+		return x.PerformanceId
+	}
+	return ""
+}
+
+func (x *GetAdjacentAvailableReq) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *GetAdjacentAvailableReq) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *GetAdjacentAvailableReq) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+// GetAdjacentAvailableRes represents the seats found together
+type GetAdjacentAvailableRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seats in row order; empty if the row has no n adjacent available seats
+	SeatIds []string `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Seat map version of the event the seats were found in
+	SeatMapVersion int32 `protobuf:"varint,2,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAdjacentAvailableRes) Reset() {
+	*x = GetAdjacentAvailableRes{}
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdjacentAvailableRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdjacentAvailableRes) ProtoMessage() {}
+
+func (x *GetAdjacentAvailableRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdjacentAvailableRes.ProtoReflect.Descriptor instead.
+func (*GetAdjacentAvailableRes) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAdjacentAvailableRes) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *GetAdjacentAvailableRes) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+// SubscribeChangesReq represents a request to stream inventory changes
+type SubscribeChangesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only changes of these events, including all their performances; empty for every event
+	EventIds []string `protobuf:"bytes,1,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	// resume_token of the last change received; empty starts with the next change
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeChangesReq) Reset() {
+	*x = SubscribeChangesReq{}
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChangesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChangesReq) ProtoMessage() {}
+
+func (x *SubscribeChangesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChangesReq.ProtoReflect.Descriptor instead.
+func (*SubscribeChangesReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SubscribeChangesReq) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *SubscribeChangesReq) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// SeatChanged reports the status a seat was written with
+type SeatChanged struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SeatId string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// UNSPECIFIED when the seat was deleted
+	Status        SeatStatus `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatChanged) Reset() {
+	*x = SeatChanged{}
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatChanged) ProtoMessage() {}
+
+func (x *SeatChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatChanged.ProtoReflect.Descriptor instead.
+func (*SeatChanged) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SeatChanged) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatChanged) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+// InventoryChanged reports an event's inventory item as written
+type InventoryChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remaining     int32                  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	TotalSeats    int32                  `protobuf:"varint,2,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryChanged) Reset() {
+	*x = InventoryChanged{}
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryChanged) ProtoMessage() {}
+
+func (x *InventoryChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryChanged.ProtoReflect.Descriptor instead.
+func (*InventoryChanged) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InventoryChanged) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *InventoryChanged) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *InventoryChanged) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-// CheckReq represents a request to check availability
-type CheckReq struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	// If qty > 0, check quantity-based inventory
-	// If seat_ids is not empty, check seat-based inventory (takes precedence)
-	Qty           int32      `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
-	SeatIds       []*SeatRef `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+// InventoryChange is one change of an event's seats or inventory
+type InventoryChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique ID of the change, to drop changes replayed after a resume
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,3,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*InventoryChange_Seat
+	//	*InventoryChange_Inventory
+	Change isInventoryChange_Change `protobuf_oneof:"change"`
+	// Token resuming the subscription after this change
+	ResumeToken   string `protobuf:"bytes,7,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckReq) Reset() {
-	*x = CheckReq{}
-	mi := &file_proto_inventory_proto_msgTypes[1]
+func (x *InventoryChange) Reset() {
+	*x = InventoryChange{}
+	mi := &file_proto_inventory_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckReq) String() string {
+func (x *InventoryChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckReq) ProtoMessage() {}
+func (*InventoryChange) ProtoMessage() {}
 
-func (x *CheckReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[1]
+func (x *InventoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,112 +3247,111 @@ func (x *CheckReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckReq.ProtoReflect.Descriptor instead.
-func (*CheckReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use InventoryChange.ProtoReflect.Descriptor instead.
+func (*InventoryChange) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CheckReq) GetEventId() string {
+func (x *InventoryChange) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *InventoryChange) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *CheckReq) GetQty() int32 {
+func (x *InventoryChange) GetPerformanceId() string {
 	if x != nil {
-		return x.Qty
+		return x.PerformanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckReq) GetSeatIds() []*SeatRef {
+func (x *InventoryChange) GetChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SeatIds
+		return x.ChangedAt
 	}
 	return nil
 }
 
-// CheckRes represents the response to availability check
-type CheckRes struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Available        bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	UnavailableSeats []string               `protobuf:"bytes,2,rep,name=unavailable_seats,json=unavailableSeats,proto3" json:"unavailable_seats,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *InventoryChange) GetChange() isInventoryChange_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
 }
 
-func (x *CheckRes) Reset() {
-	*x = CheckRes{}
-	mi := &file_proto_inventory_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InventoryChange) GetSeat() *SeatChanged {
+	if x != nil {
+		if x, ok := x.Change.(*InventoryChange_Seat); ok {
+			return x.Seat
+		}
+	}
+	return nil
 }
 
-func (x *CheckRes) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InventoryChange) GetInventory() *InventoryChanged {
+	if x != nil {
+		if x, ok := x.Change.(*InventoryChange_Inventory); ok {
+			return x.Inventory
+		}
+	}
+	return nil
 }
 
-func (*CheckRes) ProtoMessage() {}
-
-func (x *CheckRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[2]
+func (x *InventoryChange) GetResumeToken() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ResumeToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CheckRes.ProtoReflect.Descriptor instead.
-func (*CheckRes) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+type isInventoryChange_Change interface {
+	isInventoryChange_Change()
 }
 
-func (x *CheckRes) GetAvailable() bool {
-	if x != nil {
-		return x.Available
-	}
-	return false
+type InventoryChange_Seat struct {
+	Seat *SeatChanged `protobuf:"bytes,5,opt,name=seat,proto3,oneof"`
 }
 
-func (x *CheckRes) GetUnavailableSeats() []string {
-	if x != nil {
-		return x.UnavailableSeats
-	}
-	return nil
+type InventoryChange_Inventory struct {
+	Inventory *InventoryChanged `protobuf:"bytes,6,opt,name=inventory,proto3,oneof"`
 }
 
-// CommitReq represents a request to commit a reservation
-type CommitReq struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId   string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
-	EventId         string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Qty             int32                  `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
-	SeatIds         []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	PaymentIntentId string                 `protobuf:"bytes,5,opt,name=payment_intent_id,json=paymentIntentId,proto3" json:"payment_intent_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (*InventoryChange_Seat) isInventoryChange_Change() {}
+
+func (*InventoryChange_Inventory) isInventoryChange_Change() {}
+
+// GetEventInventoryReq represents a request for an event's aggregate inventory
+type GetEventInventoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitReq) Reset() {
-	*x = CommitReq{}
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *GetEventInventoryReq) Reset() {
+	*x = GetEventInventoryReq{}
+	mi := &file_proto_inventory_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitReq) String() string {
+func (x *GetEventInventoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitReq) ProtoMessage() {}
+func (*GetEventInventoryReq) ProtoMessage() {}
 
-func (x *CommitReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *GetEventInventoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,70 +3362,49 @@ func (x *CommitReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitReq.ProtoReflect.Descriptor instead.
-func (*CommitReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CommitReq) GetReservationId() string {
-	if x != nil {
-		return x.ReservationId
-	}
-	return ""
+// Deprecated: Use GetEventInventoryReq.ProtoReflect.Descriptor instead.
+func (*GetEventInventoryReq) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CommitReq) GetEventId() string {
+func (x *GetEventInventoryReq) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *CommitReq) GetQty() int32 {
-	if x != nil {
-		return x.Qty
-	}
-	return 0
-}
-
-func (x *CommitReq) GetSeatIds() []*SeatRef {
-	if x != nil {
-		return x.SeatIds
-	}
-	return nil
-}
-
-func (x *CommitReq) GetPaymentIntentId() string {
+func (x *GetEventInventoryReq) GetPerformanceId() string {
 	if x != nil {
-		return x.PaymentIntentId
+		return x.PerformanceId
 	}
 	return ""
 }
 
-// CommitRes represents the response to commit reservation
-type CommitRes struct {
+// SectionInventory is the quantity of a general-admission section of a hybrid event
+type SectionInventory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "CONFIRMED"
+	Section       string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Remaining     int32                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitRes) Reset() {
-	*x = CommitRes{}
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *SectionInventory) Reset() {
+	*x = SectionInventory{}
+	mi := &file_proto_inventory_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitRes) String() string {
+func (x *SectionInventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitRes) ProtoMessage() {}
+func (*SectionInventory) ProtoMessage() {}
 
-func (x *CommitRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *SectionInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,51 +3415,58 @@ func (x *CommitRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitRes.ProtoReflect.Descriptor instead.
-func (*CommitRes) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SectionInventory.ProtoReflect.Descriptor instead.
+func (*SectionInventory) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CommitRes) GetOrderId() string {
+func (x *SectionInventory) GetSection() string {
 	if x != nil {
-		return x.OrderId
+		return x.Section
 	}
 	return ""
 }
 
-func (x *CommitRes) GetStatus() string {
+func (x *SectionInventory) GetRemaining() int32 {
 	if x != nil {
-		return x.Status
+		return x.Remaining
 	}
-	return ""
+	return 0
 }
 
-// ReleaseReq represents a request to release a hold
-type ReleaseReq struct {
+// EventInventory is an event's aggregate inventory item
+type EventInventory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Qty           int32                  `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
-	SeatIds       []*SeatRef             `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PerformanceId string                 `protobuf:"bytes,2,opt,name=performance_id,json=performanceId,proto3" json:"performance_id,omitempty"`
+	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	TotalSeats    int32                  `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	// Optimistic locking version of the inventory item
+	Version        int32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	SeatMapVersion int32 `protobuf:"varint,6,opt,name=seat_map_version,json=seatMapVersion,proto3" json:"seat_map_version,omitempty"`
+	// General-admission sections, ordered by name
+	Sections      []*SectionInventory    `protobuf:"bytes,7,rep,name=sections,proto3" json:"sections,omitempty"`
+	Frozen        bool                   `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseReq) Reset() {
-	*x = ReleaseReq{}
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *EventInventory) Reset() {
+	*x = EventInventory{}
+	mi := &file_proto_inventory_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseReq) String() string {
+func (x *EventInventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseReq) ProtoMessage() {}
+func (*EventInventory) ProtoMessage() {}
 
-func (x *ReleaseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *EventInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,62 +3477,106 @@ func (x *ReleaseReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseReq.ProtoReflect.Descriptor instead.
-func (*ReleaseReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventInventory.ProtoReflect.Descriptor instead.
+func (*EventInventory) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ReleaseReq) GetReservationId() string {
+func (x *EventInventory) GetEventId() string {
 	if x != nil {
-		return x.ReservationId
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *ReleaseReq) GetEventId() string {
+func (x *EventInventory) GetPerformanceId() string {
 	if x != nil {
-		return x.EventId
+		return x.PerformanceId
 	}
 	return ""
 }
 
-func (x *ReleaseReq) GetQty() int32 {
+func (x *EventInventory) GetRemaining() int32 {
 	if x != nil {
-		return x.Qty
+		return x.Remaining
 	}
 	return 0
 }
 
-func (x *ReleaseReq) GetSeatIds() []*SeatRef {
+func (x *EventInventory) GetTotalSeats() int32 {
 	if x != nil {
-		return x.SeatIds
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *EventInventory) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventInventory) GetSeatMapVersion() int32 {
+	if x != nil {
+		return x.SeatMapVersion
+	}
+	return 0
+}
+
+func (x *EventInventory) GetSections() []*SectionInventory {
+	if x != nil {
+		return x.Sections
 	}
 	return nil
 }
 
-// ReleaseRes represents the response to release hold
-type ReleaseRes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "RELEASED"
+func (x *EventInventory) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *EventInventory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// BatchResult reports the outcome of one item of a batch RPC, so that a failed
+// item doesn't fail the items around it
+type BatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the item in the request
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// gRPC status code of the item; 0 (OK) when it was applied
+	Code  int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// ID of the item when the batch wasn't given in a request, e.g. a seat found by a scan
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Classified failure reason of the item, if it has a code
+	ErrorCode     ErrorCode `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3,enum=inventory.v1.ErrorCode" json:"error_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseRes) Reset() {
-	*x = ReleaseRes{}
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_proto_inventory_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseRes) String() string {
+func (x *BatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseRes) ProtoMessage() {}
+func (*BatchResult) ProtoMessage() {}
 
-func (x *ReleaseRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,54 +3587,376 @@ func (x *ReleaseRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseRes.ProtoReflect.Descriptor instead.
-func (*ReleaseRes) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ReleaseRes) GetStatus() string {
+func (x *BatchResult) GetIndex() int32 {
 	if x != nil {
-		return x.Status
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchResult) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
+func (x *BatchResult) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
 var File_proto_inventory_proto protoreflect.FileDescriptor
 
 const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\"\n" +
-	"\aSeatRef\x12\x17\n" +
-	"\aseat_id\x18\x01 \x01(\tR\x06seatId\"i\n" +
-	"\bCheckReq\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x10\n" +
-	"\x03qty\x18\x02 \x01(\x05R\x03qty\x120\n" +
-	"\bseat_ids\x18\x03 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\"U\n" +
+	"\x15proto/inventory.proto\x12\finventory.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14proto/validate.proto\"\x12\n" +
+	"\x10GetLoadStatusReq\"\x86\x03\n" +
+	"\n" +
+	"LoadStatus\x12\x1e\n" +
+	"\n" +
+	"saturation\x18\x01 \x01(\x01R\n" +
+	"saturation\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.inventory.v1.LoadStateR\x05state\x12\x1b\n" +
+	"\tin_flight\x18\x03 \x01(\x05R\binFlight\x12\"\n" +
+	"\rmax_in_flight\x18\x04 \x01(\x05R\vmaxInFlight\x12%\n" +
+	"\x0ebrownout_level\x18\x05 \x01(\x05R\rbrownoutLevel\x12*\n" +
+	"\x11commit_queue_fill\x18\x06 \x01(\x01R\x0fcommitQueueFill\x120\n" +
+	"\x14throttled_per_second\x18\a \x01(\x01R\x12throttledPerSecond\x12%\n" +
+	"\x0ethrottle_ratio\x18\b \x01(\x01R\rthrottleRatio\x12\x1a\n" +
+	"\bdegraded\x18\t \x01(\bR\bdegraded\x12 \n" +
+	"\vmaintenance\x18\n" +
+	" \x01(\bR\vmaintenance\"J\n" +
+	"\n" +
+	"SectionQty\x12\"\n" +
+	"\asection\x18\x01 \x01(\tB\b\x92\x82\x19\x04\b\x01\x18@R\asection\x12\x18\n" +
+	"\x03qty\x18\x02 \x01(\x05B\x06\x92\x82\x19\x02(\x01R\x03qty\"\xa7\x01\n" +
+	"\x10SeatAvailability\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x120\n" +
+	"\x06holder\x18\x03 \x01(\x0e2\x18.inventory.v1.SeatHolderR\x06holder\x12\x16\n" +
+	"\x06hidden\x18\x04 \x01(\bR\x06hidden\",\n" +
+	"\aSeatRef\x12!\n" +
+	"\aseat_id\x18\x01 \x01(\tB\b\x92\x82\x19\x04\b\x01\x18@R\x06seatId\"\x9b\x02\n" +
+	"\x04Seat\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x12<\n" +
+	"\bmetadata\x18\x03 \x03(\v2 .inventory.v1.Seat.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcc\x01\n" +
+	"\bCheckReq\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12\x18\n" +
+	"\x03qty\x18\x02 \x01(\x05B\x06\x92\x82\x19\x02(\x00R\x03qty\x128\n" +
+	"\bseat_ids\x18\x03 \x03(\v2\x15.inventory.v1.SeatRefB\x06\x92\x82\x19\x02@dR\aseatIds\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\x12\x1f\n" +
+	"\vaccess_code\x18\x05 \x01(\tR\n" +
+	"accessCode\"\xcf\x02\n" +
 	"\bCheckRes\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12+\n" +
-	"\x11unavailable_seats\x18\x02 \x03(\tR\x10unavailableSeats\"\xbd\x01\n" +
-	"\tCommitReq\x12%\n" +
-	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x10\n" +
-	"\x03qty\x18\x03 \x01(\x05R\x03qty\x120\n" +
-	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12*\n" +
-	"\x11payment_intent_id\x18\x05 \x01(\tR\x0fpaymentIntentId\">\n" +
+	"\x11unavailable_seats\x18\x02 \x03(\tR\x10unavailableSeats\x12(\n" +
+	"\x10seat_map_version\x18\x03 \x01(\x05R\x0eseatMapVersion\x12\x14\n" +
+	"\x05stale\x18\x04 \x01(\bR\x05stale\x12/\n" +
+	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x05R\tremaining\x123\n" +
+	"\x15remaining_approximate\x18\a \x01(\bR\x14remainingApproximate\x124\n" +
+	"\x05seats\x18\b \x03(\v2\x1e.inventory.v1.SeatAvailabilityR\x05seats\"s\n" +
+	"\n" +
+	"EventCheck\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\x03qty\x18\x03 \x01(\x05B\x06\x92\x82\x19\x02(\x00R\x03qty\"W\n" +
+	"\x19BatchCheckAvailabilityReq\x12:\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.inventory.v1.EventCheckB\b\x92\x82\x19\x04\b\x01@dR\x06events\"\xc3\x01\n" +
+	"\x10EventCheckResult\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12:\n" +
+	"\favailability\x18\x03 \x01(\v2\x16.inventory.v1.CheckResR\favailability\x121\n" +
+	"\x06result\x18\x04 \x01(\v2\x19.inventory.v1.BatchResultR\x06result\"U\n" +
+	"\x19BatchCheckAvailabilityRes\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.inventory.v1.EventCheckResultR\aresults\"\x84\x04\n" +
+	"\tCommitReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\x12\"\n" +
+	"\bevent_id\x18\x02 \x01(\tB\a\x92\x82\x19\x03\x18\x80\x01R\aeventId\x12\x18\n" +
+	"\x03qty\x18\x03 \x01(\x05B\x06\x92\x82\x19\x02(\x00R\x03qty\x128\n" +
+	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefB\x06\x92\x82\x19\x02@dR\aseatIds\x12*\n" +
+	"\x11payment_intent_id\x18\x05 \x01(\tR\x0fpaymentIntentId\x12;\n" +
+	"\fsection_qtys\x18\x06 \x03(\v2\x18.inventory.v1.SectionQtyR\vsectionQtys\x12%\n" +
+	"\x0eperformance_id\x18\a \x01(\tR\rperformanceId\x12C\n" +
+	"\n" +
+	"line_items\x18\b \x03(\v2\x1c.inventory.v1.CommitLineItemB\x06\x92\x82\x19\x02@\n" +
+	"R\tlineItems\x12#\n" +
+	"\rpreauth_token\x18\t \x01(\tR\fpreauthToken\x124\n" +
+	"\x11buyer_fingerprint\x18\n" +
+	" \x01(\tB\a\x92\x82\x19\x03\x18\x80\x02R\x10buyerFingerprint\"\xa6\x01\n" +
+	"\x15PreauthorizeCommitRes\x12#\n" +
+	"\rpreauth_token\x18\x01 \x01(\tR\fpreauthToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12-\n" +
+	"\x05lines\x18\x03 \x03(\v2\x17.inventory.v1.OrderLineR\x05lines\"\xee\x01\n" +
+	"\x0eCommitLineItem\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x18\n" +
+	"\x03qty\x18\x03 \x01(\x05B\x06\x92\x82\x19\x02(\x00R\x03qty\x128\n" +
+	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefB\x06\x92\x82\x19\x02@dR\aseatIds\x12;\n" +
+	"\fsection_qtys\x18\x05 \x03(\v2\x18.inventory.v1.SectionQtyR\vsectionQtys\"\x92\x01\n" +
 	"\tCommitRes\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\x92\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rcommitted_qty\x18\x03 \x01(\x05R\fcommittedQty\x12-\n" +
+	"\x05lines\x18\x04 \x03(\v2\x17.inventory.v1.OrderLineR\x05lines\"\xbc\x01\n" +
+	"\tOrderLine\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x17\n" +
+	"\aseat_id\x18\x03 \x01(\tR\x06seatId\x12\x18\n" +
+	"\asection\x18\x04 \x01(\tR\asection\x12\x12\n" +
+	"\x04tier\x18\x05 \x01(\tR\x04tier\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\tR\x05price\x12\x10\n" +
+	"\x03qty\x18\a \x01(\x05R\x03qty\"\xbb\x02\n" +
 	"\n" +
-	"ReleaseReq\x12%\n" +
-	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x10\n" +
-	"\x03qty\x18\x03 \x01(\x05R\x03qty\x120\n" +
-	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\"$\n" +
+	"ReleaseReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\x12$\n" +
+	"\bevent_id\x18\x02 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12\x18\n" +
+	"\x03qty\x18\x03 \x01(\x05B\x06\x92\x82\x19\x02(\x00R\x03qty\x128\n" +
+	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefB\x06\x92\x82\x19\x02@dR\aseatIds\x12;\n" +
+	"\fsection_qtys\x18\x05 \x03(\v2\x18.inventory.v1.SectionQtyR\vsectionQtys\x12%\n" +
+	"\x0eperformance_id\x18\x06 \x01(\tR\rperformanceId\"$\n" +
 	"\n" +
 	"ReleaseRes\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\xda\x01\n" +
-	"\tInventory\x12C\n" +
-	"\x11CheckAvailability\x12\x16.inventory.v1.CheckReq\x1a\x16.inventory.v1.CheckRes\x12E\n" +
-	"\x11CommitReservation\x12\x17.inventory.v1.CommitReq\x1a\x17.inventory.v1.CommitRes\x12A\n" +
-	"\vReleaseHold\x12\x18.inventory.v1.ReleaseReq\x1a\x18.inventory.v1.ReleaseResB-Z+github.com/traffictacos/inventory-api/protob\x06proto3"
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x84\x02\n" +
+	"\aHoldReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\x12$\n" +
+	"\bevent_id\x18\x02 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12:\n" +
+	"\bseat_ids\x18\x03 \x03(\v2\x15.inventory.v1.SeatRefB\b\x92\x82\x19\x04\b\x01@dR\aseatIds\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\x12\x1f\n" +
+	"\vaccess_code\x18\x05 \x01(\tR\n" +
+	"accessCode\"\xe9\x01\n" +
+	"\rExtendHoldReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\x12$\n" +
+	"\bevent_id\x18\x02 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12:\n" +
+	"\bseat_ids\x18\x03 \x03(\v2\x15.inventory.v1.SeatRefB\b\x92\x82\x19\x04\b\x01@dR\aseatIds\x12%\n" +
+	"\x0eperformance_id\x18\x04 \x01(\tR\rperformanceId\"\x8f\x01\n" +
+	"\aHoldRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x121\n" +
+	"\x14extensions_remaining\x18\x03 \x01(\x05R\x13extensionsRemaining\"\xfb\x02\n" +
+	"\fSwapSeatsReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\x12$\n" +
+	"\bevent_id\x18\x02 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\x12I\n" +
+	"\x10release_seat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefB\b\x92\x82\x19\x04\b\x01@dR\x0ereleaseSeatIds\x12I\n" +
+	"\x10acquire_seat_ids\x18\x05 \x03(\v2\x15.inventory.v1.SeatRefB\b\x92\x82\x19\x04\b\x01@dR\x0eacquireSeatIds\x12\x1f\n" +
+	"\vaccess_code\x18\x06 \x01(\tR\n" +
+	"accessCode\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x90\x01\n" +
+	"\fSwapSeatsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12-\n" +
+	"\x05lines\x18\x02 \x03(\v2\x17.inventory.v1.OrderLineR\x05lines\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\":\n" +
+	"\x12GetCommitStatusReq\x12$\n" +
+	"\border_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aorderId\"\xd0\x01\n" +
+	"\x12GetCommitStatusRes\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x0e2\x17.inventory.v1.ErrorCodeR\terrorCode\"E\n" +
+	"\vErrorDetail\x126\n" +
+	"\n" +
+	"error_code\x18\x01 \x01(\x0e2\x17.inventory.v1.ErrorCodeR\terrorCode\"\xd6\x01\n" +
+	"\x11AllocateSeasonReq\x12.\n" +
+	"\rallocation_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\fallocationId\x12$\n" +
+	"\bevent_id\x18\x02 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12/\n" +
+	"\x0fperformance_ids\x18\x03 \x03(\tB\x06\x92\x82\x19\x02\b\x01R\x0eperformanceIds\x12:\n" +
+	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefB\b\x92\x82\x19\x04\b\x01@dR\aseatIds\"u\n" +
+	"\x14MaterializeSeasonReq\x12.\n" +
+	"\rallocation_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\fallocationId\x12-\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tB\x06\x92\x82\x19\x02\b\x01R\rperformanceId\"I\n" +
+	"\x14MaterializeSeasonRes\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"i\n" +
+	"\x10ReleaseSeasonReq\x12.\n" +
+	"\rallocation_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\fallocationId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"\xb1\x03\n" +
+	"\x10SeasonAllocation\x12#\n" +
+	"\rallocation_id\x18\x01 \x01(\tR\fallocationId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12'\n" +
+	"\x0fperformance_ids\x18\x03 \x03(\tR\x0eperformanceIds\x120\n" +
+	"\bseat_ids\x18\x04 \x03(\v2\x15.inventory.v1.SeatRefR\aseatIds\x12T\n" +
+	"\fmaterialized\x18\x05 \x03(\v20.inventory.v1.SeasonAllocation.MaterializedEntryR\fmaterialized\x12\x1a\n" +
+	"\breleased\x18\x06 \x03(\tR\breleased\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a?\n" +
+	"\x11MaterializedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
+	"\x17GetReservationStatusReq\x12O\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB(\x92\x82\x19$\b\x01\x18\x80\x01\"\x1d^[A-Za-z0-9][A-Za-z0-9_.:-]*$R\rreservationId\"l\n" +
+	"\x0fReservationSeat\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x17\n" +
+	"\aseat_id\x18\x03 \x01(\tR\x06seatId\"\xef\x01\n" +
+	"\x17GetReservationStatusRes\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12<\n" +
+	"\n" +
+	"held_seats\x18\x04 \x03(\v2\x1d.inventory.v1.ReservationSeatR\theldSeats\x12<\n" +
+	"\n" +
+	"sold_seats\x18\x05 \x03(\v2\x1d.inventory.v1.ReservationSeatR\tsoldSeats\"\x9c\x02\n" +
+	"\fListSeatsReq\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12=\n" +
+	"\rstatus_filter\x18\x03 \x03(\x0e2\x18.inventory.v1.SeatStatusR\fstatusFilter\x12\x18\n" +
+	"\asection\x18\x04 \x01(\tR\asection\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12&\n" +
+	"\tpage_size\x18\x06 \x01(\x05B\t\x92\x82\x19\x05(\x000\xe8\aR\bpageSize\x12\x1f\n" +
+	"\vaccess_code\x18\a \x01(\tR\n" +
+	"accessCode\"\x8a\x01\n" +
+	"\fListSeatsRes\x12(\n" +
+	"\x05seats\x18\x01 \x03(\v2\x12.inventory.v1.SeatR\x05seats\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12(\n" +
+	"\x10seat_map_version\x18\x03 \x01(\x05R\x0eseatMapVersion\"\xc2\x01\n" +
+	"\x17GetAdjacentAvailableReq\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12!\n" +
+	"\aseat_id\x18\x03 \x01(\tB\b\x92\x82\x19\x04\b\x01\x18@R\x06seatId\x12\x16\n" +
+	"\x01n\x18\x04 \x01(\x05B\b\x92\x82\x19\x04(\x010\x14R\x01n\x12\x1f\n" +
+	"\vaccess_code\x18\x05 \x01(\tR\n" +
+	"accessCode\"^\n" +
+	"\x17GetAdjacentAvailableRes\x12\x19\n" +
+	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\x12(\n" +
+	"\x10seat_map_version\x18\x02 \x01(\x05R\x0eseatMapVersion\"U\n" +
+	"\x13SubscribeChangesReq\x12\x1b\n" +
+	"\tevent_ids\x18\x01 \x03(\tR\beventIds\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"X\n" +
+	"\vSeatChanged\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\"k\n" +
+	"\x10InventoryChanged\x12\x1c\n" +
+	"\tremaining\x18\x01 \x01(\x05R\tremaining\x12\x1f\n" +
+	"\vtotal_seats\x18\x02 \x01(\x05R\n" +
+	"totalSeats\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"\xc9\x02\n" +
+	"\x0fInventoryChange\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x03 \x01(\tR\rperformanceId\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12/\n" +
+	"\x04seat\x18\x05 \x01(\v2\x19.inventory.v1.SeatChangedH\x00R\x04seat\x12>\n" +
+	"\tinventory\x18\x06 \x01(\v2\x1e.inventory.v1.InventoryChangedH\x00R\tinventory\x12!\n" +
+	"\fresume_token\x18\a \x01(\tR\vresumeTokenB\b\n" +
+	"\x06change\"c\n" +
+	"\x14GetEventInventoryReq\x12$\n" +
+	"\bevent_id\x18\x01 \x01(\tB\t\x92\x82\x19\x05\b\x01\x18\x80\x01R\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\"J\n" +
+	"\x10SectionInventory\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x05R\tremaining\"\xe4\x02\n" +
+	"\x0eEventInventory\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eperformance_id\x18\x02 \x01(\tR\rperformanceId\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\x12\x1f\n" +
+	"\vtotal_seats\x18\x04 \x01(\x05R\n" +
+	"totalSeats\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12(\n" +
+	"\x10seat_map_version\x18\x06 \x01(\x05R\x0eseatMapVersion\x12:\n" +
+	"\bsections\x18\a \x03(\v2\x1e.inventory.v1.SectionInventoryR\bsections\x12\x16\n" +
+	"\x06frozen\x18\b \x01(\bR\x06frozen\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x95\x01\n" +
+	"\vBatchResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x126\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x0e2\x17.inventory.v1.ErrorCodeR\terrorCode*r\n" +
+	"\tLoadState\x12\x1a\n" +
+	"\x16LOAD_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LOAD_STATE_NORMAL\x10\x01\x12\x17\n" +
+	"\x13LOAD_STATE_ELEVATED\x10\x02\x12\x19\n" +
+	"\x15LOAD_STATE_OVERLOADED\x10\x03*\xf7\x01\n" +
+	"\n" +
+	"SeatStatus\x12\x1b\n" +
+	"\x17SEAT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEAT_STATUS_AVAILABLE\x10\x01\x12\x14\n" +
+	"\x10SEAT_STATUS_HOLD\x10\x02\x12\x14\n" +
+	"\x10SEAT_STATUS_SOLD\x10\x03\x12\x17\n" +
+	"\x13SEAT_STATUS_BLOCKED\x10\x04\x12\x16\n" +
+	"\x12SEAT_STATUS_KILLED\x10\x05\x12!\n" +
+	"\x1dSEAT_STATUS_RESERVED_INTERNAL\x10\x06\x12\x19\n" +
+	"\x15SEAT_STATUS_ALLOCATED\x10\a\x12\x16\n" +
+	"\x12SEAT_STATUS_CLOSED\x10\b*\x87\x01\n" +
+	"\n" +
+	"SeatHolder\x12\x1b\n" +
+	"\x17SEAT_HOLDER_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SEAT_HOLDER_HOLD\x10\x01\x12\x14\n" +
+	"\x10SEAT_HOLDER_SOLD\x10\x02\x12\x16\n" +
+	"\x12SEAT_HOLDER_SEASON\x10\x03\x12\x18\n" +
+	"\x14SEAT_HOLDER_OPERATOR\x10\x04*\x97\x02\n" +
+	"\tErrorCode\x12\x1a\n" +
+	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!ERROR_CODE_INSUFFICIENT_INVENTORY\x10\x01\x12\x1c\n" +
+	"\x18ERROR_CODE_SEAT_CONFLICT\x10\x02\x12\x1b\n" +
+	"\x17ERROR_CODE_HOLD_EXPIRED\x10\x03\x12 \n" +
+	"\x1cERROR_CODE_EVENT_NOT_ON_SALE\x10\x04\x12\x1d\n" +
+	"\x19ERROR_CODE_LIMIT_EXCEEDED\x10\x05\x12&\n" +
+	"\"ERROR_CODE_VELOCITY_LIMIT_EXCEEDED\x10\x06\x12#\n" +
+	"\x1fERROR_CODE_ORDER_LIMIT_EXCEEDED\x10\a2\xce\x12\n" +
+	"\tInventory\x12\x98\x01\n" +
+	"\x11CheckAvailability\x12\x16.inventory.v1.CheckReq\x1a\x16.inventory.v1.CheckRes\"S\x82\xd3\xe4\x93\x02MZ':\x01*\"\"/v1/events/{event_id}/availability\x12\"/v1/events/{event_id}/availability\x12z\n" +
+	"\x11CommitReservation\x12\x17.inventory.v1.CommitReq\x1a\x17.inventory.v1.CommitRes\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/reservations/{reservation_id}/commit\x12w\n" +
+	"\vReleaseHold\x12\x18.inventory.v1.ReleaseReq\x1a\x18.inventory.v1.ReleaseRes\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/reservations/{reservation_id}/release\x12a\n" +
+	"\tHoldSeats\x12\x15.inventory.v1.HoldReq\x1a\x15.inventory.v1.HoldRes\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/events/{event_id}/holds\x12o\n" +
+	"\n" +
+	"ExtendHold\x12\x1b.inventory.v1.ExtendHoldReq\x1a\x15.inventory.v1.HoldRes\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/{event_id}/holds/extend\x12\x85\x01\n" +
+	"\x16CommitReservationAsync\x12\x17.inventory.v1.CommitReq\x1a\x17.inventory.v1.CommitRes\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/reservations/{reservation_id}/commit-async\x12u\n" +
+	"\x0fGetCommitStatus\x12 .inventory.v1.GetCommitStatusReq\x1a .inventory.v1.GetCommitStatusRes\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/commits/{order_id}\x12i\n" +
+	"\x0eAllocateSeason\x12\x1f.inventory.v1.AllocateSeasonReq\x1a\x1e.inventory.v1.SeasonAllocation\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/seasons\x12\x8f\x01\n" +
+	"\x11MaterializeSeason\x12\".inventory.v1.MaterializeSeasonReq\x1a\".inventory.v1.MaterializeSeasonRes\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/seasons/{allocation_id}/materialize\x12\x7f\n" +
+	"\rReleaseSeason\x12\x1e.inventory.v1.ReleaseSeasonReq\x1a\x1e.inventory.v1.SeasonAllocation\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/seasons/{allocation_id}/release\x12\x8f\x01\n" +
+	"\x14GetReservationStatus\x12%.inventory.v1.GetReservationStatusReq\x1a%.inventory.v1.GetReservationStatusRes\")\x82\xd3\xe4\x93\x02#\x12!/v1/reservations/{reservation_id}\x12h\n" +
+	"\tListSeats\x12\x1a.inventory.v1.ListSeatsReq\x1a\x1a.inventory.v1.ListSeatsRes\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/events/{event_id}/seats\x12\x9c\x01\n" +
+	"\x14GetAdjacentAvailable\x12%.inventory.v1.GetAdjacentAvailableReq\x1a%.inventory.v1.GetAdjacentAvailableRes\"6\x82\xd3\xe4\x93\x020\x12./v1/events/{event_id}/seats/{seat_id}/adjacent\x12V\n" +
+	"\x10SubscribeChanges\x12!.inventory.v1.SubscribeChangesReq\x1a\x1d.inventory.v1.InventoryChange0\x01\x12~\n" +
+	"\x11GetEventInventory\x12\".inventory.v1.GetEventInventoryReq\x1a\x1c.inventory.v1.EventInventory\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/events/{event_id}/inventory\x12\x8d\x01\n" +
+	"\x16BatchCheckAvailability\x12'.inventory.v1.BatchCheckAvailabilityReq\x1a'.inventory.v1.BatchCheckAvailabilityRes\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/availability/batch\x12\x8d\x01\n" +
+	"\x12PreauthorizeCommit\x12\x17.inventory.v1.CommitReq\x1a#.inventory.v1.PreauthorizeCommitRes\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/reservations/{reservation_id}/preauthorize\x12[\n" +
+	"\rGetLoadStatus\x12\x1e.inventory.v1.GetLoadStatusReq\x1a\x18.inventory.v1.LoadStatus\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/load\x12p\n" +
+	"\tSwapSeats\x12\x1a.inventory.v1.SwapSeatsReq\x1a\x1a.inventory.v1.SwapSeatsRes\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/events/{event_id}/seats/swapB-Z+github.com/traffictacos/inventory-api/protob\x06proto3"
 
 var (
 	file_proto_inventory_proto_rawDescOnce sync.Once
@@ -476,31 +3970,156 @@ func file_proto_inventory_proto_rawDescGZIP() []byte {
 	return file_proto_inventory_proto_rawDescData
 }
 
-var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_proto_inventory_proto_goTypes = []any{
-	(*SeatRef)(nil),    // 0: inventory.v1.SeatRef
-	(*CheckReq)(nil),   // 1: inventory.v1.CheckReq
-	(*CheckRes)(nil),   // 2: inventory.v1.CheckRes
-	(*CommitReq)(nil),  // 3: inventory.v1.CommitReq
-	(*CommitRes)(nil),  // 4: inventory.v1.CommitRes
-	(*ReleaseReq)(nil), // 5: inventory.v1.ReleaseReq
-	(*ReleaseRes)(nil), // 6: inventory.v1.ReleaseRes
+	(LoadState)(0),                    // 0: inventory.v1.LoadState
+	(SeatStatus)(0),                   // 1: inventory.v1.SeatStatus
+	(SeatHolder)(0),                   // 2: inventory.v1.SeatHolder
+	(ErrorCode)(0),                    // 3: inventory.v1.ErrorCode
+	(*GetLoadStatusReq)(nil),          // 4: inventory.v1.GetLoadStatusReq
+	(*LoadStatus)(nil),                // 5: inventory.v1.LoadStatus
+	(*SectionQty)(nil),                // 6: inventory.v1.SectionQty
+	(*SeatAvailability)(nil),          // 7: inventory.v1.SeatAvailability
+	(*SeatRef)(nil),                   // 8: inventory.v1.SeatRef
+	(*Seat)(nil),                      // 9: inventory.v1.Seat
+	(*CheckReq)(nil),                  // 10: inventory.v1.CheckReq
+	(*CheckRes)(nil),                  // 11: inventory.v1.CheckRes
+	(*EventCheck)(nil),                // 12: inventory.v1.EventCheck
+	(*BatchCheckAvailabilityReq)(nil), // 13: inventory.v1.BatchCheckAvailabilityReq
+	(*EventCheckResult)(nil),          // 14: inventory.v1.EventCheckResult
+	(*BatchCheckAvailabilityRes)(nil), // 15: inventory.v1.BatchCheckAvailabilityRes
+	(*CommitReq)(nil),                 // 16: inventory.v1.CommitReq
+	(*PreauthorizeCommitRes)(nil),     // 17: inventory.v1.PreauthorizeCommitRes
+	(*CommitLineItem)(nil),            // 18: inventory.v1.CommitLineItem
+	(*CommitRes)(nil),                 // 19: inventory.v1.CommitRes
+	(*OrderLine)(nil),                 // 20: inventory.v1.OrderLine
+	(*ReleaseReq)(nil),                // 21: inventory.v1.ReleaseReq
+	(*ReleaseRes)(nil),                // 22: inventory.v1.ReleaseRes
+	(*HoldReq)(nil),                   // 23: inventory.v1.HoldReq
+	(*ExtendHoldReq)(nil),             // 24: inventory.v1.ExtendHoldReq
+	(*HoldRes)(nil),                   // 25: inventory.v1.HoldRes
+	(*SwapSeatsReq)(nil),              // 26: inventory.v1.SwapSeatsReq
+	(*SwapSeatsRes)(nil),              // 27: inventory.v1.SwapSeatsRes
+	(*GetCommitStatusReq)(nil),        // 28: inventory.v1.GetCommitStatusReq
+	(*GetCommitStatusRes)(nil),        // 29: inventory.v1.GetCommitStatusRes
+	(*ErrorDetail)(nil),               // 30: inventory.v1.ErrorDetail
+	(*AllocateSeasonReq)(nil),         // 31: inventory.v1.AllocateSeasonReq
+	(*MaterializeSeasonReq)(nil),      // 32: inventory.v1.MaterializeSeasonReq
+	(*MaterializeSeasonRes)(nil),      // 33: inventory.v1.MaterializeSeasonRes
+	(*ReleaseSeasonReq)(nil),          // 34: inventory.v1.ReleaseSeasonReq
+	(*SeasonAllocation)(nil),          // 35: inventory.v1.SeasonAllocation
+	(*GetReservationStatusReq)(nil),   // 36: inventory.v1.GetReservationStatusReq
+	(*ReservationSeat)(nil),           // 37: inventory.v1.ReservationSeat
+	(*GetReservationStatusRes)(nil),   // 38: inventory.v1.GetReservationStatusRes
+	(*ListSeatsReq)(nil),              // 39: inventory.v1.ListSeatsReq
+	(*ListSeatsRes)(nil),              // 40: inventory.v1.ListSeatsRes
+	(*GetAdjacentAvailableReq)(nil),   // 41: inventory.v1.GetAdjacentAvailableReq
+	(*GetAdjacentAvailableRes)(nil),   // 42: inventory.v1.GetAdjacentAvailableRes
+	(*SubscribeChangesReq)(nil),       // 43: inventory.v1.SubscribeChangesReq
+	(*SeatChanged)(nil),               // 44: inventory.v1.SeatChanged
+	(*InventoryChanged)(nil),          // 45: inventory.v1.InventoryChanged
+	(*InventoryChange)(nil),           // 46: inventory.v1.InventoryChange
+	(*GetEventInventoryReq)(nil),      // 47: inventory.v1.GetEventInventoryReq
+	(*SectionInventory)(nil),          // 48: inventory.v1.SectionInventory
+	(*EventInventory)(nil),            // 49: inventory.v1.EventInventory
+	(*BatchResult)(nil),               // 50: inventory.v1.BatchResult
+	nil,                               // 51: inventory.v1.Seat.MetadataEntry
+	nil,                               // 52: inventory.v1.SeasonAllocation.MaterializedEntry
+	(*timestamppb.Timestamp)(nil),     // 53: google.protobuf.Timestamp
 }
 var file_proto_inventory_proto_depIdxs = []int32{
-	0, // 0: inventory.v1.CheckReq.seat_ids:type_name -> inventory.v1.SeatRef
-	0, // 1: inventory.v1.CommitReq.seat_ids:type_name -> inventory.v1.SeatRef
-	0, // 2: inventory.v1.ReleaseReq.seat_ids:type_name -> inventory.v1.SeatRef
-	1, // 3: inventory.v1.Inventory.CheckAvailability:input_type -> inventory.v1.CheckReq
-	3, // 4: inventory.v1.Inventory.CommitReservation:input_type -> inventory.v1.CommitReq
-	5, // 5: inventory.v1.Inventory.ReleaseHold:input_type -> inventory.v1.ReleaseReq
-	2, // 6: inventory.v1.Inventory.CheckAvailability:output_type -> inventory.v1.CheckRes
-	4, // 7: inventory.v1.Inventory.CommitReservation:output_type -> inventory.v1.CommitRes
-	6, // 8: inventory.v1.Inventory.ReleaseHold:output_type -> inventory.v1.ReleaseRes
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: inventory.v1.LoadStatus.state:type_name -> inventory.v1.LoadState
+	1,  // 1: inventory.v1.SeatAvailability.status:type_name -> inventory.v1.SeatStatus
+	2,  // 2: inventory.v1.SeatAvailability.holder:type_name -> inventory.v1.SeatHolder
+	1,  // 3: inventory.v1.Seat.status:type_name -> inventory.v1.SeatStatus
+	51, // 4: inventory.v1.Seat.metadata:type_name -> inventory.v1.Seat.MetadataEntry
+	53, // 5: inventory.v1.Seat.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: inventory.v1.CheckReq.seat_ids:type_name -> inventory.v1.SeatRef
+	53, // 7: inventory.v1.CheckRes.as_of:type_name -> google.protobuf.Timestamp
+	7,  // 8: inventory.v1.CheckRes.seats:type_name -> inventory.v1.SeatAvailability
+	12, // 9: inventory.v1.BatchCheckAvailabilityReq.events:type_name -> inventory.v1.EventCheck
+	11, // 10: inventory.v1.EventCheckResult.availability:type_name -> inventory.v1.CheckRes
+	50, // 11: inventory.v1.EventCheckResult.result:type_name -> inventory.v1.BatchResult
+	14, // 12: inventory.v1.BatchCheckAvailabilityRes.results:type_name -> inventory.v1.EventCheckResult
+	8,  // 13: inventory.v1.CommitReq.seat_ids:type_name -> inventory.v1.SeatRef
+	6,  // 14: inventory.v1.CommitReq.section_qtys:type_name -> inventory.v1.SectionQty
+	18, // 15: inventory.v1.CommitReq.line_items:type_name -> inventory.v1.CommitLineItem
+	53, // 16: inventory.v1.PreauthorizeCommitRes.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 17: inventory.v1.PreauthorizeCommitRes.lines:type_name -> inventory.v1.OrderLine
+	8,  // 18: inventory.v1.CommitLineItem.seat_ids:type_name -> inventory.v1.SeatRef
+	6,  // 19: inventory.v1.CommitLineItem.section_qtys:type_name -> inventory.v1.SectionQty
+	20, // 20: inventory.v1.CommitRes.lines:type_name -> inventory.v1.OrderLine
+	8,  // 21: inventory.v1.ReleaseReq.seat_ids:type_name -> inventory.v1.SeatRef
+	6,  // 22: inventory.v1.ReleaseReq.section_qtys:type_name -> inventory.v1.SectionQty
+	8,  // 23: inventory.v1.HoldReq.seat_ids:type_name -> inventory.v1.SeatRef
+	8,  // 24: inventory.v1.ExtendHoldReq.seat_ids:type_name -> inventory.v1.SeatRef
+	53, // 25: inventory.v1.HoldRes.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 26: inventory.v1.SwapSeatsReq.release_seat_ids:type_name -> inventory.v1.SeatRef
+	8,  // 27: inventory.v1.SwapSeatsReq.acquire_seat_ids:type_name -> inventory.v1.SeatRef
+	20, // 28: inventory.v1.SwapSeatsRes.lines:type_name -> inventory.v1.OrderLine
+	53, // 29: inventory.v1.SwapSeatsRes.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 30: inventory.v1.GetCommitStatusRes.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 31: inventory.v1.GetCommitStatusRes.error_code:type_name -> inventory.v1.ErrorCode
+	3,  // 32: inventory.v1.ErrorDetail.error_code:type_name -> inventory.v1.ErrorCode
+	8,  // 33: inventory.v1.AllocateSeasonReq.seat_ids:type_name -> inventory.v1.SeatRef
+	8,  // 34: inventory.v1.SeasonAllocation.seat_ids:type_name -> inventory.v1.SeatRef
+	52, // 35: inventory.v1.SeasonAllocation.materialized:type_name -> inventory.v1.SeasonAllocation.MaterializedEntry
+	53, // 36: inventory.v1.SeasonAllocation.created_at:type_name -> google.protobuf.Timestamp
+	37, // 37: inventory.v1.GetReservationStatusRes.held_seats:type_name -> inventory.v1.ReservationSeat
+	37, // 38: inventory.v1.GetReservationStatusRes.sold_seats:type_name -> inventory.v1.ReservationSeat
+	1,  // 39: inventory.v1.ListSeatsReq.status_filter:type_name -> inventory.v1.SeatStatus
+	9,  // 40: inventory.v1.ListSeatsRes.seats:type_name -> inventory.v1.Seat
+	1,  // 41: inventory.v1.SeatChanged.status:type_name -> inventory.v1.SeatStatus
+	53, // 42: inventory.v1.InventoryChange.changed_at:type_name -> google.protobuf.Timestamp
+	44, // 43: inventory.v1.InventoryChange.seat:type_name -> inventory.v1.SeatChanged
+	45, // 44: inventory.v1.InventoryChange.inventory:type_name -> inventory.v1.InventoryChanged
+	48, // 45: inventory.v1.EventInventory.sections:type_name -> inventory.v1.SectionInventory
+	53, // 46: inventory.v1.EventInventory.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 47: inventory.v1.BatchResult.error_code:type_name -> inventory.v1.ErrorCode
+	10, // 48: inventory.v1.Inventory.CheckAvailability:input_type -> inventory.v1.CheckReq
+	16, // 49: inventory.v1.Inventory.CommitReservation:input_type -> inventory.v1.CommitReq
+	21, // 50: inventory.v1.Inventory.ReleaseHold:input_type -> inventory.v1.ReleaseReq
+	23, // 51: inventory.v1.Inventory.HoldSeats:input_type -> inventory.v1.HoldReq
+	24, // 52: inventory.v1.Inventory.ExtendHold:input_type -> inventory.v1.ExtendHoldReq
+	16, // 53: inventory.v1.Inventory.CommitReservationAsync:input_type -> inventory.v1.CommitReq
+	28, // 54: inventory.v1.Inventory.GetCommitStatus:input_type -> inventory.v1.GetCommitStatusReq
+	31, // 55: inventory.v1.Inventory.AllocateSeason:input_type -> inventory.v1.AllocateSeasonReq
+	32, // 56: inventory.v1.Inventory.MaterializeSeason:input_type -> inventory.v1.MaterializeSeasonReq
+	34, // 57: inventory.v1.Inventory.ReleaseSeason:input_type -> inventory.v1.ReleaseSeasonReq
+	36, // 58: inventory.v1.Inventory.GetReservationStatus:input_type -> inventory.v1.GetReservationStatusReq
+	39, // 59: inventory.v1.Inventory.ListSeats:input_type -> inventory.v1.ListSeatsReq
+	41, // 60: inventory.v1.Inventory.GetAdjacentAvailable:input_type -> inventory.v1.GetAdjacentAvailableReq
+	43, // 61: inventory.v1.Inventory.SubscribeChanges:input_type -> inventory.v1.SubscribeChangesReq
+	47, // 62: inventory.v1.Inventory.GetEventInventory:input_type -> inventory.v1.GetEventInventoryReq
+	13, // 63: inventory.v1.Inventory.BatchCheckAvailability:input_type -> inventory.v1.BatchCheckAvailabilityReq
+	16, // 64: inventory.v1.Inventory.PreauthorizeCommit:input_type -> inventory.v1.CommitReq
+	4,  // 65: inventory.v1.Inventory.GetLoadStatus:input_type -> inventory.v1.GetLoadStatusReq
+	26, // 66: inventory.v1.Inventory.SwapSeats:input_type -> inventory.v1.SwapSeatsReq
+	11, // 67: inventory.v1.Inventory.CheckAvailability:output_type -> inventory.v1.CheckRes
+	19, // 68: inventory.v1.Inventory.CommitReservation:output_type -> inventory.v1.CommitRes
+	22, // 69: inventory.v1.Inventory.ReleaseHold:output_type -> inventory.v1.ReleaseRes
+	25, // 70: inventory.v1.Inventory.HoldSeats:output_type -> inventory.v1.HoldRes
+	25, // 71: inventory.v1.Inventory.ExtendHold:output_type -> inventory.v1.HoldRes
+	19, // 72: inventory.v1.Inventory.CommitReservationAsync:output_type -> inventory.v1.CommitRes
+	29, // 73: inventory.v1.Inventory.GetCommitStatus:output_type -> inventory.v1.GetCommitStatusRes
+	35, // 74: inventory.v1.Inventory.AllocateSeason:output_type -> inventory.v1.SeasonAllocation
+	33, // 75: inventory.v1.Inventory.MaterializeSeason:output_type -> inventory.v1.MaterializeSeasonRes
+	35, // 76: inventory.v1.Inventory.ReleaseSeason:output_type -> inventory.v1.SeasonAllocation
+	38, // 77: inventory.v1.Inventory.GetReservationStatus:output_type -> inventory.v1.GetReservationStatusRes
+	40, // 78: inventory.v1.Inventory.ListSeats:output_type -> inventory.v1.ListSeatsRes
+	42, // 79: inventory.v1.Inventory.GetAdjacentAvailable:output_type -> inventory.v1.GetAdjacentAvailableRes
+	46, // 80: inventory.v1.Inventory.SubscribeChanges:output_type -> inventory.v1.InventoryChange
+	49, // 81: inventory.v1.Inventory.GetEventInventory:output_type -> inventory.v1.EventInventory
+	15, // 82: inventory.v1.Inventory.BatchCheckAvailability:output_type -> inventory.v1.BatchCheckAvailabilityRes
+	17, // 83: inventory.v1.Inventory.PreauthorizeCommit:output_type -> inventory.v1.PreauthorizeCommitRes
+	5,  // 84: inventory.v1.Inventory.GetLoadStatus:output_type -> inventory.v1.LoadStatus
+	27, // 85: inventory.v1.Inventory.SwapSeats:output_type -> inventory.v1.SwapSeatsRes
+	67, // [67:86] is the sub-list for method output_type
+	48, // [48:67] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_proto_init() }
@@ -508,18 +4127,24 @@ func file_proto_inventory_proto_init() {
 	if File_proto_inventory_proto != nil {
 		return
 	}
+	file_proto_validate_proto_init()
+	file_proto_inventory_proto_msgTypes[42].OneofWrappers = []any{
+		(*InventoryChange_Seat)(nil),
+		(*InventoryChange_Inventory)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_proto_rawDesc), len(file_proto_inventory_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_inventory_proto_goTypes,
 		DependencyIndexes: file_proto_inventory_proto_depIdxs,
+		EnumInfos:         file_proto_inventory_proto_enumTypes,
 		MessageInfos:      file_proto_inventory_proto_msgTypes,
 	}.Build()
 	File_proto_inventory_proto = out.File
@@ -19,9 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Inventory_CheckAvailability_FullMethodName = "/inventory.v1.Inventory/CheckAvailability"
-	Inventory_CommitReservation_FullMethodName = "/inventory.v1.Inventory/CommitReservation"
-	Inventory_ReleaseHold_FullMethodName       = "/inventory.v1.Inventory/ReleaseHold"
+	Inventory_CheckAvailability_FullMethodName      = "/inventory.v1.Inventory/CheckAvailability"
+	Inventory_CommitReservation_FullMethodName      = "/inventory.v1.Inventory/CommitReservation"
+	Inventory_ReleaseHold_FullMethodName            = "/inventory.v1.Inventory/ReleaseHold"
+	Inventory_HoldSeats_FullMethodName              = "/inventory.v1.Inventory/HoldSeats"
+	Inventory_ExtendHold_FullMethodName             = "/inventory.v1.Inventory/ExtendHold"
+	Inventory_CommitReservationAsync_FullMethodName = "/inventory.v1.Inventory/CommitReservationAsync"
+	Inventory_GetCommitStatus_FullMethodName        = "/inventory.v1.Inventory/GetCommitStatus"
+	Inventory_AllocateSeason_FullMethodName         = "/inventory.v1.Inventory/AllocateSeason"
+	Inventory_MaterializeSeason_FullMethodName      = "/inventory.v1.Inventory/MaterializeSeason"
+	Inventory_ReleaseSeason_FullMethodName          = "/inventory.v1.Inventory/ReleaseSeason"
+	Inventory_GetReservationStatus_FullMethodName   = "/inventory.v1.Inventory/GetReservationStatus"
+	Inventory_ListSeats_FullMethodName              = "/inventory.v1.Inventory/ListSeats"
+	Inventory_GetAdjacentAvailable_FullMethodName   = "/inventory.v1.Inventory/GetAdjacentAvailable"
+	Inventory_SubscribeChanges_FullMethodName       = "/inventory.v1.Inventory/SubscribeChanges"
+	Inventory_GetEventInventory_FullMethodName      = "/inventory.v1.Inventory/GetEventInventory"
+	Inventory_BatchCheckAvailability_FullMethodName = "/inventory.v1.Inventory/BatchCheckAvailability"
+	Inventory_PreauthorizeCommit_FullMethodName     = "/inventory.v1.Inventory/PreauthorizeCommit"
+	Inventory_GetLoadStatus_FullMethodName          = "/inventory.v1.Inventory/GetLoadStatus"
+	Inventory_SwapSeats_FullMethodName              = "/inventory.v1.Inventory/SwapSeats"
 )
 
 // InventoryClient is the client API for Inventory service.
@@ -37,6 +53,58 @@ type InventoryClient interface {
 	CommitReservation(ctx context.Context, in *CommitReq, opts ...grpc.CallOption) (*CommitRes, error)
 	// ReleaseHold releases a hold on inventory (idempotent operation)
 	ReleaseHold(ctx context.Context, in *ReleaseReq, opts ...grpc.CallOption) (*ReleaseRes, error)
+	// HoldSeats places a time-limited hold on seats for a reservation.
+	// Expired holds are returned to sale automatically.
+	HoldSeats(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*HoldRes, error)
+	// ExtendHold moves the expiry of a reservation's live hold, counting as one extension
+	ExtendHold(ctx context.Context, in *ExtendHoldReq, opts ...grpc.CallOption) (*HoldRes, error)
+	// CommitReservationAsync queues a commit and returns its order_id with status "PENDING"
+	// (or "CONFIRMED" if the reservation was already committed). Poll GetCommitStatus for the outcome.
+	CommitReservationAsync(ctx context.Context, in *CommitReq, opts ...grpc.CallOption) (*CommitRes, error)
+	// GetCommitStatus returns the status of an asynchronous commit
+	GetCommitStatus(ctx context.Context, in *GetCommitStatusReq, opts ...grpc.CallOption) (*GetCommitStatusRes, error)
+	// AllocateSeason allocates the same seats across the given performances of a series
+	// in one operation, for a season ticket. Allocated seats are ALLOCATED until each
+	// performance is materialized (sold) or released. Retrying an allocation returns it.
+	AllocateSeason(ctx context.Context, in *AllocateSeasonReq, opts ...grpc.CallOption) (*SeasonAllocation, error)
+	// MaterializeSeason sells the allocated seats of one performance under an order
+	MaterializeSeason(ctx context.Context, in *MaterializeSeasonReq, opts ...grpc.CallOption) (*MaterializeSeasonRes, error)
+	// ReleaseSeason returns the allocated seats of one performance to sale, or of every
+	// performance not materialized yet, which ends the allocation
+	ReleaseSeason(ctx context.Context, in *ReleaseSeasonReq, opts ...grpc.CallOption) (*SeasonAllocation, error)
+	// GetReservationStatus reports what a reservation ended up with: the seats it holds
+	// or bought and, once committed, its order
+	GetReservationStatus(ctx context.Context, in *GetReservationStatusReq, opts ...grpc.CallOption) (*GetReservationStatusRes, error)
+	// ListSeats returns a page of an event's seats in seat ID order, e.g. to render a seat map
+	ListSeats(ctx context.Context, in *ListSeatsReq, opts ...grpc.CallOption) (*ListSeatsRes, error)
+	// GetAdjacentAvailable finds n available seats side by side in the row of a seat,
+	// nearest to it, e.g. to "find seats together near mine". Seats never adjoin across an
+	// aisle or a row end; an empty result means the row has no such block.
+	GetAdjacentAvailable(ctx context.Context, in *GetAdjacentAvailableReq, opts ...grpc.CallOption) (*GetAdjacentAvailableRes, error)
+	// SubscribeChanges streams the seat and inventory changes made by every instance, for
+	// sibling services. Delivery is at least once; pass the resume_token of the last
+	// change received to continue after a disconnect.
+	SubscribeChanges(ctx context.Context, in *SubscribeChangesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryChange], error)
+	// GetEventInventory returns an event's aggregate inventory with exact quantities, for
+	// internal services
+	GetEventInventory(ctx context.Context, in *GetEventInventoryReq, opts ...grpc.CallOption) (*EventInventory, error)
+	// BatchCheckAvailability checks the quantity availability of several events at once,
+	// e.g. for event listing pages. Events are checked concurrently and independently:
+	// an event that fails reports its error in its result without failing the others.
+	BatchCheckAvailability(ctx context.Context, in *BatchCheckAvailabilityReq, opts ...grpc.CallOption) (*BatchCheckAvailabilityRes, error)
+	// PreauthorizeCommit validates a seat or quantity commit before payment (the seats must
+	// be held by the reservation) and returns a short-lived signed token locking its seats,
+	// quantity and seat prices. CommitReservation with the token fails if any of them changed.
+	PreauthorizeCommit(ctx context.Context, in *CommitReq, opts ...grpc.CallOption) (*PreauthorizeCommitRes, error)
+	// GetLoadStatus reports how saturated this instance is, so an admission controller can
+	// slow down before requests start failing. It reads in-memory counters only. Public
+	// unary responses carry the same saturation in the x-load-saturation trailer.
+	GetLoadStatus(ctx context.Context, in *GetLoadStatusReq, opts ...grpc.CallOption) (*LoadStatus, error)
+	// SwapSeats exchanges seats a reservation holds or bought for replacement seats in one
+	// transaction, e.g. for customer-service seat changes: the released seats return to
+	// sale and the acquired seats take their status, or nothing changes if any target seat
+	// is taken. Held replacements keep the expiry of the hold they replace.
+	SwapSeats(ctx context.Context, in *SwapSeatsReq, opts ...grpc.CallOption) (*SwapSeatsRes, error)
 }
 
 type inventoryClient struct {
@@ -77,6 +145,175 @@ func (c *inventoryClient) ReleaseHold(ctx context.Context, in *ReleaseReq, opts
 	return out, nil
 }
 
+func (c *inventoryClient) HoldSeats(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*HoldRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldRes)
+	err := c.cc.Invoke(ctx, Inventory_HoldSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ExtendHold(ctx context.Context, in *ExtendHoldReq, opts ...grpc.CallOption) (*HoldRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldRes)
+	err := c.cc.Invoke(ctx, Inventory_ExtendHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CommitReservationAsync(ctx context.Context, in *CommitReq, opts ...grpc.CallOption) (*CommitRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitRes)
+	err := c.cc.Invoke(ctx, Inventory_CommitReservationAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetCommitStatus(ctx context.Context, in *GetCommitStatusReq, opts ...grpc.CallOption) (*GetCommitStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitStatusRes)
+	err := c.cc.Invoke(ctx, Inventory_GetCommitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) AllocateSeason(ctx context.Context, in *AllocateSeasonReq, opts ...grpc.CallOption) (*SeasonAllocation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonAllocation)
+	err := c.cc.Invoke(ctx, Inventory_AllocateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) MaterializeSeason(ctx context.Context, in *MaterializeSeasonReq, opts ...grpc.CallOption) (*MaterializeSeasonRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterializeSeasonRes)
+	err := c.cc.Invoke(ctx, Inventory_MaterializeSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ReleaseSeason(ctx context.Context, in *ReleaseSeasonReq, opts ...grpc.CallOption) (*SeasonAllocation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonAllocation)
+	err := c.cc.Invoke(ctx, Inventory_ReleaseSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetReservationStatus(ctx context.Context, in *GetReservationStatusReq, opts ...grpc.CallOption) (*GetReservationStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationStatusRes)
+	err := c.cc.Invoke(ctx, Inventory_GetReservationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ListSeats(ctx context.Context, in *ListSeatsReq, opts ...grpc.CallOption) (*ListSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeatsRes)
+	err := c.cc.Invoke(ctx, Inventory_ListSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetAdjacentAvailable(ctx context.Context, in *GetAdjacentAvailableReq, opts ...grpc.CallOption) (*GetAdjacentAvailableRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdjacentAvailableRes)
+	err := c.cc.Invoke(ctx, Inventory_GetAdjacentAvailable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SubscribeChanges(ctx context.Context, in *SubscribeChangesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Inventory_ServiceDesc.Streams[0], Inventory_SubscribeChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeChangesReq, InventoryChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Inventory_SubscribeChangesClient = grpc.ServerStreamingClient[InventoryChange]
+
+func (c *inventoryClient) GetEventInventory(ctx context.Context, in *GetEventInventoryReq, opts ...grpc.CallOption) (*EventInventory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventInventory)
+	err := c.cc.Invoke(ctx, Inventory_GetEventInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) BatchCheckAvailability(ctx context.Context, in *BatchCheckAvailabilityReq, opts ...grpc.CallOption) (*BatchCheckAvailabilityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCheckAvailabilityRes)
+	err := c.cc.Invoke(ctx, Inventory_BatchCheckAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) PreauthorizeCommit(ctx context.Context, in *CommitReq, opts ...grpc.CallOption) (*PreauthorizeCommitRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreauthorizeCommitRes)
+	err := c.cc.Invoke(ctx, Inventory_PreauthorizeCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetLoadStatus(ctx context.Context, in *GetLoadStatusReq, opts ...grpc.CallOption) (*LoadStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoadStatus)
+	err := c.cc.Invoke(ctx, Inventory_GetLoadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SwapSeats(ctx context.Context, in *SwapSeatsReq, opts ...grpc.CallOption) (*SwapSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapSeatsRes)
+	err := c.cc.Invoke(ctx, Inventory_SwapSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServer is the server API for Inventory service.
 // All implementations must embed UnimplementedInventoryServer
 // for forward compatibility.
@@ -90,6 +327,58 @@ type InventoryServer interface {
 	CommitReservation(context.Context, *CommitReq) (*CommitRes, error)
 	// ReleaseHold releases a hold on inventory (idempotent operation)
 	ReleaseHold(context.Context, *ReleaseReq) (*ReleaseRes, error)
+	// HoldSeats places a time-limited hold on seats for a reservation.
+	// Expired holds are returned to sale automatically.
+	HoldSeats(context.Context, *HoldReq) (*HoldRes, error)
+	// ExtendHold moves the expiry of a reservation's live hold, counting as one extension
+	ExtendHold(context.Context, *ExtendHoldReq) (*HoldRes, error)
+	// CommitReservationAsync queues a commit and returns its order_id with status "PENDING"
+	// (or "CONFIRMED" if the reservation was already committed). Poll GetCommitStatus for the outcome.
+	CommitReservationAsync(context.Context, *CommitReq) (*CommitRes, error)
+	// GetCommitStatus returns the status of an asynchronous commit
+	GetCommitStatus(context.Context, *GetCommitStatusReq) (*GetCommitStatusRes, error)
+	// AllocateSeason allocates the same seats across the given performances of a series
+	// in one operation, for a season ticket. Allocated seats are ALLOCATED until each
+	// performance is materialized (sold) or released. Retrying an allocation returns it.
+	AllocateSeason(context.Context, *AllocateSeasonReq) (*SeasonAllocation, error)
+	// MaterializeSeason sells the allocated seats of one performance under an order
+	MaterializeSeason(context.Context, *MaterializeSeasonReq) (*MaterializeSeasonRes, error)
+	// ReleaseSeason returns the allocated seats of one performance to sale, or of every
+	// performance not materialized yet, which ends the allocation
+	ReleaseSeason(context.Context, *ReleaseSeasonReq) (*SeasonAllocation, error)
+	// GetReservationStatus reports what a reservation ended up with: the seats it holds
+	// or bought and, once committed, its order
+	GetReservationStatus(context.Context, *GetReservationStatusReq) (*GetReservationStatusRes, error)
+	// ListSeats returns a page of an event's seats in seat ID order, e.g. to render a seat map
+	ListSeats(context.Context, *ListSeatsReq) (*ListSeatsRes, error)
+	// GetAdjacentAvailable finds n available seats side by side in the row of a seat,
+	// nearest to it, e.g. to "find seats together near mine". Seats never adjoin across an
+	// aisle or a row end; an empty result means the row has no such block.
+	GetAdjacentAvailable(context.Context, *GetAdjacentAvailableReq) (*GetAdjacentAvailableRes, error)
+	// SubscribeChanges streams the seat and inventory changes made by every instance, for
+	// sibling services. Delivery is at least once; pass the resume_token of the last
+	// change received to continue after a disconnect.
+	SubscribeChanges(*SubscribeChangesReq, grpc.ServerStreamingServer[InventoryChange]) error
+	// GetEventInventory returns an event's aggregate inventory with exact quantities, for
+	// internal services
+	GetEventInventory(context.Context, *GetEventInventoryReq) (*EventInventory, error)
+	// BatchCheckAvailability checks the quantity availability of several events at once,
+	// e.g. for event listing pages. Events are checked concurrently and independently:
+	// an event that fails reports its error in its result without failing the others.
+	BatchCheckAvailability(context.Context, *BatchCheckAvailabilityReq) (*BatchCheckAvailabilityRes, error)
+	// PreauthorizeCommit validates a seat or quantity commit before payment (the seats must
+	// be held by the reservation) and returns a short-lived signed token locking its seats,
+	// quantity and seat prices. CommitReservation with the token fails if any of them changed.
+	PreauthorizeCommit(context.Context, *CommitReq) (*PreauthorizeCommitRes, error)
+	// GetLoadStatus reports how saturated this instance is, so an admission controller can
+	// slow down before requests start failing. It reads in-memory counters only. Public
+	// unary responses carry the same saturation in the x-load-saturation trailer.
+	GetLoadStatus(context.Context, *GetLoadStatusReq) (*LoadStatus, error)
+	// SwapSeats exchanges seats a reservation holds or bought for replacement seats in one
+	// transaction, e.g. for customer-service seat changes: the released seats return to
+	// sale and the acquired seats take their status, or nothing changes if any target seat
+	// is taken. Held replacements keep the expiry of the hold they replace.
+	SwapSeats(context.Context, *SwapSeatsReq) (*SwapSeatsRes, error)
 	mustEmbedUnimplementedInventoryServer()
 }
 
@@ -109,6 +398,54 @@ func (UnimplementedInventoryServer) CommitReservation(context.Context, *CommitRe
 func (UnimplementedInventoryServer) ReleaseHold(context.Context, *ReleaseReq) (*ReleaseRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
 }
+func (UnimplementedInventoryServer) HoldSeats(context.Context, *HoldReq) (*HoldRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeats not implemented")
+}
+func (UnimplementedInventoryServer) ExtendHold(context.Context, *ExtendHoldReq) (*HoldRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (UnimplementedInventoryServer) CommitReservationAsync(context.Context, *CommitReq) (*CommitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservationAsync not implemented")
+}
+func (UnimplementedInventoryServer) GetCommitStatus(context.Context, *GetCommitStatusReq) (*GetCommitStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitStatus not implemented")
+}
+func (UnimplementedInventoryServer) AllocateSeason(context.Context, *AllocateSeasonReq) (*SeasonAllocation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateSeason not implemented")
+}
+func (UnimplementedInventoryServer) MaterializeSeason(context.Context, *MaterializeSeasonReq) (*MaterializeSeasonRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaterializeSeason not implemented")
+}
+func (UnimplementedInventoryServer) ReleaseSeason(context.Context, *ReleaseSeasonReq) (*SeasonAllocation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSeason not implemented")
+}
+func (UnimplementedInventoryServer) GetReservationStatus(context.Context, *GetReservationStatusReq) (*GetReservationStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationStatus not implemented")
+}
+func (UnimplementedInventoryServer) ListSeats(context.Context, *ListSeatsReq) (*ListSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeats not implemented")
+}
+func (UnimplementedInventoryServer) GetAdjacentAvailable(context.Context, *GetAdjacentAvailableReq) (*GetAdjacentAvailableRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdjacentAvailable not implemented")
+}
+func (UnimplementedInventoryServer) SubscribeChanges(*SubscribeChangesReq, grpc.ServerStreamingServer[InventoryChange]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChanges not implemented")
+}
+func (UnimplementedInventoryServer) GetEventInventory(context.Context, *GetEventInventoryReq) (*EventInventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventInventory not implemented")
+}
+func (UnimplementedInventoryServer) BatchCheckAvailability(context.Context, *BatchCheckAvailabilityReq) (*BatchCheckAvailabilityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckAvailability not implemented")
+}
+func (UnimplementedInventoryServer) PreauthorizeCommit(context.Context, *CommitReq) (*PreauthorizeCommitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreauthorizeCommit not implemented")
+}
+func (UnimplementedInventoryServer) GetLoadStatus(context.Context, *GetLoadStatusReq) (*LoadStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadStatus not implemented")
+}
+func (UnimplementedInventoryServer) SwapSeats(context.Context, *SwapSeatsReq) (*SwapSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
 func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
 func (UnimplementedInventoryServer) testEmbeddedByValue()                   {}
 
@@ -184,6 +521,287 @@ func _Inventory_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inventory_HoldSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).HoldSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_HoldSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).HoldSeats(ctx, req.(*HoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_ExtendHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ExtendHold(ctx, req.(*ExtendHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CommitReservationAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CommitReservationAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_CommitReservationAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CommitReservationAsync(ctx, req.(*CommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetCommitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetCommitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetCommitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetCommitStatus(ctx, req.(*GetCommitStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_AllocateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateSeasonReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).AllocateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_AllocateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).AllocateSeason(ctx, req.(*AllocateSeasonReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_MaterializeSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterializeSeasonReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).MaterializeSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_MaterializeSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).MaterializeSeason(ctx, req.(*MaterializeSeasonReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ReleaseSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeasonReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ReleaseSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_ReleaseSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ReleaseSeason(ctx, req.(*ReleaseSeasonReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetReservationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetReservationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetReservationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetReservationStatus(ctx, req.(*GetReservationStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ListSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ListSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_ListSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ListSeats(ctx, req.(*ListSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetAdjacentAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdjacentAvailableReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetAdjacentAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetAdjacentAvailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetAdjacentAvailable(ctx, req.(*GetAdjacentAvailableReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SubscribeChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChangesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServer).SubscribeChanges(m, &grpc.GenericServerStream[SubscribeChangesReq, InventoryChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Inventory_SubscribeChangesServer = grpc.ServerStreamingServer[InventoryChange]
+
+func _Inventory_GetEventInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventInventoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetEventInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetEventInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetEventInventory(ctx, req.(*GetEventInventoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_BatchCheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckAvailabilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).BatchCheckAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_BatchCheckAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).BatchCheckAvailability(ctx, req.(*BatchCheckAvailabilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_PreauthorizeCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).PreauthorizeCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_PreauthorizeCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).PreauthorizeCommit(ctx, req.(*CommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetLoadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetLoadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetLoadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetLoadStatus(ctx, req.(*GetLoadStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_SwapSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SwapSeats(ctx, req.(*SwapSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,7 +821,73 @@ var Inventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseHold",
 			Handler:    _Inventory_ReleaseHold_Handler,
 		},
+		{
+			MethodName: "HoldSeats",
+			Handler:    _Inventory_HoldSeats_Handler,
+		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _Inventory_ExtendHold_Handler,
+		},
+		{
+			MethodName: "CommitReservationAsync",
+			Handler:    _Inventory_CommitReservationAsync_Handler,
+		},
+		{
+			MethodName: "GetCommitStatus",
+			Handler:    _Inventory_GetCommitStatus_Handler,
+		},
+		{
+			MethodName: "AllocateSeason",
+			Handler:    _Inventory_AllocateSeason_Handler,
+		},
+		{
+			MethodName: "MaterializeSeason",
+			Handler:    _Inventory_MaterializeSeason_Handler,
+		},
+		{
+			MethodName: "ReleaseSeason",
+			Handler:    _Inventory_ReleaseSeason_Handler,
+		},
+		{
+			MethodName: "GetReservationStatus",
+			Handler:    _Inventory_GetReservationStatus_Handler,
+		},
+		{
+			MethodName: "ListSeats",
+			Handler:    _Inventory_ListSeats_Handler,
+		},
+		{
+			MethodName: "GetAdjacentAvailable",
+			Handler:    _Inventory_GetAdjacentAvailable_Handler,
+		},
+		{
+			MethodName: "GetEventInventory",
+			Handler:    _Inventory_GetEventInventory_Handler,
+		},
+		{
+			MethodName: "BatchCheckAvailability",
+			Handler:    _Inventory_BatchCheckAvailability_Handler,
+		},
+		{
+			MethodName: "PreauthorizeCommit",
+			Handler:    _Inventory_PreauthorizeCommit_Handler,
+		},
+		{
+			MethodName: "GetLoadStatus",
+			Handler:    _Inventory_GetLoadStatus_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _Inventory_SwapSeats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeChanges",
+			Handler:       _Inventory_SubscribeChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/inventory.proto",
 }
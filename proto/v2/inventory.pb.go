@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: proto/v2/inventory.proto
+
+package inventoryv2
+
+import (
+	proto "github.com/traffictacos/inventory-api/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CommitStatus is the status of a commit
+type CommitStatus int32
+
+const (
+	CommitStatus_COMMIT_STATUS_UNSPECIFIED CommitStatus = 0
+	// Queued by CommitReservationAsync and not processed yet
+	CommitStatus_COMMIT_STATUS_PENDING   CommitStatus = 1
+	CommitStatus_COMMIT_STATUS_CONFIRMED CommitStatus = 2
+	// The asynchronous commit failed; GetCommitStatusRes.error says why
+	CommitStatus_COMMIT_STATUS_FAILED CommitStatus = 3
+)
+
+// Enum value maps for CommitStatus.
+var (
+	CommitStatus_name = map[int32]string{
+		0: "COMMIT_STATUS_UNSPECIFIED",
+		1: "COMMIT_STATUS_PENDING",
+		2: "COMMIT_STATUS_CONFIRMED",
+		3: "COMMIT_STATUS_FAILED",
+	}
+	CommitStatus_value = map[string]int32{
+		"COMMIT_STATUS_UNSPECIFIED": 0,
+		"COMMIT_STATUS_PENDING":     1,
+		"COMMIT_STATUS_CONFIRMED":   2,
+		"COMMIT_STATUS_FAILED":      3,
+	}
+)
+
+func (x CommitStatus) Enum() *CommitStatus {
+	p := new(CommitStatus)
+	*p = x
+	return p
+}
+
+func (x CommitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v2_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (CommitStatus) Type() protoreflect.EnumType {
+	return &file_proto_v2_inventory_proto_enumTypes[0]
+}
+
+func (x CommitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitStatus.Descriptor instead.
+func (CommitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// ReleaseStatus is the outcome of a release
+type ReleaseStatus int32
+
+const (
+	ReleaseStatus_RELEASE_STATUS_UNSPECIFIED ReleaseStatus = 0
+	ReleaseStatus_RELEASE_STATUS_RELEASED    ReleaseStatus = 1
+)
+
+// Enum value maps for ReleaseStatus.
+var (
+	ReleaseStatus_name = map[int32]string{
+		0: "RELEASE_STATUS_UNSPECIFIED",
+		1: "RELEASE_STATUS_RELEASED",
+	}
+	ReleaseStatus_value = map[string]int32{
+		"RELEASE_STATUS_UNSPECIFIED": 0,
+		"RELEASE_STATUS_RELEASED":    1,
+	}
+)
+
+func (x ReleaseStatus) Enum() *ReleaseStatus {
+	p := new(ReleaseStatus)
+	*p = x
+	return p
+}
+
+func (x ReleaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReleaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v2_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (ReleaseStatus) Type() protoreflect.EnumType {
+	return &file_proto_v2_inventory_proto_enumTypes[1]
+}
+
+func (x ReleaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReleaseStatus.Descriptor instead.
+func (ReleaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// ReservationStatus is what a reservation ended up with
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_HELD        ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_COMMITTED   ReservationStatus = 2
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 3
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_HELD",
+		2: "RESERVATION_STATUS_COMMITTED",
+		3: "RESERVATION_STATUS_RELEASED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_HELD":        1,
+		"RESERVATION_STATUS_COMMITTED":   2,
+		"RESERVATION_STATUS_RELEASED":    3,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v2_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_proto_v2_inventory_proto_enumTypes[2]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// CommitRes represents the response to commit reservation
+type CommitRes struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status  CommitStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v2.CommitStatus" json:"status,omitempty"`
+	// Seats plus quantity committed; set with lines
+	CommittedQty int32 `protobuf:"varint,3,opt,name=committed_qty,json=committedQty,proto3" json:"committed_qty,omitempty"`
+	// Confirmed lines for receipts; set only when this call confirmed the commit, not on
+	// retries of an already confirmed reservation or for asynchronous commits
+	Lines         []*proto.OrderLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitRes) Reset() {
+	*x = CommitRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitRes) ProtoMessage() {}
+
+func (x *CommitRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitRes.ProtoReflect.Descriptor instead.
+func (*CommitRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommitRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CommitRes) GetStatus() CommitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommitStatus_COMMIT_STATUS_UNSPECIFIED
+}
+
+func (x *CommitRes) GetCommittedQty() int32 {
+	if x != nil {
+		return x.CommittedQty
+	}
+	return 0
+}
+
+func (x *CommitRes) GetLines() []*proto.OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// GetCommitStatusRes represents the status of an asynchronous commit
+type GetCommitStatusRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Order the reservation was committed as; differs from the requested order_id
+	// when the reservation had already been committed by another request
+	OrderId string       `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status  CommitStatus `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v2.CommitStatus" json:"status,omitempty"`
+	// Failure reason when status is COMMIT_STATUS_FAILED
+	Error     string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Classified failure reason when status is COMMIT_STATUS_FAILED, if it has a code
+	ErrorCode     proto.ErrorCode `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3,enum=inventory.v1.ErrorCode" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommitStatusRes) Reset() {
+	*x = GetCommitStatusRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommitStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommitStatusRes) ProtoMessage() {}
+
+func (x *GetCommitStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommitStatusRes.ProtoReflect.Descriptor instead.
+func (*GetCommitStatusRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCommitStatusRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetCommitStatusRes) GetStatus() CommitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommitStatus_COMMIT_STATUS_UNSPECIFIED
+}
+
+func (x *GetCommitStatusRes) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCommitStatusRes) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetCommitStatusRes) GetErrorCode() proto.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return proto.ErrorCode(0)
+}
+
+// ReleaseRes represents the response to release hold
+type ReleaseRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ReleaseStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=inventory.v2.ReleaseStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRes) Reset() {
+	*x = ReleaseRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRes) ProtoMessage() {}
+
+func (x *ReleaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRes.ProtoReflect.Descriptor instead.
+func (*ReleaseRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseRes) GetStatus() ReleaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReleaseStatus_RELEASE_STATUS_UNSPECIFIED
+}
+
+// HoldRes represents the response to a seat hold
+type HoldRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SEAT_STATUS_HOLD
+	Status    proto.SeatStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Times the hold can still be extended by ExtendHold or by holding the seats again
+	ExtensionsRemaining int32 `protobuf:"varint,3,opt,name=extensions_remaining,json=extensionsRemaining,proto3" json:"extensions_remaining,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HoldRes) Reset() {
+	*x = HoldRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRes) ProtoMessage() {}
+
+func (x *HoldRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRes.ProtoReflect.Descriptor instead.
+func (*HoldRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HoldRes) GetStatus() proto.SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return proto.SeatStatus(0)
+}
+
+func (x *HoldRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *HoldRes) GetExtensionsRemaining() int32 {
+	if x != nil {
+		return x.ExtensionsRemaining
+	}
+	return 0
+}
+
+// SwapSeatsRes represents the response to a seat swap
+type SwapSeatsRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SEAT_STATUS_HOLD or SEAT_STATUS_SOLD: the status of the acquired seats
+	Status proto.SeatStatus `protobuf:"varint,1,opt,name=status,proto3,enum=inventory.v1.SeatStatus" json:"status,omitempty"`
+	// Lines of the acquired seats, with their prices
+	Lines []*proto.OrderLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Expiry of the hold on the acquired seats, when held
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapSeatsRes) Reset() {
+	*x = SwapSeatsRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapSeatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsRes) ProtoMessage() {}
+
+func (x *SwapSeatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsRes.ProtoReflect.Descriptor instead.
+func (*SwapSeatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SwapSeatsRes) GetStatus() proto.SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return proto.SeatStatus(0)
+}
+
+func (x *SwapSeatsRes) GetLines() []*proto.OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *SwapSeatsRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// MaterializeSeasonRes represents the response to materializing a performance
+type MaterializeSeasonRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Order the performance was sold as; the same order when it was already materialized
+	OrderId       string       `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        CommitStatus `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v2.CommitStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterializeSeasonRes) Reset() {
+	*x = MaterializeSeasonRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterializeSeasonRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeSeasonRes) ProtoMessage() {}
+
+func (x *MaterializeSeasonRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeSeasonRes.ProtoReflect.Descriptor instead.
+func (*MaterializeSeasonRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaterializeSeasonRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *MaterializeSeasonRes) GetStatus() CommitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommitStatus_COMMIT_STATUS_UNSPECIFIED
+}
+
+// GetReservationStatusRes represents what a reservation ended up with. Seats are read
+// from an index that lags writes by up to about a second.
+type GetReservationStatusRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.v2.ReservationStatus" json:"status,omitempty"`
+	// Order of a committed reservation; empty if its commit record has already expired
+	OrderId       string                   `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	HeldSeats     []*proto.ReservationSeat `protobuf:"bytes,4,rep,name=held_seats,json=heldSeats,proto3" json:"held_seats,omitempty"`
+	SoldSeats     []*proto.ReservationSeat `protobuf:"bytes,5,rep,name=sold_seats,json=soldSeats,proto3" json:"sold_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusRes) Reset() {
+	*x = GetReservationStatusRes{}
+	mi := &file_proto_v2_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusRes) ProtoMessage() {}
+
+func (x *GetReservationStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusRes.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusRes) Descriptor() ([]byte, []int) {
+	return file_proto_v2_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReservationStatusRes) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRes) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *GetReservationStatusRes) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRes) GetHeldSeats() []*proto.ReservationSeat {
+	if x != nil {
+		return x.HeldSeats
+	}
+	return nil
+}
+
+func (x *GetReservationStatusRes) GetSoldSeats() []*proto.ReservationSeat {
+	if x != nil {
+		return x.SoldSeats
+	}
+	return nil
+}
+
+var File_proto_v2_inventory_proto protoreflect.FileDescriptor
+
+const file_proto_v2_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/v2/inventory.proto\x12\finventory.v2\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15proto/inventory.proto\"\xae\x01\n" +
+	"\tCommitRes\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.inventory.v2.CommitStatusR\x06status\x12#\n" +
+	"\rcommitted_qty\x18\x03 \x01(\x05R\fcommittedQty\x12-\n" +
+	"\x05lines\x18\x04 \x03(\v2\x17.inventory.v1.OrderLineR\x05lines\"\xec\x01\n" +
+	"\x12GetCommitStatusRes\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.inventory.v2.CommitStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x0e2\x17.inventory.v1.ErrorCodeR\terrorCode\"A\n" +
+	"\n" +
+	"ReleaseRes\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.inventory.v2.ReleaseStatusR\x06status\"\xa9\x01\n" +
+	"\aHoldRes\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x121\n" +
+	"\x14extensions_remaining\x18\x03 \x01(\x05R\x13extensionsRemaining\"\xaa\x01\n" +
+	"\fSwapSeatsRes\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.inventory.v1.SeatStatusR\x06status\x12-\n" +
+	"\x05lines\x18\x02 \x03(\v2\x17.inventory.v1.OrderLineR\x05lines\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"e\n" +
+	"\x14MaterializeSeasonRes\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.inventory.v2.CommitStatusR\x06status\"\x90\x02\n" +
+	"\x17GetReservationStatusRes\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.inventory.v2.ReservationStatusR\x06status\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12<\n" +
+	"\n" +
+	"held_seats\x18\x04 \x03(\v2\x1d.inventory.v1.ReservationSeatR\theldSeats\x12<\n" +
+	"\n" +
+	"sold_seats\x18\x05 \x03(\v2\x1d.inventory.v1.ReservationSeatR\tsoldSeats*\x7f\n" +
+	"\fCommitStatus\x12\x1d\n" +
+	"\x19COMMIT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15COMMIT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17COMMIT_STATUS_CONFIRMED\x10\x02\x12\x18\n" +
+	"\x14COMMIT_STATUS_FAILED\x10\x03*L\n" +
+	"\rReleaseStatus\x12\x1e\n" +
+	"\x1aRELEASE_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17RELEASE_STATUS_RELEASED\x10\x01*\x97\x01\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17RESERVATION_STATUS_HELD\x10\x01\x12 \n" +
+	"\x1cRESERVATION_STATUS_COMMITTED\x10\x02\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x032\xbd\x05\n" +
+	"\tInventory\x12E\n" +
+	"\x11CommitReservation\x12\x17.inventory.v1.CommitReq\x1a\x17.inventory.v2.CommitRes\x12J\n" +
+	"\x16CommitReservationAsync\x12\x17.inventory.v1.CommitReq\x1a\x17.inventory.v2.CommitRes\x12U\n" +
+	"\x0fGetCommitStatus\x12 .inventory.v1.GetCommitStatusReq\x1a .inventory.v2.GetCommitStatusRes\x12A\n" +
+	"\vReleaseHold\x12\x18.inventory.v1.ReleaseReq\x1a\x18.inventory.v2.ReleaseRes\x129\n" +
+	"\tHoldSeats\x12\x15.inventory.v1.HoldReq\x1a\x15.inventory.v2.HoldRes\x12@\n" +
+	"\n" +
+	"ExtendHold\x12\x1b.inventory.v1.ExtendHoldReq\x1a\x15.inventory.v2.HoldRes\x12C\n" +
+	"\tSwapSeats\x12\x1a.inventory.v1.SwapSeatsReq\x1a\x1a.inventory.v2.SwapSeatsRes\x12[\n" +
+	"\x11MaterializeSeason\x12\".inventory.v1.MaterializeSeasonReq\x1a\".inventory.v2.MaterializeSeasonRes\x12d\n" +
+	"\x14GetReservationStatus\x12%.inventory.v1.GetReservationStatusReq\x1a%.inventory.v2.GetReservationStatusResB<Z:github.com/traffictacos/inventory-api/proto/v2;inventoryv2b\x06proto3"
+
+var (
+	file_proto_v2_inventory_proto_rawDescOnce sync.Once
+	file_proto_v2_inventory_proto_rawDescData []byte
+)
+
+func file_proto_v2_inventory_proto_rawDescGZIP() []byte {
+	file_proto_v2_inventory_proto_rawDescOnce.Do(func() {
+		file_proto_v2_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v2_inventory_proto_rawDesc), len(file_proto_v2_inventory_proto_rawDesc)))
+	})
+	return file_proto_v2_inventory_proto_rawDescData
+}
+
+var file_proto_v2_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_v2_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_v2_inventory_proto_goTypes = []any{
+	(CommitStatus)(0),                     // 0: inventory.v2.CommitStatus
+	(ReleaseStatus)(0),                    // 1: inventory.v2.ReleaseStatus
+	(ReservationStatus)(0),                // 2: inventory.v2.ReservationStatus
+	(*CommitRes)(nil),                     // 3: inventory.v2.CommitRes
+	(*GetCommitStatusRes)(nil),            // 4: inventory.v2.GetCommitStatusRes
+	(*ReleaseRes)(nil),                    // 5: inventory.v2.ReleaseRes
+	(*HoldRes)(nil),                       // 6: inventory.v2.HoldRes
+	(*SwapSeatsRes)(nil),                  // 7: inventory.v2.SwapSeatsRes
+	(*MaterializeSeasonRes)(nil),          // 8: inventory.v2.MaterializeSeasonRes
+	(*GetReservationStatusRes)(nil),       // 9: inventory.v2.GetReservationStatusRes
+	(*proto.OrderLine)(nil),               // 10: inventory.v1.OrderLine
+	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+	(proto.ErrorCode)(0),                  // 12: inventory.v1.ErrorCode
+	(proto.SeatStatus)(0),                 // 13: inventory.v1.SeatStatus
+	(*proto.ReservationSeat)(nil),         // 14: inventory.v1.ReservationSeat
+	(*proto.CommitReq)(nil),               // 15: inventory.v1.CommitReq
+	(*proto.GetCommitStatusReq)(nil),      // 16: inventory.v1.GetCommitStatusReq
+	(*proto.ReleaseReq)(nil),              // 17: inventory.v1.ReleaseReq
+	(*proto.HoldReq)(nil),                 // 18: inventory.v1.HoldReq
+	(*proto.ExtendHoldReq)(nil),           // 19: inventory.v1.ExtendHoldReq
+	(*proto.SwapSeatsReq)(nil),            // 20: inventory.v1.SwapSeatsReq
+	(*proto.MaterializeSeasonReq)(nil),    // 21: inventory.v1.MaterializeSeasonReq
+	(*proto.GetReservationStatusReq)(nil), // 22: inventory.v1.GetReservationStatusReq
+}
+var file_proto_v2_inventory_proto_depIdxs = []int32{
+	0,  // 0: inventory.v2.CommitRes.status:type_name -> inventory.v2.CommitStatus
+	10, // 1: inventory.v2.CommitRes.lines:type_name -> inventory.v1.OrderLine
+	0,  // 2: inventory.v2.GetCommitStatusRes.status:type_name -> inventory.v2.CommitStatus
+	11, // 3: inventory.v2.GetCommitStatusRes.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 4: inventory.v2.GetCommitStatusRes.error_code:type_name -> inventory.v1.ErrorCode
+	1,  // 5: inventory.v2.ReleaseRes.status:type_name -> inventory.v2.ReleaseStatus
+	13, // 6: inventory.v2.HoldRes.status:type_name -> inventory.v1.SeatStatus
+	11, // 7: inventory.v2.HoldRes.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 8: inventory.v2.SwapSeatsRes.status:type_name -> inventory.v1.SeatStatus
+	10, // 9: inventory.v2.SwapSeatsRes.lines:type_name -> inventory.v1.OrderLine
+	11, // 10: inventory.v2.SwapSeatsRes.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: inventory.v2.MaterializeSeasonRes.status:type_name -> inventory.v2.CommitStatus
+	2,  // 12: inventory.v2.GetReservationStatusRes.status:type_name -> inventory.v2.ReservationStatus
+	14, // 13: inventory.v2.GetReservationStatusRes.held_seats:type_name -> inventory.v1.ReservationSeat
+	14, // 14: inventory.v2.GetReservationStatusRes.sold_seats:type_name -> inventory.v1.ReservationSeat
+	15, // 15: inventory.v2.Inventory.CommitReservation:input_type -> inventory.v1.CommitReq
+	15, // 16: inventory.v2.Inventory.CommitReservationAsync:input_type -> inventory.v1.CommitReq
+	16, // 17: inventory.v2.Inventory.GetCommitStatus:input_type -> inventory.v1.GetCommitStatusReq
+	17, // 18: inventory.v2.Inventory.ReleaseHold:input_type -> inventory.v1.ReleaseReq
+	18, // 19: inventory.v2.Inventory.HoldSeats:input_type -> inventory.v1.HoldReq
+	19, // 20: inventory.v2.Inventory.ExtendHold:input_type -> inventory.v1.ExtendHoldReq
+	20, // 21: inventory.v2.Inventory.SwapSeats:input_type -> inventory.v1.SwapSeatsReq
+	21, // 22: inventory.v2.Inventory.MaterializeSeason:input_type -> inventory.v1.MaterializeSeasonReq
+	22, // 23: inventory.v2.Inventory.GetReservationStatus:input_type -> inventory.v1.GetReservationStatusReq
+	3,  // 24: inventory.v2.Inventory.CommitReservation:output_type -> inventory.v2.CommitRes
+	3,  // 25: inventory.v2.Inventory.CommitReservationAsync:output_type -> inventory.v2.CommitRes
+	4,  // 26: inventory.v2.Inventory.GetCommitStatus:output_type -> inventory.v2.GetCommitStatusRes
+	5,  // 27: inventory.v2.Inventory.ReleaseHold:output_type -> inventory.v2.ReleaseRes
+	6,  // 28: inventory.v2.Inventory.HoldSeats:output_type -> inventory.v2.HoldRes
+	6,  // 29: inventory.v2.Inventory.ExtendHold:output_type -> inventory.v2.HoldRes
+	7,  // 30: inventory.v2.Inventory.SwapSeats:output_type -> inventory.v2.SwapSeatsRes
+	8,  // 31: inventory.v2.Inventory.MaterializeSeason:output_type -> inventory.v2.MaterializeSeasonRes
+	9,  // 32: inventory.v2.Inventory.GetReservationStatus:output_type -> inventory.v2.GetReservationStatusRes
+	24, // [24:33] is the sub-list for method output_type
+	15, // [15:24] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_v2_inventory_proto_init() }
+func file_proto_v2_inventory_proto_init() {
+	if File_proto_v2_inventory_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v2_inventory_proto_rawDesc), len(file_proto_v2_inventory_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v2_inventory_proto_goTypes,
+		DependencyIndexes: file_proto_v2_inventory_proto_depIdxs,
+		EnumInfos:         file_proto_v2_inventory_proto_enumTypes,
+		MessageInfos:      file_proto_v2_inventory_proto_msgTypes,
+	}.Build()
+	File_proto_v2_inventory_proto = out.File
+	file_proto_v2_inventory_proto_goTypes = nil
+	file_proto_v2_inventory_proto_depIdxs = nil
+}
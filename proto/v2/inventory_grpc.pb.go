@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: proto/v2/inventory.proto
+
+package inventoryv2
+
+import (
+	context "context"
+	proto "github.com/traffictacos/inventory-api/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Inventory_CommitReservation_FullMethodName      = "/inventory.v2.Inventory/CommitReservation"
+	Inventory_CommitReservationAsync_FullMethodName = "/inventory.v2.Inventory/CommitReservationAsync"
+	Inventory_GetCommitStatus_FullMethodName        = "/inventory.v2.Inventory/GetCommitStatus"
+	Inventory_ReleaseHold_FullMethodName            = "/inventory.v2.Inventory/ReleaseHold"
+	Inventory_HoldSeats_FullMethodName              = "/inventory.v2.Inventory/HoldSeats"
+	Inventory_ExtendHold_FullMethodName             = "/inventory.v2.Inventory/ExtendHold"
+	Inventory_SwapSeats_FullMethodName              = "/inventory.v2.Inventory/SwapSeats"
+	Inventory_MaterializeSeason_FullMethodName      = "/inventory.v2.Inventory/MaterializeSeason"
+	Inventory_GetReservationStatus_FullMethodName   = "/inventory.v2.Inventory/GetReservationStatus"
+)
+
+// InventoryClient is the client API for Inventory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Inventory v2 serves the reservation RPCs of inventory.v1.Inventory with enum statuses
+// instead of free-form strings. Requests are the v1 messages; both versions are served
+// during the migration and behave the same. RPCs without a status stay on v1 only.
+type InventoryClient interface {
+	// CommitReservation commits a reservation by reducing inventory
+	CommitReservation(ctx context.Context, in *proto.CommitReq, opts ...grpc.CallOption) (*CommitRes, error)
+	// CommitReservationAsync queues a commit and returns its order_id with status
+	// COMMIT_STATUS_PENDING (or COMMIT_STATUS_CONFIRMED if the reservation was already
+	// committed). Poll GetCommitStatus for the outcome.
+	CommitReservationAsync(ctx context.Context, in *proto.CommitReq, opts ...grpc.CallOption) (*CommitRes, error)
+	// GetCommitStatus returns the status of an asynchronous commit
+	GetCommitStatus(ctx context.Context, in *proto.GetCommitStatusReq, opts ...grpc.CallOption) (*GetCommitStatusRes, error)
+	// ReleaseHold releases a hold on inventory (idempotent operation)
+	ReleaseHold(ctx context.Context, in *proto.ReleaseReq, opts ...grpc.CallOption) (*ReleaseRes, error)
+	// HoldSeats places a time-limited hold on seats for a reservation
+	HoldSeats(ctx context.Context, in *proto.HoldReq, opts ...grpc.CallOption) (*HoldRes, error)
+	// ExtendHold moves the expiry of a reservation's live hold, counting as one extension
+	ExtendHold(ctx context.Context, in *proto.ExtendHoldReq, opts ...grpc.CallOption) (*HoldRes, error)
+	// SwapSeats exchanges seats a reservation holds or bought for replacement seats
+	SwapSeats(ctx context.Context, in *proto.SwapSeatsReq, opts ...grpc.CallOption) (*SwapSeatsRes, error)
+	// MaterializeSeason sells the allocated seats of one performance under an order
+	MaterializeSeason(ctx context.Context, in *proto.MaterializeSeasonReq, opts ...grpc.CallOption) (*MaterializeSeasonRes, error)
+	// GetReservationStatus reports what a reservation ended up with
+	GetReservationStatus(ctx context.Context, in *proto.GetReservationStatusReq, opts ...grpc.CallOption) (*GetReservationStatusRes, error)
+}
+
+type inventoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryClient(cc grpc.ClientConnInterface) InventoryClient {
+	return &inventoryClient{cc}
+}
+
+func (c *inventoryClient) CommitReservation(ctx context.Context, in *proto.CommitReq, opts ...grpc.CallOption) (*CommitRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitRes)
+	err := c.cc.Invoke(ctx, Inventory_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CommitReservationAsync(ctx context.Context, in *proto.CommitReq, opts ...grpc.CallOption) (*CommitRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitRes)
+	err := c.cc.Invoke(ctx, Inventory_CommitReservationAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetCommitStatus(ctx context.Context, in *proto.GetCommitStatusReq, opts ...grpc.CallOption) (*GetCommitStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitStatusRes)
+	err := c.cc.Invoke(ctx, Inventory_GetCommitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ReleaseHold(ctx context.Context, in *proto.ReleaseReq, opts ...grpc.CallOption) (*ReleaseRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseRes)
+	err := c.cc.Invoke(ctx, Inventory_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) HoldSeats(ctx context.Context, in *proto.HoldReq, opts ...grpc.CallOption) (*HoldRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldRes)
+	err := c.cc.Invoke(ctx, Inventory_HoldSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ExtendHold(ctx context.Context, in *proto.ExtendHoldReq, opts ...grpc.CallOption) (*HoldRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldRes)
+	err := c.cc.Invoke(ctx, Inventory_ExtendHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SwapSeats(ctx context.Context, in *proto.SwapSeatsReq, opts ...grpc.CallOption) (*SwapSeatsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapSeatsRes)
+	err := c.cc.Invoke(ctx, Inventory_SwapSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) MaterializeSeason(ctx context.Context, in *proto.MaterializeSeasonReq, opts ...grpc.CallOption) (*MaterializeSeasonRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterializeSeasonRes)
+	err := c.cc.Invoke(ctx, Inventory_MaterializeSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) GetReservationStatus(ctx context.Context, in *proto.GetReservationStatusReq, opts ...grpc.CallOption) (*GetReservationStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationStatusRes)
+	err := c.cc.Invoke(ctx, Inventory_GetReservationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServer is the server API for Inventory service.
+// All implementations must embed UnimplementedInventoryServer
+// for forward compatibility.
+//
+// Inventory v2 serves the reservation RPCs of inventory.v1.Inventory with enum statuses
+// instead of free-form strings. Requests are the v1 messages; both versions are served
+// during the migration and behave the same. RPCs without a status stay on v1 only.
+type InventoryServer interface {
+	// CommitReservation commits a reservation by reducing inventory
+	CommitReservation(context.Context, *proto.CommitReq) (*CommitRes, error)
+	// CommitReservationAsync queues a commit and returns its order_id with status
+	// COMMIT_STATUS_PENDING (or COMMIT_STATUS_CONFIRMED if the reservation was already
+	// committed). Poll GetCommitStatus for the outcome.
+	CommitReservationAsync(context.Context, *proto.CommitReq) (*CommitRes, error)
+	// GetCommitStatus returns the status of an asynchronous commit
+	GetCommitStatus(context.Context, *proto.GetCommitStatusReq) (*GetCommitStatusRes, error)
+	// ReleaseHold releases a hold on inventory (idempotent operation)
+	ReleaseHold(context.Context, *proto.ReleaseReq) (*ReleaseRes, error)
+	// HoldSeats places a time-limited hold on seats for a reservation
+	HoldSeats(context.Context, *proto.HoldReq) (*HoldRes, error)
+	// ExtendHold moves the expiry of a reservation's live hold, counting as one extension
+	ExtendHold(context.Context, *proto.ExtendHoldReq) (*HoldRes, error)
+	// SwapSeats exchanges seats a reservation holds or bought for replacement seats
+	SwapSeats(context.Context, *proto.SwapSeatsReq) (*SwapSeatsRes, error)
+	// MaterializeSeason sells the allocated seats of one performance under an order
+	MaterializeSeason(context.Context, *proto.MaterializeSeasonReq) (*MaterializeSeasonRes, error)
+	// GetReservationStatus reports what a reservation ended up with
+	GetReservationStatus(context.Context, *proto.GetReservationStatusReq) (*GetReservationStatusRes, error)
+	mustEmbedUnimplementedInventoryServer()
+}
+
+// UnimplementedInventoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServer struct{}
+
+func (UnimplementedInventoryServer) CommitReservation(context.Context, *proto.CommitReq) (*CommitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedInventoryServer) CommitReservationAsync(context.Context, *proto.CommitReq) (*CommitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservationAsync not implemented")
+}
+func (UnimplementedInventoryServer) GetCommitStatus(context.Context, *proto.GetCommitStatusReq) (*GetCommitStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitStatus not implemented")
+}
+func (UnimplementedInventoryServer) ReleaseHold(context.Context, *proto.ReleaseReq) (*ReleaseRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedInventoryServer) HoldSeats(context.Context, *proto.HoldReq) (*HoldRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeats not implemented")
+}
+func (UnimplementedInventoryServer) ExtendHold(context.Context, *proto.ExtendHoldReq) (*HoldRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (UnimplementedInventoryServer) SwapSeats(context.Context, *proto.SwapSeatsReq) (*SwapSeatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
+func (UnimplementedInventoryServer) MaterializeSeason(context.Context, *proto.MaterializeSeasonReq) (*MaterializeSeasonRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaterializeSeason not implemented")
+}
+func (UnimplementedInventoryServer) GetReservationStatus(context.Context, *proto.GetReservationStatusReq) (*GetReservationStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationStatus not implemented")
+}
+func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
+func (UnimplementedInventoryServer) testEmbeddedByValue()                   {}
+
+// UnsafeInventoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServer will
+// result in compilation errors.
+type UnsafeInventoryServer interface {
+	mustEmbedUnimplementedInventoryServer()
+}
+
+func RegisterInventoryServer(s grpc.ServiceRegistrar, srv InventoryServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Inventory_ServiceDesc, srv)
+}
+
+func _Inventory_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.CommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CommitReservation(ctx, req.(*proto.CommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CommitReservationAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.CommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CommitReservationAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_CommitReservationAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CommitReservationAsync(ctx, req.(*proto.CommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetCommitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.GetCommitStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetCommitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetCommitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetCommitStatus(ctx, req.(*proto.GetCommitStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ReleaseHold(ctx, req.(*proto.ReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_HoldSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.HoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).HoldSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_HoldSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).HoldSeats(ctx, req.(*proto.HoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ExtendHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_ExtendHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ExtendHold(ctx, req.(*proto.ExtendHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SwapSeatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_SwapSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SwapSeats(ctx, req.(*proto.SwapSeatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_MaterializeSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.MaterializeSeasonReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).MaterializeSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_MaterializeSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).MaterializeSeason(ctx, req.(*proto.MaterializeSeasonReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_GetReservationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.GetReservationStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).GetReservationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_GetReservationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).GetReservationStatus(ctx, req.(*proto.GetReservationStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Inventory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "inventory.v2.Inventory",
+	HandlerType: (*InventoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommitReservation",
+			Handler:    _Inventory_CommitReservation_Handler,
+		},
+		{
+			MethodName: "CommitReservationAsync",
+			Handler:    _Inventory_CommitReservationAsync_Handler,
+		},
+		{
+			MethodName: "GetCommitStatus",
+			Handler:    _Inventory_GetCommitStatus_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _Inventory_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "HoldSeats",
+			Handler:    _Inventory_HoldSeats_Handler,
+		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _Inventory_ExtendHold_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _Inventory_SwapSeats_Handler,
+		},
+		{
+			MethodName: "MaterializeSeason",
+			Handler:    _Inventory_MaterializeSeason_Handler,
+		},
+		{
+			MethodName: "GetReservationStatus",
+			Handler:    _Inventory_GetReservationStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v2/inventory.proto",
+}
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: proto/validate.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldRules are the constraints of a request field. The server rejects requests that
+// break them with INVALID_ARGUMENT before they reach the service. Rules that don't apply
+// to a field's type are ignored; rules of a repeated field apply to its length, except
+// max_len and pattern, which apply to each string.
+type FieldRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The field must be set: a non-empty string or list, or a non-zero number
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// Length bounds of strings, in bytes; 0 leaves the bound open
+	MinLen uint32 `protobuf:"varint,2,opt,name=min_len,json=minLen,proto3" json:"min_len,omitempty"`
+	MaxLen uint32 `protobuf:"varint,3,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`
+	// RE2 expression non-empty strings must match
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Bounds of integers
+	Gte *int64 `protobuf:"varint,5,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lte *int64 `protobuf:"varint,6,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	// Length bounds of repeated fields; 0 leaves the bound open
+	MinItems      uint32 `protobuf:"varint,7,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems      uint32 `protobuf:"varint,8,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_proto_validate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_proto_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetMinLen() uint32 {
+	if x != nil {
+		return x.MinLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMaxLen() uint32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *FieldRules) GetGte() int64 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *FieldRules) GetLte() int64 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMinItems() uint32 {
+	if x != nil {
+		return x.MinItems
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMaxItems() uint32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+var file_proto_validate_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         51234,
+		Name:          "inventory.v1.rules",
+		Tag:           "bytes,51234,opt,name=rules",
+		Filename:      "proto/validate.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Constraints of the field, checked by the server's validation interceptor
+	//
+	// optional inventory.v1.FieldRules rules = 51234;
+	E_Rules = &file_proto_validate_proto_extTypes[0]
+)
+
+var File_proto_validate_proto protoreflect.FileDescriptor
+
+const file_proto_validate_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/validate.proto\x12\finventory.v1\x1a google/protobuf/descriptor.proto\"\xec\x01\n" +
+	"\n" +
+	"FieldRules\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x17\n" +
+	"\amin_len\x18\x02 \x01(\rR\x06minLen\x12\x17\n" +
+	"\amax_len\x18\x03 \x01(\rR\x06maxLen\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x15\n" +
+	"\x03gte\x18\x05 \x01(\x03H\x00R\x03gte\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x06 \x01(\x03H\x01R\x03lte\x88\x01\x01\x12\x1b\n" +
+	"\tmin_items\x18\a \x01(\rR\bminItems\x12\x1b\n" +
+	"\tmax_items\x18\b \x01(\rR\bmaxItemsB\x06\n" +
+	"\x04_gteB\x06\n" +
+	"\x04_lte:O\n" +
+	"\x05rules\x12\x1d.google.protobuf.FieldOptions\x18\xa2\x90\x03 \x01(\v2\x18.inventory.v1.FieldRulesR\x05rulesB-Z+github.com/traffictacos/inventory-api/protob\x06proto3"
+
+var (
+	file_proto_validate_proto_rawDescOnce sync.Once
+	file_proto_validate_proto_rawDescData []byte
+)
+
+func file_proto_validate_proto_rawDescGZIP() []byte {
+	file_proto_validate_proto_rawDescOnce.Do(func() {
+		file_proto_validate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_validate_proto_rawDesc), len(file_proto_validate_proto_rawDesc)))
+	})
+	return file_proto_validate_proto_rawDescData
+}
+
+var file_proto_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_validate_proto_goTypes = []any{
+	(*FieldRules)(nil),                // 0: inventory.v1.FieldRules
+	(*descriptorpb.FieldOptions)(nil), // 1: google.protobuf.FieldOptions
+}
+var file_proto_validate_proto_depIdxs = []int32{
+	1, // 0: inventory.v1.rules:extendee -> google.protobuf.FieldOptions
+	0, // 1: inventory.v1.rules:type_name -> inventory.v1.FieldRules
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_validate_proto_init() }
+func file_proto_validate_proto_init() {
+	if File_proto_validate_proto != nil {
+		return
+	}
+	file_proto_validate_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_validate_proto_rawDesc), len(file_proto_validate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_validate_proto_goTypes,
+		DependencyIndexes: file_proto_validate_proto_depIdxs,
+		MessageInfos:      file_proto_validate_proto_msgTypes,
+		ExtensionInfos:    file_proto_validate_proto_extTypes,
+	}.Build()
+	File_proto_validate_proto = out.File
+	file_proto_validate_proto_goTypes = nil
+	file_proto_validate_proto_depIdxs = nil
+}